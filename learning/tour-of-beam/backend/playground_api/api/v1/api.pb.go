@@ -45,6 +45,7 @@ const (
 	Sdk_SDK_GO          Sdk = 2
 	Sdk_SDK_PYTHON      Sdk = 3
 	Sdk_SDK_SCIO        Sdk = 4
+	Sdk_SDK_TYPESCRIPT  Sdk = 5
 )
 
 // Enum value maps for Sdk.
@@ -55,6 +56,7 @@ var (
 		2: "SDK_GO",
 		3: "SDK_PYTHON",
 		4: "SDK_SCIO",
+		5: "SDK_TYPESCRIPT",
 	}
 	Sdk_value = map[string]int32{
 		"SDK_UNSPECIFIED": 0,
@@ -62,6 +64,7 @@ var (
 		"SDK_GO":          2,
 		"SDK_PYTHON":      3,
 		"SDK_SCIO":        4,
+		"SDK_TYPESCRIPT":  5,
 	}
 )
 
@@ -108,6 +111,7 @@ const (
 	Status_STATUS_ERROR             Status = 10
 	Status_STATUS_RUN_TIMEOUT       Status = 11
 	Status_STATUS_CANCELED          Status = 12
+	Status_STATUS_QUEUED            Status = 13
 )
 
 // Enum value maps for Status.
@@ -126,6 +130,7 @@ var (
 		10: "STATUS_ERROR",
 		11: "STATUS_RUN_TIMEOUT",
 		12: "STATUS_CANCELED",
+		13: "STATUS_QUEUED",
 	}
 	Status_value = map[string]int32{
 		"STATUS_UNSPECIFIED":       0,
@@ -141,6 +146,7 @@ var (
 		"STATUS_ERROR":             10,
 		"STATUS_RUN_TIMEOUT":       11,
 		"STATUS_CANCELED":          12,
+		"STATUS_QUEUED":            13,
 	}
 )
 
@@ -280,6 +286,9 @@ type EmulatorType int32
 const (
 	EmulatorType_EMULATOR_TYPE_UNSPECIFIED EmulatorType = 0
 	EmulatorType_EMULATOR_TYPE_KAFKA       EmulatorType = 1
+	EmulatorType_EMULATOR_TYPE_PUBSUB      EmulatorType = 2
+	EmulatorType_EMULATOR_TYPE_GCS         EmulatorType = 3
+	EmulatorType_EMULATOR_TYPE_JDBC        EmulatorType = 4
 )
 
 // Enum value maps for EmulatorType.
@@ -287,10 +296,16 @@ var (
 	EmulatorType_name = map[int32]string{
 		0: "EMULATOR_TYPE_UNSPECIFIED",
 		1: "EMULATOR_TYPE_KAFKA",
+		2: "EMULATOR_TYPE_PUBSUB",
+		3: "EMULATOR_TYPE_GCS",
+		4: "EMULATOR_TYPE_JDBC",
 	}
 	EmulatorType_value = map[string]int32{
 		"EMULATOR_TYPE_UNSPECIFIED": 0,
 		"EMULATOR_TYPE_KAFKA":       1,
+		"EMULATOR_TYPE_PUBSUB":      2,
+		"EMULATOR_TYPE_GCS":         3,
+		"EMULATOR_TYPE_JDBC":        4,
 	}
 )
 
@@ -1185,8 +1200,8 @@ func (x *GetLogsResponse) GetOutput() string {
 	return ""
 }
 
-// GetGraphRequest contains information of the pipeline uuid.
-type GetGraphRequest struct {
+// StreamRunOutputRequest contains information of the pipeline uuid.
+type StreamRunOutputRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1194,8 +1209,8 @@ type GetGraphRequest struct {
 	PipelineUuid string `protobuf:"bytes,1,opt,name=pipeline_uuid,json=pipelineUuid,proto3" json:"pipeline_uuid,omitempty"`
 }
 
-func (x *GetGraphRequest) Reset() {
-	*x = GetGraphRequest{}
+func (x *StreamRunOutputRequest) Reset() {
+	*x = StreamRunOutputRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_api_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1203,13 +1218,13 @@ func (x *GetGraphRequest) Reset() {
 	}
 }
 
-func (x *GetGraphRequest) String() string {
+func (x *StreamRunOutputRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGraphRequest) ProtoMessage() {}
+func (*StreamRunOutputRequest) ProtoMessage() {}
 
-func (x *GetGraphRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamRunOutputRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_api_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1221,29 +1236,29 @@ func (x *GetGraphRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGraphRequest.ProtoReflect.Descriptor instead.
-func (*GetGraphRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamRunOutputRequest.ProtoReflect.Descriptor instead.
+func (*StreamRunOutputRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_api_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetGraphRequest) GetPipelineUuid() string {
+func (x *StreamRunOutputRequest) GetPipelineUuid() string {
 	if x != nil {
 		return x.PipelineUuid
 	}
 	return ""
 }
 
-// GetGraphResponse represents the string representation of pipeline execution graph in DOT format.
-type GetGraphResponse struct {
+// StreamRunOutputResponse represents a chunk of the output of the executed code.
+type StreamRunOutputResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Graph string `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
+	Output string `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
 }
 
-func (x *GetGraphResponse) Reset() {
-	*x = GetGraphResponse{}
+func (x *StreamRunOutputResponse) Reset() {
+	*x = StreamRunOutputResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_api_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1251,13 +1266,13 @@ func (x *GetGraphResponse) Reset() {
 	}
 }
 
-func (x *GetGraphResponse) String() string {
+func (x *StreamRunOutputResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGraphResponse) ProtoMessage() {}
+func (*StreamRunOutputResponse) ProtoMessage() {}
 
-func (x *GetGraphResponse) ProtoReflect() protoreflect.Message {
+func (x *StreamRunOutputResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_api_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1269,20 +1284,20 @@ func (x *GetGraphResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGraphResponse.ProtoReflect.Descriptor instead.
-func (*GetGraphResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamRunOutputResponse.ProtoReflect.Descriptor instead.
+func (*StreamRunOutputResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_api_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetGraphResponse) GetGraph() string {
+func (x *StreamRunOutputResponse) GetOutput() string {
 	if x != nil {
-		return x.Graph
+		return x.Output
 	}
 	return ""
 }
 
-// CancelRequest request to cancel code processing
-type CancelRequest struct {
+// StreamLogsRequest contains information of the pipeline uuid.
+type StreamLogsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1290,8 +1305,8 @@ type CancelRequest struct {
 	PipelineUuid string `protobuf:"bytes,1,opt,name=pipeline_uuid,json=pipelineUuid,proto3" json:"pipeline_uuid,omitempty"`
 }
 
-func (x *CancelRequest) Reset() {
-	*x = CancelRequest{}
+func (x *StreamLogsRequest) Reset() {
+	*x = StreamLogsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_api_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1299,13 +1314,13 @@ func (x *CancelRequest) Reset() {
 	}
 }
 
-func (x *CancelRequest) String() string {
+func (x *StreamLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelRequest) ProtoMessage() {}
+func (*StreamLogsRequest) ProtoMessage() {}
 
-func (x *CancelRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamLogsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_api_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1317,27 +1332,29 @@ func (x *CancelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelRequest.ProtoReflect.Descriptor instead.
-func (*CancelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamLogsRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_api_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *CancelRequest) GetPipelineUuid() string {
+func (x *StreamLogsRequest) GetPipelineUuid() string {
 	if x != nil {
 		return x.PipelineUuid
 	}
 	return ""
 }
 
-// CancelResponse response for cancel request
-type CancelResponse struct {
+// StreamLogsResponse represents a chunk of the logs of the executed code.
+type StreamLogsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Output string `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
 }
 
-func (x *CancelResponse) Reset() {
-	*x = CancelResponse{}
+func (x *StreamLogsResponse) Reset() {
+	*x = StreamLogsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_api_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1345,13 +1362,13 @@ func (x *CancelResponse) Reset() {
 	}
 }
 
-func (x *CancelResponse) String() string {
+func (x *StreamLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelResponse) ProtoMessage() {}
+func (*StreamLogsResponse) ProtoMessage() {}
 
-func (x *CancelResponse) ProtoReflect() protoreflect.Message {
+func (x *StreamLogsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_api_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1363,40 +1380,29 @@ func (x *CancelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelResponse.ProtoReflect.Descriptor instead.
-func (*CancelResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamLogsResponse.ProtoReflect.Descriptor instead.
+func (*StreamLogsResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_api_proto_rawDescGZIP(), []int{20}
 }
 
-// PrecompiledObject represents one PrecompiledObject with its information
-type PrecompiledObject struct {
+func (x *StreamLogsResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+// StreamStatusRequest contains information of the pipeline uuid.
+type StreamStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CloudPath       string                `protobuf:"bytes,1,opt,name=cloud_path,json=cloudPath,proto3" json:"cloud_path,omitempty"`
-	Name            string                `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description     string                `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Type            PrecompiledObjectType `protobuf:"varint,4,opt,name=type,proto3,enum=api.v1.PrecompiledObjectType" json:"type,omitempty"`
-	PipelineOptions string                `protobuf:"bytes,5,opt,name=pipeline_options,json=pipelineOptions,proto3" json:"pipeline_options,omitempty"`
-	// TODO mark reserved after #24402 update sequence is done
-	Link           string     `protobuf:"bytes,6,opt,name=link,proto3" json:"link,omitempty"`
-	Multifile      bool       `protobuf:"varint,7,opt,name=multifile,proto3" json:"multifile,omitempty"`
-	ContextLine    int32      `protobuf:"varint,8,opt,name=context_line,json=contextLine,proto3" json:"context_line,omitempty"`
-	DefaultExample bool       `protobuf:"varint,9,opt,name=default_example,json=defaultExample,proto3" json:"default_example,omitempty"`
-	Sdk            Sdk        `protobuf:"varint,10,opt,name=sdk,proto3,enum=api.v1.Sdk" json:"sdk,omitempty"`
-	Complexity     Complexity `protobuf:"varint,11,opt,name=complexity,proto3,enum=api.v1.Complexity" json:"complexity,omitempty"`
-	Tags           []string   `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`
-	Datasets       []*Dataset `protobuf:"bytes,13,rep,name=datasets,proto3" json:"datasets,omitempty"`
-	// Link to the example in the Beam repository
-	UrlVcs      string `protobuf:"bytes,14,opt,name=url_vcs,json=urlVcs,proto3" json:"url_vcs,omitempty"`
-	UrlNotebook string `protobuf:"bytes,15,opt,name=url_notebook,json=urlNotebook,proto3" json:"url_notebook,omitempty"`
-	AlwaysRun   bool   `protobuf:"varint,16,opt,name=always_run,json=alwaysRun,proto3" json:"always_run,omitempty"`
-	NeverRun    bool   `protobuf:"varint,17,opt,name=never_run,json=neverRun,proto3" json:"never_run,omitempty"`
+	PipelineUuid string `protobuf:"bytes,1,opt,name=pipeline_uuid,json=pipelineUuid,proto3" json:"pipeline_uuid,omitempty"`
 }
 
-func (x *PrecompiledObject) Reset() {
-	*x = PrecompiledObject{}
+func (x *StreamStatusRequest) Reset() {
+	*x = StreamStatusRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_api_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1404,13 +1410,13 @@ func (x *PrecompiledObject) Reset() {
 	}
 }
 
-func (x *PrecompiledObject) String() string {
+func (x *StreamStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PrecompiledObject) ProtoMessage() {}
+func (*StreamStatusRequest) ProtoMessage() {}
 
-func (x *PrecompiledObject) ProtoReflect() protoreflect.Message {
+func (x *StreamStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_api_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1422,142 +1428,29 @@ func (x *PrecompiledObject) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PrecompiledObject.ProtoReflect.Descriptor instead.
-func (*PrecompiledObject) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamStatusRequest.ProtoReflect.Descriptor instead.
+func (*StreamStatusRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_api_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *PrecompiledObject) GetCloudPath() string {
-	if x != nil {
-		return x.CloudPath
-	}
-	return ""
-}
-
-func (x *PrecompiledObject) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *PrecompiledObject) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *PrecompiledObject) GetType() PrecompiledObjectType {
-	if x != nil {
-		return x.Type
-	}
-	return PrecompiledObjectType_PRECOMPILED_OBJECT_TYPE_UNSPECIFIED
-}
-
-func (x *PrecompiledObject) GetPipelineOptions() string {
-	if x != nil {
-		return x.PipelineOptions
-	}
-	return ""
-}
-
-func (x *PrecompiledObject) GetLink() string {
-	if x != nil {
-		return x.Link
-	}
-	return ""
-}
-
-func (x *PrecompiledObject) GetMultifile() bool {
-	if x != nil {
-		return x.Multifile
-	}
-	return false
-}
-
-func (x *PrecompiledObject) GetContextLine() int32 {
-	if x != nil {
-		return x.ContextLine
-	}
-	return 0
-}
-
-func (x *PrecompiledObject) GetDefaultExample() bool {
-	if x != nil {
-		return x.DefaultExample
-	}
-	return false
-}
-
-func (x *PrecompiledObject) GetSdk() Sdk {
-	if x != nil {
-		return x.Sdk
-	}
-	return Sdk_SDK_UNSPECIFIED
-}
-
-func (x *PrecompiledObject) GetComplexity() Complexity {
-	if x != nil {
-		return x.Complexity
-	}
-	return Complexity_COMPLEXITY_UNSPECIFIED
-}
-
-func (x *PrecompiledObject) GetTags() []string {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
-}
-
-func (x *PrecompiledObject) GetDatasets() []*Dataset {
-	if x != nil {
-		return x.Datasets
-	}
-	return nil
-}
-
-func (x *PrecompiledObject) GetUrlVcs() string {
-	if x != nil {
-		return x.UrlVcs
-	}
-	return ""
-}
-
-func (x *PrecompiledObject) GetUrlNotebook() string {
+func (x *StreamStatusRequest) GetPipelineUuid() string {
 	if x != nil {
-		return x.UrlNotebook
+		return x.PipelineUuid
 	}
 	return ""
 }
 
-func (x *PrecompiledObject) GetAlwaysRun() bool {
-	if x != nil {
-		return x.AlwaysRun
-	}
-	return false
-}
-
-func (x *PrecompiledObject) GetNeverRun() bool {
-	if x != nil {
-		return x.NeverRun
-	}
-	return false
-}
-
-// Categories represent the array of messages with sdk and categories at this sdk
-type Categories struct {
+// StreamStatusResponse represents a status of pipeline execution.
+type StreamStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sdk        Sdk                    `protobuf:"varint,1,opt,name=sdk,proto3,enum=api.v1.Sdk" json:"sdk,omitempty"`
-	Categories []*Categories_Category `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`
+	Status Status `protobuf:"varint,1,opt,name=status,proto3,enum=api.v1.Status" json:"status,omitempty"`
 }
 
-func (x *Categories) Reset() {
-	*x = Categories{}
+func (x *StreamStatusResponse) Reset() {
+	*x = StreamStatusResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_api_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1565,13 +1458,13 @@ func (x *Categories) Reset() {
 	}
 }
 
-func (x *Categories) String() string {
+func (x *StreamStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Categories) ProtoMessage() {}
+func (*StreamStatusResponse) ProtoMessage() {}
 
-func (x *Categories) ProtoReflect() protoreflect.Message {
+func (x *StreamStatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_api_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1583,37 +1476,29 @@ func (x *Categories) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Categories.ProtoReflect.Descriptor instead.
-func (*Categories) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamStatusResponse.ProtoReflect.Descriptor instead.
+func (*StreamStatusResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_api_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *Categories) GetSdk() Sdk {
-	if x != nil {
-		return x.Sdk
-	}
-	return Sdk_SDK_UNSPECIFIED
-}
-
-func (x *Categories) GetCategories() []*Categories_Category {
+func (x *StreamStatusResponse) GetStatus() Status {
 	if x != nil {
-		return x.Categories
+		return x.Status
 	}
-	return nil
+	return Status_STATUS_UNSPECIFIED
 }
 
-// GetPrecompiledObjectsRequest contains information of the needed PrecompiledObjects sdk and categories.
-type GetPrecompiledObjectsRequest struct {
+// GetGraphRequest contains information of the pipeline uuid.
+type GetGraphRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sdk      Sdk    `protobuf:"varint,1,opt,name=sdk,proto3,enum=api.v1.Sdk" json:"sdk,omitempty"`
-	Category string `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	PipelineUuid string `protobuf:"bytes,1,opt,name=pipeline_uuid,json=pipelineUuid,proto3" json:"pipeline_uuid,omitempty"`
 }
 
-func (x *GetPrecompiledObjectsRequest) Reset() {
-	*x = GetPrecompiledObjectsRequest{}
+func (x *GetGraphRequest) Reset() {
+	*x = GetGraphRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_api_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1621,13 +1506,13 @@ func (x *GetPrecompiledObjectsRequest) Reset() {
 	}
 }
 
-func (x *GetPrecompiledObjectsRequest) String() string {
+func (x *GetGraphRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPrecompiledObjectsRequest) ProtoMessage() {}
+func (*GetGraphRequest) ProtoMessage() {}
 
-func (x *GetPrecompiledObjectsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetGraphRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_api_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1639,36 +1524,29 @@ func (x *GetPrecompiledObjectsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPrecompiledObjectsRequest.ProtoReflect.Descriptor instead.
-func (*GetPrecompiledObjectsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetGraphRequest.ProtoReflect.Descriptor instead.
+func (*GetGraphRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_api_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetPrecompiledObjectsRequest) GetSdk() Sdk {
-	if x != nil {
-		return x.Sdk
-	}
-	return Sdk_SDK_UNSPECIFIED
-}
-
-func (x *GetPrecompiledObjectsRequest) GetCategory() string {
+func (x *GetGraphRequest) GetPipelineUuid() string {
 	if x != nil {
-		return x.Category
+		return x.PipelineUuid
 	}
 	return ""
 }
 
-// GetPrecompiledObjectRequest contains information of the needed PrecompiledObject.
-type GetPrecompiledObjectRequest struct {
+// GetGraphResponse represents the string representation of pipeline execution graph in DOT format.
+type GetGraphResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CloudPath string `protobuf:"bytes,1,opt,name=cloud_path,json=cloudPath,proto3" json:"cloud_path,omitempty"`
+	Graph string `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
 }
 
-func (x *GetPrecompiledObjectRequest) Reset() {
-	*x = GetPrecompiledObjectRequest{}
+func (x *GetGraphResponse) Reset() {
+	*x = GetGraphResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_api_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1676,13 +1554,13 @@ func (x *GetPrecompiledObjectRequest) Reset() {
 	}
 }
 
-func (x *GetPrecompiledObjectRequest) String() string {
+func (x *GetGraphResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPrecompiledObjectRequest) ProtoMessage() {}
+func (*GetGraphResponse) ProtoMessage() {}
 
-func (x *GetPrecompiledObjectRequest) ProtoReflect() protoreflect.Message {
+func (x *GetGraphResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_api_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1694,29 +1572,29 @@ func (x *GetPrecompiledObjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPrecompiledObjectRequest.ProtoReflect.Descriptor instead.
-func (*GetPrecompiledObjectRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetGraphResponse.ProtoReflect.Descriptor instead.
+func (*GetGraphResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_api_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetPrecompiledObjectRequest) GetCloudPath() string {
+func (x *GetGraphResponse) GetGraph() string {
 	if x != nil {
-		return x.CloudPath
+		return x.Graph
 	}
 	return ""
 }
 
-// GetPrecompiledObjectCodeRequest contains information of the PrecompiledObject uuid.
-type GetPrecompiledObjectCodeRequest struct {
+// GetRunMetricsRequest contains information of the pipeline uuid.
+type GetRunMetricsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CloudPath string `protobuf:"bytes,1,opt,name=cloud_path,json=cloudPath,proto3" json:"cloud_path,omitempty"`
+	PipelineUuid string `protobuf:"bytes,1,opt,name=pipeline_uuid,json=pipelineUuid,proto3" json:"pipeline_uuid,omitempty"`
 }
 
-func (x *GetPrecompiledObjectCodeRequest) Reset() {
-	*x = GetPrecompiledObjectCodeRequest{}
+func (x *GetRunMetricsRequest) Reset() {
+	*x = GetRunMetricsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_api_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1724,13 +1602,13 @@ func (x *GetPrecompiledObjectCodeRequest) Reset() {
 	}
 }
 
-func (x *GetPrecompiledObjectCodeRequest) String() string {
+func (x *GetRunMetricsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPrecompiledObjectCodeRequest) ProtoMessage() {}
+func (*GetRunMetricsRequest) ProtoMessage() {}
 
-func (x *GetPrecompiledObjectCodeRequest) ProtoReflect() protoreflect.Message {
+func (x *GetRunMetricsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_api_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1742,29 +1620,31 @@ func (x *GetPrecompiledObjectCodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPrecompiledObjectCodeRequest.ProtoReflect.Descriptor instead.
-func (*GetPrecompiledObjectCodeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRunMetricsRequest.ProtoReflect.Descriptor instead.
+func (*GetRunMetricsRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_api_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetPrecompiledObjectCodeRequest) GetCloudPath() string {
+func (x *GetRunMetricsRequest) GetPipelineUuid() string {
 	if x != nil {
-		return x.CloudPath
+		return x.PipelineUuid
 	}
 	return ""
 }
 
-// GetPrecompiledObjectOutputRequest contains information of the PrecompiledObject uuid.
-type GetPrecompiledObjectOutputRequest struct {
+// MetricKey identifies a metric by the step it was reported in, its namespace and its name.
+type MetricKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CloudPath string `protobuf:"bytes,1,opt,name=cloud_path,json=cloudPath,proto3" json:"cloud_path,omitempty"`
+	Step      string `protobuf:"bytes,1,opt,name=step,proto3" json:"step,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *GetPrecompiledObjectOutputRequest) Reset() {
-	*x = GetPrecompiledObjectOutputRequest{}
+func (x *MetricKey) Reset() {
+	*x = MetricKey{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_api_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1772,13 +1652,13 @@ func (x *GetPrecompiledObjectOutputRequest) Reset() {
 	}
 }
 
-func (x *GetPrecompiledObjectOutputRequest) String() string {
+func (x *MetricKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPrecompiledObjectOutputRequest) ProtoMessage() {}
+func (*MetricKey) ProtoMessage() {}
 
-func (x *GetPrecompiledObjectOutputRequest) ProtoReflect() protoreflect.Message {
+func (x *MetricKey) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_api_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1790,29 +1670,45 @@ func (x *GetPrecompiledObjectOutputRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPrecompiledObjectOutputRequest.ProtoReflect.Descriptor instead.
-func (*GetPrecompiledObjectOutputRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetricKey.ProtoReflect.Descriptor instead.
+func (*MetricKey) Descriptor() ([]byte, []int) {
 	return file_api_v1_api_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetPrecompiledObjectOutputRequest) GetCloudPath() string {
+func (x *MetricKey) GetStep() string {
 	if x != nil {
-		return x.CloudPath
+		return x.Step
 	}
 	return ""
 }
 
-// GetPrecompiledObjectLogsRequest contains information of the PrecompiledObject uuid.
-type GetPrecompiledObjectLogsRequest struct {
+func (x *MetricKey) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *MetricKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// CounterMetric represents the attempted and committed values of a counter.
+type CounterMetric struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CloudPath string `protobuf:"bytes,1,opt,name=cloud_path,json=cloudPath,proto3" json:"cloud_path,omitempty"`
+	Key       *MetricKey `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Attempted int64      `protobuf:"varint,2,opt,name=attempted,proto3" json:"attempted,omitempty"`
+	Committed int64      `protobuf:"varint,3,opt,name=committed,proto3" json:"committed,omitempty"`
 }
 
-func (x *GetPrecompiledObjectLogsRequest) Reset() {
-	*x = GetPrecompiledObjectLogsRequest{}
+func (x *CounterMetric) Reset() {
+	*x = CounterMetric{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_api_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1820,13 +1716,13 @@ func (x *GetPrecompiledObjectLogsRequest) Reset() {
 	}
 }
 
-func (x *GetPrecompiledObjectLogsRequest) String() string {
+func (x *CounterMetric) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPrecompiledObjectLogsRequest) ProtoMessage() {}
+func (*CounterMetric) ProtoMessage() {}
 
-func (x *GetPrecompiledObjectLogsRequest) ProtoReflect() protoreflect.Message {
+func (x *CounterMetric) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_api_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1838,29 +1734,46 @@ func (x *GetPrecompiledObjectLogsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPrecompiledObjectLogsRequest.ProtoReflect.Descriptor instead.
-func (*GetPrecompiledObjectLogsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CounterMetric.ProtoReflect.Descriptor instead.
+func (*CounterMetric) Descriptor() ([]byte, []int) {
 	return file_api_v1_api_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetPrecompiledObjectLogsRequest) GetCloudPath() string {
+func (x *CounterMetric) GetKey() *MetricKey {
 	if x != nil {
-		return x.CloudPath
+		return x.Key
 	}
-	return ""
+	return nil
 }
 
-// GetPrecompiledObjectGraphRequest contains information of the PrecompiledObject cloud path.
-type GetPrecompiledObjectGraphRequest struct {
+func (x *CounterMetric) GetAttempted() int64 {
+	if x != nil {
+		return x.Attempted
+	}
+	return 0
+}
+
+func (x *CounterMetric) GetCommitted() int64 {
+	if x != nil {
+		return x.Committed
+	}
+	return 0
+}
+
+// DistributionValue represents the value of a distribution.
+type DistributionValue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CloudPath string `protobuf:"bytes,1,opt,name=cloud_path,json=cloudPath,proto3" json:"cloud_path,omitempty"`
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Sum   int64 `protobuf:"varint,2,opt,name=sum,proto3" json:"sum,omitempty"`
+	Min   int64 `protobuf:"varint,3,opt,name=min,proto3" json:"min,omitempty"`
+	Max   int64 `protobuf:"varint,4,opt,name=max,proto3" json:"max,omitempty"`
 }
 
-func (x *GetPrecompiledObjectGraphRequest) Reset() {
-	*x = GetPrecompiledObjectGraphRequest{}
+func (x *DistributionValue) Reset() {
+	*x = DistributionValue{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_api_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1868,13 +1781,13 @@ func (x *GetPrecompiledObjectGraphRequest) Reset() {
 	}
 }
 
-func (x *GetPrecompiledObjectGraphRequest) String() string {
+func (x *DistributionValue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPrecompiledObjectGraphRequest) ProtoMessage() {}
+func (*DistributionValue) ProtoMessage() {}
 
-func (x *GetPrecompiledObjectGraphRequest) ProtoReflect() protoreflect.Message {
+func (x *DistributionValue) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_api_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1886,29 +1799,52 @@ func (x *GetPrecompiledObjectGraphRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPrecompiledObjectGraphRequest.ProtoReflect.Descriptor instead.
-func (*GetPrecompiledObjectGraphRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DistributionValue.ProtoReflect.Descriptor instead.
+func (*DistributionValue) Descriptor() ([]byte, []int) {
 	return file_api_v1_api_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetPrecompiledObjectGraphRequest) GetCloudPath() string {
+func (x *DistributionValue) GetCount() int64 {
 	if x != nil {
-		return x.CloudPath
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-// GetDefaultPrecompiledObjectRequest contains information of the needed PrecompiledObject sdk.
-type GetDefaultPrecompiledObjectRequest struct {
+func (x *DistributionValue) GetSum() int64 {
+	if x != nil {
+		return x.Sum
+	}
+	return 0
+}
+
+func (x *DistributionValue) GetMin() int64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *DistributionValue) GetMax() int64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+// DistributionMetric represents the attempted and committed values of a distribution.
+type DistributionMetric struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sdk Sdk `protobuf:"varint,1,opt,name=sdk,proto3,enum=api.v1.Sdk" json:"sdk,omitempty"`
+	Key       *MetricKey         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Attempted *DistributionValue `protobuf:"bytes,2,opt,name=attempted,proto3" json:"attempted,omitempty"`
+	Committed *DistributionValue `protobuf:"bytes,3,opt,name=committed,proto3" json:"committed,omitempty"`
 }
 
-func (x *GetDefaultPrecompiledObjectRequest) Reset() {
-	*x = GetDefaultPrecompiledObjectRequest{}
+func (x *DistributionMetric) Reset() {
+	*x = DistributionMetric{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_api_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1916,13 +1852,13 @@ func (x *GetDefaultPrecompiledObjectRequest) Reset() {
 	}
 }
 
-func (x *GetDefaultPrecompiledObjectRequest) String() string {
+func (x *DistributionMetric) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDefaultPrecompiledObjectRequest) ProtoMessage() {}
+func (*DistributionMetric) ProtoMessage() {}
 
-func (x *GetDefaultPrecompiledObjectRequest) ProtoReflect() protoreflect.Message {
+func (x *DistributionMetric) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_api_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1934,44 +1870,1414 @@ func (x *GetDefaultPrecompiledObjectRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDefaultPrecompiledObjectRequest.ProtoReflect.Descriptor instead.
-func (*GetDefaultPrecompiledObjectRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_api_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use DistributionMetric.ProtoReflect.Descriptor instead.
+func (*DistributionMetric) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DistributionMetric) GetKey() *MetricKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *DistributionMetric) GetAttempted() *DistributionValue {
+	if x != nil {
+		return x.Attempted
+	}
+	return nil
+}
+
+func (x *DistributionMetric) GetCommitted() *DistributionValue {
+	if x != nil {
+		return x.Committed
+	}
+	return nil
+}
+
+// GaugeValue represents the value of a gauge and the time in milliseconds since epoch when it was set.
+type GaugeValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value     int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Timestamp int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *GaugeValue) Reset() {
+	*x = GaugeValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_api_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GaugeValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GaugeValue) ProtoMessage() {}
+
+func (x *GaugeValue) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GaugeValue.ProtoReflect.Descriptor instead.
+func (*GaugeValue) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GaugeValue) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *GaugeValue) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// GaugeMetric represents the attempted and committed values of a gauge.
+type GaugeMetric struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key       *MetricKey  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Attempted *GaugeValue `protobuf:"bytes,2,opt,name=attempted,proto3" json:"attempted,omitempty"`
+	Committed *GaugeValue `protobuf:"bytes,3,opt,name=committed,proto3" json:"committed,omitempty"`
+}
+
+func (x *GaugeMetric) Reset() {
+	*x = GaugeMetric{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_api_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GaugeMetric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GaugeMetric) ProtoMessage() {}
+
+func (x *GaugeMetric) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GaugeMetric.ProtoReflect.Descriptor instead.
+func (*GaugeMetric) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GaugeMetric) GetKey() *MetricKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *GaugeMetric) GetAttempted() *GaugeValue {
+	if x != nil {
+		return x.Attempted
+	}
+	return nil
+}
+
+func (x *GaugeMetric) GetCommitted() *GaugeValue {
+	if x != nil {
+		return x.Committed
+	}
+	return nil
+}
+
+// GetRunMetricsResponse represents the final metrics of pipeline execution reported by the runner.
+type GetRunMetricsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Counters      []*CounterMetric      `protobuf:"bytes,1,rep,name=counters,proto3" json:"counters,omitempty"`
+	Distributions []*DistributionMetric `protobuf:"bytes,2,rep,name=distributions,proto3" json:"distributions,omitempty"`
+	Gauges        []*GaugeMetric        `protobuf:"bytes,3,rep,name=gauges,proto3" json:"gauges,omitempty"`
+}
+
+func (x *GetRunMetricsResponse) Reset() {
+	*x = GetRunMetricsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_api_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRunMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRunMetricsResponse) ProtoMessage() {}
+
+func (x *GetRunMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRunMetricsResponse.ProtoReflect.Descriptor instead.
+func (*GetRunMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetRunMetricsResponse) GetCounters() []*CounterMetric {
+	if x != nil {
+		return x.Counters
+	}
+	return nil
+}
+
+func (x *GetRunMetricsResponse) GetDistributions() []*DistributionMetric {
+	if x != nil {
+		return x.Distributions
+	}
+	return nil
+}
+
+func (x *GetRunMetricsResponse) GetGauges() []*GaugeMetric {
+	if x != nil {
+		return x.Gauges
+	}
+	return nil
+}
+
+// CancelRequest request to cancel code processing
+type CancelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PipelineUuid string `protobuf:"bytes,1,opt,name=pipeline_uuid,json=pipelineUuid,proto3" json:"pipeline_uuid,omitempty"`
+}
+
+func (x *CancelRequest) Reset() {
+	*x = CancelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_api_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRequest) ProtoMessage() {}
+
+func (x *CancelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRequest.ProtoReflect.Descriptor instead.
+func (*CancelRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CancelRequest) GetPipelineUuid() string {
+	if x != nil {
+		return x.PipelineUuid
+	}
+	return ""
+}
+
+// CancelResponse response for cancel request
+type CancelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelResponse) Reset() {
+	*x = CancelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_api_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelResponse) ProtoMessage() {}
+
+func (x *CancelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelResponse.ProtoReflect.Descriptor instead.
+func (*CancelResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{34}
+}
+
+// PrecompiledObject represents one PrecompiledObject with its information
+type PrecompiledObject struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CloudPath       string                `protobuf:"bytes,1,opt,name=cloud_path,json=cloudPath,proto3" json:"cloud_path,omitempty"`
+	Name            string                `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Type            PrecompiledObjectType `protobuf:"varint,4,opt,name=type,proto3,enum=api.v1.PrecompiledObjectType" json:"type,omitempty"`
+	PipelineOptions string                `protobuf:"bytes,5,opt,name=pipeline_options,json=pipelineOptions,proto3" json:"pipeline_options,omitempty"`
+	// TODO mark reserved after #24402 update sequence is done
+	Link           string     `protobuf:"bytes,6,opt,name=link,proto3" json:"link,omitempty"`
+	Multifile      bool       `protobuf:"varint,7,opt,name=multifile,proto3" json:"multifile,omitempty"`
+	ContextLine    int32      `protobuf:"varint,8,opt,name=context_line,json=contextLine,proto3" json:"context_line,omitempty"`
+	DefaultExample bool       `protobuf:"varint,9,opt,name=default_example,json=defaultExample,proto3" json:"default_example,omitempty"`
+	Sdk            Sdk        `protobuf:"varint,10,opt,name=sdk,proto3,enum=api.v1.Sdk" json:"sdk,omitempty"`
+	Complexity     Complexity `protobuf:"varint,11,opt,name=complexity,proto3,enum=api.v1.Complexity" json:"complexity,omitempty"`
+	Tags           []string   `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`
+	Datasets       []*Dataset `protobuf:"bytes,13,rep,name=datasets,proto3" json:"datasets,omitempty"`
+	// Link to the example in the Beam repository
+	UrlVcs      string `protobuf:"bytes,14,opt,name=url_vcs,json=urlVcs,proto3" json:"url_vcs,omitempty"`
+	UrlNotebook string `protobuf:"bytes,15,opt,name=url_notebook,json=urlNotebook,proto3" json:"url_notebook,omitempty"`
+	AlwaysRun   bool   `protobuf:"varint,16,opt,name=always_run,json=alwaysRun,proto3" json:"always_run,omitempty"`
+	NeverRun    bool   `protobuf:"varint,17,opt,name=never_run,json=neverRun,proto3" json:"never_run,omitempty"`
+}
+
+func (x *PrecompiledObject) Reset() {
+	*x = PrecompiledObject{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_api_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrecompiledObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrecompiledObject) ProtoMessage() {}
+
+func (x *PrecompiledObject) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrecompiledObject.ProtoReflect.Descriptor instead.
+func (*PrecompiledObject) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PrecompiledObject) GetCloudPath() string {
+	if x != nil {
+		return x.CloudPath
+	}
+	return ""
+}
+
+func (x *PrecompiledObject) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PrecompiledObject) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PrecompiledObject) GetType() PrecompiledObjectType {
+	if x != nil {
+		return x.Type
+	}
+	return PrecompiledObjectType_PRECOMPILED_OBJECT_TYPE_UNSPECIFIED
+}
+
+func (x *PrecompiledObject) GetPipelineOptions() string {
+	if x != nil {
+		return x.PipelineOptions
+	}
+	return ""
+}
+
+func (x *PrecompiledObject) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *PrecompiledObject) GetMultifile() bool {
+	if x != nil {
+		return x.Multifile
+	}
+	return false
+}
+
+func (x *PrecompiledObject) GetContextLine() int32 {
+	if x != nil {
+		return x.ContextLine
+	}
+	return 0
+}
+
+func (x *PrecompiledObject) GetDefaultExample() bool {
+	if x != nil {
+		return x.DefaultExample
+	}
+	return false
+}
+
+func (x *PrecompiledObject) GetSdk() Sdk {
+	if x != nil {
+		return x.Sdk
+	}
+	return Sdk_SDK_UNSPECIFIED
+}
+
+func (x *PrecompiledObject) GetComplexity() Complexity {
+	if x != nil {
+		return x.Complexity
+	}
+	return Complexity_COMPLEXITY_UNSPECIFIED
+}
+
+func (x *PrecompiledObject) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PrecompiledObject) GetDatasets() []*Dataset {
+	if x != nil {
+		return x.Datasets
+	}
+	return nil
+}
+
+func (x *PrecompiledObject) GetUrlVcs() string {
+	if x != nil {
+		return x.UrlVcs
+	}
+	return ""
+}
+
+func (x *PrecompiledObject) GetUrlNotebook() string {
+	if x != nil {
+		return x.UrlNotebook
+	}
+	return ""
+}
+
+func (x *PrecompiledObject) GetAlwaysRun() bool {
+	if x != nil {
+		return x.AlwaysRun
+	}
+	return false
+}
+
+func (x *PrecompiledObject) GetNeverRun() bool {
+	if x != nil {
+		return x.NeverRun
+	}
+	return false
+}
+
+// Categories represent the array of messages with sdk and categories at this sdk
+type Categories struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sdk        Sdk                    `protobuf:"varint,1,opt,name=sdk,proto3,enum=api.v1.Sdk" json:"sdk,omitempty"`
+	Categories []*Categories_Category `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`
+}
+
+func (x *Categories) Reset() {
+	*x = Categories{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_api_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Categories) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Categories) ProtoMessage() {}
+
+func (x *Categories) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Categories.ProtoReflect.Descriptor instead.
+func (*Categories) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Categories) GetSdk() Sdk {
+	if x != nil {
+		return x.Sdk
+	}
+	return Sdk_SDK_UNSPECIFIED
+}
+
+func (x *Categories) GetCategories() []*Categories_Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// GetPrecompiledObjectsRequest contains information of the needed PrecompiledObjects sdk and categories.
+type GetPrecompiledObjectsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sdk      Sdk    `protobuf:"varint,1,opt,name=sdk,proto3,enum=api.v1.Sdk" json:"sdk,omitempty"`
+	Category string `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+}
+
+func (x *GetPrecompiledObjectsRequest) Reset() {
+	*x = GetPrecompiledObjectsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_api_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPrecompiledObjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrecompiledObjectsRequest) ProtoMessage() {}
+
+func (x *GetPrecompiledObjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrecompiledObjectsRequest.ProtoReflect.Descriptor instead.
+func (*GetPrecompiledObjectsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetPrecompiledObjectsRequest) GetSdk() Sdk {
+	if x != nil {
+		return x.Sdk
+	}
+	return Sdk_SDK_UNSPECIFIED
+}
+
+func (x *GetPrecompiledObjectsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+// GetPrecompiledObjectRequest contains information of the needed PrecompiledObject.
+type GetPrecompiledObjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CloudPath string `protobuf:"bytes,1,opt,name=cloud_path,json=cloudPath,proto3" json:"cloud_path,omitempty"`
+}
+
+func (x *GetPrecompiledObjectRequest) Reset() {
+	*x = GetPrecompiledObjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_api_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPrecompiledObjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrecompiledObjectRequest) ProtoMessage() {}
+
+func (x *GetPrecompiledObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrecompiledObjectRequest.ProtoReflect.Descriptor instead.
+func (*GetPrecompiledObjectRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetPrecompiledObjectRequest) GetCloudPath() string {
+	if x != nil {
+		return x.CloudPath
+	}
+	return ""
+}
+
+// GetPrecompiledObjectCodeRequest contains information of the PrecompiledObject uuid.
+type GetPrecompiledObjectCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CloudPath string `protobuf:"bytes,1,opt,name=cloud_path,json=cloudPath,proto3" json:"cloud_path,omitempty"`
+}
+
+func (x *GetPrecompiledObjectCodeRequest) Reset() {
+	*x = GetPrecompiledObjectCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_api_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPrecompiledObjectCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrecompiledObjectCodeRequest) ProtoMessage() {}
+
+func (x *GetPrecompiledObjectCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrecompiledObjectCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetPrecompiledObjectCodeRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetPrecompiledObjectCodeRequest) GetCloudPath() string {
+	if x != nil {
+		return x.CloudPath
+	}
+	return ""
+}
+
+// GetPrecompiledObjectOutputRequest contains information of the PrecompiledObject uuid.
+type GetPrecompiledObjectOutputRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CloudPath string `protobuf:"bytes,1,opt,name=cloud_path,json=cloudPath,proto3" json:"cloud_path,omitempty"`
+}
+
+func (x *GetPrecompiledObjectOutputRequest) Reset() {
+	*x = GetPrecompiledObjectOutputRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_api_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPrecompiledObjectOutputRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrecompiledObjectOutputRequest) ProtoMessage() {}
+
+func (x *GetPrecompiledObjectOutputRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrecompiledObjectOutputRequest.ProtoReflect.Descriptor instead.
+func (*GetPrecompiledObjectOutputRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetPrecompiledObjectOutputRequest) GetCloudPath() string {
+	if x != nil {
+		return x.CloudPath
+	}
+	return ""
+}
+
+// GetPrecompiledObjectLogsRequest contains information of the PrecompiledObject uuid.
+type GetPrecompiledObjectLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CloudPath string `protobuf:"bytes,1,opt,name=cloud_path,json=cloudPath,proto3" json:"cloud_path,omitempty"`
+}
+
+func (x *GetPrecompiledObjectLogsRequest) Reset() {
+	*x = GetPrecompiledObjectLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_api_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPrecompiledObjectLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrecompiledObjectLogsRequest) ProtoMessage() {}
+
+func (x *GetPrecompiledObjectLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrecompiledObjectLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetPrecompiledObjectLogsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetPrecompiledObjectLogsRequest) GetCloudPath() string {
+	if x != nil {
+		return x.CloudPath
+	}
+	return ""
+}
+
+// GetPrecompiledObjectGraphRequest contains information of the PrecompiledObject cloud path.
+type GetPrecompiledObjectGraphRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CloudPath string `protobuf:"bytes,1,opt,name=cloud_path,json=cloudPath,proto3" json:"cloud_path,omitempty"`
+}
+
+func (x *GetPrecompiledObjectGraphRequest) Reset() {
+	*x = GetPrecompiledObjectGraphRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_api_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPrecompiledObjectGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrecompiledObjectGraphRequest) ProtoMessage() {}
+
+func (x *GetPrecompiledObjectGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrecompiledObjectGraphRequest.ProtoReflect.Descriptor instead.
+func (*GetPrecompiledObjectGraphRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetPrecompiledObjectGraphRequest) GetCloudPath() string {
+	if x != nil {
+		return x.CloudPath
+	}
+	return ""
+}
+
+// GetDefaultPrecompiledObjectRequest contains information of the needed PrecompiledObject sdk.
+type GetDefaultPrecompiledObjectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sdk Sdk `protobuf:"varint,1,opt,name=sdk,proto3,enum=api.v1.Sdk" json:"sdk,omitempty"`
+}
+
+func (x *GetDefaultPrecompiledObjectRequest) Reset() {
+	*x = GetDefaultPrecompiledObjectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_api_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDefaultPrecompiledObjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDefaultPrecompiledObjectRequest) ProtoMessage() {}
+
+func (x *GetDefaultPrecompiledObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDefaultPrecompiledObjectRequest.ProtoReflect.Descriptor instead.
+func (*GetDefaultPrecompiledObjectRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetDefaultPrecompiledObjectRequest) GetSdk() Sdk {
+	if x != nil {
+		return x.Sdk
+	}
+	return Sdk_SDK_UNSPECIFIED
+}
+
+// GetPrecompiledObjectsResponse represent the map between sdk and categories for the sdk.
+type GetPrecompiledObjectsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SdkCategories []*Categories `protobuf:"bytes,1,rep,name=sdk_categories,json=sdkCategories,proto3" json:"sdk_categories,omitempty"`
+}
+
+func (x *GetPrecompiledObjectsResponse) Reset() {
+	*x = GetPrecompiledObjectsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_api_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPrecompiledObjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrecompiledObjectsResponse) ProtoMessage() {}
+
+func (x *GetPrecompiledObjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrecompiledObjectsResponse.ProtoReflect.Descriptor instead.
+func (*GetPrecompiledObjectsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetPrecompiledObjectsResponse) GetSdkCategories() []*Categories {
+	if x != nil {
+		return x.SdkCategories
+	}
+	return nil
+}
+
+// GetPrecompiledObjectResponse represent the PrecompiledObject.
+type GetPrecompiledObjectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PrecompiledObject *PrecompiledObject `protobuf:"bytes,1,opt,name=precompiled_object,json=precompiledObject,proto3" json:"precompiled_object,omitempty"`
+}
+
+func (x *GetPrecompiledObjectResponse) Reset() {
+	*x = GetPrecompiledObjectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_api_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPrecompiledObjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrecompiledObjectResponse) ProtoMessage() {}
+
+func (x *GetPrecompiledObjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrecompiledObjectResponse.ProtoReflect.Descriptor instead.
+func (*GetPrecompiledObjectResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetPrecompiledObjectResponse) GetPrecompiledObject() *PrecompiledObject {
+	if x != nil {
+		return x.PrecompiledObject
+	}
+	return nil
+}
+
+// GetPrecompiledObjectResponse represents the source code of the PrecompiledObject.
+type GetPrecompiledObjectCodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code  string         `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Files []*SnippetFile `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+}
+
+func (x *GetPrecompiledObjectCodeResponse) Reset() {
+	*x = GetPrecompiledObjectCodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_api_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPrecompiledObjectCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrecompiledObjectCodeResponse) ProtoMessage() {}
+
+func (x *GetPrecompiledObjectCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrecompiledObjectCodeResponse.ProtoReflect.Descriptor instead.
+func (*GetPrecompiledObjectCodeResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetPrecompiledObjectCodeResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *GetPrecompiledObjectCodeResponse) GetFiles() []*SnippetFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+// GetPrecompiledObjectOutputResponse represents the result of the executed code.
+type GetPrecompiledObjectOutputResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Output string `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (x *GetPrecompiledObjectOutputResponse) Reset() {
+	*x = GetPrecompiledObjectOutputResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_api_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPrecompiledObjectOutputResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrecompiledObjectOutputResponse) ProtoMessage() {}
+
+func (x *GetPrecompiledObjectOutputResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrecompiledObjectOutputResponse.ProtoReflect.Descriptor instead.
+func (*GetPrecompiledObjectOutputResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetPrecompiledObjectOutputResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+// GetPrecompiledObjectLogsResponse represents the result of the executed code.
+type GetPrecompiledObjectLogsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Output string `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (x *GetPrecompiledObjectLogsResponse) Reset() {
+	*x = GetPrecompiledObjectLogsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_api_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPrecompiledObjectLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrecompiledObjectLogsResponse) ProtoMessage() {}
+
+func (x *GetPrecompiledObjectLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrecompiledObjectLogsResponse.ProtoReflect.Descriptor instead.
+func (*GetPrecompiledObjectLogsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetPrecompiledObjectLogsResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+// GetPrecompiledObjectGraphResponse represents the string representation of the executed code graph in DOT format.
+type GetPrecompiledObjectGraphResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Graph string `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
+}
+
+func (x *GetPrecompiledObjectGraphResponse) Reset() {
+	*x = GetPrecompiledObjectGraphResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_api_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPrecompiledObjectGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrecompiledObjectGraphResponse) ProtoMessage() {}
+
+func (x *GetPrecompiledObjectGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrecompiledObjectGraphResponse.ProtoReflect.Descriptor instead.
+func (*GetPrecompiledObjectGraphResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetPrecompiledObjectGraphResponse) GetGraph() string {
+	if x != nil {
+		return x.Graph
+	}
+	return ""
+}
+
+// GetDefaultPrecompiledObjectResponse represents the default PrecompiledObject and his category for the sdk.
+type GetDefaultPrecompiledObjectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PrecompiledObject *PrecompiledObject `protobuf:"bytes,1,opt,name=precompiled_object,json=precompiledObject,proto3" json:"precompiled_object,omitempty"`
+}
+
+func (x *GetDefaultPrecompiledObjectResponse) Reset() {
+	*x = GetDefaultPrecompiledObjectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_api_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDefaultPrecompiledObjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDefaultPrecompiledObjectResponse) ProtoMessage() {}
+
+func (x *GetDefaultPrecompiledObjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDefaultPrecompiledObjectResponse.ProtoReflect.Descriptor instead.
+func (*GetDefaultPrecompiledObjectResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetDefaultPrecompiledObjectResponse) GetPrecompiledObject() *PrecompiledObject {
+	if x != nil {
+		return x.PrecompiledObject
+	}
+	return nil
+}
+
+// SnippetFile represents the snippet file content and its name to save.
+type SnippetFile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	IsMain  bool   `protobuf:"varint,3,opt,name=is_main,json=isMain,proto3" json:"is_main,omitempty"`
+}
+
+func (x *SnippetFile) Reset() {
+	*x = SnippetFile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_api_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnippetFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnippetFile) ProtoMessage() {}
+
+func (x *SnippetFile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnippetFile.ProtoReflect.Descriptor instead.
+func (*SnippetFile) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SnippetFile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SnippetFile) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *SnippetFile) GetIsMain() bool {
+	if x != nil {
+		return x.IsMain
+	}
+	return false
+}
+
+// SaveSnippetRequest represents a snippet content and options of SDK which executes the snippet.
+type SaveSnippetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Files []*SnippetFile `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	Sdk   Sdk            `protobuf:"varint,2,opt,name=sdk,proto3,enum=api.v1.Sdk" json:"sdk,omitempty"`
+	// The pipeline options as they would be passed to the program (e.g. "--option1 value1 --option2 value2")
+	PipelineOptions string     `protobuf:"bytes,3,opt,name=pipeline_options,json=pipelineOptions,proto3" json:"pipeline_options,omitempty"`
+	Complexity      Complexity `protobuf:"varint,4,opt,name=complexity,proto3,enum=api.v1.Complexity" json:"complexity,omitempty"`
+	PersistenceKey  string     `protobuf:"bytes,5,opt,name=persistence_key,json=persistenceKey,proto3" json:"persistence_key,omitempty"`
+	// The ID of the snippet which the new snippet is the next revision of, if any
+	ParentId string `protobuf:"bytes,6,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// The token identifying the author of the snippet
+	AuthorToken string `protobuf:"bytes,7,opt,name=author_token,json=authorToken,proto3" json:"author_token,omitempty"`
+}
+
+func (x *SaveSnippetRequest) Reset() {
+	*x = SaveSnippetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_api_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveSnippetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSnippetRequest) ProtoMessage() {}
+
+func (x *SaveSnippetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSnippetRequest.ProtoReflect.Descriptor instead.
+func (*SaveSnippetRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SaveSnippetRequest) GetFiles() []*SnippetFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *SaveSnippetRequest) GetSdk() Sdk {
+	if x != nil {
+		return x.Sdk
+	}
+	return Sdk_SDK_UNSPECIFIED
+}
+
+func (x *SaveSnippetRequest) GetPipelineOptions() string {
+	if x != nil {
+		return x.PipelineOptions
+	}
+	return ""
+}
+
+func (x *SaveSnippetRequest) GetComplexity() Complexity {
+	if x != nil {
+		return x.Complexity
+	}
+	return Complexity_COMPLEXITY_UNSPECIFIED
+}
+
+func (x *SaveSnippetRequest) GetPersistenceKey() string {
+	if x != nil {
+		return x.PersistenceKey
+	}
+	return ""
+}
+
+func (x *SaveSnippetRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
 }
 
-func (x *GetDefaultPrecompiledObjectRequest) GetSdk() Sdk {
+func (x *SaveSnippetRequest) GetAuthorToken() string {
 	if x != nil {
-		return x.Sdk
+		return x.AuthorToken
 	}
-	return Sdk_SDK_UNSPECIFIED
+	return ""
 }
 
-// GetPrecompiledObjectsResponse represent the map between sdk and categories for the sdk.
-type GetPrecompiledObjectsResponse struct {
+// SaveSnippetResponse contains information of the generated ID.
+type SaveSnippetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SdkCategories []*Categories `protobuf:"bytes,1,rep,name=sdk_categories,json=sdkCategories,proto3" json:"sdk_categories,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetPrecompiledObjectsResponse) Reset() {
-	*x = GetPrecompiledObjectsResponse{}
+func (x *SaveSnippetResponse) Reset() {
+	*x = SaveSnippetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_api_proto_msgTypes[30]
+		mi := &file_api_v1_api_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPrecompiledObjectsResponse) String() string {
+func (x *SaveSnippetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPrecompiledObjectsResponse) ProtoMessage() {}
+func (*SaveSnippetResponse) ProtoMessage() {}
 
-func (x *GetPrecompiledObjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_api_proto_msgTypes[30]
+func (x *SaveSnippetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1982,44 +3288,44 @@ func (x *GetPrecompiledObjectsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPrecompiledObjectsResponse.ProtoReflect.Descriptor instead.
-func (*GetPrecompiledObjectsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_api_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use SaveSnippetResponse.ProtoReflect.Descriptor instead.
+func (*SaveSnippetResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *GetPrecompiledObjectsResponse) GetSdkCategories() []*Categories {
+func (x *SaveSnippetResponse) GetId() string {
 	if x != nil {
-		return x.SdkCategories
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// GetPrecompiledObjectResponse represent the PrecompiledObject.
-type GetPrecompiledObjectResponse struct {
+// GetSnippetRequest represents the generated ID.
+type GetSnippetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PrecompiledObject *PrecompiledObject `protobuf:"bytes,1,opt,name=precompiled_object,json=precompiledObject,proto3" json:"precompiled_object,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetPrecompiledObjectResponse) Reset() {
-	*x = GetPrecompiledObjectResponse{}
+func (x *GetSnippetRequest) Reset() {
+	*x = GetSnippetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_api_proto_msgTypes[31]
+		mi := &file_api_v1_api_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPrecompiledObjectResponse) String() string {
+func (x *GetSnippetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPrecompiledObjectResponse) ProtoMessage() {}
+func (*GetSnippetRequest) ProtoMessage() {}
 
-func (x *GetPrecompiledObjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_api_proto_msgTypes[31]
+func (x *GetSnippetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2030,45 +3336,51 @@ func (x *GetPrecompiledObjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPrecompiledObjectResponse.ProtoReflect.Descriptor instead.
-func (*GetPrecompiledObjectResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_api_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use GetSnippetRequest.ProtoReflect.Descriptor instead.
+func (*GetSnippetRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetPrecompiledObjectResponse) GetPrecompiledObject() *PrecompiledObject {
+func (x *GetSnippetRequest) GetId() string {
 	if x != nil {
-		return x.PrecompiledObject
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// GetPrecompiledObjectResponse represents the source code of the PrecompiledObject.
-type GetPrecompiledObjectCodeResponse struct {
+// GetSnippetResponse contains information of a snippet content and options of SDK which executes the snippet.
+type GetSnippetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code  string         `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	Files []*SnippetFile `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+	Files []*SnippetFile `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	Sdk   Sdk            `protobuf:"varint,2,opt,name=sdk,proto3,enum=api.v1.Sdk" json:"sdk,omitempty"`
+	// The pipeline options as they would be passed to the program (e.g. "--option1 value1 --option2 value2")
+	PipelineOptions string     `protobuf:"bytes,3,opt,name=pipeline_options,json=pipelineOptions,proto3" json:"pipeline_options,omitempty"`
+	Complexity      Complexity `protobuf:"varint,4,opt,name=complexity,proto3,enum=api.v1.Complexity" json:"complexity,omitempty"`
+	// The ID of the snippet which the snippet is the next revision of, if any
+	ParentId string `protobuf:"bytes,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Revision int32  `protobuf:"varint,6,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
-func (x *GetPrecompiledObjectCodeResponse) Reset() {
-	*x = GetPrecompiledObjectCodeResponse{}
+func (x *GetSnippetResponse) Reset() {
+	*x = GetSnippetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_api_proto_msgTypes[32]
+		mi := &file_api_v1_api_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPrecompiledObjectCodeResponse) String() string {
+func (x *GetSnippetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPrecompiledObjectCodeResponse) ProtoMessage() {}
+func (*GetSnippetResponse) ProtoMessage() {}
 
-func (x *GetPrecompiledObjectCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_api_proto_msgTypes[32]
+func (x *GetSnippetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2079,99 +3391,80 @@ func (x *GetPrecompiledObjectCodeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPrecompiledObjectCodeResponse.ProtoReflect.Descriptor instead.
-func (*GetPrecompiledObjectCodeResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_api_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *GetPrecompiledObjectCodeResponse) GetCode() string {
-	if x != nil {
-		return x.Code
-	}
-	return ""
+// Deprecated: Use GetSnippetResponse.ProtoReflect.Descriptor instead.
+func (*GetSnippetResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetPrecompiledObjectCodeResponse) GetFiles() []*SnippetFile {
+func (x *GetSnippetResponse) GetFiles() []*SnippetFile {
 	if x != nil {
 		return x.Files
 	}
 	return nil
 }
 
-// GetPrecompiledObjectOutputResponse represents the result of the executed code.
-type GetPrecompiledObjectOutputResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Output string `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
-}
-
-func (x *GetPrecompiledObjectOutputResponse) Reset() {
-	*x = GetPrecompiledObjectOutputResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_api_proto_msgTypes[33]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetSnippetResponse) GetSdk() Sdk {
+	if x != nil {
+		return x.Sdk
 	}
+	return Sdk_SDK_UNSPECIFIED
 }
 
-func (x *GetPrecompiledObjectOutputResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetSnippetResponse) GetPipelineOptions() string {
+	if x != nil {
+		return x.PipelineOptions
+	}
+	return ""
 }
 
-func (*GetPrecompiledObjectOutputResponse) ProtoMessage() {}
-
-func (x *GetPrecompiledObjectOutputResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_api_proto_msgTypes[33]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetSnippetResponse) GetComplexity() Complexity {
+	if x != nil {
+		return x.Complexity
 	}
-	return mi.MessageOf(x)
+	return Complexity_COMPLEXITY_UNSPECIFIED
 }
 
-// Deprecated: Use GetPrecompiledObjectOutputResponse.ProtoReflect.Descriptor instead.
-func (*GetPrecompiledObjectOutputResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_api_proto_rawDescGZIP(), []int{33}
+func (x *GetSnippetResponse) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
 }
 
-func (x *GetPrecompiledObjectOutputResponse) GetOutput() string {
+func (x *GetSnippetResponse) GetRevision() int32 {
 	if x != nil {
-		return x.Output
+		return x.Revision
 	}
-	return ""
+	return 0
 }
 
-// GetPrecompiledObjectLogsResponse represents the result of the executed code.
-type GetPrecompiledObjectLogsResponse struct {
+// ForkSnippetRequest represents the ID of the snippet to fork and the token identifying the author of the fork.
+type ForkSnippetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Output string `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AuthorToken string `protobuf:"bytes,2,opt,name=author_token,json=authorToken,proto3" json:"author_token,omitempty"`
 }
 
-func (x *GetPrecompiledObjectLogsResponse) Reset() {
-	*x = GetPrecompiledObjectLogsResponse{}
+func (x *ForkSnippetRequest) Reset() {
+	*x = ForkSnippetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_api_proto_msgTypes[34]
+		mi := &file_api_v1_api_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPrecompiledObjectLogsResponse) String() string {
+func (x *ForkSnippetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPrecompiledObjectLogsResponse) ProtoMessage() {}
+func (*ForkSnippetRequest) ProtoMessage() {}
 
-func (x *GetPrecompiledObjectLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_api_proto_msgTypes[34]
+func (x *ForkSnippetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2182,44 +3475,51 @@ func (x *GetPrecompiledObjectLogsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPrecompiledObjectLogsResponse.ProtoReflect.Descriptor instead.
-func (*GetPrecompiledObjectLogsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_api_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use ForkSnippetRequest.ProtoReflect.Descriptor instead.
+func (*ForkSnippetRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *GetPrecompiledObjectLogsResponse) GetOutput() string {
+func (x *ForkSnippetRequest) GetId() string {
 	if x != nil {
-		return x.Output
+		return x.Id
 	}
 	return ""
 }
 
-// GetPrecompiledObjectGraphResponse represents the string representation of the executed code graph in DOT format.
-type GetPrecompiledObjectGraphResponse struct {
+func (x *ForkSnippetRequest) GetAuthorToken() string {
+	if x != nil {
+		return x.AuthorToken
+	}
+	return ""
+}
+
+// ForkSnippetResponse contains the ID of the fork, which is the next revision of the snippet.
+type ForkSnippetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Graph string `protobuf:"bytes,1,opt,name=graph,proto3" json:"graph,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetPrecompiledObjectGraphResponse) Reset() {
-	*x = GetPrecompiledObjectGraphResponse{}
+func (x *ForkSnippetResponse) Reset() {
+	*x = ForkSnippetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_api_proto_msgTypes[35]
+		mi := &file_api_v1_api_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPrecompiledObjectGraphResponse) String() string {
+func (x *ForkSnippetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPrecompiledObjectGraphResponse) ProtoMessage() {}
+func (*ForkSnippetResponse) ProtoMessage() {}
 
-func (x *GetPrecompiledObjectGraphResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_api_proto_msgTypes[35]
+func (x *ForkSnippetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2230,44 +3530,44 @@ func (x *GetPrecompiledObjectGraphResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPrecompiledObjectGraphResponse.ProtoReflect.Descriptor instead.
-func (*GetPrecompiledObjectGraphResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_api_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use ForkSnippetResponse.ProtoReflect.Descriptor instead.
+func (*ForkSnippetResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *GetPrecompiledObjectGraphResponse) GetGraph() string {
+func (x *ForkSnippetResponse) GetId() string {
 	if x != nil {
-		return x.Graph
+		return x.Id
 	}
 	return ""
 }
 
-// GetDefaultPrecompiledObjectResponse represents the default PrecompiledObject and his category for the sdk.
-type GetDefaultPrecompiledObjectResponse struct {
+// ListSnippetRevisionsRequest represents the ID of the snippet to list the revisions of.
+type ListSnippetRevisionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PrecompiledObject *PrecompiledObject `protobuf:"bytes,1,opt,name=precompiled_object,json=precompiledObject,proto3" json:"precompiled_object,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetDefaultPrecompiledObjectResponse) Reset() {
-	*x = GetDefaultPrecompiledObjectResponse{}
+func (x *ListSnippetRevisionsRequest) Reset() {
+	*x = ListSnippetRevisionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_api_proto_msgTypes[36]
+		mi := &file_api_v1_api_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetDefaultPrecompiledObjectResponse) String() string {
+func (x *ListSnippetRevisionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDefaultPrecompiledObjectResponse) ProtoMessage() {}
+func (*ListSnippetRevisionsRequest) ProtoMessage() {}
 
-func (x *GetDefaultPrecompiledObjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_api_proto_msgTypes[36]
+func (x *ListSnippetRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2278,46 +3578,50 @@ func (x *GetDefaultPrecompiledObjectResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDefaultPrecompiledObjectResponse.ProtoReflect.Descriptor instead.
-func (*GetDefaultPrecompiledObjectResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_api_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use ListSnippetRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSnippetRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GetDefaultPrecompiledObjectResponse) GetPrecompiledObject() *PrecompiledObject {
+func (x *ListSnippetRevisionsRequest) GetId() string {
 	if x != nil {
-		return x.PrecompiledObject
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// SnippetFile represents the snippet file content and its name to save.
-type SnippetFile struct {
+// SnippetRevision represents one revision of a snippet.
+type SnippetRevision struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	IsMain  bool   `protobuf:"varint,3,opt,name=is_main,json=isMain,proto3" json:"is_main,omitempty"`
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParentId string `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Revision int32  `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	// The time in milliseconds since epoch when the revision was created
+	Created int64 `protobuf:"varint,5,opt,name=created,proto3" json:"created,omitempty"`
+	// The hex-encoded SHA-256 hash of the token identifying the author, empty if the author is unknown
+	AuthorHash string `protobuf:"bytes,6,opt,name=author_hash,json=authorHash,proto3" json:"author_hash,omitempty"`
 }
 
-func (x *SnippetFile) Reset() {
-	*x = SnippetFile{}
+func (x *SnippetRevision) Reset() {
+	*x = SnippetRevision{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_api_proto_msgTypes[37]
+		mi := &file_api_v1_api_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SnippetFile) String() string {
+func (x *SnippetRevision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SnippetFile) ProtoMessage() {}
+func (*SnippetRevision) ProtoMessage() {}
 
-func (x *SnippetFile) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_api_proto_msgTypes[37]
+func (x *SnippetRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2328,63 +3632,75 @@ func (x *SnippetFile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SnippetFile.ProtoReflect.Descriptor instead.
-func (*SnippetFile) Descriptor() ([]byte, []int) {
-	return file_api_v1_api_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use SnippetRevision.ProtoReflect.Descriptor instead.
+func (*SnippetRevision) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *SnippetFile) GetName() string {
+func (x *SnippetRevision) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-func (x *SnippetFile) GetContent() string {
+func (x *SnippetRevision) GetParentId() string {
 	if x != nil {
-		return x.Content
+		return x.ParentId
 	}
 	return ""
 }
 
-func (x *SnippetFile) GetIsMain() bool {
+func (x *SnippetRevision) GetRevision() int32 {
 	if x != nil {
-		return x.IsMain
+		return x.Revision
 	}
-	return false
+	return 0
 }
 
-// SaveSnippetRequest represents a snippet content and options of SDK which executes the snippet.
-type SaveSnippetRequest struct {
+func (x *SnippetRevision) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *SnippetRevision) GetAuthorHash() string {
+	if x != nil {
+		return x.AuthorHash
+	}
+	return ""
+}
+
+// ListSnippetRevisionsResponse contains the revisions of the snippet from the first one to the snippet itself
+// and the revisions derived from the snippet.
+type ListSnippetRevisionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Files []*SnippetFile `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
-	Sdk   Sdk            `protobuf:"varint,2,opt,name=sdk,proto3,enum=api.v1.Sdk" json:"sdk,omitempty"`
-	// The pipeline options as they would be passed to the program (e.g. "--option1 value1 --option2 value2")
-	PipelineOptions string     `protobuf:"bytes,3,opt,name=pipeline_options,json=pipelineOptions,proto3" json:"pipeline_options,omitempty"`
-	Complexity      Complexity `protobuf:"varint,4,opt,name=complexity,proto3,enum=api.v1.Complexity" json:"complexity,omitempty"`
-	PersistenceKey  string     `protobuf:"bytes,5,opt,name=persistence_key,json=persistenceKey,proto3" json:"persistence_key,omitempty"`
+
+	Revisions []*SnippetRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	// The revisions derived from the snippet, directly or through other revisions, ordered by revision number
+	Descendants []*SnippetRevision `protobuf:"bytes,2,rep,name=descendants,proto3" json:"descendants,omitempty"`
 }
 
-func (x *SaveSnippetRequest) Reset() {
-	*x = SaveSnippetRequest{}
+func (x *ListSnippetRevisionsResponse) Reset() {
+	*x = ListSnippetRevisionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_api_proto_msgTypes[38]
+		mi := &file_api_v1_api_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SaveSnippetRequest) String() string {
+func (x *ListSnippetRevisionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveSnippetRequest) ProtoMessage() {}
+func (*ListSnippetRevisionsResponse) ProtoMessage() {}
 
-func (x *SaveSnippetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_api_proto_msgTypes[38]
+func (x *ListSnippetRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2395,72 +3711,52 @@ func (x *SaveSnippetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveSnippetRequest.ProtoReflect.Descriptor instead.
-func (*SaveSnippetRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_api_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use ListSnippetRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSnippetRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *SaveSnippetRequest) GetFiles() []*SnippetFile {
+func (x *ListSnippetRevisionsResponse) GetRevisions() []*SnippetRevision {
 	if x != nil {
-		return x.Files
+		return x.Revisions
 	}
 	return nil
 }
 
-func (x *SaveSnippetRequest) GetSdk() Sdk {
-	if x != nil {
-		return x.Sdk
-	}
-	return Sdk_SDK_UNSPECIFIED
-}
-
-func (x *SaveSnippetRequest) GetPipelineOptions() string {
-	if x != nil {
-		return x.PipelineOptions
-	}
-	return ""
-}
-
-func (x *SaveSnippetRequest) GetComplexity() Complexity {
-	if x != nil {
-		return x.Complexity
-	}
-	return Complexity_COMPLEXITY_UNSPECIFIED
-}
-
-func (x *SaveSnippetRequest) GetPersistenceKey() string {
+func (x *ListSnippetRevisionsResponse) GetDescendants() []*SnippetRevision {
 	if x != nil {
-		return x.PersistenceKey
+		return x.Descendants
 	}
-	return ""
+	return nil
 }
 
-// SaveSnippetResponse contains information of the generated ID.
-type SaveSnippetResponse struct {
+// GetSnippetDiffRequest represents the IDs of two revisions of a snippet to compare.
+type GetSnippetDiffRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromId string `protobuf:"bytes,1,opt,name=from_id,json=fromId,proto3" json:"from_id,omitempty"`
+	ToId   string `protobuf:"bytes,2,opt,name=to_id,json=toId,proto3" json:"to_id,omitempty"`
 }
 
-func (x *SaveSnippetResponse) Reset() {
-	*x = SaveSnippetResponse{}
+func (x *GetSnippetDiffRequest) Reset() {
+	*x = GetSnippetDiffRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_api_proto_msgTypes[39]
+		mi := &file_api_v1_api_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SaveSnippetResponse) String() string {
+func (x *GetSnippetDiffRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveSnippetResponse) ProtoMessage() {}
+func (*GetSnippetDiffRequest) ProtoMessage() {}
 
-func (x *SaveSnippetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_api_proto_msgTypes[39]
+func (x *GetSnippetDiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2471,44 +3767,52 @@ func (x *SaveSnippetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveSnippetResponse.ProtoReflect.Descriptor instead.
-func (*SaveSnippetResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_api_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use GetSnippetDiffRequest.ProtoReflect.Descriptor instead.
+func (*GetSnippetDiffRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *SaveSnippetResponse) GetId() string {
+func (x *GetSnippetDiffRequest) GetFromId() string {
 	if x != nil {
-		return x.Id
+		return x.FromId
 	}
 	return ""
 }
 
-// GetSnippetRequest represents the generated ID.
-type GetSnippetRequest struct {
+func (x *GetSnippetDiffRequest) GetToId() string {
+	if x != nil {
+		return x.ToId
+	}
+	return ""
+}
+
+// SnippetFileDiff represents the unified diff of a file between two revisions of a snippet.
+type SnippetFileDiff struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Diff string `protobuf:"bytes,2,opt,name=diff,proto3" json:"diff,omitempty"`
 }
 
-func (x *GetSnippetRequest) Reset() {
-	*x = GetSnippetRequest{}
+func (x *SnippetFileDiff) Reset() {
+	*x = SnippetFileDiff{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_api_proto_msgTypes[40]
+		mi := &file_api_v1_api_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSnippetRequest) String() string {
+func (x *SnippetFileDiff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSnippetRequest) ProtoMessage() {}
+func (*SnippetFileDiff) ProtoMessage() {}
 
-func (x *GetSnippetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_api_proto_msgTypes[40]
+func (x *SnippetFileDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2519,48 +3823,51 @@ func (x *GetSnippetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSnippetRequest.ProtoReflect.Descriptor instead.
-func (*GetSnippetRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_api_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use SnippetFileDiff.ProtoReflect.Descriptor instead.
+func (*SnippetFileDiff) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *GetSnippetRequest) GetId() string {
+func (x *SnippetFileDiff) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-// GetSnippetResponse contains information of a snippet content and options of SDK which executes the snippet.
-type GetSnippetResponse struct {
+func (x *SnippetFileDiff) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+// GetSnippetDiffResponse contains the diffs of the files which differ between two revisions of a snippet.
+type GetSnippetDiffResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Files []*SnippetFile `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
-	Sdk   Sdk            `protobuf:"varint,2,opt,name=sdk,proto3,enum=api.v1.Sdk" json:"sdk,omitempty"`
-	// The pipeline options as they would be passed to the program (e.g. "--option1 value1 --option2 value2")
-	PipelineOptions string     `protobuf:"bytes,3,opt,name=pipeline_options,json=pipelineOptions,proto3" json:"pipeline_options,omitempty"`
-	Complexity      Complexity `protobuf:"varint,4,opt,name=complexity,proto3,enum=api.v1.Complexity" json:"complexity,omitempty"`
+	Files []*SnippetFileDiff `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
 }
 
-func (x *GetSnippetResponse) Reset() {
-	*x = GetSnippetResponse{}
+func (x *GetSnippetDiffResponse) Reset() {
+	*x = GetSnippetDiffResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_api_proto_msgTypes[41]
+		mi := &file_api_v1_api_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSnippetResponse) String() string {
+func (x *GetSnippetDiffResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSnippetResponse) ProtoMessage() {}
+func (*GetSnippetDiffResponse) ProtoMessage() {}
 
-func (x *GetSnippetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_api_proto_msgTypes[41]
+func (x *GetSnippetDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2571,39 +3878,18 @@ func (x *GetSnippetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSnippetResponse.ProtoReflect.Descriptor instead.
-func (*GetSnippetResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_api_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use GetSnippetDiffResponse.ProtoReflect.Descriptor instead.
+func (*GetSnippetDiffResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *GetSnippetResponse) GetFiles() []*SnippetFile {
+func (x *GetSnippetDiffResponse) GetFiles() []*SnippetFileDiff {
 	if x != nil {
 		return x.Files
 	}
 	return nil
 }
 
-func (x *GetSnippetResponse) GetSdk() Sdk {
-	if x != nil {
-		return x.Sdk
-	}
-	return Sdk_SDK_UNSPECIFIED
-}
-
-func (x *GetSnippetResponse) GetPipelineOptions() string {
-	if x != nil {
-		return x.PipelineOptions
-	}
-	return ""
-}
-
-func (x *GetSnippetResponse) GetComplexity() Complexity {
-	if x != nil {
-		return x.Complexity
-	}
-	return Complexity_COMPLEXITY_UNSPECIFIED
-}
-
 // GetMetadataRequest represents request for runner metadata
 type GetMetadataRequest struct {
 	state         protoimpl.MessageState
@@ -2614,7 +3900,7 @@ type GetMetadataRequest struct {
 func (x *GetMetadataRequest) Reset() {
 	*x = GetMetadataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_api_proto_msgTypes[42]
+		mi := &file_api_v1_api_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2627,7 +3913,7 @@ func (x *GetMetadataRequest) String() string {
 func (*GetMetadataRequest) ProtoMessage() {}
 
 func (x *GetMetadataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_api_proto_msgTypes[42]
+	mi := &file_api_v1_api_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2640,7 +3926,7 @@ func (x *GetMetadataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMetadataRequest.ProtoReflect.Descriptor instead.
 func (*GetMetadataRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_api_proto_rawDescGZIP(), []int{42}
+	return file_api_v1_api_proto_rawDescGZIP(), []int{64}
 }
 
 // GetMetadataResponse contains metadata about the runner
@@ -2658,7 +3944,7 @@ type GetMetadataResponse struct {
 func (x *GetMetadataResponse) Reset() {
 	*x = GetMetadataResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_api_proto_msgTypes[43]
+		mi := &file_api_v1_api_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2671,7 +3957,7 @@ func (x *GetMetadataResponse) String() string {
 func (*GetMetadataResponse) ProtoMessage() {}
 
 func (x *GetMetadataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_api_proto_msgTypes[43]
+	mi := &file_api_v1_api_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2684,7 +3970,7 @@ func (x *GetMetadataResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMetadataResponse.ProtoReflect.Descriptor instead.
 func (*GetMetadataResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_api_proto_rawDescGZIP(), []int{43}
+	return file_api_v1_api_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *GetMetadataResponse) GetRunnerSdk() string {
@@ -2727,7 +4013,7 @@ type Categories_Category struct {
 func (x *Categories_Category) Reset() {
 	*x = Categories_Category{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_api_proto_msgTypes[45]
+		mi := &file_api_v1_api_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2740,7 +4026,7 @@ func (x *Categories_Category) String() string {
 func (*Categories_Category) ProtoMessage() {}
 
 func (x *Categories_Category) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_api_proto_msgTypes[45]
+	mi := &file_api_v1_api_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2753,7 +4039,7 @@ func (x *Categories_Category) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Categories_Category.ProtoReflect.Descriptor instead.
 func (*Categories_Category) Descriptor() ([]byte, []int) {
-	return file_api_v1_api_proto_rawDescGZIP(), []int{22, 0}
+	return file_api_v1_api_proto_rawDescGZIP(), []int{36, 0}
 }
 
 func (x *Categories_Category) GetCategoryName() string {
@@ -2853,173 +4139,303 @@ var file_api_v1_api_proto_rawDesc = []byte{
 	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x75, 0x69, 0x64, 0x22, 0x29, 0x0a, 0x0f, 0x47, 0x65, 0x74,
 	0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
 	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x22, 0x36, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x75, 0x69, 0x64, 0x22, 0x28, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x70, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x67, 0x72, 0x61, 0x70, 0x68, 0x22, 0x34, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x69, 0x70, 0x65, 0x6c,
-	0x69, 0x6e, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x75, 0x69, 0x64, 0x22, 0x10, 0x0a, 0x0e,
-	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd0,
-	0x04, 0x0a, 0x11, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x50,
-	0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x10,
-	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x6d,
-	0x75, 0x6c, 0x74, 0x69, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x78, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x27, 0x0a, 0x0f,
-	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x45, 0x78,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x03, 0x73, 0x64, 0x6b, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x64, 0x6b, 0x52,
-	0x03, 0x73, 0x64, 0x6b, 0x12, 0x32, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x78, 0x69,
-	0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x78, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x63, 0x6f,
-	0x6d, 0x70, 0x6c, 0x65, 0x78, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73,
-	0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x2b, 0x0a, 0x08,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52,
-	0x08, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x72, 0x6c,
-	0x5f, 0x76, 0x63, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x72, 0x6c, 0x56,
-	0x63, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x72, 0x6c, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f,
-	0x6f, 0x6b, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x72, 0x6c, 0x4e, 0x6f, 0x74,
-	0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x5f,
-	0x72, 0x75, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6c, 0x77, 0x61, 0x79,
-	0x73, 0x52, 0x75, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x76, 0x65, 0x72, 0x5f, 0x72, 0x75,
-	0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6e, 0x65, 0x76, 0x65, 0x72, 0x52, 0x75,
-	0x6e, 0x22, 0xe5, 0x01, 0x0a, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
-	0x12, 0x1d, 0x0a, 0x03, 0x73, 0x64, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x64, 0x6b, 0x52, 0x03, 0x73, 0x64, 0x6b, 0x12,
-	0x3b, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
-	0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x7b, 0x0a, 0x08,
-	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4a, 0x0a,
-	0x13, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x6f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x12, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c,
-	0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x22, 0x59, 0x0a, 0x1c, 0x47, 0x65, 0x74,
-	0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x03, 0x73, 0x64, 0x6b,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x64, 0x6b, 0x52, 0x03, 0x73, 0x64, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x79, 0x22, 0x3c, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f,
-	0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x22, 0x3d, 0x0a, 0x16, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x75,
+	0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x55,
+	0x75, 0x69, 0x64, 0x22, 0x31, 0x0a, 0x17, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x75, 0x6e,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x38, 0x0a, 0x11, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x75, 0x69, 0x64,
+	0x22, 0x2c, 0x0a, 0x12, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x3a,
+	0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x75, 0x69, 0x64, 0x22, 0x3e, 0x0a, 0x14, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x36, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x75,
+	0x69, 0x64, 0x22, 0x28, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x70, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x61, 0x70, 0x68, 0x22, 0x3b, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x75, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x09, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x70, 0x0a, 0x0d,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x23, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x65, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x22, 0x5f,
+	0x0a, 0x11, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x75, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x75, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x61, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22,
+	0xab, 0x01, 0x0a, 0x12, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x23, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x09, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x22, 0x40, 0x0a,
+	0x0a, 0x47, 0x61, 0x75, 0x67, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22,
+	0x96, 0x01, 0x0a, 0x0b, 0x47, 0x61, 0x75, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12,
+	0x23, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x09, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x61, 0x75, 0x67, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x61, 0x75, 0x67, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x22, 0xb9, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x52, 0x75, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x08, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x73, 0x12, 0x40, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x67, 0x61, 0x75, 0x67, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x61, 0x75, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x06, 0x67, 0x61,
+	0x75, 0x67, 0x65, 0x73, 0x22, 0x34, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x55, 0x75, 0x69, 0x64, 0x22, 0x10, 0x0a, 0x0e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd0, 0x04, 0x0a,
+	0x11, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x45, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x03, 0x73, 0x64, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x64, 0x6b, 0x52, 0x03, 0x73,
+	0x64, 0x6b, 0x12, 0x32, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x78, 0x69, 0x74, 0x79,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x78, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x78, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x08, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x72, 0x6c, 0x5f, 0x76,
+	0x63, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x72, 0x6c, 0x56, 0x63, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x75, 0x72, 0x6c, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x72, 0x6c, 0x4e, 0x6f, 0x74, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x5f, 0x72, 0x75,
+	0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x52,
+	0x75, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x76, 0x65, 0x72, 0x5f, 0x72, 0x75, 0x6e, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6e, 0x65, 0x76, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x22,
+	0xe5, 0x01, 0x0a, 0x0a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1d,
+	0x0a, 0x03, 0x73, 0x64, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x64, 0x6b, 0x52, 0x03, 0x73, 0x64, 0x6b, 0x12, 0x3b, 0x0a,
+	0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x0a,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x7b, 0x0a, 0x08, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x13, 0x70,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x12, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x22, 0x59, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x03, 0x73, 0x64, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x64,
+	0x6b, 0x52, 0x03, 0x73, 0x64, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x22, 0x3c, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70,
+	0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x50, 0x61, 0x74, 0x68,
+	0x22, 0x40, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c,
+	0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x70, 0x61, 0x74,
 	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x50, 0x61,
-	0x74, 0x68, 0x22, 0x40, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70,
-	0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x75, 0x64,
-	0x50, 0x61, 0x74, 0x68, 0x22, 0x42, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f,
-	0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x6f,
+	0x74, 0x68, 0x22, 0x42, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70,
+	0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x50, 0x61, 0x74, 0x68, 0x22, 0x40, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65,
+	0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x6f,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x6f,
 	0x75, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
-	0x6c, 0x6f, 0x75, 0x64, 0x50, 0x61, 0x74, 0x68, 0x22, 0x40, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x50,
-	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x50, 0x61, 0x74, 0x68, 0x22, 0x41, 0x0a, 0x20, 0x47, 0x65,
-	0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x50, 0x61, 0x74, 0x68, 0x22, 0x43, 0x0a,
-	0x22, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f,
-	0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x03, 0x73, 0x64, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x64, 0x6b, 0x52, 0x03, 0x73,
-	0x64, 0x6b, 0x22, 0x5a, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70,
-	0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0e, 0x73, 0x64, 0x6b, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52,
-	0x0d, 0x73, 0x64, 0x6b, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x68,
-	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64,
-	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48,
-	0x0a, 0x12, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x6f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x11, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c,
-	0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x61, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x6f, 0x75, 0x64, 0x50, 0x61, 0x74, 0x68, 0x22, 0x41, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x50,
 	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x12, 0x29, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74,
-	0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x22, 0x47,
-	0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x3a, 0x0a, 0x20, 0x47, 0x65, 0x74,
-	0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x39, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63,
-	0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x47, 0x72, 0x61,
-	0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72,
-	0x61, 0x70, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x61, 0x70, 0x68,
-	0x22, 0x6f, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x72,
-	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x63, 0x6f,
-	0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65,
-	0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x11,
-	0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x22, 0x54, 0x0a, 0x0b, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x17,
-	0x0a, 0x07, 0x69, 0x73, 0x5f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x06, 0x69, 0x73, 0x4d, 0x61, 0x69, 0x6e, 0x22, 0xe6, 0x01, 0x0a, 0x12, 0x53, 0x61, 0x76, 0x65,
-	0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29,
-	0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x50, 0x61, 0x74, 0x68, 0x22, 0x43, 0x0a, 0x22, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70,
+	0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x03, 0x73, 0x64, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x64, 0x6b, 0x52, 0x03, 0x73, 0x64, 0x6b,
+	0x22, 0x5a, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c,
+	0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x39, 0x0a, 0x0e, 0x73, 0x64, 0x6b, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x0d, 0x73,
+	0x64, 0x6b, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x68, 0x0a, 0x1c,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x12,
+	0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x11, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x61, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65,
+	0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x29,
+	0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
 	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x46, 0x69,
-	0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x03, 0x73, 0x64, 0x6b,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x64, 0x6b, 0x52, 0x03, 0x73, 0x64, 0x6b, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x69, 0x70, 0x65,
-	0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x78, 0x69, 0x74,
-	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x78, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x63, 0x6f, 0x6d,
-	0x70, 0x6c, 0x65, 0x78, 0x69, 0x74, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x65, 0x72, 0x73, 0x69,
-	0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x4b, 0x65, 0x79,
-	0x22, 0x25, 0x0a, 0x13, 0x53, 0x61, 0x76, 0x65, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x6e,
-	0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xbd, 0x01, 0x0a,
-	0x12, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x69, 0x70,
-	0x70, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1d,
-	0x0a, 0x03, 0x73, 0x64, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x64, 0x6b, 0x52, 0x03, 0x73, 0x64, 0x6b, 0x12, 0x29, 0x0a,
-	0x10, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
-	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70,
-	0x6c, 0x65, 0x78, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x78, 0x69, 0x74, 0x79,
-	0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x78, 0x69, 0x74, 0x79, 0x22, 0x14, 0x0a, 0x12,
+	0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x22, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x3a, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4c,
+	0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x22, 0x39, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d,
+	0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x61, 0x70, 0x68, 0x22, 0x6f,
+	0x0a, 0x23, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70,
+	0x69, 0x6c, 0x65, 0x64, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x63, 0x6f,
+	0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x11, 0x70, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22,
+	0x54, 0x0a, 0x0b, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x69, 0x73, 0x5f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69,
+	0x73, 0x4d, 0x61, 0x69, 0x6e, 0x22, 0xa6, 0x02, 0x0a, 0x12, 0x53, 0x61, 0x76, 0x65, 0x53, 0x6e,
+	0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x05,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x03, 0x73, 0x64, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x64,
+	0x6b, 0x52, 0x03, 0x73, 0x64, 0x6b, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x32, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x78, 0x69, 0x74, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x78, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x78, 0x69, 0x74, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x25,
+	0x0a, 0x13, 0x53, 0x61, 0x76, 0x65, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x69, 0x70,
+	0x70, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xf6, 0x01, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x29, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x03,
+	0x73, 0x64, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x64, 0x6b, 0x52, 0x03, 0x73, 0x64, 0x6b, 0x12, 0x29, 0x0a, 0x10, 0x70,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x78, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x78, 0x69, 0x74, 0x79, 0x52, 0x0a,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x78, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x47, 0x0a, 0x12, 0x46, 0x6f, 0x72, 0x6b, 0x53, 0x6e, 0x69, 0x70, 0x70,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x25, 0x0a, 0x13,
+	0x46, 0x6f, 0x72, 0x6b, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x2d, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x69, 0x70, 0x70,
+	0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0xa9, 0x01, 0x0a, 0x0f, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x48, 0x61, 0x73, 0x68, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05,
+	0x52, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x90,
+	0x01, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x35, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x69, 0x70,
+	0x70, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e,
+	0x64, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x74,
+	0x73, 0x22, 0x45, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x44,
+	0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x72,
+	0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x72, 0x6f,
+	0x6d, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x0f, 0x53, 0x6e, 0x69, 0x70,
+	0x70, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x69, 0x66, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64,
+	0x69, 0x66, 0x66, 0x22, 0x47, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65,
+	0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a,
+	0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x44, 0x69, 0x66, 0x66, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x14, 0x0a, 0x12,
 	0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x22, 0xe5, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
 	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x75,
@@ -3035,168 +4451,210 @@ var file_api_v1_api_proto_rawDesc = []byte{
 	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x45, 0x70, 0x6f, 0x63, 0x68,
 	0x12, 0x28, 0x0a, 0x10, 0x62, 0x65, 0x61, 0x6d, 0x5f, 0x73, 0x64, 0x6b, 0x5f, 0x76, 0x65, 0x72,
 	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x65, 0x61, 0x6d,
-	0x53, 0x64, 0x6b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2a, 0x52, 0x0a, 0x03, 0x53, 0x64,
+	0x53, 0x64, 0x6b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2a, 0x66, 0x0a, 0x03, 0x53, 0x64,
 	0x6b, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x44, 0x4b, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
 	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x44, 0x4b, 0x5f, 0x4a, 0x41,
 	0x56, 0x41, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x44, 0x4b, 0x5f, 0x47, 0x4f, 0x10, 0x02,
 	0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x44, 0x4b, 0x5f, 0x50, 0x59, 0x54, 0x48, 0x4f, 0x4e, 0x10, 0x03,
-	0x12, 0x0c, 0x0a, 0x08, 0x53, 0x44, 0x4b, 0x5f, 0x53, 0x43, 0x49, 0x4f, 0x10, 0x04, 0x2a, 0xb8,
-	0x02, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x41, 0x4c, 0x49,
-	0x44, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52,
-	0x52, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x50, 0x52, 0x45, 0x50, 0x41, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x52, 0x45, 0x50, 0x41, 0x52, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x49, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x12,
-	0x18, 0x0a, 0x14, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x49, 0x4c,
-	0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x06, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x07, 0x12,
-	0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48,
-	0x45, 0x44, 0x10, 0x08, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52,
-	0x55, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x09, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x0a, 0x12, 0x16, 0x0a, 0x12,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f,
-	0x55, 0x54, 0x10, 0x0b, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43,
-	0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x0c, 0x2a, 0xae, 0x01, 0x0a, 0x15, 0x50, 0x72,
-	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x23, 0x50, 0x52, 0x45, 0x43, 0x4f, 0x4d, 0x50, 0x49, 0x4c,
-	0x45, 0x44, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f,
-	0x50, 0x52, 0x45, 0x43, 0x4f, 0x4d, 0x50, 0x49, 0x4c, 0x45, 0x44, 0x5f, 0x4f, 0x42, 0x4a, 0x45,
-	0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x58, 0x41, 0x4d, 0x50, 0x4c, 0x45, 0x10,
-	0x01, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x52, 0x45, 0x43, 0x4f, 0x4d, 0x50, 0x49, 0x4c, 0x45, 0x44,
-	0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4b, 0x41, 0x54,
-	0x41, 0x10, 0x02, 0x12, 0x25, 0x0a, 0x21, 0x50, 0x52, 0x45, 0x43, 0x4f, 0x4d, 0x50, 0x49, 0x4c,
-	0x45, 0x44, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
-	0x4e, 0x49, 0x54, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x10, 0x03, 0x2a, 0x6e, 0x0a, 0x0a, 0x43, 0x6f,
-	0x6d, 0x70, 0x6c, 0x65, 0x78, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x4d, 0x50,
-	0x4c, 0x45, 0x58, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x58, 0x49,
-	0x54, 0x59, 0x5f, 0x42, 0x41, 0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x4f,
-	0x4d, 0x50, 0x4c, 0x45, 0x58, 0x49, 0x54, 0x59, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x55, 0x4d, 0x10,
-	0x02, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x58, 0x49, 0x54, 0x59, 0x5f,
-	0x41, 0x44, 0x56, 0x41, 0x4e, 0x43, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x46, 0x0a, 0x0c, 0x45, 0x6d,
-	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x4d,
-	0x55, 0x4c, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4d, 0x55,
-	0x4c, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4b, 0x41, 0x46, 0x4b, 0x41,
-	0x10, 0x01, 0x32, 0xd3, 0x0d, 0x0a, 0x11, 0x50, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e,
-	0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e,
-	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c,
-	0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1b, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x4f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4c, 0x6f,
-	0x67, 0x73, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c,
-	0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12,
-	0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x70,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75,
-	0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x13, 0x47, 0x65,
-	0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x14, 0x47, 0x65,
-	0x74, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x12, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a,
-	0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
-	0x6d, 0x70, 0x69, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x15,
+	0x12, 0x0c, 0x0a, 0x08, 0x53, 0x44, 0x4b, 0x5f, 0x53, 0x43, 0x49, 0x4f, 0x10, 0x04, 0x12, 0x12,
+	0x0a, 0x0e, 0x53, 0x44, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54,
+	0x10, 0x05, 0x2a, 0xcb, 0x02, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a,
+	0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x50, 0x52, 0x45, 0x50, 0x41, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12,
+	0x1c, 0x0a, 0x18, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x52, 0x45, 0x50, 0x41, 0x52,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x04, 0x12, 0x14, 0x0a,
+	0x10, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x49, 0x4c, 0x49, 0x4e,
+	0x47, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f,
+	0x4d, 0x50, 0x49, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x06, 0x12, 0x14, 0x0a,
+	0x10, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4e,
+	0x47, 0x10, 0x07, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x49,
+	0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x08, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x09, 0x12, 0x10,
+	0x0a, 0x0c, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x0a,
+	0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x54,
+	0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x0b, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x0c, 0x12, 0x11, 0x0a,
+	0x0d, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10, 0x0d,
+	0x2a, 0xae, 0x01, 0x0a, 0x15, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x23, 0x50, 0x52,
+	0x45, 0x43, 0x4f, 0x4d, 0x50, 0x49, 0x4c, 0x45, 0x44, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x50, 0x52, 0x45, 0x43, 0x4f, 0x4d, 0x50, 0x49, 0x4c,
+	0x45, 0x44, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45,
+	0x58, 0x41, 0x4d, 0x50, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x52, 0x45, 0x43,
+	0x4f, 0x4d, 0x50, 0x49, 0x4c, 0x45, 0x44, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x4b, 0x41, 0x54, 0x41, 0x10, 0x02, 0x12, 0x25, 0x0a, 0x21, 0x50, 0x52,
+	0x45, 0x43, 0x4f, 0x4d, 0x50, 0x49, 0x4c, 0x45, 0x44, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x10,
+	0x03, 0x2a, 0x6e, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x78, 0x69, 0x74, 0x79, 0x12,
+	0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x58, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x43,
+	0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x58, 0x49, 0x54, 0x59, 0x5f, 0x42, 0x41, 0x53, 0x49, 0x43, 0x10,
+	0x01, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x58, 0x49, 0x54, 0x59, 0x5f,
+	0x4d, 0x45, 0x44, 0x49, 0x55, 0x4d, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x4d, 0x50,
+	0x4c, 0x45, 0x58, 0x49, 0x54, 0x59, 0x5f, 0x41, 0x44, 0x56, 0x41, 0x4e, 0x43, 0x45, 0x44, 0x10,
+	0x03, 0x2a, 0x8f, 0x01, 0x0a, 0x0c, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x4b, 0x41, 0x46, 0x4b, 0x41, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x4d,
+	0x55, 0x4c, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x55, 0x42, 0x53,
+	0x55, 0x42, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x4f, 0x52,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x43, 0x53, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x45,
+	0x4d, 0x55, 0x4c, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a, 0x44, 0x42,
+	0x43, 0x10, 0x04, 0x32, 0x87, 0x12, 0x0a, 0x11, 0x50, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x52, 0x75, 0x6e,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75,
+	0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a,
+	0x0c, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1b, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4c,
+	0x6f, 0x67, 0x73, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x75,
+	0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x75, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x75, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x0a, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30,
+	0x01, 0x12, 0x4b, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x3d,
+	0x0a, 0x08, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x1c,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x47,
+	0x65, 0x74, 0x52, 0x75, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x23, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65,
+	0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x69, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x06, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
 	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65,
+	0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12,
+	0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x14,
 	0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70,
-	0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d,
-	0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x23, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69,
-	0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65,
-	0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65,
-	0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x70,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65,
+	0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x6d, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65,
+	0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x2e, 0x61, 0x70,
 	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69,
-	0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63,
-	0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x12, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f,
-	0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x18, 0x47, 0x65,
+	0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
 	0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63,
+	0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73,
+	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x29, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70,
+	0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d,
+	0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x12,
+	0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63,
 	0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x6f, 0x67,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x19, 0x47, 0x65, 0x74,
-	0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65,
-	0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x47, 0x72,
-	0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x1b, 0x47,
-	0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70,
-	0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x2a, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x72,
-	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d,
-	0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x53, 0x61, 0x76, 0x65, 0x53, 0x6e, 0x69, 0x70, 0x70,
-	0x65, 0x74, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x76, 0x65,
-	0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x53, 0x6e, 0x69, 0x70,
-	0x70, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x47,
-	0x65, 0x74, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x46, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x38, 0x5a, 0x36, 0x62, 0x65, 0x61, 0x6d,
-	0x2e, 0x61, 0x70, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x70, 0x6c, 0x61, 0x79,
-	0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x3b, 0x70, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75,
-	0x6e, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x70, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70,
+	0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12,
+	0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65,
+	0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c,
+	0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b,
+	0x53, 0x61, 0x76, 0x65, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x1a, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x61, 0x76, 0x65, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x69, 0x70, 0x70,
+	0x65, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x46, 0x6f, 0x72,
+	0x6b, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6b, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f,
+	0x72, 0x6b, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x61, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x69, 0x70,
+	0x70, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x69, 0x70, 0x70,
+	0x65, 0x74, 0x44, 0x69, 0x66, 0x66, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x38, 0x5a,
+	0x36, 0x62, 0x65, 0x61, 0x6d, 0x2e, 0x61, 0x70, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x6f, 0x72, 0x67,
+	0x2f, 0x70, 0x6c, 0x61, 0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x2f, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x3b, 0x70, 0x6c, 0x61,
+	0x79, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3212,7 +4670,7 @@ func file_api_v1_api_proto_rawDescGZIP() []byte {
 }
 
 var file_api_v1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_api_v1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
+var file_api_v1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 68)
 var file_api_v1_api_proto_goTypes = []interface{}{
 	(Sdk)(0),                                    // 0: api.v1.Sdk
 	(Status)(0),                                 // 1: api.v1.Status
@@ -3236,107 +4694,157 @@ var file_api_v1_api_proto_goTypes = []interface{}{
 	(*GetRunErrorResponse)(nil),                 // 19: api.v1.GetRunErrorResponse
 	(*GetLogsRequest)(nil),                      // 20: api.v1.GetLogsRequest
 	(*GetLogsResponse)(nil),                     // 21: api.v1.GetLogsResponse
-	(*GetGraphRequest)(nil),                     // 22: api.v1.GetGraphRequest
-	(*GetGraphResponse)(nil),                    // 23: api.v1.GetGraphResponse
-	(*CancelRequest)(nil),                       // 24: api.v1.CancelRequest
-	(*CancelResponse)(nil),                      // 25: api.v1.CancelResponse
-	(*PrecompiledObject)(nil),                   // 26: api.v1.PrecompiledObject
-	(*Categories)(nil),                          // 27: api.v1.Categories
-	(*GetPrecompiledObjectsRequest)(nil),        // 28: api.v1.GetPrecompiledObjectsRequest
-	(*GetPrecompiledObjectRequest)(nil),         // 29: api.v1.GetPrecompiledObjectRequest
-	(*GetPrecompiledObjectCodeRequest)(nil),     // 30: api.v1.GetPrecompiledObjectCodeRequest
-	(*GetPrecompiledObjectOutputRequest)(nil),   // 31: api.v1.GetPrecompiledObjectOutputRequest
-	(*GetPrecompiledObjectLogsRequest)(nil),     // 32: api.v1.GetPrecompiledObjectLogsRequest
-	(*GetPrecompiledObjectGraphRequest)(nil),    // 33: api.v1.GetPrecompiledObjectGraphRequest
-	(*GetDefaultPrecompiledObjectRequest)(nil),  // 34: api.v1.GetDefaultPrecompiledObjectRequest
-	(*GetPrecompiledObjectsResponse)(nil),       // 35: api.v1.GetPrecompiledObjectsResponse
-	(*GetPrecompiledObjectResponse)(nil),        // 36: api.v1.GetPrecompiledObjectResponse
-	(*GetPrecompiledObjectCodeResponse)(nil),    // 37: api.v1.GetPrecompiledObjectCodeResponse
-	(*GetPrecompiledObjectOutputResponse)(nil),  // 38: api.v1.GetPrecompiledObjectOutputResponse
-	(*GetPrecompiledObjectLogsResponse)(nil),    // 39: api.v1.GetPrecompiledObjectLogsResponse
-	(*GetPrecompiledObjectGraphResponse)(nil),   // 40: api.v1.GetPrecompiledObjectGraphResponse
-	(*GetDefaultPrecompiledObjectResponse)(nil), // 41: api.v1.GetDefaultPrecompiledObjectResponse
-	(*SnippetFile)(nil),                         // 42: api.v1.SnippetFile
-	(*SaveSnippetRequest)(nil),                  // 43: api.v1.SaveSnippetRequest
-	(*SaveSnippetResponse)(nil),                 // 44: api.v1.SaveSnippetResponse
-	(*GetSnippetRequest)(nil),                   // 45: api.v1.GetSnippetRequest
-	(*GetSnippetResponse)(nil),                  // 46: api.v1.GetSnippetResponse
-	(*GetMetadataRequest)(nil),                  // 47: api.v1.GetMetadataRequest
-	(*GetMetadataResponse)(nil),                 // 48: api.v1.GetMetadataResponse
-	nil,                                         // 49: api.v1.Dataset.OptionsEntry
-	(*Categories_Category)(nil),                 // 50: api.v1.Categories.Category
+	(*StreamRunOutputRequest)(nil),              // 22: api.v1.StreamRunOutputRequest
+	(*StreamRunOutputResponse)(nil),             // 23: api.v1.StreamRunOutputResponse
+	(*StreamLogsRequest)(nil),                   // 24: api.v1.StreamLogsRequest
+	(*StreamLogsResponse)(nil),                  // 25: api.v1.StreamLogsResponse
+	(*StreamStatusRequest)(nil),                 // 26: api.v1.StreamStatusRequest
+	(*StreamStatusResponse)(nil),                // 27: api.v1.StreamStatusResponse
+	(*GetGraphRequest)(nil),                     // 28: api.v1.GetGraphRequest
+	(*GetGraphResponse)(nil),                    // 29: api.v1.GetGraphResponse
+	(*GetRunMetricsRequest)(nil),                // 30: api.v1.GetRunMetricsRequest
+	(*MetricKey)(nil),                           // 31: api.v1.MetricKey
+	(*CounterMetric)(nil),                       // 32: api.v1.CounterMetric
+	(*DistributionValue)(nil),                   // 33: api.v1.DistributionValue
+	(*DistributionMetric)(nil),                  // 34: api.v1.DistributionMetric
+	(*GaugeValue)(nil),                          // 35: api.v1.GaugeValue
+	(*GaugeMetric)(nil),                         // 36: api.v1.GaugeMetric
+	(*GetRunMetricsResponse)(nil),               // 37: api.v1.GetRunMetricsResponse
+	(*CancelRequest)(nil),                       // 38: api.v1.CancelRequest
+	(*CancelResponse)(nil),                      // 39: api.v1.CancelResponse
+	(*PrecompiledObject)(nil),                   // 40: api.v1.PrecompiledObject
+	(*Categories)(nil),                          // 41: api.v1.Categories
+	(*GetPrecompiledObjectsRequest)(nil),        // 42: api.v1.GetPrecompiledObjectsRequest
+	(*GetPrecompiledObjectRequest)(nil),         // 43: api.v1.GetPrecompiledObjectRequest
+	(*GetPrecompiledObjectCodeRequest)(nil),     // 44: api.v1.GetPrecompiledObjectCodeRequest
+	(*GetPrecompiledObjectOutputRequest)(nil),   // 45: api.v1.GetPrecompiledObjectOutputRequest
+	(*GetPrecompiledObjectLogsRequest)(nil),     // 46: api.v1.GetPrecompiledObjectLogsRequest
+	(*GetPrecompiledObjectGraphRequest)(nil),    // 47: api.v1.GetPrecompiledObjectGraphRequest
+	(*GetDefaultPrecompiledObjectRequest)(nil),  // 48: api.v1.GetDefaultPrecompiledObjectRequest
+	(*GetPrecompiledObjectsResponse)(nil),       // 49: api.v1.GetPrecompiledObjectsResponse
+	(*GetPrecompiledObjectResponse)(nil),        // 50: api.v1.GetPrecompiledObjectResponse
+	(*GetPrecompiledObjectCodeResponse)(nil),    // 51: api.v1.GetPrecompiledObjectCodeResponse
+	(*GetPrecompiledObjectOutputResponse)(nil),  // 52: api.v1.GetPrecompiledObjectOutputResponse
+	(*GetPrecompiledObjectLogsResponse)(nil),    // 53: api.v1.GetPrecompiledObjectLogsResponse
+	(*GetPrecompiledObjectGraphResponse)(nil),   // 54: api.v1.GetPrecompiledObjectGraphResponse
+	(*GetDefaultPrecompiledObjectResponse)(nil), // 55: api.v1.GetDefaultPrecompiledObjectResponse
+	(*SnippetFile)(nil),                         // 56: api.v1.SnippetFile
+	(*SaveSnippetRequest)(nil),                  // 57: api.v1.SaveSnippetRequest
+	(*SaveSnippetResponse)(nil),                 // 58: api.v1.SaveSnippetResponse
+	(*GetSnippetRequest)(nil),                   // 59: api.v1.GetSnippetRequest
+	(*GetSnippetResponse)(nil),                  // 60: api.v1.GetSnippetResponse
+	(*ForkSnippetRequest)(nil),                  // 61: api.v1.ForkSnippetRequest
+	(*ForkSnippetResponse)(nil),                 // 62: api.v1.ForkSnippetResponse
+	(*ListSnippetRevisionsRequest)(nil),         // 63: api.v1.ListSnippetRevisionsRequest
+	(*SnippetRevision)(nil),                     // 64: api.v1.SnippetRevision
+	(*ListSnippetRevisionsResponse)(nil),        // 65: api.v1.ListSnippetRevisionsResponse
+	(*GetSnippetDiffRequest)(nil),               // 66: api.v1.GetSnippetDiffRequest
+	(*SnippetFileDiff)(nil),                     // 67: api.v1.SnippetFileDiff
+	(*GetSnippetDiffResponse)(nil),              // 68: api.v1.GetSnippetDiffResponse
+	(*GetMetadataRequest)(nil),                  // 69: api.v1.GetMetadataRequest
+	(*GetMetadataResponse)(nil),                 // 70: api.v1.GetMetadataResponse
+	nil,                                         // 71: api.v1.Dataset.OptionsEntry
+	(*Categories_Category)(nil),                 // 72: api.v1.Categories.Category
 }
 var file_api_v1_api_proto_depIdxs = []int32{
 	4,  // 0: api.v1.Dataset.type:type_name -> api.v1.EmulatorType
-	49, // 1: api.v1.Dataset.options:type_name -> api.v1.Dataset.OptionsEntry
+	71, // 1: api.v1.Dataset.options:type_name -> api.v1.Dataset.OptionsEntry
 	0,  // 2: api.v1.RunCodeRequest.sdk:type_name -> api.v1.Sdk
 	5,  // 3: api.v1.RunCodeRequest.datasets:type_name -> api.v1.Dataset
-	42, // 4: api.v1.RunCodeRequest.files:type_name -> api.v1.SnippetFile
+	56, // 4: api.v1.RunCodeRequest.files:type_name -> api.v1.SnippetFile
 	1,  // 5: api.v1.CheckStatusResponse.status:type_name -> api.v1.Status
-	2,  // 6: api.v1.PrecompiledObject.type:type_name -> api.v1.PrecompiledObjectType
-	0,  // 7: api.v1.PrecompiledObject.sdk:type_name -> api.v1.Sdk
-	3,  // 8: api.v1.PrecompiledObject.complexity:type_name -> api.v1.Complexity
-	5,  // 9: api.v1.PrecompiledObject.datasets:type_name -> api.v1.Dataset
-	0,  // 10: api.v1.Categories.sdk:type_name -> api.v1.Sdk
-	50, // 11: api.v1.Categories.categories:type_name -> api.v1.Categories.Category
-	0,  // 12: api.v1.GetPrecompiledObjectsRequest.sdk:type_name -> api.v1.Sdk
-	0,  // 13: api.v1.GetDefaultPrecompiledObjectRequest.sdk:type_name -> api.v1.Sdk
-	27, // 14: api.v1.GetPrecompiledObjectsResponse.sdk_categories:type_name -> api.v1.Categories
-	26, // 15: api.v1.GetPrecompiledObjectResponse.precompiled_object:type_name -> api.v1.PrecompiledObject
-	42, // 16: api.v1.GetPrecompiledObjectCodeResponse.files:type_name -> api.v1.SnippetFile
-	26, // 17: api.v1.GetDefaultPrecompiledObjectResponse.precompiled_object:type_name -> api.v1.PrecompiledObject
-	42, // 18: api.v1.SaveSnippetRequest.files:type_name -> api.v1.SnippetFile
-	0,  // 19: api.v1.SaveSnippetRequest.sdk:type_name -> api.v1.Sdk
-	3,  // 20: api.v1.SaveSnippetRequest.complexity:type_name -> api.v1.Complexity
-	42, // 21: api.v1.GetSnippetResponse.files:type_name -> api.v1.SnippetFile
-	0,  // 22: api.v1.GetSnippetResponse.sdk:type_name -> api.v1.Sdk
-	3,  // 23: api.v1.GetSnippetResponse.complexity:type_name -> api.v1.Complexity
-	26, // 24: api.v1.Categories.Category.precompiled_objects:type_name -> api.v1.PrecompiledObject
-	6,  // 25: api.v1.PlaygroundService.RunCode:input_type -> api.v1.RunCodeRequest
-	8,  // 26: api.v1.PlaygroundService.CheckStatus:input_type -> api.v1.CheckStatusRequest
-	16, // 27: api.v1.PlaygroundService.GetRunOutput:input_type -> api.v1.GetRunOutputRequest
-	20, // 28: api.v1.PlaygroundService.GetLogs:input_type -> api.v1.GetLogsRequest
-	22, // 29: api.v1.PlaygroundService.GetGraph:input_type -> api.v1.GetGraphRequest
-	18, // 30: api.v1.PlaygroundService.GetRunError:input_type -> api.v1.GetRunErrorRequest
-	10, // 31: api.v1.PlaygroundService.GetValidationOutput:input_type -> api.v1.GetValidationOutputRequest
-	12, // 32: api.v1.PlaygroundService.GetPreparationOutput:input_type -> api.v1.GetPreparationOutputRequest
-	14, // 33: api.v1.PlaygroundService.GetCompileOutput:input_type -> api.v1.GetCompileOutputRequest
-	24, // 34: api.v1.PlaygroundService.Cancel:input_type -> api.v1.CancelRequest
-	28, // 35: api.v1.PlaygroundService.GetPrecompiledObjects:input_type -> api.v1.GetPrecompiledObjectsRequest
-	29, // 36: api.v1.PlaygroundService.GetPrecompiledObject:input_type -> api.v1.GetPrecompiledObjectRequest
-	30, // 37: api.v1.PlaygroundService.GetPrecompiledObjectCode:input_type -> api.v1.GetPrecompiledObjectCodeRequest
-	31, // 38: api.v1.PlaygroundService.GetPrecompiledObjectOutput:input_type -> api.v1.GetPrecompiledObjectOutputRequest
-	32, // 39: api.v1.PlaygroundService.GetPrecompiledObjectLogs:input_type -> api.v1.GetPrecompiledObjectLogsRequest
-	33, // 40: api.v1.PlaygroundService.GetPrecompiledObjectGraph:input_type -> api.v1.GetPrecompiledObjectGraphRequest
-	34, // 41: api.v1.PlaygroundService.GetDefaultPrecompiledObject:input_type -> api.v1.GetDefaultPrecompiledObjectRequest
-	43, // 42: api.v1.PlaygroundService.SaveSnippet:input_type -> api.v1.SaveSnippetRequest
-	45, // 43: api.v1.PlaygroundService.GetSnippet:input_type -> api.v1.GetSnippetRequest
-	47, // 44: api.v1.PlaygroundService.GetMetadata:input_type -> api.v1.GetMetadataRequest
-	7,  // 45: api.v1.PlaygroundService.RunCode:output_type -> api.v1.RunCodeResponse
-	9,  // 46: api.v1.PlaygroundService.CheckStatus:output_type -> api.v1.CheckStatusResponse
-	17, // 47: api.v1.PlaygroundService.GetRunOutput:output_type -> api.v1.GetRunOutputResponse
-	21, // 48: api.v1.PlaygroundService.GetLogs:output_type -> api.v1.GetLogsResponse
-	23, // 49: api.v1.PlaygroundService.GetGraph:output_type -> api.v1.GetGraphResponse
-	19, // 50: api.v1.PlaygroundService.GetRunError:output_type -> api.v1.GetRunErrorResponse
-	11, // 51: api.v1.PlaygroundService.GetValidationOutput:output_type -> api.v1.GetValidationOutputResponse
-	13, // 52: api.v1.PlaygroundService.GetPreparationOutput:output_type -> api.v1.GetPreparationOutputResponse
-	15, // 53: api.v1.PlaygroundService.GetCompileOutput:output_type -> api.v1.GetCompileOutputResponse
-	25, // 54: api.v1.PlaygroundService.Cancel:output_type -> api.v1.CancelResponse
-	35, // 55: api.v1.PlaygroundService.GetPrecompiledObjects:output_type -> api.v1.GetPrecompiledObjectsResponse
-	36, // 56: api.v1.PlaygroundService.GetPrecompiledObject:output_type -> api.v1.GetPrecompiledObjectResponse
-	37, // 57: api.v1.PlaygroundService.GetPrecompiledObjectCode:output_type -> api.v1.GetPrecompiledObjectCodeResponse
-	38, // 58: api.v1.PlaygroundService.GetPrecompiledObjectOutput:output_type -> api.v1.GetPrecompiledObjectOutputResponse
-	39, // 59: api.v1.PlaygroundService.GetPrecompiledObjectLogs:output_type -> api.v1.GetPrecompiledObjectLogsResponse
-	40, // 60: api.v1.PlaygroundService.GetPrecompiledObjectGraph:output_type -> api.v1.GetPrecompiledObjectGraphResponse
-	41, // 61: api.v1.PlaygroundService.GetDefaultPrecompiledObject:output_type -> api.v1.GetDefaultPrecompiledObjectResponse
-	44, // 62: api.v1.PlaygroundService.SaveSnippet:output_type -> api.v1.SaveSnippetResponse
-	46, // 63: api.v1.PlaygroundService.GetSnippet:output_type -> api.v1.GetSnippetResponse
-	48, // 64: api.v1.PlaygroundService.GetMetadata:output_type -> api.v1.GetMetadataResponse
-	45, // [45:65] is the sub-list for method output_type
-	25, // [25:45] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	1,  // 6: api.v1.StreamStatusResponse.status:type_name -> api.v1.Status
+	31, // 7: api.v1.CounterMetric.key:type_name -> api.v1.MetricKey
+	31, // 8: api.v1.DistributionMetric.key:type_name -> api.v1.MetricKey
+	33, // 9: api.v1.DistributionMetric.attempted:type_name -> api.v1.DistributionValue
+	33, // 10: api.v1.DistributionMetric.committed:type_name -> api.v1.DistributionValue
+	31, // 11: api.v1.GaugeMetric.key:type_name -> api.v1.MetricKey
+	35, // 12: api.v1.GaugeMetric.attempted:type_name -> api.v1.GaugeValue
+	35, // 13: api.v1.GaugeMetric.committed:type_name -> api.v1.GaugeValue
+	32, // 14: api.v1.GetRunMetricsResponse.counters:type_name -> api.v1.CounterMetric
+	34, // 15: api.v1.GetRunMetricsResponse.distributions:type_name -> api.v1.DistributionMetric
+	36, // 16: api.v1.GetRunMetricsResponse.gauges:type_name -> api.v1.GaugeMetric
+	2,  // 17: api.v1.PrecompiledObject.type:type_name -> api.v1.PrecompiledObjectType
+	0,  // 18: api.v1.PrecompiledObject.sdk:type_name -> api.v1.Sdk
+	3,  // 19: api.v1.PrecompiledObject.complexity:type_name -> api.v1.Complexity
+	5,  // 20: api.v1.PrecompiledObject.datasets:type_name -> api.v1.Dataset
+	0,  // 21: api.v1.Categories.sdk:type_name -> api.v1.Sdk
+	72, // 22: api.v1.Categories.categories:type_name -> api.v1.Categories.Category
+	0,  // 23: api.v1.GetPrecompiledObjectsRequest.sdk:type_name -> api.v1.Sdk
+	0,  // 24: api.v1.GetDefaultPrecompiledObjectRequest.sdk:type_name -> api.v1.Sdk
+	41, // 25: api.v1.GetPrecompiledObjectsResponse.sdk_categories:type_name -> api.v1.Categories
+	40, // 26: api.v1.GetPrecompiledObjectResponse.precompiled_object:type_name -> api.v1.PrecompiledObject
+	56, // 27: api.v1.GetPrecompiledObjectCodeResponse.files:type_name -> api.v1.SnippetFile
+	40, // 28: api.v1.GetDefaultPrecompiledObjectResponse.precompiled_object:type_name -> api.v1.PrecompiledObject
+	56, // 29: api.v1.SaveSnippetRequest.files:type_name -> api.v1.SnippetFile
+	0,  // 30: api.v1.SaveSnippetRequest.sdk:type_name -> api.v1.Sdk
+	3,  // 31: api.v1.SaveSnippetRequest.complexity:type_name -> api.v1.Complexity
+	56, // 32: api.v1.GetSnippetResponse.files:type_name -> api.v1.SnippetFile
+	0,  // 33: api.v1.GetSnippetResponse.sdk:type_name -> api.v1.Sdk
+	3,  // 34: api.v1.GetSnippetResponse.complexity:type_name -> api.v1.Complexity
+	64, // 35: api.v1.ListSnippetRevisionsResponse.revisions:type_name -> api.v1.SnippetRevision
+	64, // 36: api.v1.ListSnippetRevisionsResponse.descendants:type_name -> api.v1.SnippetRevision
+	67, // 37: api.v1.GetSnippetDiffResponse.files:type_name -> api.v1.SnippetFileDiff
+	40, // 38: api.v1.Categories.Category.precompiled_objects:type_name -> api.v1.PrecompiledObject
+	6,  // 39: api.v1.PlaygroundService.RunCode:input_type -> api.v1.RunCodeRequest
+	8,  // 40: api.v1.PlaygroundService.CheckStatus:input_type -> api.v1.CheckStatusRequest
+	16, // 41: api.v1.PlaygroundService.GetRunOutput:input_type -> api.v1.GetRunOutputRequest
+	20, // 42: api.v1.PlaygroundService.GetLogs:input_type -> api.v1.GetLogsRequest
+	22, // 43: api.v1.PlaygroundService.StreamRunOutput:input_type -> api.v1.StreamRunOutputRequest
+	24, // 44: api.v1.PlaygroundService.StreamLogs:input_type -> api.v1.StreamLogsRequest
+	26, // 45: api.v1.PlaygroundService.StreamStatus:input_type -> api.v1.StreamStatusRequest
+	28, // 46: api.v1.PlaygroundService.GetGraph:input_type -> api.v1.GetGraphRequest
+	30, // 47: api.v1.PlaygroundService.GetRunMetrics:input_type -> api.v1.GetRunMetricsRequest
+	18, // 48: api.v1.PlaygroundService.GetRunError:input_type -> api.v1.GetRunErrorRequest
+	10, // 49: api.v1.PlaygroundService.GetValidationOutput:input_type -> api.v1.GetValidationOutputRequest
+	12, // 50: api.v1.PlaygroundService.GetPreparationOutput:input_type -> api.v1.GetPreparationOutputRequest
+	14, // 51: api.v1.PlaygroundService.GetCompileOutput:input_type -> api.v1.GetCompileOutputRequest
+	38, // 52: api.v1.PlaygroundService.Cancel:input_type -> api.v1.CancelRequest
+	42, // 53: api.v1.PlaygroundService.GetPrecompiledObjects:input_type -> api.v1.GetPrecompiledObjectsRequest
+	43, // 54: api.v1.PlaygroundService.GetPrecompiledObject:input_type -> api.v1.GetPrecompiledObjectRequest
+	44, // 55: api.v1.PlaygroundService.GetPrecompiledObjectCode:input_type -> api.v1.GetPrecompiledObjectCodeRequest
+	45, // 56: api.v1.PlaygroundService.GetPrecompiledObjectOutput:input_type -> api.v1.GetPrecompiledObjectOutputRequest
+	46, // 57: api.v1.PlaygroundService.GetPrecompiledObjectLogs:input_type -> api.v1.GetPrecompiledObjectLogsRequest
+	47, // 58: api.v1.PlaygroundService.GetPrecompiledObjectGraph:input_type -> api.v1.GetPrecompiledObjectGraphRequest
+	48, // 59: api.v1.PlaygroundService.GetDefaultPrecompiledObject:input_type -> api.v1.GetDefaultPrecompiledObjectRequest
+	57, // 60: api.v1.PlaygroundService.SaveSnippet:input_type -> api.v1.SaveSnippetRequest
+	59, // 61: api.v1.PlaygroundService.GetSnippet:input_type -> api.v1.GetSnippetRequest
+	61, // 62: api.v1.PlaygroundService.ForkSnippet:input_type -> api.v1.ForkSnippetRequest
+	63, // 63: api.v1.PlaygroundService.ListSnippetRevisions:input_type -> api.v1.ListSnippetRevisionsRequest
+	66, // 64: api.v1.PlaygroundService.GetSnippetDiff:input_type -> api.v1.GetSnippetDiffRequest
+	69, // 65: api.v1.PlaygroundService.GetMetadata:input_type -> api.v1.GetMetadataRequest
+	7,  // 66: api.v1.PlaygroundService.RunCode:output_type -> api.v1.RunCodeResponse
+	9,  // 67: api.v1.PlaygroundService.CheckStatus:output_type -> api.v1.CheckStatusResponse
+	17, // 68: api.v1.PlaygroundService.GetRunOutput:output_type -> api.v1.GetRunOutputResponse
+	21, // 69: api.v1.PlaygroundService.GetLogs:output_type -> api.v1.GetLogsResponse
+	23, // 70: api.v1.PlaygroundService.StreamRunOutput:output_type -> api.v1.StreamRunOutputResponse
+	25, // 71: api.v1.PlaygroundService.StreamLogs:output_type -> api.v1.StreamLogsResponse
+	27, // 72: api.v1.PlaygroundService.StreamStatus:output_type -> api.v1.StreamStatusResponse
+	29, // 73: api.v1.PlaygroundService.GetGraph:output_type -> api.v1.GetGraphResponse
+	37, // 74: api.v1.PlaygroundService.GetRunMetrics:output_type -> api.v1.GetRunMetricsResponse
+	19, // 75: api.v1.PlaygroundService.GetRunError:output_type -> api.v1.GetRunErrorResponse
+	11, // 76: api.v1.PlaygroundService.GetValidationOutput:output_type -> api.v1.GetValidationOutputResponse
+	13, // 77: api.v1.PlaygroundService.GetPreparationOutput:output_type -> api.v1.GetPreparationOutputResponse
+	15, // 78: api.v1.PlaygroundService.GetCompileOutput:output_type -> api.v1.GetCompileOutputResponse
+	39, // 79: api.v1.PlaygroundService.Cancel:output_type -> api.v1.CancelResponse
+	49, // 80: api.v1.PlaygroundService.GetPrecompiledObjects:output_type -> api.v1.GetPrecompiledObjectsResponse
+	50, // 81: api.v1.PlaygroundService.GetPrecompiledObject:output_type -> api.v1.GetPrecompiledObjectResponse
+	51, // 82: api.v1.PlaygroundService.GetPrecompiledObjectCode:output_type -> api.v1.GetPrecompiledObjectCodeResponse
+	52, // 83: api.v1.PlaygroundService.GetPrecompiledObjectOutput:output_type -> api.v1.GetPrecompiledObjectOutputResponse
+	53, // 84: api.v1.PlaygroundService.GetPrecompiledObjectLogs:output_type -> api.v1.GetPrecompiledObjectLogsResponse
+	54, // 85: api.v1.PlaygroundService.GetPrecompiledObjectGraph:output_type -> api.v1.GetPrecompiledObjectGraphResponse
+	55, // 86: api.v1.PlaygroundService.GetDefaultPrecompiledObject:output_type -> api.v1.GetDefaultPrecompiledObjectResponse
+	58, // 87: api.v1.PlaygroundService.SaveSnippet:output_type -> api.v1.SaveSnippetResponse
+	60, // 88: api.v1.PlaygroundService.GetSnippet:output_type -> api.v1.GetSnippetResponse
+	62, // 89: api.v1.PlaygroundService.ForkSnippet:output_type -> api.v1.ForkSnippetResponse
+	65, // 90: api.v1.PlaygroundService.ListSnippetRevisions:output_type -> api.v1.ListSnippetRevisionsResponse
+	68, // 91: api.v1.PlaygroundService.GetSnippetDiff:output_type -> api.v1.GetSnippetDiffResponse
+	70, // 92: api.v1.PlaygroundService.GetMetadata:output_type -> api.v1.GetMetadataResponse
+	66, // [66:93] is the sub-list for method output_type
+	39, // [39:66] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_api_proto_init() }
@@ -3357,8 +4865,104 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunCodeRequest); i {
+		file_api_v1_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunCodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValidationOutputRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValidationOutputResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPreparationOutputRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPreparationOutputResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCompileOutputRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3369,8 +4973,8 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunCodeResponse); i {
+		file_api_v1_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCompileOutputResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3381,8 +4985,8 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckStatusRequest); i {
+		file_api_v1_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRunOutputRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3393,8 +4997,8 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckStatusResponse); i {
+		file_api_v1_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRunOutputResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3405,8 +5009,8 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetValidationOutputRequest); i {
+		file_api_v1_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRunErrorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3417,8 +5021,8 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetValidationOutputResponse); i {
+		file_api_v1_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRunErrorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3429,8 +5033,8 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPreparationOutputRequest); i {
+		file_api_v1_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLogsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3441,8 +5045,8 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPreparationOutputResponse); i {
+		file_api_v1_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLogsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3453,8 +5057,8 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCompileOutputRequest); i {
+		file_api_v1_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamRunOutputRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3465,8 +5069,8 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCompileOutputResponse); i {
+		file_api_v1_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamRunOutputResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3477,8 +5081,8 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRunOutputRequest); i {
+		file_api_v1_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamLogsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3489,8 +5093,8 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRunOutputResponse); i {
+		file_api_v1_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamLogsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3501,8 +5105,8 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRunErrorRequest); i {
+		file_api_v1_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3513,8 +5117,8 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRunErrorResponse); i {
+		file_api_v1_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3525,8 +5129,8 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLogsRequest); i {
+		file_api_v1_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGraphRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3537,8 +5141,8 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLogsResponse); i {
+		file_api_v1_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGraphResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3549,8 +5153,8 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGraphRequest); i {
+		file_api_v1_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRunMetricsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3561,8 +5165,8 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGraphResponse); i {
+		file_api_v1_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetricKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3573,7 +5177,79 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CounterMetric); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DistributionValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DistributionMetric); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GaugeValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GaugeMetric); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRunMetricsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CancelRequest); i {
 			case 0:
 				return &v.state
@@ -3585,7 +5261,7 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CancelResponse); i {
 			case 0:
 				return &v.state
@@ -3597,7 +5273,7 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PrecompiledObject); i {
 			case 0:
 				return &v.state
@@ -3609,7 +5285,7 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Categories); i {
 			case 0:
 				return &v.state
@@ -3621,7 +5297,7 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPrecompiledObjectsRequest); i {
 			case 0:
 				return &v.state
@@ -3633,7 +5309,7 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPrecompiledObjectRequest); i {
 			case 0:
 				return &v.state
@@ -3645,7 +5321,7 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPrecompiledObjectCodeRequest); i {
 			case 0:
 				return &v.state
@@ -3657,7 +5333,7 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_api_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPrecompiledObjectOutputRequest); i {
 			case 0:
 				return &v.state
@@ -3669,7 +5345,7 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_api_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPrecompiledObjectLogsRequest); i {
 			case 0:
 				return &v.state
@@ -3681,7 +5357,7 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_api_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPrecompiledObjectGraphRequest); i {
 			case 0:
 				return &v.state
@@ -3693,7 +5369,7 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_api_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetDefaultPrecompiledObjectRequest); i {
 			case 0:
 				return &v.state
@@ -3705,7 +5381,7 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_api_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPrecompiledObjectsResponse); i {
 			case 0:
 				return &v.state
@@ -3717,7 +5393,7 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_api_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPrecompiledObjectResponse); i {
 			case 0:
 				return &v.state
@@ -3729,7 +5405,7 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_api_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPrecompiledObjectCodeResponse); i {
 			case 0:
 				return &v.state
@@ -3741,7 +5417,7 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_api_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPrecompiledObjectOutputResponse); i {
 			case 0:
 				return &v.state
@@ -3753,7 +5429,7 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_api_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPrecompiledObjectLogsResponse); i {
 			case 0:
 				return &v.state
@@ -3765,7 +5441,7 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_api_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPrecompiledObjectGraphResponse); i {
 			case 0:
 				return &v.state
@@ -3777,7 +5453,7 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_api_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetDefaultPrecompiledObjectResponse); i {
 			case 0:
 				return &v.state
@@ -3789,7 +5465,7 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_api_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SnippetFile); i {
 			case 0:
 				return &v.state
@@ -3801,7 +5477,7 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_api_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SaveSnippetRequest); i {
 			case 0:
 				return &v.state
@@ -3813,7 +5489,7 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_api_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SaveSnippetResponse); i {
 			case 0:
 				return &v.state
@@ -3825,7 +5501,7 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_api_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSnippetRequest); i {
 			case 0:
 				return &v.state
@@ -3837,7 +5513,7 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_api_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSnippetResponse); i {
 			case 0:
 				return &v.state
@@ -3849,7 +5525,103 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_api_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForkSnippetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_api_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForkSnippetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_api_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSnippetRevisionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_api_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnippetRevision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_api_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSnippetRevisionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_api_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSnippetDiffRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_api_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnippetFileDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_api_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSnippetDiffResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_api_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetMetadataRequest); i {
 			case 0:
 				return &v.state
@@ -3861,7 +5633,7 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_api_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetMetadataResponse); i {
 			case 0:
 				return &v.state
@@ -3873,7 +5645,7 @@ func file_api_v1_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_api_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_api_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Categories_Category); i {
 			case 0:
 				return &v.state
@@ -3892,7 +5664,7 @@ func file_api_v1_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_v1_api_proto_rawDesc,
 			NumEnums:      5,
-			NumMessages:   46,
+			NumMessages:   68,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,6 +25,9 @@ var _ PlaygroundServiceClient = &PlaygroundServiceClientMock{}
 //			CheckStatusFunc: func(ctx context.Context, in *CheckStatusRequest, opts ...grpc.CallOption) (*CheckStatusResponse, error) {
 //				panic("mock out the CheckStatus method")
 //			},
+//			ForkSnippetFunc: func(ctx context.Context, in *ForkSnippetRequest, opts ...grpc.CallOption) (*ForkSnippetResponse, error) {
+//				panic("mock out the ForkSnippet method")
+//			},
 //			GetCompileOutputFunc: func(ctx context.Context, in *GetCompileOutputRequest, opts ...grpc.CallOption) (*GetCompileOutputResponse, error) {
 //				panic("mock out the GetCompileOutput method")
 //			},
@@ -64,21 +67,39 @@ var _ PlaygroundServiceClient = &PlaygroundServiceClientMock{}
 //			GetRunErrorFunc: func(ctx context.Context, in *GetRunErrorRequest, opts ...grpc.CallOption) (*GetRunErrorResponse, error) {
 //				panic("mock out the GetRunError method")
 //			},
+//			GetRunMetricsFunc: func(ctx context.Context, in *GetRunMetricsRequest, opts ...grpc.CallOption) (*GetRunMetricsResponse, error) {
+//				panic("mock out the GetRunMetrics method")
+//			},
 //			GetRunOutputFunc: func(ctx context.Context, in *GetRunOutputRequest, opts ...grpc.CallOption) (*GetRunOutputResponse, error) {
 //				panic("mock out the GetRunOutput method")
 //			},
 //			GetSnippetFunc: func(ctx context.Context, in *GetSnippetRequest, opts ...grpc.CallOption) (*GetSnippetResponse, error) {
 //				panic("mock out the GetSnippet method")
 //			},
+//			GetSnippetDiffFunc: func(ctx context.Context, in *GetSnippetDiffRequest, opts ...grpc.CallOption) (*GetSnippetDiffResponse, error) {
+//				panic("mock out the GetSnippetDiff method")
+//			},
 //			GetValidationOutputFunc: func(ctx context.Context, in *GetValidationOutputRequest, opts ...grpc.CallOption) (*GetValidationOutputResponse, error) {
 //				panic("mock out the GetValidationOutput method")
 //			},
+//			ListSnippetRevisionsFunc: func(ctx context.Context, in *ListSnippetRevisionsRequest, opts ...grpc.CallOption) (*ListSnippetRevisionsResponse, error) {
+//				panic("mock out the ListSnippetRevisions method")
+//			},
 //			RunCodeFunc: func(ctx context.Context, in *RunCodeRequest, opts ...grpc.CallOption) (*RunCodeResponse, error) {
 //				panic("mock out the RunCode method")
 //			},
 //			SaveSnippetFunc: func(ctx context.Context, in *SaveSnippetRequest, opts ...grpc.CallOption) (*SaveSnippetResponse, error) {
 //				panic("mock out the SaveSnippet method")
 //			},
+//			StreamLogsFunc: func(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (PlaygroundService_StreamLogsClient, error) {
+//				panic("mock out the StreamLogs method")
+//			},
+//			StreamRunOutputFunc: func(ctx context.Context, in *StreamRunOutputRequest, opts ...grpc.CallOption) (PlaygroundService_StreamRunOutputClient, error) {
+//				panic("mock out the StreamRunOutput method")
+//			},
+//			StreamStatusFunc: func(ctx context.Context, in *StreamStatusRequest, opts ...grpc.CallOption) (PlaygroundService_StreamStatusClient, error) {
+//				panic("mock out the StreamStatus method")
+//			},
 //		}
 //
 //		// use mockedPlaygroundServiceClient in code that requires PlaygroundServiceClient
@@ -92,6 +113,9 @@ type PlaygroundServiceClientMock struct {
 	// CheckStatusFunc mocks the CheckStatus method.
 	CheckStatusFunc func(ctx context.Context, in *CheckStatusRequest, opts ...grpc.CallOption) (*CheckStatusResponse, error)
 
+	// ForkSnippetFunc mocks the ForkSnippet method.
+	ForkSnippetFunc func(ctx context.Context, in *ForkSnippetRequest, opts ...grpc.CallOption) (*ForkSnippetResponse, error)
+
 	// GetCompileOutputFunc mocks the GetCompileOutput method.
 	GetCompileOutputFunc func(ctx context.Context, in *GetCompileOutputRequest, opts ...grpc.CallOption) (*GetCompileOutputResponse, error)
 
@@ -131,21 +155,39 @@ type PlaygroundServiceClientMock struct {
 	// GetRunErrorFunc mocks the GetRunError method.
 	GetRunErrorFunc func(ctx context.Context, in *GetRunErrorRequest, opts ...grpc.CallOption) (*GetRunErrorResponse, error)
 
+	// GetRunMetricsFunc mocks the GetRunMetrics method.
+	GetRunMetricsFunc func(ctx context.Context, in *GetRunMetricsRequest, opts ...grpc.CallOption) (*GetRunMetricsResponse, error)
+
 	// GetRunOutputFunc mocks the GetRunOutput method.
 	GetRunOutputFunc func(ctx context.Context, in *GetRunOutputRequest, opts ...grpc.CallOption) (*GetRunOutputResponse, error)
 
 	// GetSnippetFunc mocks the GetSnippet method.
 	GetSnippetFunc func(ctx context.Context, in *GetSnippetRequest, opts ...grpc.CallOption) (*GetSnippetResponse, error)
 
+	// GetSnippetDiffFunc mocks the GetSnippetDiff method.
+	GetSnippetDiffFunc func(ctx context.Context, in *GetSnippetDiffRequest, opts ...grpc.CallOption) (*GetSnippetDiffResponse, error)
+
 	// GetValidationOutputFunc mocks the GetValidationOutput method.
 	GetValidationOutputFunc func(ctx context.Context, in *GetValidationOutputRequest, opts ...grpc.CallOption) (*GetValidationOutputResponse, error)
 
+	// ListSnippetRevisionsFunc mocks the ListSnippetRevisions method.
+	ListSnippetRevisionsFunc func(ctx context.Context, in *ListSnippetRevisionsRequest, opts ...grpc.CallOption) (*ListSnippetRevisionsResponse, error)
+
 	// RunCodeFunc mocks the RunCode method.
 	RunCodeFunc func(ctx context.Context, in *RunCodeRequest, opts ...grpc.CallOption) (*RunCodeResponse, error)
 
 	// SaveSnippetFunc mocks the SaveSnippet method.
 	SaveSnippetFunc func(ctx context.Context, in *SaveSnippetRequest, opts ...grpc.CallOption) (*SaveSnippetResponse, error)
 
+	// StreamLogsFunc mocks the StreamLogs method.
+	StreamLogsFunc func(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (PlaygroundService_StreamLogsClient, error)
+
+	// StreamRunOutputFunc mocks the StreamRunOutput method.
+	StreamRunOutputFunc func(ctx context.Context, in *StreamRunOutputRequest, opts ...grpc.CallOption) (PlaygroundService_StreamRunOutputClient, error)
+
+	// StreamStatusFunc mocks the StreamStatus method.
+	StreamStatusFunc func(ctx context.Context, in *StreamStatusRequest, opts ...grpc.CallOption) (PlaygroundService_StreamStatusClient, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// Cancel holds details about calls to the Cancel method.
@@ -166,6 +208,15 @@ type PlaygroundServiceClientMock struct {
 			// Opts is the opts argument value.
 			Opts []grpc.CallOption
 		}
+		// ForkSnippet holds details about calls to the ForkSnippet method.
+		ForkSnippet []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *ForkSnippetRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
 		// GetCompileOutput holds details about calls to the GetCompileOutput method.
 		GetCompileOutput []struct {
 			// Ctx is the ctx argument value.
@@ -283,6 +334,15 @@ type PlaygroundServiceClientMock struct {
 			// Opts is the opts argument value.
 			Opts []grpc.CallOption
 		}
+		// GetRunMetrics holds details about calls to the GetRunMetrics method.
+		GetRunMetrics []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *GetRunMetricsRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
 		// GetRunOutput holds details about calls to the GetRunOutput method.
 		GetRunOutput []struct {
 			// Ctx is the ctx argument value.
@@ -301,6 +361,15 @@ type PlaygroundServiceClientMock struct {
 			// Opts is the opts argument value.
 			Opts []grpc.CallOption
 		}
+		// GetSnippetDiff holds details about calls to the GetSnippetDiff method.
+		GetSnippetDiff []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *GetSnippetDiffRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
 		// GetValidationOutput holds details about calls to the GetValidationOutput method.
 		GetValidationOutput []struct {
 			// Ctx is the ctx argument value.
@@ -310,6 +379,15 @@ type PlaygroundServiceClientMock struct {
 			// Opts is the opts argument value.
 			Opts []grpc.CallOption
 		}
+		// ListSnippetRevisions holds details about calls to the ListSnippetRevisions method.
+		ListSnippetRevisions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *ListSnippetRevisionsRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
 		// RunCode holds details about calls to the RunCode method.
 		RunCode []struct {
 			// Ctx is the ctx argument value.
@@ -328,9 +406,37 @@ type PlaygroundServiceClientMock struct {
 			// Opts is the opts argument value.
 			Opts []grpc.CallOption
 		}
+		// StreamLogs holds details about calls to the StreamLogs method.
+		StreamLogs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *StreamLogsRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+		// StreamRunOutput holds details about calls to the StreamRunOutput method.
+		StreamRunOutput []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *StreamRunOutputRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+		// StreamStatus holds details about calls to the StreamStatus method.
+		StreamStatus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *StreamStatusRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
 	}
 	lockCancel                      sync.RWMutex
 	lockCheckStatus                 sync.RWMutex
+	lockForkSnippet                 sync.RWMutex
 	lockGetCompileOutput            sync.RWMutex
 	lockGetDefaultPrecompiledObject sync.RWMutex
 	lockGetGraph                    sync.RWMutex
@@ -344,11 +450,17 @@ type PlaygroundServiceClientMock struct {
 	lockGetPrecompiledObjects       sync.RWMutex
 	lockGetPreparationOutput        sync.RWMutex
 	lockGetRunError                 sync.RWMutex
+	lockGetRunMetrics               sync.RWMutex
 	lockGetRunOutput                sync.RWMutex
 	lockGetSnippet                  sync.RWMutex
+	lockGetSnippetDiff              sync.RWMutex
 	lockGetValidationOutput         sync.RWMutex
+	lockListSnippetRevisions        sync.RWMutex
 	lockRunCode                     sync.RWMutex
 	lockSaveSnippet                 sync.RWMutex
+	lockStreamLogs                  sync.RWMutex
+	lockStreamRunOutput             sync.RWMutex
+	lockStreamStatus                sync.RWMutex
 }
 
 // Cancel calls CancelFunc.
@@ -431,6 +543,46 @@ func (mock *PlaygroundServiceClientMock) CheckStatusCalls() []struct {
 	return calls
 }
 
+// ForkSnippet calls ForkSnippetFunc.
+func (mock *PlaygroundServiceClientMock) ForkSnippet(ctx context.Context, in *ForkSnippetRequest, opts ...grpc.CallOption) (*ForkSnippetResponse, error) {
+	if mock.ForkSnippetFunc == nil {
+		panic("PlaygroundServiceClientMock.ForkSnippetFunc: method is nil but PlaygroundServiceClient.ForkSnippet was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *ForkSnippetRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockForkSnippet.Lock()
+	mock.calls.ForkSnippet = append(mock.calls.ForkSnippet, callInfo)
+	mock.lockForkSnippet.Unlock()
+	return mock.ForkSnippetFunc(ctx, in, opts...)
+}
+
+// ForkSnippetCalls gets all the calls that were made to ForkSnippet.
+// Check the length with:
+//
+//	len(mockedPlaygroundServiceClient.ForkSnippetCalls())
+func (mock *PlaygroundServiceClientMock) ForkSnippetCalls() []struct {
+	Ctx  context.Context
+	In   *ForkSnippetRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *ForkSnippetRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockForkSnippet.RLock()
+	calls = mock.calls.ForkSnippet
+	mock.lockForkSnippet.RUnlock()
+	return calls
+}
+
 // GetCompileOutput calls GetCompileOutputFunc.
 func (mock *PlaygroundServiceClientMock) GetCompileOutput(ctx context.Context, in *GetCompileOutputRequest, opts ...grpc.CallOption) (*GetCompileOutputResponse, error) {
 	if mock.GetCompileOutputFunc == nil {
@@ -951,6 +1103,46 @@ func (mock *PlaygroundServiceClientMock) GetRunErrorCalls() []struct {
 	return calls
 }
 
+// GetRunMetrics calls GetRunMetricsFunc.
+func (mock *PlaygroundServiceClientMock) GetRunMetrics(ctx context.Context, in *GetRunMetricsRequest, opts ...grpc.CallOption) (*GetRunMetricsResponse, error) {
+	if mock.GetRunMetricsFunc == nil {
+		panic("PlaygroundServiceClientMock.GetRunMetricsFunc: method is nil but PlaygroundServiceClient.GetRunMetrics was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *GetRunMetricsRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockGetRunMetrics.Lock()
+	mock.calls.GetRunMetrics = append(mock.calls.GetRunMetrics, callInfo)
+	mock.lockGetRunMetrics.Unlock()
+	return mock.GetRunMetricsFunc(ctx, in, opts...)
+}
+
+// GetRunMetricsCalls gets all the calls that were made to GetRunMetrics.
+// Check the length with:
+//
+//	len(mockedPlaygroundServiceClient.GetRunMetricsCalls())
+func (mock *PlaygroundServiceClientMock) GetRunMetricsCalls() []struct {
+	Ctx  context.Context
+	In   *GetRunMetricsRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *GetRunMetricsRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockGetRunMetrics.RLock()
+	calls = mock.calls.GetRunMetrics
+	mock.lockGetRunMetrics.RUnlock()
+	return calls
+}
+
 // GetRunOutput calls GetRunOutputFunc.
 func (mock *PlaygroundServiceClientMock) GetRunOutput(ctx context.Context, in *GetRunOutputRequest, opts ...grpc.CallOption) (*GetRunOutputResponse, error) {
 	if mock.GetRunOutputFunc == nil {
@@ -1031,6 +1223,46 @@ func (mock *PlaygroundServiceClientMock) GetSnippetCalls() []struct {
 	return calls
 }
 
+// GetSnippetDiff calls GetSnippetDiffFunc.
+func (mock *PlaygroundServiceClientMock) GetSnippetDiff(ctx context.Context, in *GetSnippetDiffRequest, opts ...grpc.CallOption) (*GetSnippetDiffResponse, error) {
+	if mock.GetSnippetDiffFunc == nil {
+		panic("PlaygroundServiceClientMock.GetSnippetDiffFunc: method is nil but PlaygroundServiceClient.GetSnippetDiff was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *GetSnippetDiffRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockGetSnippetDiff.Lock()
+	mock.calls.GetSnippetDiff = append(mock.calls.GetSnippetDiff, callInfo)
+	mock.lockGetSnippetDiff.Unlock()
+	return mock.GetSnippetDiffFunc(ctx, in, opts...)
+}
+
+// GetSnippetDiffCalls gets all the calls that were made to GetSnippetDiff.
+// Check the length with:
+//
+//	len(mockedPlaygroundServiceClient.GetSnippetDiffCalls())
+func (mock *PlaygroundServiceClientMock) GetSnippetDiffCalls() []struct {
+	Ctx  context.Context
+	In   *GetSnippetDiffRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *GetSnippetDiffRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockGetSnippetDiff.RLock()
+	calls = mock.calls.GetSnippetDiff
+	mock.lockGetSnippetDiff.RUnlock()
+	return calls
+}
+
 // GetValidationOutput calls GetValidationOutputFunc.
 func (mock *PlaygroundServiceClientMock) GetValidationOutput(ctx context.Context, in *GetValidationOutputRequest, opts ...grpc.CallOption) (*GetValidationOutputResponse, error) {
 	if mock.GetValidationOutputFunc == nil {
@@ -1071,6 +1303,46 @@ func (mock *PlaygroundServiceClientMock) GetValidationOutputCalls() []struct {
 	return calls
 }
 
+// ListSnippetRevisions calls ListSnippetRevisionsFunc.
+func (mock *PlaygroundServiceClientMock) ListSnippetRevisions(ctx context.Context, in *ListSnippetRevisionsRequest, opts ...grpc.CallOption) (*ListSnippetRevisionsResponse, error) {
+	if mock.ListSnippetRevisionsFunc == nil {
+		panic("PlaygroundServiceClientMock.ListSnippetRevisionsFunc: method is nil but PlaygroundServiceClient.ListSnippetRevisions was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *ListSnippetRevisionsRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockListSnippetRevisions.Lock()
+	mock.calls.ListSnippetRevisions = append(mock.calls.ListSnippetRevisions, callInfo)
+	mock.lockListSnippetRevisions.Unlock()
+	return mock.ListSnippetRevisionsFunc(ctx, in, opts...)
+}
+
+// ListSnippetRevisionsCalls gets all the calls that were made to ListSnippetRevisions.
+// Check the length with:
+//
+//	len(mockedPlaygroundServiceClient.ListSnippetRevisionsCalls())
+func (mock *PlaygroundServiceClientMock) ListSnippetRevisionsCalls() []struct {
+	Ctx  context.Context
+	In   *ListSnippetRevisionsRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *ListSnippetRevisionsRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockListSnippetRevisions.RLock()
+	calls = mock.calls.ListSnippetRevisions
+	mock.lockListSnippetRevisions.RUnlock()
+	return calls
+}
+
 // RunCode calls RunCodeFunc.
 func (mock *PlaygroundServiceClientMock) RunCode(ctx context.Context, in *RunCodeRequest, opts ...grpc.CallOption) (*RunCodeResponse, error) {
 	if mock.RunCodeFunc == nil {
@@ -1150,3 +1422,123 @@ func (mock *PlaygroundServiceClientMock) SaveSnippetCalls() []struct {
 	mock.lockSaveSnippet.RUnlock()
 	return calls
 }
+
+// StreamLogs calls StreamLogsFunc.
+func (mock *PlaygroundServiceClientMock) StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (PlaygroundService_StreamLogsClient, error) {
+	if mock.StreamLogsFunc == nil {
+		panic("PlaygroundServiceClientMock.StreamLogsFunc: method is nil but PlaygroundServiceClient.StreamLogs was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *StreamLogsRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockStreamLogs.Lock()
+	mock.calls.StreamLogs = append(mock.calls.StreamLogs, callInfo)
+	mock.lockStreamLogs.Unlock()
+	return mock.StreamLogsFunc(ctx, in, opts...)
+}
+
+// StreamLogsCalls gets all the calls that were made to StreamLogs.
+// Check the length with:
+//
+//	len(mockedPlaygroundServiceClient.StreamLogsCalls())
+func (mock *PlaygroundServiceClientMock) StreamLogsCalls() []struct {
+	Ctx  context.Context
+	In   *StreamLogsRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *StreamLogsRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockStreamLogs.RLock()
+	calls = mock.calls.StreamLogs
+	mock.lockStreamLogs.RUnlock()
+	return calls
+}
+
+// StreamRunOutput calls StreamRunOutputFunc.
+func (mock *PlaygroundServiceClientMock) StreamRunOutput(ctx context.Context, in *StreamRunOutputRequest, opts ...grpc.CallOption) (PlaygroundService_StreamRunOutputClient, error) {
+	if mock.StreamRunOutputFunc == nil {
+		panic("PlaygroundServiceClientMock.StreamRunOutputFunc: method is nil but PlaygroundServiceClient.StreamRunOutput was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *StreamRunOutputRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockStreamRunOutput.Lock()
+	mock.calls.StreamRunOutput = append(mock.calls.StreamRunOutput, callInfo)
+	mock.lockStreamRunOutput.Unlock()
+	return mock.StreamRunOutputFunc(ctx, in, opts...)
+}
+
+// StreamRunOutputCalls gets all the calls that were made to StreamRunOutput.
+// Check the length with:
+//
+//	len(mockedPlaygroundServiceClient.StreamRunOutputCalls())
+func (mock *PlaygroundServiceClientMock) StreamRunOutputCalls() []struct {
+	Ctx  context.Context
+	In   *StreamRunOutputRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *StreamRunOutputRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockStreamRunOutput.RLock()
+	calls = mock.calls.StreamRunOutput
+	mock.lockStreamRunOutput.RUnlock()
+	return calls
+}
+
+// StreamStatus calls StreamStatusFunc.
+func (mock *PlaygroundServiceClientMock) StreamStatus(ctx context.Context, in *StreamStatusRequest, opts ...grpc.CallOption) (PlaygroundService_StreamStatusClient, error) {
+	if mock.StreamStatusFunc == nil {
+		panic("PlaygroundServiceClientMock.StreamStatusFunc: method is nil but PlaygroundServiceClient.StreamStatus was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *StreamStatusRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockStreamStatus.Lock()
+	mock.calls.StreamStatus = append(mock.calls.StreamStatus, callInfo)
+	mock.lockStreamStatus.Unlock()
+	return mock.StreamStatusFunc(ctx, in, opts...)
+}
+
+// StreamStatusCalls gets all the calls that were made to StreamStatus.
+// Check the length with:
+//
+//	len(mockedPlaygroundServiceClient.StreamStatusCalls())
+func (mock *PlaygroundServiceClientMock) StreamStatusCalls() []struct {
+	Ctx  context.Context
+	In   *StreamStatusRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *StreamStatusRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockStreamStatus.RLock()
+	calls = mock.calls.StreamStatus
+	mock.lockStreamStatus.RUnlock()
+	return calls
+}
@@ -17,8 +17,7 @@ package main
 
 import (
 	"beam.apache.org/playground/backend/internal/constants"
-	"beam.apache.org/playground/backend/internal/db/datastore"
-	"beam.apache.org/playground/backend/internal/db/mapper"
+	"beam.apache.org/playground/backend/internal/db"
 	"beam.apache.org/playground/backend/internal/db/schema"
 	"beam.apache.org/playground/backend/internal/logger"
 	"context"
@@ -31,6 +30,8 @@ func main() {
 	projectId := flag.String("project-id", "", "GCP project id")
 	sdkConfigPath := flag.String("sdk-config", "", "Path to the sdk config file")
 	namespace := flag.String("namespace", constants.Namespace, "Datastore namespace")
+	dbType := flag.String("db-type", db.DatastoreType, "Database type: datastore, postgres or sqlite")
+	dataSourceName := flag.String("dsn", "", "Data source name of postgres and sqlite databases")
 
 	flag.Parse()
 
@@ -43,7 +44,7 @@ func main() {
 	}
 	logger.SetupLogger(context.Background(), cwd, *projectId)
 
-	migratedDb, err := datastore.New(ctx, mapper.NewPrecompiledObjectMapper(), nil, *projectId)
+	migratedDb, err := db.New(ctx, *dbType, *dataSourceName, *projectId, nil)
 	if err != nil {
 		logger.Fatalf("Couldn't create DB client instance, err: %s \n", err.Error())
 		os.Exit(1)
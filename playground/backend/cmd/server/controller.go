@@ -17,9 +17,17 @@ package main
 import (
 	"cloud.google.com/go/datastore"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"net"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	pb "beam.apache.org/playground/backend/internal/api/v1"
 	"beam.apache.org/playground/backend/internal/cache"
@@ -32,13 +40,18 @@ import (
 	"beam.apache.org/playground/backend/internal/environment"
 	cerrors "beam.apache.org/playground/backend/internal/errors"
 	"beam.apache.org/playground/backend/internal/logger"
+	"beam.apache.org/playground/backend/internal/preparers"
 	"beam.apache.org/playground/backend/internal/setup_tools/life_cycle"
+	"beam.apache.org/playground/backend/internal/streaming"
 	"beam.apache.org/playground/backend/internal/utils"
 )
 
 const (
 	errorTitleGetSnippet        = "Error during getting snippet"
 	errorTitleSaveSnippet       = "Error during saving snippet"
+	errorTitleForkSnippet       = "Error during forking snippet"
+	errorTitleListRevisions     = "Error during listing snippet revisions"
+	errorTitleGetSnippetDiff    = "Error during getting snippet diff"
 	errorTitleGetCatalog        = "Error during getting catalog"
 	errorTitleGetExample        = "Error during getting example"
 	errorTitleGetExampleCode    = "Error during getting example code"
@@ -51,6 +64,9 @@ const (
 	userCloudConnectionErrMsg  = "Cloud connection error"
 	resourceNotFoundErrMsg     = "Resource is not found"
 	resourceInconsistentErrMsg = "Resource is not consistent"
+
+	// streamRecheckInterval is how often streams recheck the cache in case notifications were lost
+	streamRecheckInterval = 5 * time.Second
 )
 
 // playgroundController processes `gRPC' requests from clients.
@@ -58,6 +74,10 @@ const (
 type playgroundController struct {
 	env          *environment.Environment
 	cacheService cache.Cache
+	// notifier notifies streams about the updates of cacheService's values
+	notifier cache.Notifier
+	// runQueue limits the number of pipelines processed at the same time
+	runQueue cache.RunQueue
 	// Database setup only if the server doesn't suppose to run code, i.e. SDK is unspecified
 	db             db.Database
 	props          *environment.Properties
@@ -69,17 +89,12 @@ type playgroundController struct {
 
 // RunCode is running code from requests using a particular SDK
 //   - In case of incorrect sdk returns codes.InvalidArgument
-//   - In case of exceeded number of parallel jobs returns codes.ResourceExhausted
 //   - In case of error during preparing files/folders returns codes.Internal
-//   - In case of no errors saves playground.Status_STATUS_EXECUTING as cache.Status into cache and sets expiration time
-//     for all cache values which will be saved into cache during processing received code.
+//   - In case of no errors saves playground.Status_STATUS_QUEUED as cache.Status into cache, sets expiration time
+//     for all cache values which will be saved into cache during processing received code and enqueues the code processing.
+//     The code is processed once the run queue admits it, which is the only limit of the number of parallel jobs.
 //     Returns id of code processing (pipelineId)
 func (controller *playgroundController) RunCode(ctx context.Context, info *pb.RunCodeRequest) (*pb.RunCodeResponse, error) {
-	// check if we can take a new RunCode request
-	if !utils.CheckNumOfTheParallelJobs(controller.env.ApplicationEnvs.WorkingDir(), controller.env.BeamSdkEnvs.NumOfParallelJobs()) {
-		logger.Warnf("RunCode(): number of parallel jobs is exceeded\n")
-		return nil, cerrors.ResourceExhaustedError("Error during preparing", "Number of parallel jobs is exceeded")
-	}
 	// check for correct sdk
 	if info.Sdk != controller.env.BeamSdkEnvs.ApacheBeamSdk {
 		logger.Errorf("RunCode(): request contains incorrect sdk: %s\n", info.Sdk)
@@ -129,7 +144,7 @@ func (controller *playgroundController) RunCode(ctx context.Context, info *pb.Ru
 		return nil, cerrors.InternalError("Error during preparing", "Error during setup file system for the code processing: %s", err.Error())
 	}
 
-	if err = utils.SetToCache(controller.cacheService, pipelineId, cache.Status, pb.Status_STATUS_VALIDATING); err != nil {
+	if err = utils.SetToCache(controller.cacheService, pipelineId, cache.Status, pb.Status_STATUS_QUEUED); err != nil {
 		code_processing.DeleteResources(pipelineId, lc)
 		return nil, cerrors.InternalError("Error during preparing", "Error during saving status of the code processing")
 	}
@@ -151,7 +166,13 @@ func (controller *playgroundController) RunCode(ctx context.Context, info *pb.Ru
 		return nil, cerrors.InternalError("Error during preparing", "Internal error")
 	}
 
-	go code_processing.Process(context.Background(), controller.cacheService, lc, pipelineId, &controller.env.ApplicationEnvs, &controller.env.BeamSdkEnvs, info.PipelineOptions)
+	if err = controller.runQueue.Enqueue(ctx, pipelineId, getClientId(ctx, controller.env.NetworkEnvs.TrustedProxyHops())); err != nil {
+		logger.Errorf("%s: RunCode(): runQueue.Enqueue(): %s\n", pipelineId, err.Error())
+		code_processing.DeleteResources(pipelineId, lc)
+		return nil, cerrors.InternalError("Error during preparing", "Error during queueing the code processing")
+	}
+
+	go code_processing.ProcessQueued(context.Background(), controller.cacheService, controller.runQueue, lc, pipelineId, &controller.env.ApplicationEnvs, &controller.env.BeamSdkEnvs, info.PipelineOptions)
 
 	pipelineInfo := pb.RunCodeResponse{PipelineUuid: pipelineId.String()}
 	return &pipelineInfo, nil
@@ -231,6 +252,92 @@ func (controller *playgroundController) GetLogs(ctx context.Context, info *pb.Ge
 	return &pipelineResult, nil
 }
 
+// StreamRunOutput is streaming output of execution for specific pipeline by PipelineUuid until the pipeline is finished.
+// Unlike GetRunOutput, it doesn't save the pagination value to cache, so several clients may stream the same output.
+func (controller *playgroundController) StreamRunOutput(info *pb.StreamRunOutputRequest, stream pb.PlaygroundService_StreamRunOutputServer) error {
+	pipelineId, err := uuid.Parse(info.PipelineUuid)
+	errorMessage := "Error during streaming run output of the code processing"
+	if err != nil {
+		logger.Errorf("%s: StreamRunOutput(): pipelineId has incorrect value and couldn't be parsed as uuid value: %s", info.PipelineUuid, err.Error())
+		return cerrors.InvalidArgumentError(errorMessage, "pipelineId has incorrect value and couldn't be parsed as uuid value: %s", info.PipelineUuid)
+	}
+	return controller.streamOutput(stream.Context(), pipelineId, cache.RunOutput, errorMessage, func(output string) error {
+		return stream.Send(&pb.StreamRunOutputResponse{Output: output})
+	})
+}
+
+// StreamLogs is streaming logs of execution for specific pipeline by PipelineUuid until the pipeline is finished.
+// Unlike GetLogs, it doesn't save the pagination value to cache, so several clients may stream the same logs.
+func (controller *playgroundController) StreamLogs(info *pb.StreamLogsRequest, stream pb.PlaygroundService_StreamLogsServer) error {
+	pipelineId, err := uuid.Parse(info.PipelineUuid)
+	errorMessage := "Error during streaming logs of the code processing"
+	if err != nil {
+		logger.Errorf("%s: StreamLogs(): pipelineId has incorrect value and couldn't be parsed as uuid value: %s", info.PipelineUuid, err.Error())
+		return cerrors.InvalidArgumentError(errorMessage, "pipelineId has incorrect value and couldn't be parsed as uuid value: %s", info.PipelineUuid)
+	}
+	return controller.streamOutput(stream.Context(), pipelineId, cache.Logs, errorMessage, func(output string) error {
+		return stream.Send(&pb.StreamLogsResponse{Output: output})
+	})
+}
+
+// StreamStatus is streaming status changes of specific pipeline by PipelineUuid, starting with the current status,
+// until the pipeline is finished.
+func (controller *playgroundController) StreamStatus(info *pb.StreamStatusRequest, stream pb.PlaygroundService_StreamStatusServer) error {
+	pipelineId, err := uuid.Parse(info.PipelineUuid)
+	errorMessage := "Error during streaming status of the code processing"
+	if err != nil {
+		logger.Errorf("%s: StreamStatus(): pipelineId has incorrect value and couldn't be parsed as uuid value: %s", info.PipelineUuid, err.Error())
+		return cerrors.InvalidArgumentError(errorMessage, "pipelineId has incorrect value and couldn't be parsed as uuid value: %s", info.PipelineUuid)
+	}
+	ctx := stream.Context()
+	sent := false
+	lastStatus := pb.Status_STATUS_UNSPECIFIED
+	return streaming.Watch(ctx, controller.notifier, pipelineId, streamRecheckInterval, func() (bool, error) {
+		status, err := code_processing.GetProcessingStatus(ctx, controller.cacheService, pipelineId, errorMessage)
+		if err != nil {
+			return false, err
+		}
+		if !sent || status != lastStatus {
+			if err := stream.Send(&pb.StreamStatusResponse{Status: status}); err != nil {
+				return false, err
+			}
+			sent, lastStatus = true, status
+		}
+		return streaming.IsFinished(status), nil
+	})
+}
+
+// streamOutput sends the new part of the output by pipelineId and subKey each time it's updated,
+// until the pipeline is finished.
+func (controller *playgroundController) streamOutput(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey, errorMessage string, send func(string) error) error {
+	sentLen := 0
+	return streaming.Watch(ctx, controller.notifier, pipelineId, streamRecheckInterval, func() (bool, error) {
+		// Get the status before the output, so that the output is complete once the status is final.
+		status, err := code_processing.GetProcessingStatus(ctx, controller.cacheService, pipelineId, errorMessage)
+		if err != nil {
+			return false, err
+		}
+		switch status {
+		case pb.Status_STATUS_QUEUED, pb.Status_STATUS_VALIDATING, pb.Status_STATUS_PREPARING, pb.Status_STATUS_COMPILING:
+			// The output is saved to cache once the code is compiled.
+			return false, nil
+		}
+		value, err := controller.cacheService.GetValue(ctx, pipelineId, subKey)
+		if err != nil {
+			// There's no output if the pipeline failed before the run step.
+			return streaming.IsFinished(status), nil
+		}
+		output, _ := value.(string)
+		if len(output) > sentLen {
+			if err := send(output[sentLen:]); err != nil {
+				return false, err
+			}
+			sentLen = len(output)
+		}
+		return streaming.IsFinished(status), nil
+	})
+}
+
 // GetRunError is returning error output of execution for specific pipeline by PipelineUuid
 func (controller *playgroundController) GetRunError(ctx context.Context, info *pb.GetRunErrorRequest) (*pb.GetRunErrorResponse, error) {
 	pipelineId, err := uuid.Parse(info.PipelineUuid)
@@ -306,6 +413,22 @@ func (controller *playgroundController) GetGraph(ctx context.Context, info *pb.G
 	return &pb.GetGraphResponse{Graph: graph}, nil
 }
 
+// GetRunMetrics is returning the final metrics of the pipeline reported by the runner.
+// Returns an Unimplemented error for sdks whose preparers don't save the metrics.
+func (controller *playgroundController) GetRunMetrics(ctx context.Context, info *pb.GetRunMetricsRequest) (*pb.GetRunMetricsResponse, error) {
+	pipelineId, err := uuid.Parse(info.PipelineUuid)
+	errorMessage := "Error during getting run metrics"
+	if err != nil {
+		logger.Errorf("%s: GetRunMetrics(): pipelineId has incorrect value and couldn't be parsed as uuid value: %s", info.PipelineUuid, err.Error())
+		return nil, cerrors.InvalidArgumentError(errorMessage, "pipelineId has incorrect value and couldn't be parsed as uuid value: %s", info.PipelineUuid)
+	}
+	if sdk := controller.env.BeamSdkEnvs.ApacheBeamSdk; !preparers.IsRunMetricsSupported(sdk) {
+		logger.Errorf("%s: GetRunMetrics(): run metrics are not supported for %s", info.PipelineUuid, sdk)
+		return nil, cerrors.UnimplementedError(errorMessage, "run metrics are not supported for %s", sdk)
+	}
+	return code_processing.GetRunMetrics(ctx, controller.cacheService, pipelineId, errorMessage)
+}
+
 // Cancel is setting cancel flag to stop code processing
 func (controller *playgroundController) Cancel(ctx context.Context, info *pb.CancelRequest) (*pb.CancelResponse, error) {
 	pipelineId, err := uuid.Parse(info.PipelineUuid)
@@ -519,20 +642,40 @@ func (controller *playgroundController) SaveSnippet(ctx context.Context, req *pb
 		snippet.Files = append(snippet.Files, fileEntity)
 	}
 
-	id, err := snippet.ID()
+	id, err := controller.putSnippet(ctx, snippet, errorTitleSaveSnippet)
 	if err != nil {
-		logger.Errorf("SaveSnippet(): ID(): error during ID generation: %s", err.Error())
-		return nil, cerrors.InternalError(errorTitleSaveSnippet, "Failed to generate ID")
-	}
-	if err = controller.db.PutSnippet(ctx, id, snippet); err != nil {
-		logger.Errorf("SaveSnippet(): PutSnippet(): error during entity saving: %s", err.Error())
-		return nil, cerrors.InternalError(errorTitleSaveSnippet, "Failed to save a snippet entity")
+		return nil, err
 	}
 
 	response := pb.SaveSnippetResponse{Id: id}
 	return &response, nil
 }
 
+// putSnippet saves the snippet as the next revision of its parent snippet, if it has one, and returns its ID
+func (controller *playgroundController) putSnippet(ctx context.Context, snippet *entity.Snippet, errorTitle string) (string, error) {
+	if parentId := snippet.Snippet.ParentId; parentId != "" {
+		parent, err := controller.db.GetSnippetRevision(ctx, parentId)
+		if err != nil {
+			logger.Errorf("putSnippet(): error during getting the parent snippet %s: %s", parentId, err.Error())
+			if errors.Is(err, datastore.ErrNoSuchEntity) {
+				return "", cerrors.NotFoundError(errorTitle, "Parent snippet is not found")
+			}
+			return "", cerrors.InternalError(errorTitle, "Failed to retrieve the parent snippet")
+		}
+		snippet.Snippet.Revision = parent.NextRevision()
+	}
+	id, err := snippet.ID()
+	if err != nil {
+		logger.Errorf("putSnippet(): ID(): error during ID generation: %s", err.Error())
+		return "", cerrors.InternalError(errorTitle, "Failed to generate ID")
+	}
+	if err = controller.db.PutSnippet(ctx, id, snippet); err != nil {
+		logger.Errorf("putSnippet(): PutSnippet(): error during entity saving: %s", err.Error())
+		return "", cerrors.InternalError(errorTitle, "Failed to save a snippet entity")
+	}
+	return id, nil
+}
+
 // GetSnippet returns the snippet entity
 func (controller *playgroundController) GetSnippet(ctx context.Context, info *pb.GetSnippetRequest) (*pb.GetSnippetResponse, error) {
 	if err := controller.verifyRouter(); err != nil {
@@ -556,6 +699,8 @@ func (controller *playgroundController) GetSnippet(ctx context.Context, info *pb
 		Sdk:             pb.Sdk(pb.Sdk_value[snippet.Sdk.Name]),
 		PipelineOptions: snippet.PipeOpts,
 		Complexity:      pb.Complexity(pb.Complexity_value[snippet.Complexity]),
+		ParentId:        snippet.ParentId,
+		Revision:        int32(snippet.GetRevision()),
 	}
 	files, err := controller.db.GetFiles(ctx, info.GetId(), snippet.NumberOfFiles)
 	if err != nil {
@@ -572,6 +717,179 @@ func (controller *playgroundController) GetSnippet(ctx context.Context, info *pb
 	return &response, nil
 }
 
+// ForkSnippet saves the copy of the snippet as its next revision
+func (controller *playgroundController) ForkSnippet(ctx context.Context, info *pb.ForkSnippetRequest) (*pb.ForkSnippetResponse, error) {
+	if err := controller.verifyRouter(); err != nil {
+		logger.Errorf("ForkSnippet() error: %s", err.Error())
+		return nil, err
+	}
+	snippet, files, err := controller.getSnippetRevision(ctx, info.GetId(), errorTitleForkSnippet)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &pb.SaveSnippetRequest{
+		Sdk:             pb.Sdk(pb.Sdk_value[snippet.Sdk.Name]),
+		PipelineOptions: snippet.PipeOpts,
+		Complexity:      pb.Complexity(pb.Complexity_value[snippet.Complexity]),
+		ParentId:        info.GetId(),
+		AuthorToken:     info.GetAuthorToken(),
+	}
+	for _, file := range files {
+		req.Files = append(req.Files, &pb.SnippetFile{
+			Name:    file.Name,
+			Content: file.Content,
+			IsMain:  file.IsMain,
+		})
+	}
+	fork := controller.entityMapper.ToSnippet(req)
+	for _, file := range req.Files {
+		fileEntity, err := controller.entityMapper.ToFileEntity(req, file)
+		if err != nil {
+			logger.Errorf("ForkSnippet(): file has wrong properties, err: %s", err.Error())
+			return nil, cerrors.InternalError(errorTitleForkSnippet, resourceInconsistentErrMsg)
+		}
+		fork.Files = append(fork.Files, fileEntity)
+	}
+
+	id, err := controller.putSnippet(ctx, fork, errorTitleForkSnippet)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ForkSnippetResponse{Id: id}, nil
+}
+
+// ListSnippetRevisions returns the revisions of the snippet from the first one to the snippet itself
+// and the revisions derived from the snippet
+func (controller *playgroundController) ListSnippetRevisions(ctx context.Context, info *pb.ListSnippetRevisionsRequest) (*pb.ListSnippetRevisionsResponse, error) {
+	if err := controller.verifyRouter(); err != nil {
+		logger.Errorf("ListSnippetRevisions() error: %s", err.Error())
+		return nil, err
+	}
+
+	var revisions []*pb.SnippetRevision
+	for id := info.GetId(); id != ""; {
+		snippet, err := controller.db.GetSnippetRevision(ctx, id)
+		if err != nil {
+			if errors.Is(err, datastore.ErrNoSuchEntity) && len(revisions) > 0 {
+				// The earlier revisions were deleted as unused
+				break
+			}
+			logger.Errorf("ListSnippetRevisions(): error during getting the snippet %s: %s", id, err.Error())
+			if errors.Is(err, datastore.ErrNoSuchEntity) {
+				return nil, cerrors.NotFoundError(errorTitleListRevisions, resourceNotFoundErrMsg)
+			}
+			return nil, cerrors.InternalError(errorTitleListRevisions, "Failed to retrieve the snippet")
+		}
+		revisions = append(revisions, newSnippetRevision(id, snippet))
+		id = snippet.ParentId
+	}
+
+	// The revisions were collected from the snippet to the first revision
+	for i, j := 0, len(revisions)-1; i < j; i, j = i+1, j-1 {
+		revisions[i], revisions[j] = revisions[j], revisions[i]
+	}
+
+	var descendants []*pb.SnippetRevision
+	for parentIds := []string{info.GetId()}; len(parentIds) > 0; {
+		children, err := controller.db.GetSnippetChildren(ctx, parentIds[0])
+		if err != nil {
+			logger.Errorf("ListSnippetRevisions(): error during getting the next revisions of the snippet %s: %s", parentIds[0], err.Error())
+			return nil, cerrors.InternalError(errorTitleListRevisions, "Failed to retrieve the next revisions of the snippet")
+		}
+		parentIds = parentIds[1:]
+		for _, child := range children {
+			descendants = append(descendants, newSnippetRevision(child.Key.Name, child))
+			parentIds = append(parentIds, child.Key.Name)
+		}
+	}
+	sort.SliceStable(descendants, func(i, j int) bool {
+		if descendants[i].Revision != descendants[j].Revision {
+			return descendants[i].Revision < descendants[j].Revision
+		}
+		return descendants[i].Created < descendants[j].Created
+	})
+	return &pb.ListSnippetRevisionsResponse{Revisions: revisions, Descendants: descendants}, nil
+}
+
+// newSnippetRevision returns the revision of the snippet.
+// The token of the author is replaced with its hash, so the revisions of one author can be grouped without disclosing the token.
+func newSnippetRevision(id string, snippet *entity.SnippetEntity) *pb.SnippetRevision {
+	revision := &pb.SnippetRevision{
+		Id:       id,
+		ParentId: snippet.ParentId,
+		Revision: int32(snippet.GetRevision()),
+		Created:  snippet.Created.UnixMilli(),
+	}
+	if snippet.AuthorToken != "" {
+		hash := sha256.Sum256([]byte(snippet.AuthorToken))
+		revision.AuthorHash = hex.EncodeToString(hash[:])
+	}
+	return revision
+}
+
+// GetSnippetDiff returns the unified diffs of the files which differ between two revisions of the snippet
+func (controller *playgroundController) GetSnippetDiff(ctx context.Context, info *pb.GetSnippetDiffRequest) (*pb.GetSnippetDiffResponse, error) {
+	if err := controller.verifyRouter(); err != nil {
+		logger.Errorf("GetSnippetDiff() error: %s", err.Error())
+		return nil, err
+	}
+	_, fromFiles, err := controller.getSnippetRevision(ctx, info.GetFromId(), errorTitleGetSnippetDiff)
+	if err != nil {
+		return nil, err
+	}
+	_, toFiles, err := controller.getSnippetRevision(ctx, info.GetToId(), errorTitleGetSnippetDiff)
+	if err != nil {
+		return nil, err
+	}
+
+	// Files are compared by name, the removed and the added files are compared to the empty ones
+	var names []string
+	fromContents := make(map[string]string, len(fromFiles))
+	for _, file := range fromFiles {
+		fromContents[file.Name] = file.Content
+		names = append(names, file.Name)
+	}
+	toContents := make(map[string]string, len(toFiles))
+	for _, file := range toFiles {
+		toContents[file.Name] = file.Content
+		if _, ok := fromContents[file.Name]; !ok {
+			names = append(names, file.Name)
+		}
+	}
+
+	response := pb.GetSnippetDiffResponse{}
+	for _, name := range names {
+		diff, err := utils.GetUnifiedDiff(name, fromContents[name], toContents[name])
+		if err != nil {
+			logger.Errorf("GetSnippetDiff(): error during comparing the file %s: %s", name, err.Error())
+			return nil, cerrors.InternalError(errorTitleGetSnippetDiff, "Failed to compare the snippets")
+		}
+		if diff != "" {
+			response.Files = append(response.Files, &pb.SnippetFileDiff{Name: name, Diff: diff})
+		}
+	}
+	return &response, nil
+}
+
+// getSnippetRevision returns the snippet entity with its files without counting the visit
+func (controller *playgroundController) getSnippetRevision(ctx context.Context, id string, errorTitle string) (*entity.SnippetEntity, []*entity.FileEntity, error) {
+	snippet, err := controller.db.GetSnippetRevision(ctx, id)
+	if err != nil {
+		logger.Errorf("getSnippetRevision(): error during getting the snippet %s: %s", id, err.Error())
+		if errors.Is(err, datastore.ErrNoSuchEntity) {
+			return nil, nil, cerrors.NotFoundError(errorTitle, resourceNotFoundErrMsg)
+		}
+		return nil, nil, cerrors.InternalError(errorTitle, "Failed to retrieve the snippet")
+	}
+	files, err := controller.db.GetFiles(ctx, id, snippet.NumberOfFiles)
+	if err != nil {
+		logger.Errorf("getSnippetRevision(): GetFiles(): error during getting files: %s", err.Error())
+		return nil, nil, cerrors.InternalError(errorTitle, "Failed to retrieve files")
+	}
+	return snippet, files, nil
+}
+
 // GetMetadata returns runner metadata
 func (controller *playgroundController) GetMetadata(_ context.Context, _ *pb.GetMetadataRequest) (*pb.GetMetadataResponse, error) {
 	commitTimestampInteger, err := strconv.ParseInt(BuildCommitTimestamp, 10, 64)
@@ -609,3 +927,29 @@ func (controller *playgroundController) verifyRouter() error {
 	}
 	return nil
 }
+
+// getClientId returns the address of the client of the request, which identifies the client for the run queue
+// and the rate limiter. Each of the trustedProxyHops proxies in front of the server appends the address it received
+// the request from to the X-Forwarded-For header, so the client address is the entry appended by the outermost proxy,
+// trustedProxyHops entries from the end. The entries before it are sent by the client and can be spoofed.
+// Without trusted proxies, or if the header has fewer entries, the address of the peer is used.
+func getClientId(ctx context.Context, trustedProxyHops int) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok && trustedProxyHops > 0 {
+		var forwardedFor []string
+		for _, value := range md.Get("x-forwarded-for") {
+			for _, address := range strings.Split(value, ",") {
+				forwardedFor = append(forwardedFor, strings.TrimSpace(address))
+			}
+		}
+		if len(forwardedFor) >= trustedProxyHops {
+			return forwardedFor[len(forwardedFor)-trustedProxyHops]
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return host
+		}
+		return p.Addr.String()
+	}
+	return ""
+}
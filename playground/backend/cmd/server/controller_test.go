@@ -17,6 +17,8 @@ package main
 import (
 	"beam.apache.org/playground/backend/internal/db/schema"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io/fs"
 	"log"
@@ -33,6 +35,8 @@ import (
 	"go.uber.org/goleak"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/test/bufconn"
 
 	pb "beam.apache.org/playground/backend/internal/api/v1"
@@ -96,7 +100,8 @@ func setupServer(sdk pb.Sdk) *grpc.Server {
 	logger.SetupLogger(ctx, "local", "some_google_project_id")
 
 	// setup cache
-	cacheService = local.New(ctx)
+	notifyingCache := &cache.NotifyingCache{Cache: local.New(ctx), Notifier: local.NewNotifier()}
+	cacheService = notifyingCache
 
 	// setup database
 	dbEmulator, err = datastoreDb.NewEmulated(ctx)
@@ -170,6 +175,8 @@ func setupServer(sdk pb.Sdk) *grpc.Server {
 	pb.RegisterPlaygroundServiceServer(s, &playgroundController{
 		env:            environment.NewEnvironment(*networkEnv, *sdkEnv, *appEnv),
 		cacheService:   cacheService,
+		notifier:       notifyingCache,
+		runQueue:       local.NewRunQueue(sdkEnv.NumOfParallelJobs()),
 		db:             dbEmulator,
 		props:          props,
 		entityMapper:   entityMapper,
@@ -1020,6 +1027,119 @@ func TestPlaygroundController_GetSnippet(t *testing.T) {
 	}
 }
 
+func TestPlaygroundController_SnippetRevisions(t *testing.T) {
+	server := setupServer(pb.Sdk_SDK_UNSPECIFIED)
+	opt := goleak.IgnoreCurrent()
+	defer goleak.VerifyNone(t, opt)
+	defer teardown(server)
+
+	client, closeFunc := getPlaygroundServiceClient(ctx, t)
+	defer closeFunc()
+
+	saveRequest := &pb.SaveSnippetRequest{
+		Files:           []*pb.SnippetFile{{Name: "main.py", Content: "print('revision 1')\n", IsMain: true}},
+		Sdk:             pb.Sdk_SDK_PYTHON,
+		PipelineOptions: "MOCK_OPTIONS",
+	}
+	first, err := client.SaveSnippet(ctx, saveRequest)
+	if err != nil {
+		t.Fatalf("SaveSnippet() error = %v", err)
+	}
+	defer test_cleaner.CleanSnippet(ctx, t, first.Id)
+	defer test_cleaner.CleanFiles(ctx, t, first.Id, 1)
+
+	fork, err := client.ForkSnippet(ctx, &pb.ForkSnippetRequest{Id: first.Id, AuthorToken: "MOCK_AUTHOR_TOKEN"})
+	if err != nil {
+		t.Fatalf("ForkSnippet() error = %v", err)
+	}
+	defer test_cleaner.CleanSnippet(ctx, t, fork.Id)
+	defer test_cleaner.CleanFiles(ctx, t, fork.Id, 1)
+	if fork.Id == first.Id {
+		t.Errorf("ForkSnippet() returns the ID of the forked snippet")
+	}
+
+	saveRequest.Files[0].Content = "print('revision 3')\n"
+	saveRequest.ParentId = fork.Id
+	saveRequest.AuthorToken = "MOCK_AUTHOR_TOKEN"
+	third, err := client.SaveSnippet(ctx, saveRequest)
+	if err != nil {
+		t.Fatalf("SaveSnippet() of the next revision error = %v", err)
+	}
+	defer test_cleaner.CleanSnippet(ctx, t, third.Id)
+	defer test_cleaner.CleanFiles(ctx, t, third.Id, 1)
+
+	snippet, err := client.GetSnippet(ctx, &pb.GetSnippetRequest{Id: third.Id})
+	if err != nil {
+		t.Fatalf("GetSnippet() error = %v", err)
+	}
+	if snippet.ParentId != fork.Id || snippet.Revision != 3 {
+		t.Errorf("GetSnippet() parent = %s, revision = %d, want %s, 3", snippet.ParentId, snippet.Revision, fork.Id)
+	}
+
+	revisions, err := client.ListSnippetRevisions(ctx, &pb.ListSnippetRevisionsRequest{Id: third.Id})
+	if err != nil {
+		t.Fatalf("ListSnippetRevisions() error = %v", err)
+	}
+	wantIds := []string{first.Id, fork.Id, third.Id}
+	if len(revisions.Revisions) != len(wantIds) {
+		t.Fatalf("ListSnippetRevisions() returns %d revisions, want %d", len(revisions.Revisions), len(wantIds))
+	}
+	for i, revision := range revisions.Revisions {
+		if revision.Id != wantIds[i] || revision.Revision != int32(i+1) {
+			t.Errorf("ListSnippetRevisions() revision %d = %s (%d), want %s (%d)", i, revision.Id, revision.Revision, wantIds[i], i+1)
+		}
+	}
+	authorHash := sha256.Sum256([]byte("MOCK_AUTHOR_TOKEN"))
+	if revisions.Revisions[0].AuthorHash != "" || revisions.Revisions[1].AuthorHash != hex.EncodeToString(authorHash[:]) {
+		t.Errorf("ListSnippetRevisions() author hashes = %s, %s, want the empty one and the hash of MOCK_AUTHOR_TOKEN",
+			revisions.Revisions[0].AuthorHash, revisions.Revisions[1].AuthorHash)
+	}
+	if len(revisions.Descendants) != 0 {
+		t.Errorf("ListSnippetRevisions() returns %d descendants of the last revision, want 0", len(revisions.Descendants))
+	}
+
+	revisions, err = client.ListSnippetRevisions(ctx, &pb.ListSnippetRevisionsRequest{Id: first.Id})
+	if err != nil {
+		t.Fatalf("ListSnippetRevisions() of the first revision error = %v", err)
+	}
+	if len(revisions.Revisions) != 1 || revisions.Revisions[0].Id != first.Id {
+		t.Errorf("ListSnippetRevisions() of the first revision returns %v revisions, want only %s", revisions.Revisions, first.Id)
+	}
+	wantIds = []string{fork.Id, third.Id}
+	if len(revisions.Descendants) != len(wantIds) {
+		t.Fatalf("ListSnippetRevisions() returns %d descendants, want %d", len(revisions.Descendants), len(wantIds))
+	}
+	for i, descendant := range revisions.Descendants {
+		if descendant.Id != wantIds[i] || descendant.Revision != int32(i+2) {
+			t.Errorf("ListSnippetRevisions() descendant %d = %s (%d), want %s (%d)", i, descendant.Id, descendant.Revision, wantIds[i], i+2)
+		}
+	}
+
+	diff, err := client.GetSnippetDiff(ctx, &pb.GetSnippetDiffRequest{FromId: first.Id, ToId: third.Id})
+	if err != nil {
+		t.Fatalf("GetSnippetDiff() error = %v", err)
+	}
+	wantDiff := "--- main.py\n+++ main.py\n@@ -1 +1 @@\n-print('revision 1')\n+print('revision 3')\n"
+	if len(diff.Files) != 1 || diff.Files[0].Name != "main.py" || diff.Files[0].Diff != wantDiff {
+		t.Errorf("GetSnippetDiff() = %v, want the diff of main.py %q", diff.Files, wantDiff)
+	}
+	diff, err = client.GetSnippetDiff(ctx, &pb.GetSnippetDiffRequest{FromId: first.Id, ToId: fork.Id})
+	if err != nil || len(diff.Files) != 0 {
+		t.Errorf("GetSnippetDiff() of the fork = %v, %v, want no diffs", diff, err)
+	}
+
+	if _, err = client.ForkSnippet(ctx, &pb.ForkSnippetRequest{Id: "MOCK_MISSING_ID"}); err == nil {
+		t.Errorf("ForkSnippet() of the missing snippet doesn't return an error")
+	}
+	saveRequest.ParentId = "MOCK_MISSING_ID"
+	if _, err = client.SaveSnippet(ctx, saveRequest); err == nil {
+		t.Errorf("SaveSnippet() with the missing parent doesn't return an error")
+	}
+	if _, err = client.ListSnippetRevisions(ctx, &pb.ListSnippetRevisionsRequest{Id: "MOCK_MISSING_ID"}); err == nil {
+		t.Errorf("ListSnippetRevisions() of the missing snippet doesn't return an error")
+	}
+}
+
 func makeSaveSnippetRequest() *pb.SaveSnippetRequest {
 	return &pb.SaveSnippetRequest{
 		Files: []*pb.SnippetFile{
@@ -1499,3 +1619,61 @@ func getPlaygroundServiceClient(ctx context.Context, t *testing.T) (pb.Playgroun
 	client := pb.NewPlaygroundServiceClient(conn)
 	return client, closeFunc
 }
+
+func Test_getClientId(t *testing.T) {
+	peerCtx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("198.51.100.1"), Port: 5000}})
+	tests := []struct {
+		name             string
+		ctx              context.Context
+		trustedProxyHops int
+		want             string
+	}{
+		{
+			name:             "Address of the client behind a proxy",
+			ctx:              metadata.NewIncomingContext(peerCtx, metadata.Pairs("x-forwarded-for", "203.0.113.7")),
+			trustedProxyHops: 1,
+			want:             "203.0.113.7",
+		},
+		{
+			name:             "Address appended by the proxy after a spoofed one",
+			ctx:              metadata.NewIncomingContext(peerCtx, metadata.Pairs("x-forwarded-for", "192.0.2.99, 203.0.113.7")),
+			trustedProxyHops: 1,
+			want:             "203.0.113.7",
+		},
+		{
+			name:             "Address appended by the outermost of two proxies",
+			ctx:              metadata.NewIncomingContext(peerCtx, metadata.Pairs("x-forwarded-for", "192.0.2.99, 203.0.113.7, 10.0.0.1")),
+			trustedProxyHops: 2,
+			want:             "203.0.113.7",
+		},
+		{
+			name:             "Header without trusted proxies",
+			ctx:              metadata.NewIncomingContext(peerCtx, metadata.Pairs("x-forwarded-for", "203.0.113.7")),
+			trustedProxyHops: 0,
+			want:             "198.51.100.1",
+		},
+		{
+			name:             "Header with fewer addresses than trusted proxies",
+			ctx:              metadata.NewIncomingContext(peerCtx, metadata.Pairs("x-forwarded-for", "203.0.113.7")),
+			trustedProxyHops: 2,
+			want:             "198.51.100.1",
+		},
+		{
+			name: "Address of the peer",
+			ctx:  peerCtx,
+			want: "198.51.100.1",
+		},
+		{
+			name: "Unknown client",
+			ctx:  context.Background(),
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getClientId(tt.ctx, tt.trustedProxyHops); got != tt.want {
+				t.Errorf("getClientId() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
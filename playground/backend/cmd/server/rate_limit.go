@@ -0,0 +1,186 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"path"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/environment"
+	cerrors "beam.apache.org/playground/backend/internal/errors"
+	"beam.apache.org/playground/backend/internal/logger"
+)
+
+const (
+	apiTokenHeader           = "x-api-key"
+	retryAfterHeader         = "retry-after"
+	errorTitleRateLimit      = "Rate limit exceeded"
+	errorTitleCodeSize       = "Code size limit exceeded"
+	errorTitleAuthentication = "Error during authentication"
+)
+
+// rateLimiter protects the server from abusive clients by interceptors of its RPCs.
+// The clients are identified by their API token if they provide a known one, otherwise by their IP address.
+// The requests of each client are limited by the quotas of the RPCs, which are counted in the shared cache,
+// so the quotas hold across all instances of the SDK using the same cache. The requests with too large code are rejected.
+type rateLimiter struct {
+	limiter          cache.RateLimiter
+	sdk              string
+	envs             *environment.RateLimitEnvs
+	apiTokens        [][]byte
+	trustedProxyHops int
+}
+
+// newRateLimiter returns a new rateLimiter of the server of the sdk behind trustedProxyHops proxies
+func newRateLimiter(limiter cache.RateLimiter, sdk pb.Sdk, envs *environment.RateLimitEnvs, trustedProxyHops int) *rateLimiter {
+	apiTokens := make([][]byte, 0, len(envs.APITokens()))
+	for _, token := range envs.APITokens() {
+		apiTokens = append(apiTokens, []byte(token))
+	}
+	return &rateLimiter{
+		limiter:          limiter,
+		sdk:              sdk.String(),
+		envs:             envs,
+		apiTokens:        apiTokens,
+		trustedProxyHops: trustedProxyHops,
+	}
+}
+
+// unaryInterceptor rejects the request if the client isn't authenticated, its code is too large or the client exceeded the quota of the RPC
+func (rl *rateLimiter) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	clientKey, err := rl.getClientKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = rl.checkCodeSize(req); err != nil {
+		return nil, err
+	}
+	retryAfter, err := rl.take(ctx, info.FullMethod, clientKey)
+	if err != nil {
+		_ = grpc.SetHeader(ctx, retryAfterMetadata(retryAfter))
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// streamInterceptor rejects the stream if the client isn't authenticated or exceeded the quota of the RPC
+func (rl *rateLimiter) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	clientKey, err := rl.getClientKey(ss.Context())
+	if err != nil {
+		return err
+	}
+	retryAfter, err := rl.take(ss.Context(), info.FullMethod, clientKey)
+	if err != nil {
+		_ = ss.SetHeader(retryAfterMetadata(retryAfter))
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// getClientKey returns the key of the client by its API token or its IP address.
+// The IP address is taken from X-Forwarded-For only as appended by the trusted proxies, so the client can't spoof it.
+// Returns an Unauthenticated error if API tokens are configured and the client provided an unknown one.
+// The token is hashed, so it isn't stored in the cache.
+func (rl *rateLimiter) getClientKey(ctx context.Context) (string, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(rl.apiTokens) > 0 {
+		if tokens := md.Get(apiTokenHeader); len(tokens) > 0 {
+			if !rl.isKnownToken([]byte(tokens[0])) {
+				logger.Warnf("getClientKey(): unknown API token of the client: %s\n", getClientId(ctx, rl.trustedProxyHops))
+				return "", cerrors.UnauthenticatedError(errorTitleAuthentication, "Unknown API token")
+			}
+			hash := sha256.Sum256([]byte(tokens[0]))
+			return "token:" + hex.EncodeToString(hash[:]), nil
+		}
+	}
+	return "ip:" + getClientId(ctx, rl.trustedProxyHops), nil
+}
+
+// isKnownToken reports whether the token is one of the API tokens, comparing them in constant time
+func (rl *rateLimiter) isKnownToken(token []byte) bool {
+	known := 0
+	for _, apiToken := range rl.apiTokens {
+		known |= subtle.ConstantTimeCompare(apiToken, token)
+	}
+	return known == 1
+}
+
+// checkCodeSize returns an InvalidArgument error if the code of the request is larger than the max code size
+func (rl *rateLimiter) checkCodeSize(req interface{}) error {
+	maxCodeSize := rl.envs.MaxCodeSize()
+	if maxCodeSize == 0 {
+		return nil
+	}
+	if codeSize := getCodeSize(req); codeSize > maxCodeSize {
+		return cerrors.InvalidArgumentError(errorTitleCodeSize, "Size of the code is %d bytes, but the max size is %d bytes", codeSize, maxCodeSize)
+	}
+	return nil
+}
+
+// take takes the request from the client's quota of the RPC.
+// Returns a ResourceExhausted error and the time until the quota is reset if the client exceeded the quota.
+// The request is allowed if the quota can't be checked, so the server keeps working while the cache is unavailable.
+func (rl *rateLimiter) take(ctx context.Context, fullMethod, clientKey string) (time.Duration, error) {
+	rpcName := path.Base(fullMethod)
+	quota, ok := rl.envs.Quota(rpcName)
+	if !ok {
+		return 0, nil
+	}
+	key := fmt.Sprintf("%s:%s:%s", rl.sdk, rpcName, clientKey)
+	allowed, retryAfter, err := rl.limiter.Take(ctx, key, quota.Requests, quota.Window)
+	if err != nil {
+		logger.Errorf("take(): error during checking the quota of %s, the request is allowed: %s\n", key, err.Error())
+		return 0, nil
+	}
+	if !allowed {
+		logger.Warnf("take(): %s exceeded the quota of %d requests per %s\n", key, quota.Requests, quota.Window)
+		return retryAfter, cerrors.ResourceExhaustedError(errorTitleRateLimit, "Too many %s requests, the limit is %d requests per %s. Retry in %s", rpcName, quota.Requests, quota.Window, retryAfter.Round(time.Second))
+	}
+	return 0, nil
+}
+
+// getCodeSize returns the size of the code of the request in bytes
+func getCodeSize(req interface{}) int {
+	size := 0
+	switch request := req.(type) {
+	case *pb.RunCodeRequest:
+		size += len(request.Code)
+		for _, file := range request.Files {
+			size += len(file.Content)
+		}
+	case *pb.SaveSnippetRequest:
+		for _, file := range request.Files {
+			size += len(file.Content)
+		}
+	}
+	return size
+}
+
+// retryAfterMetadata returns the header with the number of seconds the client should wait before retrying
+func retryAfterMetadata(retryAfter time.Duration) metadata.MD {
+	return metadata.Pairs(retryAfterHeader, strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+}
@@ -0,0 +1,252 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache/local"
+	"beam.apache.org/playground/backend/internal/environment"
+)
+
+const runCodeMethod = "/api.v1.PlaygroundService/RunCode"
+
+// clientContext returns the context of the request of the client with the IP address and the API token
+func clientContext(ip, token string) context.Context {
+	clientCtx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 1234}})
+	if token != "" {
+		clientCtx = metadata.NewIncomingContext(clientCtx, metadata.Pairs(apiTokenHeader, token))
+	}
+	return clientCtx
+}
+
+func newTestRateLimiter(maxCodeSize int, apiTokens []string) *rateLimiter {
+	envs := environment.NewRateLimitEnvs(map[string]environment.RateLimitQuota{
+		"RunCode": {Requests: 2, Window: time.Minute},
+	}, maxCodeSize, apiTokens)
+	return newRateLimiter(local.NewRateLimiter(), pb.Sdk_SDK_JAVA, envs, 0)
+}
+
+func callUnary(rl *rateLimiter, requestCtx context.Context, method string, req interface{}) error {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "response", nil
+	}
+	_, err := rl.unaryInterceptor(requestCtx, req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	return err
+}
+
+func TestRateLimiter_unaryInterceptor(t *testing.T) {
+	rl := newTestRateLimiter(10, []string{"token_1", "token_2"})
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		method   string
+		req      interface{}
+		wantCode codes.Code
+	}{
+		{
+			name:     "First request of the client",
+			ctx:      clientContext("203.0.113.1", ""),
+			method:   runCodeMethod,
+			req:      &pb.RunCodeRequest{Code: "MOCK_CODE"},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "Too large code",
+			ctx:      clientContext("203.0.113.1", ""),
+			method:   runCodeMethod,
+			req:      &pb.RunCodeRequest{Files: []*pb.SnippetFile{{Content: "MOCK_CODE"}, {Content: "MOCK_CODE"}}},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Second request of the client within the quota",
+			ctx:      clientContext("203.0.113.1", ""),
+			method:   runCodeMethod,
+			req:      &pb.RunCodeRequest{Code: "MOCK_CODE"},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "Request of the client over the quota",
+			ctx:      clientContext("203.0.113.1", ""),
+			method:   runCodeMethod,
+			req:      &pb.RunCodeRequest{Code: "MOCK_CODE"},
+			wantCode: codes.ResourceExhausted,
+		},
+		{
+			name:     "Request of the client to the RPC without quota",
+			ctx:      clientContext("203.0.113.1", ""),
+			method:   "/api.v1.PlaygroundService/GetRunOutput",
+			req:      &pb.GetRunOutputRequest{},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "Request of another client",
+			ctx:      clientContext("203.0.113.2", ""),
+			method:   runCodeMethod,
+			req:      &pb.RunCodeRequest{Code: "MOCK_CODE"},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "Client with the API token has its own quota",
+			ctx:      clientContext("203.0.113.1", "token_1"),
+			method:   runCodeMethod,
+			req:      &pb.RunCodeRequest{Code: "MOCK_CODE"},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "Unknown API token",
+			ctx:      clientContext("203.0.113.1", "token_3"),
+			method:   runCodeMethod,
+			req:      &pb.RunCodeRequest{Code: "MOCK_CODE"},
+			wantCode: codes.Unauthenticated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := callUnary(rl, tt.ctx, tt.method, tt.req)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("unaryInterceptor() code = %v, want %v, err: %v", got, tt.wantCode, err)
+			}
+		})
+	}
+}
+
+func TestRateLimiter_unaryInterceptor_TokensAreIgnoredWithoutAPITokens(t *testing.T) {
+	rl := newTestRateLimiter(0, nil)
+	for i := 0; i < 2; i++ {
+		if err := callUnary(rl, clientContext("203.0.113.1", "token_1"), runCodeMethod, &pb.RunCodeRequest{}); err != nil {
+			t.Fatalf("unaryInterceptor() error = %v", err)
+		}
+	}
+	// The client is identified by the IP address, so the quota is exceeded regardless of the token
+	err := callUnary(rl, clientContext("203.0.113.1", "token_2"), runCodeMethod, &pb.RunCodeRequest{})
+	if status.Code(err) != codes.ResourceExhausted || !strings.Contains(err.Error(), errorTitleRateLimit) {
+		t.Errorf("unaryInterceptor() error = %v, want %v", err, codes.ResourceExhausted)
+	}
+}
+
+func TestRateLimiter_unaryInterceptor_SpoofedForwardedFor(t *testing.T) {
+	envs := environment.NewRateLimitEnvs(map[string]environment.RateLimitQuota{
+		"RunCode": {Requests: 2, Window: time.Minute},
+	}, 0, nil)
+	rl := newRateLimiter(local.NewRateLimiter(), pb.Sdk_SDK_JAVA, envs, 1)
+	// forwardedContext returns the context of the request forwarded by the load balancer,
+	// which appends the address of the client to the X-Forwarded-For sent by the client
+	forwardedContext := func(forwardedFor string) context.Context {
+		return metadata.NewIncomingContext(clientContext("10.0.0.1", ""), metadata.Pairs("x-forwarded-for", forwardedFor))
+	}
+	for i := 0; i < 2; i++ {
+		spoofed := fmt.Sprintf("198.51.100.%d, 203.0.113.1", i)
+		if err := callUnary(rl, forwardedContext(spoofed), runCodeMethod, &pb.RunCodeRequest{}); err != nil {
+			t.Fatalf("unaryInterceptor() error = %v", err)
+		}
+	}
+	// The client is identified by the address appended by the load balancer, so a new spoofed address doesn't reset the quota
+	err := callUnary(rl, forwardedContext("198.51.100.2, 203.0.113.1"), runCodeMethod, &pb.RunCodeRequest{})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("unaryInterceptor() error = %v, want %v", err, codes.ResourceExhausted)
+	}
+	if err = callUnary(rl, forwardedContext("198.51.100.2, 203.0.113.2"), runCodeMethod, &pb.RunCodeRequest{}); err != nil {
+		t.Errorf("unaryInterceptor() error of another client = %v", err)
+	}
+}
+
+// testServerStream is a grpc.ServerStream of the client context
+type testServerStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	header metadata.MD
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testServerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestRateLimiter_streamInterceptor(t *testing.T) {
+	envs := environment.NewRateLimitEnvs(map[string]environment.RateLimitQuota{
+		"*": {Requests: 1, Window: time.Minute},
+	}, 0, nil)
+	rl := newRateLimiter(local.NewRateLimiter(), pb.Sdk_SDK_JAVA, envs, 0)
+	info := &grpc.StreamServerInfo{FullMethod: "/api.v1.PlaygroundService/StreamRunOutput", IsServerStream: true}
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		return nil
+	}
+
+	stream := &testServerStream{ctx: clientContext("203.0.113.1", "")}
+	if err := rl.streamInterceptor(nil, stream, info, handler); err != nil {
+		t.Fatalf("streamInterceptor() error = %v", err)
+	}
+	err := rl.streamInterceptor(nil, stream, info, handler)
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("streamInterceptor() error = %v, want %v", err, codes.ResourceExhausted)
+	}
+	if retryAfter := stream.header.Get(retryAfterHeader); len(retryAfter) != 1 || retryAfter[0] != "60" {
+		t.Errorf("streamInterceptor() retry after header = %v, want 60", retryAfter)
+	}
+}
+
+func Test_getCodeSize(t *testing.T) {
+	tests := []struct {
+		name string
+		req  interface{}
+		want int
+	}{
+		{
+			name: "Code of RunCode request",
+			req:  &pb.RunCodeRequest{Code: "12345"},
+			want: 5,
+		},
+		{
+			name: "Files of RunCode request",
+			req:  &pb.RunCodeRequest{Files: []*pb.SnippetFile{{Content: "123"}, {Content: "45"}}},
+			want: 5,
+		},
+		{
+			name: "Files of SaveSnippet request",
+			req:  &pb.SaveSnippetRequest{Files: []*pb.SnippetFile{{Content: "123"}, {Content: "4567"}}},
+			want: 7,
+		},
+		{
+			name: "Request without code",
+			req:  &pb.GetRunOutputRequest{PipelineUuid: "12345"},
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getCodeSize(tt.req); got != tt.want {
+				t.Errorf("getCodeSize() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"beam.apache.org/playground/backend/internal/external_functions"
 	"context"
 	"fmt"
+	"github.com/google/uuid"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
 	"google.golang.org/grpc"
 	"os"
@@ -29,13 +30,16 @@ import (
 	"beam.apache.org/playground/backend/internal/cache/redis"
 	"beam.apache.org/playground/backend/internal/components"
 	"beam.apache.org/playground/backend/internal/db"
-	"beam.apache.org/playground/backend/internal/db/datastore"
 	"beam.apache.org/playground/backend/internal/db/entity"
 	"beam.apache.org/playground/backend/internal/db/mapper"
+	"beam.apache.org/playground/backend/internal/db/schema"
+	"beam.apache.org/playground/backend/internal/db/sqldb"
 	"beam.apache.org/playground/backend/internal/environment"
 	"beam.apache.org/playground/backend/internal/logger"
+	"beam.apache.org/playground/backend/internal/sandbox"
 	"beam.apache.org/playground/backend/internal/tasks"
 	"beam.apache.org/playground/backend/internal/tests/test_data"
+	"beam.apache.org/playground/backend/internal/verifier"
 )
 
 // runServer is starting http server wrapped on grpc
@@ -50,13 +54,16 @@ func runServer() error {
 
 	logger.SetupLogger(ctx, envService.ApplicationEnvs.LaunchSite(), envService.ApplicationEnvs.GoogleProjectId())
 
-	grpcServer := grpc.NewServer()
-
-	cacheService, err := setupCache(ctx, envService.ApplicationEnvs)
+	cacheService, runQueue, limiter, err := setupCache(ctx, envService.ApplicationEnvs, envService.BeamSdkEnvs)
 	if err != nil {
 		return err
 	}
 
+	// The backend of the SDK verifies the catalog examples instead of serving requests if the verification report is configured
+	if verificationEnvs := environment.GetVerificationEnvsFromOsEnvs(); verificationEnvs.Enabled() {
+		return verifyExamples(ctx, envService, cacheService, verificationEnvs)
+	}
+
 	var dbClient db.Database
 	var entityMapper mapper.EntityMapper
 	var props *environment.Properties
@@ -72,13 +79,11 @@ func runServer() error {
 			return err
 		}
 
-		dbClient, err = datastore.New(ctx, mapper.NewPrecompiledObjectMapper(), externalFunctions, envService.ApplicationEnvs.GoogleProjectId())
+		dbClient, err = setupDatabase(ctx, envService.ApplicationEnvs, externalFunctions)
 		if err != nil {
 			return err
 		}
 
-		downloadCatalogsToDatastoreEmulator(ctx)
-
 		migrationVersion, err := dbClient.GetCurrentDbMigrationVersion(ctx)
 		if err != nil {
 			return err
@@ -105,9 +110,17 @@ func runServer() error {
 		}
 	}
 
+	rateLimiter := newRateLimiter(limiter, envService.BeamSdkEnvs.ApacheBeamSdk, environment.GetRateLimitEnvsFromOsEnvs(), envService.NetworkEnvs.TrustedProxyHops())
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(rateLimiter.unaryInterceptor),
+		grpc.ChainStreamInterceptor(rateLimiter.streamInterceptor),
+	)
+
 	pb.RegisterPlaygroundServiceServer(grpcServer, &playgroundController{
 		env:            envService,
 		cacheService:   cacheService,
+		notifier:       cacheService,
+		runQueue:       runQueue,
 		db:             dbClient,
 		props:          props,
 		entityMapper:   entityMapper,
@@ -135,6 +148,50 @@ func runServer() error {
 	}
 }
 
+// verifyExamples runs the catalog examples of the SDK, compares their outputs with the golden outputs and writes the report.
+// Returns an error if some examples don't match their golden outputs.
+func verifyExamples(ctx context.Context, envService *environment.Environment, cacheService cache.Cache, verificationEnvs *environment.VerificationEnvs) error {
+	externalFunctions := external_functions.NewExternalFunctionsComponent(envService.ApplicationEnvs)
+	dbClient, err := setupDatabase(ctx, envService.ApplicationEnvs, externalFunctions)
+	if err != nil {
+		return err
+	}
+	matcher, err := verifier.NewMatcher(verificationEnvs.OrderInsensitive(), verificationEnvs.MaskPatterns())
+	if err != nil {
+		return err
+	}
+	report, err := verifier.New(envService, cacheService, dbClient, matcher).VerifyCatalog(ctx)
+	if err != nil {
+		return err
+	}
+	if err = report.WriteFile(verificationEnvs.ReportPath(), verificationEnvs.ReportFormat()); err != nil {
+		return err
+	}
+	if !report.Passed() {
+		return fmt.Errorf("%d examples failed and %d examples errored, see the report: %s",
+			report.Count(verifier.StatusFailed), report.Count(verifier.StatusError), verificationEnvs.ReportPath())
+	}
+	logger.Infof("verifyExamples(): all examples are verified, see the report: %s\n", verificationEnvs.ReportPath())
+	return nil
+}
+
+// setupDatabase constructs required database by application environment.
+// Migrations of SQL databases are applied on start, since they're usually self-hosted without a separate migration step.
+func setupDatabase(ctx context.Context, appEnv environment.ApplicationEnvs, externalFunctions external_functions.ExternalFunctions) (db.Database, error) {
+	database, err := db.New(ctx, appEnv.DatabaseEnvs().DatabaseType(), appEnv.DatabaseEnvs().DataSourceName(), appEnv.GoogleProjectId(), externalFunctions)
+	if err != nil {
+		return nil, err
+	}
+	if sqlDb, ok := database.(*sqldb.SQL); ok {
+		if err = sqlDb.ApplyMigrations(ctx, schema.Migrations, appEnv.SdkConfigPath()); err != nil {
+			return nil, err
+		}
+		return sqlDb, nil
+	}
+	downloadCatalogsToDatastoreEmulator(ctx)
+	return database, nil
+}
+
 func downloadCatalogsToDatastoreEmulator(ctx context.Context) {
 	if _, ok := os.LookupEnv("DATASTORE_EMULATOR_HOST"); ok {
 		test_data.DownloadCatalogsWithMockData(ctx)
@@ -191,14 +248,38 @@ func getGrpcWebOptions() []grpcweb.Option {
 
 }
 
-// setupCache constructs required cache by application environment
-func setupCache(ctx context.Context, appEnv environment.ApplicationEnvs) (cache.Cache, error) {
+// setupCache constructs required cache, run queue and rate limiter by application environment.
+// The cache notifies about the updates of its values, which streams of the pipelines' outputs subscribe to.
+// The run queue of the remote cache is shared by all instances of the SDK, so it also limits the number of runs of the cluster.
+// The same goes for the rate limiter, which counts the requests of the clients of all instances.
+func setupCache(ctx context.Context, appEnv environment.ApplicationEnvs, sdkEnv environment.BeamEnvs) (*cache.NotifyingCache, cache.RunQueue, cache.RateLimiter, error) {
+	maxConcurrentRuns := appEnv.QueueEnvs().MaxConcurrentRuns()
+	if maxConcurrentRuns == 0 {
+		maxConcurrentRuns = sdkEnv.NumOfParallelJobs()
+	}
 	switch appEnv.CacheEnvs().CacheType() {
 	case "remote":
-		return redis.New(ctx, appEnv.CacheEnvs().Address())
+		redisCache, err := redis.New(ctx, appEnv.CacheEnvs().Address())
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		runQueue := redis.NewRunQueue(redisCache.Client, sdkEnv.ApacheBeamSdk.String(), getInstanceId(), maxConcurrentRuns, appEnv.QueueEnvs().MaxClusterConcurrentRuns())
+		return &cache.NotifyingCache{Cache: redisCache, Notifier: redis.NewNotifier(ctx, redisCache.Client)}, runQueue, redis.NewRateLimiter(redisCache.Client), nil
 	default:
-		return local.New(ctx), nil
+		if clusterLimit := appEnv.QueueEnvs().MaxClusterConcurrentRuns(); clusterLimit > 0 && clusterLimit < maxConcurrentRuns {
+			maxConcurrentRuns = clusterLimit
+		}
+		return &cache.NotifyingCache{Cache: local.New(ctx), Notifier: local.NewNotifier()}, local.NewRunQueue(maxConcurrentRuns), local.NewRateLimiter(), nil
+	}
+}
+
+// getInstanceId returns a unique id of the backend instance, which owns the runs it processes in the run queue.
+func getInstanceId() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "playground"
 	}
+	return fmt.Sprintf("%s-%s", hostname, uuid.New())
 }
 
 // setupExamplesCatalogFromDatastore saves precompiled objects catalog from the cloud datastore to the cache
@@ -228,6 +309,8 @@ func setupExamplesCatalogFromDatastore(ctx context.Context, cacheService cache.C
 }
 
 func main() {
+	// Pipelines of cgroup sandboxes are started by the binary itself, see sandbox.Init
+	sandbox.Init()
 	err := runServer()
 	if err != nil {
 		panic(err)
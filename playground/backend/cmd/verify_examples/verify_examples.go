@@ -0,0 +1,111 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"beam.apache.org/playground/backend/internal/cache/local"
+	"beam.apache.org/playground/backend/internal/constants"
+	"beam.apache.org/playground/backend/internal/db"
+	"beam.apache.org/playground/backend/internal/environment"
+	"beam.apache.org/playground/backend/internal/logger"
+	"beam.apache.org/playground/backend/internal/sandbox"
+	"beam.apache.org/playground/backend/internal/verifier"
+)
+
+// maskPatterns is a flag which can be provided several times
+type maskPatterns []string
+
+func (m *maskPatterns) String() string {
+	return strings.Join(*m, ", ")
+}
+
+func (m *maskPatterns) Set(value string) error {
+	*m = append(*m, value)
+	return nil
+}
+
+// The tool runs the catalog examples of the SDK and compares their outputs with the golden outputs.
+// It should be run in the container of the SDK, since the SDK and the code processing are configured
+// by the same os environment variables as the backend, e.g. BEAM_SDK and APP_WORK_DIR.
+func main() {
+	// Pipelines of cgroup sandboxes are started by the binary itself, see sandbox.Init
+	sandbox.Init()
+	projectId := flag.String("project-id", "", "GCP project id")
+	namespace := flag.String("namespace", constants.Namespace, "Datastore namespace")
+	dbType := flag.String("db-type", db.DatastoreType, "Database type: datastore, postgres or sqlite")
+	dataSourceName := flag.String("dsn", "", "Data source name of postgres and sqlite databases")
+	reportPath := flag.String("report", "report.xml", "Path of the verification report")
+	reportFormat := flag.String("format", verifier.JUnitFormat, "Format of the verification report: junit or json")
+	orderInsensitive := flag.Bool("order-insensitive", false, "Compare lines of the outputs regardless of their order")
+	var masks maskPatterns
+	flag.Var(&masks, "mask", "Regular expression of the volatile parts of the outputs to ignore, can be provided several times")
+
+	flag.Parse()
+
+	ctx := context.WithValue(context.Background(), constants.DatastoreNamespaceKey, *namespace)
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		fmt.Printf("Couldn't get the current working directory, err: %s \n", err.Error())
+		os.Exit(1)
+	}
+	logger.SetupLogger(context.Background(), cwd, *projectId)
+
+	appEnvs, err := environment.GetApplicationEnvsFromOsEnvs()
+	if err != nil {
+		logger.Fatalf("Couldn't get the application environment, err: %s \n", err.Error())
+		os.Exit(1)
+	}
+	beamEnvs, err := environment.ConfigureBeamEnvs(appEnvs.WorkingDir())
+	if err != nil {
+		logger.Fatalf("Couldn't configure the sdk environment, err: %s \n", err.Error())
+		os.Exit(1)
+	}
+	env := environment.NewEnvironment(environment.NetworkEnvs{}, *beamEnvs, *appEnvs)
+
+	database, err := db.New(ctx, *dbType, *dataSourceName, *projectId, nil)
+	if err != nil {
+		logger.Fatalf("Couldn't create DB client instance, err: %s \n", err.Error())
+		os.Exit(1)
+	}
+
+	matcher, err := verifier.NewMatcher(*orderInsensitive, masks)
+	if err != nil {
+		logger.Fatalf("Couldn't create the output matcher, err: %s \n", err.Error())
+		os.Exit(1)
+	}
+
+	report, err := verifier.New(env, local.New(ctx), database, matcher).VerifyCatalog(ctx)
+	if err != nil {
+		logger.Fatalf("Couldn't verify the examples, err: %s \n", err.Error())
+		os.Exit(1)
+	}
+	if err = report.WriteFile(*reportPath, *reportFormat); err != nil {
+		logger.Fatalf("Couldn't write the verification report, err: %s \n", err.Error())
+		os.Exit(1)
+	}
+	if !report.Passed() {
+		logger.Errorf("%d examples failed and %d examples errored, see the report: %s \n",
+			report.Count(verifier.StatusFailed), report.Count(verifier.StatusError), *reportPath)
+		os.Exit(1)
+	}
+}
@@ -40,7 +40,11 @@ const (
 	PlaygroundService_CheckStatus_FullMethodName                 = "/api.v1.PlaygroundService/CheckStatus"
 	PlaygroundService_GetRunOutput_FullMethodName                = "/api.v1.PlaygroundService/GetRunOutput"
 	PlaygroundService_GetLogs_FullMethodName                     = "/api.v1.PlaygroundService/GetLogs"
+	PlaygroundService_StreamRunOutput_FullMethodName             = "/api.v1.PlaygroundService/StreamRunOutput"
+	PlaygroundService_StreamLogs_FullMethodName                  = "/api.v1.PlaygroundService/StreamLogs"
+	PlaygroundService_StreamStatus_FullMethodName                = "/api.v1.PlaygroundService/StreamStatus"
 	PlaygroundService_GetGraph_FullMethodName                    = "/api.v1.PlaygroundService/GetGraph"
+	PlaygroundService_GetRunMetrics_FullMethodName               = "/api.v1.PlaygroundService/GetRunMetrics"
 	PlaygroundService_GetRunError_FullMethodName                 = "/api.v1.PlaygroundService/GetRunError"
 	PlaygroundService_GetValidationOutput_FullMethodName         = "/api.v1.PlaygroundService/GetValidationOutput"
 	PlaygroundService_GetPreparationOutput_FullMethodName        = "/api.v1.PlaygroundService/GetPreparationOutput"
@@ -55,6 +59,9 @@ const (
 	PlaygroundService_GetDefaultPrecompiledObject_FullMethodName = "/api.v1.PlaygroundService/GetDefaultPrecompiledObject"
 	PlaygroundService_SaveSnippet_FullMethodName                 = "/api.v1.PlaygroundService/SaveSnippet"
 	PlaygroundService_GetSnippet_FullMethodName                  = "/api.v1.PlaygroundService/GetSnippet"
+	PlaygroundService_ForkSnippet_FullMethodName                 = "/api.v1.PlaygroundService/ForkSnippet"
+	PlaygroundService_ListSnippetRevisions_FullMethodName        = "/api.v1.PlaygroundService/ListSnippetRevisions"
+	PlaygroundService_GetSnippetDiff_FullMethodName              = "/api.v1.PlaygroundService/GetSnippetDiff"
 	PlaygroundService_GetMetadata_FullMethodName                 = "/api.v1.PlaygroundService/GetMetadata"
 )
 
@@ -70,8 +77,16 @@ type PlaygroundServiceClient interface {
 	GetRunOutput(ctx context.Context, in *GetRunOutputRequest, opts ...grpc.CallOption) (*GetRunOutputResponse, error)
 	// Get the logs of pipeline execution.
 	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error)
+	// Stream the output of pipeline execution until the pipeline is finished.
+	StreamRunOutput(ctx context.Context, in *StreamRunOutputRequest, opts ...grpc.CallOption) (PlaygroundService_StreamRunOutputClient, error)
+	// Stream the logs of pipeline execution until the pipeline is finished.
+	StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (PlaygroundService_StreamLogsClient, error)
+	// Stream the status changes of pipeline execution until the pipeline is finished.
+	StreamStatus(ctx context.Context, in *StreamStatusRequest, opts ...grpc.CallOption) (PlaygroundService_StreamStatusClient, error)
 	// Get the string representation of the pipeline execution graph in DOT format.
 	GetGraph(ctx context.Context, in *GetGraphRequest, opts ...grpc.CallOption) (*GetGraphResponse, error)
+	// Get the final metrics of pipeline execution reported by the runner.
+	GetRunMetrics(ctx context.Context, in *GetRunMetricsRequest, opts ...grpc.CallOption) (*GetRunMetricsResponse, error)
 	// Get the error of pipeline execution.
 	GetRunError(ctx context.Context, in *GetRunErrorRequest, opts ...grpc.CallOption) (*GetRunErrorResponse, error)
 	// Get the result of pipeline validation.
@@ -100,6 +115,12 @@ type PlaygroundServiceClient interface {
 	SaveSnippet(ctx context.Context, in *SaveSnippetRequest, opts ...grpc.CallOption) (*SaveSnippetResponse, error)
 	// Get the snippet of playground.
 	GetSnippet(ctx context.Context, in *GetSnippetRequest, opts ...grpc.CallOption) (*GetSnippetResponse, error)
+	// Fork the snippet as its next revision.
+	ForkSnippet(ctx context.Context, in *ForkSnippetRequest, opts ...grpc.CallOption) (*ForkSnippetResponse, error)
+	// List the revisions of the snippet and the revisions derived from it.
+	ListSnippetRevisions(ctx context.Context, in *ListSnippetRevisionsRequest, opts ...grpc.CallOption) (*ListSnippetRevisionsResponse, error)
+	// Get the diff between two revisions of the snippet.
+	GetSnippetDiff(ctx context.Context, in *GetSnippetDiffRequest, opts ...grpc.CallOption) (*GetSnippetDiffResponse, error)
 	// Get the runner metadata.
 	GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error)
 }
@@ -148,6 +169,102 @@ func (c *playgroundServiceClient) GetLogs(ctx context.Context, in *GetLogsReques
 	return out, nil
 }
 
+func (c *playgroundServiceClient) StreamRunOutput(ctx context.Context, in *StreamRunOutputRequest, opts ...grpc.CallOption) (PlaygroundService_StreamRunOutputClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PlaygroundService_ServiceDesc.Streams[0], PlaygroundService_StreamRunOutput_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &playgroundServiceStreamRunOutputClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PlaygroundService_StreamRunOutputClient interface {
+	Recv() (*StreamRunOutputResponse, error)
+	grpc.ClientStream
+}
+
+type playgroundServiceStreamRunOutputClient struct {
+	grpc.ClientStream
+}
+
+func (x *playgroundServiceStreamRunOutputClient) Recv() (*StreamRunOutputResponse, error) {
+	m := new(StreamRunOutputResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *playgroundServiceClient) StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (PlaygroundService_StreamLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PlaygroundService_ServiceDesc.Streams[1], PlaygroundService_StreamLogs_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &playgroundServiceStreamLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PlaygroundService_StreamLogsClient interface {
+	Recv() (*StreamLogsResponse, error)
+	grpc.ClientStream
+}
+
+type playgroundServiceStreamLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *playgroundServiceStreamLogsClient) Recv() (*StreamLogsResponse, error) {
+	m := new(StreamLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *playgroundServiceClient) StreamStatus(ctx context.Context, in *StreamStatusRequest, opts ...grpc.CallOption) (PlaygroundService_StreamStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PlaygroundService_ServiceDesc.Streams[2], PlaygroundService_StreamStatus_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &playgroundServiceStreamStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PlaygroundService_StreamStatusClient interface {
+	Recv() (*StreamStatusResponse, error)
+	grpc.ClientStream
+}
+
+type playgroundServiceStreamStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *playgroundServiceStreamStatusClient) Recv() (*StreamStatusResponse, error) {
+	m := new(StreamStatusResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *playgroundServiceClient) GetGraph(ctx context.Context, in *GetGraphRequest, opts ...grpc.CallOption) (*GetGraphResponse, error) {
 	out := new(GetGraphResponse)
 	err := c.cc.Invoke(ctx, PlaygroundService_GetGraph_FullMethodName, in, out, opts...)
@@ -157,6 +274,15 @@ func (c *playgroundServiceClient) GetGraph(ctx context.Context, in *GetGraphRequ
 	return out, nil
 }
 
+func (c *playgroundServiceClient) GetRunMetrics(ctx context.Context, in *GetRunMetricsRequest, opts ...grpc.CallOption) (*GetRunMetricsResponse, error) {
+	out := new(GetRunMetricsResponse)
+	err := c.cc.Invoke(ctx, PlaygroundService_GetRunMetrics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *playgroundServiceClient) GetRunError(ctx context.Context, in *GetRunErrorRequest, opts ...grpc.CallOption) (*GetRunErrorResponse, error) {
 	out := new(GetRunErrorResponse)
 	err := c.cc.Invoke(ctx, PlaygroundService_GetRunError_FullMethodName, in, out, opts...)
@@ -283,6 +409,33 @@ func (c *playgroundServiceClient) GetSnippet(ctx context.Context, in *GetSnippet
 	return out, nil
 }
 
+func (c *playgroundServiceClient) ForkSnippet(ctx context.Context, in *ForkSnippetRequest, opts ...grpc.CallOption) (*ForkSnippetResponse, error) {
+	out := new(ForkSnippetResponse)
+	err := c.cc.Invoke(ctx, PlaygroundService_ForkSnippet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playgroundServiceClient) ListSnippetRevisions(ctx context.Context, in *ListSnippetRevisionsRequest, opts ...grpc.CallOption) (*ListSnippetRevisionsResponse, error) {
+	out := new(ListSnippetRevisionsResponse)
+	err := c.cc.Invoke(ctx, PlaygroundService_ListSnippetRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playgroundServiceClient) GetSnippetDiff(ctx context.Context, in *GetSnippetDiffRequest, opts ...grpc.CallOption) (*GetSnippetDiffResponse, error) {
+	out := new(GetSnippetDiffResponse)
+	err := c.cc.Invoke(ctx, PlaygroundService_GetSnippetDiff_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *playgroundServiceClient) GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error) {
 	out := new(GetMetadataResponse)
 	err := c.cc.Invoke(ctx, PlaygroundService_GetMetadata_FullMethodName, in, out, opts...)
@@ -304,8 +457,16 @@ type PlaygroundServiceServer interface {
 	GetRunOutput(context.Context, *GetRunOutputRequest) (*GetRunOutputResponse, error)
 	// Get the logs of pipeline execution.
 	GetLogs(context.Context, *GetLogsRequest) (*GetLogsResponse, error)
+	// Stream the output of pipeline execution until the pipeline is finished.
+	StreamRunOutput(*StreamRunOutputRequest, PlaygroundService_StreamRunOutputServer) error
+	// Stream the logs of pipeline execution until the pipeline is finished.
+	StreamLogs(*StreamLogsRequest, PlaygroundService_StreamLogsServer) error
+	// Stream the status changes of pipeline execution until the pipeline is finished.
+	StreamStatus(*StreamStatusRequest, PlaygroundService_StreamStatusServer) error
 	// Get the string representation of the pipeline execution graph in DOT format.
 	GetGraph(context.Context, *GetGraphRequest) (*GetGraphResponse, error)
+	// Get the final metrics of pipeline execution reported by the runner.
+	GetRunMetrics(context.Context, *GetRunMetricsRequest) (*GetRunMetricsResponse, error)
 	// Get the error of pipeline execution.
 	GetRunError(context.Context, *GetRunErrorRequest) (*GetRunErrorResponse, error)
 	// Get the result of pipeline validation.
@@ -334,6 +495,12 @@ type PlaygroundServiceServer interface {
 	SaveSnippet(context.Context, *SaveSnippetRequest) (*SaveSnippetResponse, error)
 	// Get the snippet of playground.
 	GetSnippet(context.Context, *GetSnippetRequest) (*GetSnippetResponse, error)
+	// Fork the snippet as its next revision.
+	ForkSnippet(context.Context, *ForkSnippetRequest) (*ForkSnippetResponse, error)
+	// List the revisions of the snippet and the revisions derived from it.
+	ListSnippetRevisions(context.Context, *ListSnippetRevisionsRequest) (*ListSnippetRevisionsResponse, error)
+	// Get the diff between two revisions of the snippet.
+	GetSnippetDiff(context.Context, *GetSnippetDiffRequest) (*GetSnippetDiffResponse, error)
 	// Get the runner metadata.
 	GetMetadata(context.Context, *GetMetadataRequest) (*GetMetadataResponse, error)
 }
@@ -354,9 +521,21 @@ func (UnimplementedPlaygroundServiceServer) GetRunOutput(context.Context, *GetRu
 func (UnimplementedPlaygroundServiceServer) GetLogs(context.Context, *GetLogsRequest) (*GetLogsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLogs not implemented")
 }
+func (UnimplementedPlaygroundServiceServer) StreamRunOutput(*StreamRunOutputRequest, PlaygroundService_StreamRunOutputServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamRunOutput not implemented")
+}
+func (UnimplementedPlaygroundServiceServer) StreamLogs(*StreamLogsRequest, PlaygroundService_StreamLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamLogs not implemented")
+}
+func (UnimplementedPlaygroundServiceServer) StreamStatus(*StreamStatusRequest, PlaygroundService_StreamStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamStatus not implemented")
+}
 func (UnimplementedPlaygroundServiceServer) GetGraph(context.Context, *GetGraphRequest) (*GetGraphResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGraph not implemented")
 }
+func (UnimplementedPlaygroundServiceServer) GetRunMetrics(context.Context, *GetRunMetricsRequest) (*GetRunMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRunMetrics not implemented")
+}
 func (UnimplementedPlaygroundServiceServer) GetRunError(context.Context, *GetRunErrorRequest) (*GetRunErrorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRunError not implemented")
 }
@@ -399,6 +578,15 @@ func (UnimplementedPlaygroundServiceServer) SaveSnippet(context.Context, *SaveSn
 func (UnimplementedPlaygroundServiceServer) GetSnippet(context.Context, *GetSnippetRequest) (*GetSnippetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSnippet not implemented")
 }
+func (UnimplementedPlaygroundServiceServer) ForkSnippet(context.Context, *ForkSnippetRequest) (*ForkSnippetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForkSnippet not implemented")
+}
+func (UnimplementedPlaygroundServiceServer) ListSnippetRevisions(context.Context, *ListSnippetRevisionsRequest) (*ListSnippetRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnippetRevisions not implemented")
+}
+func (UnimplementedPlaygroundServiceServer) GetSnippetDiff(context.Context, *GetSnippetDiffRequest) (*GetSnippetDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSnippetDiff not implemented")
+}
 func (UnimplementedPlaygroundServiceServer) GetMetadata(context.Context, *GetMetadataRequest) (*GetMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetadata not implemented")
 }
@@ -486,6 +674,69 @@ func _PlaygroundService_GetLogs_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PlaygroundService_StreamRunOutput_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRunOutputRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PlaygroundServiceServer).StreamRunOutput(m, &playgroundServiceStreamRunOutputServer{stream})
+}
+
+type PlaygroundService_StreamRunOutputServer interface {
+	Send(*StreamRunOutputResponse) error
+	grpc.ServerStream
+}
+
+type playgroundServiceStreamRunOutputServer struct {
+	grpc.ServerStream
+}
+
+func (x *playgroundServiceStreamRunOutputServer) Send(m *StreamRunOutputResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PlaygroundService_StreamLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PlaygroundServiceServer).StreamLogs(m, &playgroundServiceStreamLogsServer{stream})
+}
+
+type PlaygroundService_StreamLogsServer interface {
+	Send(*StreamLogsResponse) error
+	grpc.ServerStream
+}
+
+type playgroundServiceStreamLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *playgroundServiceStreamLogsServer) Send(m *StreamLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PlaygroundService_StreamStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PlaygroundServiceServer).StreamStatus(m, &playgroundServiceStreamStatusServer{stream})
+}
+
+type PlaygroundService_StreamStatusServer interface {
+	Send(*StreamStatusResponse) error
+	grpc.ServerStream
+}
+
+type playgroundServiceStreamStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *playgroundServiceStreamStatusServer) Send(m *StreamStatusResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _PlaygroundService_GetGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetGraphRequest)
 	if err := dec(in); err != nil {
@@ -504,6 +755,24 @@ func _PlaygroundService_GetGraph_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PlaygroundService_GetRunMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRunMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaygroundServiceServer).GetRunMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaygroundService_GetRunMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaygroundServiceServer).GetRunMetrics(ctx, req.(*GetRunMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PlaygroundService_GetRunError_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetRunErrorRequest)
 	if err := dec(in); err != nil {
@@ -756,6 +1025,60 @@ func _PlaygroundService_GetSnippet_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PlaygroundService_ForkSnippet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForkSnippetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaygroundServiceServer).ForkSnippet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaygroundService_ForkSnippet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaygroundServiceServer).ForkSnippet(ctx, req.(*ForkSnippetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaygroundService_ListSnippetRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnippetRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaygroundServiceServer).ListSnippetRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaygroundService_ListSnippetRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaygroundServiceServer).ListSnippetRevisions(ctx, req.(*ListSnippetRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaygroundService_GetSnippetDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSnippetDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaygroundServiceServer).GetSnippetDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaygroundService_GetSnippetDiff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaygroundServiceServer).GetSnippetDiff(ctx, req.(*GetSnippetDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PlaygroundService_GetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetMetadataRequest)
 	if err := dec(in); err != nil {
@@ -801,6 +1124,10 @@ var PlaygroundService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetGraph",
 			Handler:    _PlaygroundService_GetGraph_Handler,
 		},
+		{
+			MethodName: "GetRunMetrics",
+			Handler:    _PlaygroundService_GetRunMetrics_Handler,
+		},
 		{
 			MethodName: "GetRunError",
 			Handler:    _PlaygroundService_GetRunError_Handler,
@@ -857,11 +1184,39 @@ var PlaygroundService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSnippet",
 			Handler:    _PlaygroundService_GetSnippet_Handler,
 		},
+		{
+			MethodName: "ForkSnippet",
+			Handler:    _PlaygroundService_ForkSnippet_Handler,
+		},
+		{
+			MethodName: "ListSnippetRevisions",
+			Handler:    _PlaygroundService_ListSnippetRevisions_Handler,
+		},
+		{
+			MethodName: "GetSnippetDiff",
+			Handler:    _PlaygroundService_GetSnippetDiff_Handler,
+		},
 		{
 			MethodName: "GetMetadata",
 			Handler:    _PlaygroundService_GetMetadata_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamRunOutput",
+			Handler:       _PlaygroundService_StreamRunOutput_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamLogs",
+			Handler:       _PlaygroundService_StreamLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamStatus",
+			Handler:       _PlaygroundService_StreamStatus_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "api/v1/api.proto",
 }
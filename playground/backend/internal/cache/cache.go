@@ -63,6 +63,9 @@ const (
 	// Graph is used to keep graph of the execution
 	Graph SubKey = "GRAPH"
 
+	// Metrics is used to keep final metrics of the execution
+	Metrics SubKey = "METRICS"
+
 	// ExamplesCatalog is catalog of examples available in Playground
 	ExamplesCatalog string = "EXAMPLES_CATALOG"
 
@@ -0,0 +1,51 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"beam.apache.org/playground/backend/internal/cache"
+)
+
+// Notifier is an in-memory implementation of cache.Notifier.
+// It only notifies subscribers in the same process, so it's used together with the local Cache.
+type Notifier struct {
+	subs cache.Subscriptions
+}
+
+// NewNotifier returns local implementation of cache.Notifier.
+func NewNotifier() *Notifier {
+	return &Notifier{}
+}
+
+// Notify sends the subKey to the subscribers of the pipelineId.
+func (n *Notifier) Notify(_ context.Context, pipelineId uuid.UUID, subKey cache.SubKey) error {
+	n.subs.Publish(pipelineId, subKey)
+	return nil
+}
+
+// Subscribe returns a channel receiving the subKeys updated for the pipelineId until ctx is done.
+func (n *Notifier) Subscribe(ctx context.Context, pipelineId uuid.UUID) (<-chan cache.SubKey, error) {
+	ch, _ := n.subs.Add(pipelineId)
+	go func() {
+		<-ctx.Done()
+		n.subs.Remove(pipelineId, ch)
+	}()
+	return ch, nil
+}
@@ -0,0 +1,92 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"beam.apache.org/playground/backend/internal/cache"
+)
+
+func TestNotifier_Subscribe(t *testing.T) {
+	pipelineId := uuid.New()
+	n := NewNotifier()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	notifications, err := n.Subscribe(ctx, pipelineId)
+	if err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+	others, err := n.Subscribe(ctx, uuid.New())
+	if err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+
+	if err := n.Notify(ctx, pipelineId, cache.RunOutput); err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+	select {
+	case got := <-notifications:
+		if got != cache.RunOutput {
+			t.Errorf("Subscribe() got = %v, want %v", got, cache.RunOutput)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Subscribe() didn't receive the notification")
+	}
+	select {
+	case got := <-others:
+		t.Errorf("Subscribe() of another pipeline got = %v, want no notification", got)
+	default:
+	}
+
+	cancel()
+	select {
+	case _, ok := <-notifications:
+		if ok {
+			t.Errorf("Subscribe() channel received a notification after cancellation, want closed")
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Subscribe() channel isn't closed after cancellation")
+	}
+}
+
+func TestNotifyingCache_SetValue(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pipelineId := uuid.New()
+	nc := &cache.NotifyingCache{Cache: New(ctx), Notifier: NewNotifier()}
+	notifications, _ := nc.Subscribe(ctx, pipelineId)
+
+	if err := nc.SetValue(ctx, pipelineId, cache.Status, "MOCK_STATUS"); err != nil {
+		t.Fatalf("SetValue() error = %v", err)
+	}
+	if got, _ := nc.GetValue(ctx, pipelineId, cache.Status); got != "MOCK_STATUS" {
+		t.Errorf("GetValue() got = %v, want %v", got, "MOCK_STATUS")
+	}
+	select {
+	case got := <-notifications:
+		if got != cache.Status {
+			t.Errorf("SetValue() notified about %v, want %v", got, cache.Status)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("SetValue() didn't notify about the value")
+	}
+}
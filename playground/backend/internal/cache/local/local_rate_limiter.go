@@ -0,0 +1,69 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// rateLimiterCleanupInterval is how often the expired windows are removed
+const rateLimiterCleanupInterval = time.Minute
+
+// rateLimitWindow is the number of requests of a key in the current window
+type rateLimitWindow struct {
+	count   int
+	resetAt time.Time
+}
+
+// RateLimiter is an in-memory implementation of cache.RateLimiter.
+// It only counts requests of the current process, so it's used together with the local Cache.
+type RateLimiter struct {
+	mu          sync.Mutex
+	windows     map[string]*rateLimitWindow
+	nextCleanup time.Time
+}
+
+// NewRateLimiter returns local implementation of cache.RateLimiter.
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		windows:     make(map[string]*rateLimitWindow),
+		nextCleanup: time.Now().Add(rateLimiterCleanupInterval),
+	}
+}
+
+// Take takes a request from the quota of the key in the current window of the given length.
+func (rl *RateLimiter) Take(_ context.Context, key string, limit int, window time.Duration) (bool, time.Duration, error) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	now := time.Now()
+	if now.After(rl.nextCleanup) {
+		for windowKey, w := range rl.windows {
+			if !now.Before(w.resetAt) {
+				delete(rl.windows, windowKey)
+			}
+		}
+		rl.nextCleanup = now.Add(rateLimiterCleanupInterval)
+	}
+	w, ok := rl.windows[key]
+	if !ok || !now.Before(w.resetAt) {
+		w = &rateLimitWindow{resetAt: now.Add(window)}
+		rl.windows[key] = w
+	}
+	w.count++
+	return w.count <= limit, w.resetAt.Sub(now), nil
+}
@@ -0,0 +1,58 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_Take(t *testing.T) {
+	ctx := context.Background()
+	rl := NewRateLimiter()
+	for i := 0; i < 2; i++ {
+		if allowed, _, err := rl.Take(ctx, "client_1", 2, time.Minute); err != nil || !allowed {
+			t.Fatalf("Take() = %v, %v, want true, nil", allowed, err)
+		}
+	}
+	allowed, retryAfter, err := rl.Take(ctx, "client_1", 2, time.Minute)
+	if err != nil || allowed {
+		t.Fatalf("Take() = %v, %v, want false, nil", allowed, err)
+	}
+	if retryAfter <= 0 || retryAfter > time.Minute {
+		t.Errorf("Take() retry after = %v, want within the window", retryAfter)
+	}
+	if allowed, _, _ := rl.Take(ctx, "client_2", 2, time.Minute); !allowed {
+		t.Errorf("Take() didn't allow a request of another client")
+	}
+}
+
+func TestRateLimiter_Take_WindowReset(t *testing.T) {
+	ctx := context.Background()
+	rl := NewRateLimiter()
+	if allowed, _, _ := rl.Take(ctx, "client", 1, time.Millisecond); !allowed {
+		t.Fatalf("Take() didn't allow the first request")
+	}
+	time.Sleep(2 * time.Millisecond)
+	rl.nextCleanup = time.Now()
+	if allowed, _, _ := rl.Take(ctx, "client", 1, time.Minute); !allowed {
+		t.Errorf("Take() didn't allow a request after the window is reset")
+	}
+	if len(rl.windows) != 1 {
+		t.Errorf("Take() didn't clean up expired windows, got %d windows", len(rl.windows))
+	}
+}
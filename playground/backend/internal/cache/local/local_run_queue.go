@@ -0,0 +1,134 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"beam.apache.org/playground/backend/internal/cache"
+)
+
+// queuedRun is a pipeline waiting in the RunQueue.
+type queuedRun struct {
+	pipelineId uuid.UUID
+	clientId   string
+	// round is the number of pipelines of the client that were waiting when the pipeline was enqueued
+	round      int
+	enqueuedAt time.Time
+}
+
+// before reports whether the run should be admitted before the other one.
+func (r queuedRun) before(other queuedRun) bool {
+	if r.round != other.round {
+		return r.round < other.round
+	}
+	return r.enqueuedAt.Before(other.enqueuedAt)
+}
+
+// RunQueue is an in-memory implementation of cache.RunQueue.
+// It only limits pipelines of the current process, so it's used together with the local Cache.
+// Since runs can't outlive the process, their leases never expire.
+type RunQueue struct {
+	mu      sync.Mutex
+	limit   int
+	queued  []queuedRun
+	waiting map[string]int
+	running map[uuid.UUID]struct{}
+}
+
+// NewRunQueue returns local implementation of cache.RunQueue processing at most limit pipelines at the same time.
+func NewRunQueue(limit int) *RunQueue {
+	return &RunQueue{
+		limit:   limit,
+		waiting: make(map[string]int),
+		running: make(map[uuid.UUID]struct{}),
+	}
+}
+
+// Enqueue adds the pipeline of the client to the end of its round in the queue.
+func (rq *RunQueue) Enqueue(_ context.Context, pipelineId uuid.UUID, clientId string) error {
+	rq.mu.Lock()
+	defer rq.mu.Unlock()
+	run := queuedRun{pipelineId: pipelineId, clientId: clientId, round: rq.waiting[clientId], enqueuedAt: time.Now()}
+	i := sort.Search(len(rq.queued), func(i int) bool { return run.before(rq.queued[i]) })
+	rq.queued = append(rq.queued, queuedRun{})
+	copy(rq.queued[i+1:], rq.queued[i:])
+	rq.queued[i] = run
+	rq.waiting[clientId]++
+	return nil
+}
+
+// TryAcquire admits the pipeline if it's the first one in the queue and fewer than limit pipelines are running.
+func (rq *RunQueue) TryAcquire(_ context.Context, pipelineId uuid.UUID) (bool, error) {
+	rq.mu.Lock()
+	defer rq.mu.Unlock()
+	if _, ok := rq.running[pipelineId]; ok {
+		return true, nil
+	}
+	i := rq.index(pipelineId)
+	if i < 0 {
+		return false, cache.ErrNotQueued
+	}
+	if i > 0 || len(rq.running) >= rq.limit {
+		return false, nil
+	}
+	rq.dequeue(i)
+	rq.running[pipelineId] = struct{}{}
+	return true, nil
+}
+
+// Renew checks that the pipeline is running, since leases of the local RunQueue don't expire.
+func (rq *RunQueue) Renew(_ context.Context, pipelineId uuid.UUID) error {
+	rq.mu.Lock()
+	defer rq.mu.Unlock()
+	if _, ok := rq.running[pipelineId]; !ok {
+		return cache.ErrNotQueued
+	}
+	return nil
+}
+
+// Release removes the pipeline from the queue and frees its slot if it was admitted.
+func (rq *RunQueue) Release(_ context.Context, pipelineId uuid.UUID) error {
+	rq.mu.Lock()
+	defer rq.mu.Unlock()
+	if i := rq.index(pipelineId); i >= 0 {
+		rq.dequeue(i)
+	}
+	delete(rq.running, pipelineId)
+	return nil
+}
+
+func (rq *RunQueue) index(pipelineId uuid.UUID) int {
+	for i, run := range rq.queued {
+		if run.pipelineId == pipelineId {
+			return i
+		}
+	}
+	return -1
+}
+
+func (rq *RunQueue) dequeue(i int) {
+	clientId := rq.queued[i].clientId
+	rq.queued = append(rq.queued[:i], rq.queued[i+1:]...)
+	if rq.waiting[clientId]--; rq.waiting[clientId] <= 0 {
+		delete(rq.waiting, clientId)
+	}
+}
@@ -0,0 +1,71 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"beam.apache.org/playground/backend/internal/cache"
+)
+
+func TestRunQueue_TryAcquire(t *testing.T) {
+	ctx := context.Background()
+	rq := NewRunQueue(1)
+	busy := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	other := uuid.New()
+	for _, pipelineId := range busy {
+		if err := rq.Enqueue(ctx, pipelineId, "busy_client"); err != nil {
+			t.Fatalf("Enqueue() error = %v", err)
+		}
+	}
+	if err := rq.Enqueue(ctx, other, "other_client"); err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+
+	// Rounds: busy[0] and other are in the first round, the rest of the busy client's pipelines follow.
+	want := []uuid.UUID{busy[0], other, busy[1], busy[2]}
+	for i, pipelineId := range want {
+		for _, waiting := range want[i+1:] {
+			if admitted, err := rq.TryAcquire(ctx, waiting); err != nil || admitted {
+				t.Fatalf("TryAcquire() = %v, %v, want false, nil", admitted, err)
+			}
+		}
+		if admitted, err := rq.TryAcquire(ctx, pipelineId); err != nil || !admitted {
+			t.Fatalf("TryAcquire() = %v, %v, want true, nil", admitted, err)
+		}
+		if err := rq.Renew(ctx, pipelineId); err != nil {
+			t.Fatalf("Renew() error = %v", err)
+		}
+		if i+1 < len(want) {
+			if admitted, _ := rq.TryAcquire(ctx, want[i+1]); admitted {
+				t.Fatalf("TryAcquire() admitted a pipeline over the limit")
+			}
+		}
+		if err := rq.Release(ctx, pipelineId); err != nil {
+			t.Fatalf("Release() error = %v", err)
+		}
+	}
+	if _, err := rq.TryAcquire(ctx, busy[0]); !errors.Is(err, cache.ErrNotQueued) {
+		t.Errorf("TryAcquire() error = %v, want %v", err, cache.ErrNotQueued)
+	}
+	if len(rq.waiting) != 0 || len(rq.running) != 0 {
+		t.Errorf("RunQueue isn't empty after all pipelines were released")
+	}
+}
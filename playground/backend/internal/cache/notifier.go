@@ -0,0 +1,111 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"beam.apache.org/playground/backend/internal/logger"
+)
+
+// subscriptionBufferSize is the number of notifications buffered for each subscriber.
+// Further notifications are dropped until the subscriber catches up,
+// so subscribers should re-read the values they're interested in on each notification
+// rather than rely on receiving every subKey.
+const subscriptionBufferSize = 16
+
+// Notifier notifies subscribers about updated values of pipelines,
+// so that they don't need to poll Cache for changes.
+type Notifier interface {
+	// Notify notifies subscribers of the pipelineId that the value of subKey was updated.
+	Notify(ctx context.Context, pipelineId uuid.UUID, subKey SubKey) error
+
+	// Subscribe returns a channel receiving the subKeys updated for the pipelineId.
+	// The channel is closed once ctx is done.
+	Subscribe(ctx context.Context, pipelineId uuid.UUID) (<-chan SubKey, error)
+}
+
+// NotifyingCache is a Cache that notifies the Notifier about each value it sets.
+type NotifyingCache struct {
+	Cache
+	Notifier
+}
+
+// SetValue adds value to the cache by pipelineId and subKey, and notifies subscribers of the pipelineId.
+// Failed notifications are only logged, since the value is already saved and subscribers re-read values periodically.
+func (nc *NotifyingCache) SetValue(ctx context.Context, pipelineId uuid.UUID, subKey SubKey, value interface{}) error {
+	if err := nc.Cache.SetValue(ctx, pipelineId, subKey, value); err != nil {
+		return err
+	}
+	if err := nc.Notify(ctx, pipelineId, subKey); err != nil {
+		logger.Warnf("%s: NotifyingCache: error during notification about %s: %s", pipelineId, subKey, err.Error())
+	}
+	return nil
+}
+
+// Subscriptions keeps the subscribers of each pipeline in the current process.
+// It's used by Notifier implementations to fan out notifications. The zero value is ready to use.
+type Subscriptions struct {
+	mu   sync.Mutex
+	subs map[uuid.UUID]map[chan SubKey]struct{}
+}
+
+// Add adds a subscriber of the pipelineId, and reports whether it's the first one.
+func (s *Subscriptions) Add(pipelineId uuid.UUID) (chan SubKey, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.subs == nil {
+		s.subs = make(map[uuid.UUID]map[chan SubKey]struct{})
+	}
+	first := len(s.subs[pipelineId]) == 0
+	if first {
+		s.subs[pipelineId] = make(map[chan SubKey]struct{})
+	}
+	ch := make(chan SubKey, subscriptionBufferSize)
+	s.subs[pipelineId][ch] = struct{}{}
+	return ch, first
+}
+
+// Remove removes and closes a subscriber of the pipelineId, and reports whether it was the last one.
+func (s *Subscriptions) Remove(pipelineId uuid.UUID, ch chan SubKey) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.subs[pipelineId][ch]; !ok {
+		return false
+	}
+	delete(s.subs[pipelineId], ch)
+	close(ch)
+	if len(s.subs[pipelineId]) > 0 {
+		return false
+	}
+	delete(s.subs, pipelineId)
+	return true
+}
+
+// Publish sends the subKey to all subscribers of the pipelineId without blocking.
+func (s *Subscriptions) Publish(pipelineId uuid.UUID, subKey SubKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for ch := range s.subs[pipelineId] {
+		select {
+		case ch <- subKey:
+		default:
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestSubscriptions(t *testing.T) {
+	pipelineId := uuid.New()
+	var subs Subscriptions
+
+	first, isFirst := subs.Add(pipelineId)
+	if !isFirst {
+		t.Errorf("Add() of the first subscriber reported false, want true")
+	}
+	second, isFirst := subs.Add(pipelineId)
+	if isFirst {
+		t.Errorf("Add() of the second subscriber reported true, want false")
+	}
+
+	// Publish doesn't block on full subscribers.
+	for i := 0; i < subscriptionBufferSize+1; i++ {
+		subs.Publish(pipelineId, Status)
+	}
+	if got := len(first); got != subscriptionBufferSize {
+		t.Errorf("Publish() buffered %d notifications, want %d", got, subscriptionBufferSize)
+	}
+
+	if subs.Remove(pipelineId, first) {
+		t.Errorf("Remove() of the first subscriber reported true, want false")
+	}
+	if !subs.Remove(pipelineId, second) {
+		t.Errorf("Remove() of the last subscriber reported false, want true")
+	}
+	if subs.Remove(pipelineId, second) {
+		t.Errorf("Remove() of a removed subscriber reported true, want false")
+	}
+	for range second {
+	}
+}
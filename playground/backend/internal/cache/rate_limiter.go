@@ -0,0 +1,30 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// RateLimiter counts the requests of clients in fixed time windows to limit how many requests a client can send.
+// The windows of the remote implementation are shared by all instances using the same cache,
+// so the limits hold across the cluster.
+type RateLimiter interface {
+	// Take takes a request from the quota of the key in the current window of the given length.
+	// Reports whether the request is within the limit, and the time remaining until the window is reset.
+	Take(ctx context.Context, key string, limit int, window time.Duration) (bool, time.Duration, error)
+}
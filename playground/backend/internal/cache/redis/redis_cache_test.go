@@ -633,6 +633,15 @@ func Test_unmarshalBySubKey(t *testing.T) {
 			want:    output,
 			wantErr: false,
 		},
+		{
+			name: "Metrics subKey",
+			args: args{
+				subKey: cache.Metrics,
+				value:  string(outputValue),
+			},
+			want:    output,
+			wantErr: false,
+		},
 		{
 			// Test case with calling unmarshalBySubKey method with Canceled subKey.
 			// As a result, want to receive false.
@@ -0,0 +1,118 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+
+	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/logger"
+)
+
+const notificationChannelPrefix = "playground:updates:"
+
+// Notifier is the Redis implementation of cache.Notifier.
+// Notifications are published to a Redis channel per pipeline, so they reach subscribers of all
+// backend instances sharing the Redis cache. Each instance keeps a single connection subscribed
+// to the channels of the pipelines that its subscribers are interested in.
+type Notifier struct {
+	client *redis.Client
+
+	mu     sync.Mutex
+	pubsub *redis.PubSub
+	subs   cache.Subscriptions
+}
+
+// NewNotifier returns Redis implementation of cache.Notifier using the client.
+// Notifications are received until ctx is done.
+func NewNotifier(ctx context.Context, client *redis.Client) *Notifier {
+	n := &Notifier{client: client, pubsub: client.Subscribe(ctx)}
+	go n.receive(ctx)
+	return n
+}
+
+// Notify publishes the subKey to the channel of the pipelineId.
+func (n *Notifier) Notify(ctx context.Context, pipelineId uuid.UUID, subKey cache.SubKey) error {
+	if err := n.client.Publish(ctx, notificationChannel(pipelineId), string(subKey)).Err(); err != nil {
+		logger.Errorf("Redis Cache: notify: error during Publish operation for key: %s, subKey: %s, err: %s\n", pipelineId, subKey, err.Error())
+		return err
+	}
+	return nil
+}
+
+// Subscribe returns a channel receiving the subKeys updated for the pipelineId until ctx is done.
+// The Redis channel of the pipelineId is subscribed to with the first subscriber of the pipelineId,
+// and unsubscribed from after the last one.
+func (n *Notifier) Subscribe(ctx context.Context, pipelineId uuid.UUID) (<-chan cache.SubKey, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	ch, first := n.subs.Add(pipelineId)
+	if first {
+		if err := n.pubsub.Subscribe(ctx, notificationChannel(pipelineId)); err != nil {
+			logger.Errorf("Redis Cache: subscribe: error during Subscribe operation for key: %s, err: %s\n", pipelineId, err.Error())
+			n.subs.Remove(pipelineId, ch)
+			return nil, err
+		}
+	}
+	go func() {
+		<-ctx.Done()
+		n.unsubscribe(pipelineId, ch)
+	}()
+	return ch, nil
+}
+
+func (n *Notifier) unsubscribe(pipelineId uuid.UUID, ch chan cache.SubKey) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if !n.subs.Remove(pipelineId, ch) {
+		return
+	}
+	if err := n.pubsub.Unsubscribe(context.Background(), notificationChannel(pipelineId)); err != nil {
+		logger.Errorf("Redis Cache: unsubscribe: error during Unsubscribe operation for key: %s, err: %s\n", pipelineId, err.Error())
+	}
+}
+
+// receive dispatches the messages of the subscribed Redis channels to the subscribers until ctx is done.
+func (n *Notifier) receive(ctx context.Context) {
+	defer n.pubsub.Close()
+	messages := n.pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+			pipelineId, err := uuid.Parse(strings.TrimPrefix(msg.Channel, notificationChannelPrefix))
+			if err != nil {
+				logger.Errorf("Redis Cache: receive: unexpected channel: %s\n", msg.Channel)
+				continue
+			}
+			n.subs.Publish(pipelineId, cache.SubKey(msg.Payload))
+		}
+	}
+}
+
+func notificationChannel(pipelineId uuid.UUID) string {
+	return fmt.Sprintf("%s%s", notificationChannelPrefix, pipelineId)
+}
@@ -0,0 +1,72 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-redis/redismock/v8"
+	"github.com/google/uuid"
+
+	"beam.apache.org/playground/backend/internal/cache"
+)
+
+func TestNotifier_Notify(t *testing.T) {
+	pipelineId := uuid.New()
+	client, mock := redismock.NewClientMock()
+	type args struct {
+		ctx        context.Context
+		pipelineId uuid.UUID
+		subKey     cache.SubKey
+	}
+	tests := []struct {
+		name    string
+		mocks   func()
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Error during Publish operation",
+			mocks: func() {
+				mock.ExpectPublish(notificationChannel(pipelineId), string(cache.RunOutput)).SetErr(fmt.Errorf("MOCK_ERROR"))
+			},
+			args:    args{ctx: context.Background(), pipelineId: pipelineId, subKey: cache.RunOutput},
+			wantErr: true,
+		},
+		{
+			name: "Publishing the subKey to the channel of the pipeline",
+			mocks: func() {
+				mock.ExpectPublish("playground:updates:"+pipelineId.String(), string(cache.Status)).SetVal(1)
+			},
+			args:    args{ctx: context.Background(), pipelineId: pipelineId, subKey: cache.Status},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mocks()
+			n := &Notifier{client: client}
+			if err := n.Notify(tt.args.ctx, tt.args.pipelineId, tt.args.subKey); (err != nil) != tt.wantErr {
+				t.Errorf("Notify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Notify() unmet expectations: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"beam.apache.org/playground/backend/internal/logger"
+)
+
+const rateLimiterKeyPrefix = "playground:ratelimit:"
+
+// takeScript increments the number of requests of the key in the current window, which starts with the first request.
+// Returns the number of requests and the time remaining until the window is reset in milliseconds.
+// ARGV: window length in milliseconds.
+var takeScript = redis.NewScript(`
+local count = redis.call('INCR', KEYS[1])
+local ttl = redis.call('PTTL', KEYS[1])
+if ttl < 0 then
+  redis.call('PEXPIRE', KEYS[1], ARGV[1])
+  ttl = tonumber(ARGV[1])
+end
+return {count, ttl}
+`)
+
+// RateLimiter is the Redis implementation of cache.RateLimiter.
+// The windows are shared by all backend instances using the same Redis cache. Each window is a counter
+// expiring at the end of the window, which is updated by a Lua script, so it's atomic.
+type RateLimiter struct {
+	client *redis.Client
+}
+
+// NewRateLimiter returns Redis implementation of cache.RateLimiter.
+func NewRateLimiter(client *redis.Client) *RateLimiter {
+	return &RateLimiter{client: client}
+}
+
+// Take takes a request from the quota of the key in the current window of the given length.
+func (rl *RateLimiter) Take(ctx context.Context, key string, limit int, window time.Duration) (bool, time.Duration, error) {
+	result, err := takeScript.Run(ctx, rl.client, []string{rateLimiterKeyPrefix + key}, window.Milliseconds()).Int64Slice()
+	if err != nil {
+		logger.Errorf("Redis Cache: take: error during take script for key: %s, err: %s\n", key, err.Error())
+		return false, 0, err
+	}
+	return result[0] <= int64(limit), time.Duration(result[1]) * time.Millisecond, nil
+}
@@ -0,0 +1,64 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+)
+
+// newTestRateLimiters returns rate limiters of the instances sharing a Redis server, which runs the Lua scripts.
+func newTestRateLimiters(t *testing.T, count int) (*miniredis.Miniredis, []*RateLimiter) {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+	var limiters []*RateLimiter
+	for i := 0; i < count; i++ {
+		limiters = append(limiters, NewRateLimiter(client))
+	}
+	return server, limiters
+}
+
+func TestRateLimiter_Take(t *testing.T) {
+	ctx := context.Background()
+	server, limiters := newTestRateLimiters(t, 2)
+
+	// The quota of the client is shared by the instances
+	for _, rl := range limiters {
+		if allowed, _, err := rl.Take(ctx, "client_1", 2, time.Minute); err != nil || !allowed {
+			t.Fatalf("Take() = %v, %v, want true, nil", allowed, err)
+		}
+	}
+	allowed, retryAfter, err := limiters[0].Take(ctx, "client_1", 2, time.Minute)
+	if err != nil || allowed {
+		t.Fatalf("Take() = %v, %v, want false, nil", allowed, err)
+	}
+	if retryAfter <= 0 || retryAfter > time.Minute {
+		t.Errorf("Take() retry after = %v, want within the window", retryAfter)
+	}
+	if allowed, _, _ := limiters[1].Take(ctx, "client_2", 2, time.Minute); !allowed {
+		t.Errorf("Take() didn't allow a request of another client")
+	}
+
+	server.FastForward(time.Minute)
+	if allowed, _, _ := limiters[1].Take(ctx, "client_1", 2, time.Minute); !allowed {
+		t.Errorf("Take() didn't allow a request after the window is reset")
+	}
+}
@@ -0,0 +1,245 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+
+	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/logger"
+)
+
+const runQueueKeyPrefix = "playground:queue:"
+
+// roundScore is the score distance between rounds of the queue. It's bigger than any timestamp in milliseconds,
+// so pipelines are ordered by round first and by the time they were enqueued second.
+const roundScore = 1e13
+
+// runQueueFunctions are shared by the scripts of RunQueue.
+// KEYS: queued pipelines (ZSET by round and time), leases (ZSET by expiration time),
+// running pipelines (HASH to instance), owners of queued pipelines (HASH to instance),
+// clients of queued pipelines (HASH to client), waiting pipelines of clients (HASH to count),
+// running pipelines of instances (HASH to count), limits of instances (HASH to limit).
+const runQueueFunctions = `
+local function leave(id)
+  if redis.call('ZREM', KEYS[1], id) == 1 then
+    local client = redis.call('HGET', KEYS[5], id)
+    redis.call('HDEL', KEYS[4], id)
+    redis.call('HDEL', KEYS[5], id)
+    if client and redis.call('HINCRBY', KEYS[6], client, -1) <= 0 then
+      redis.call('HDEL', KEYS[6], client)
+    end
+  end
+  local instance = redis.call('HGET', KEYS[3], id)
+  if instance then
+    redis.call('HDEL', KEYS[3], id)
+    if redis.call('HINCRBY', KEYS[7], instance, -1) <= 0 then
+      redis.call('HDEL', KEYS[7], instance)
+    end
+  end
+  redis.call('ZREM', KEYS[2], id)
+end
+
+local function purge(now)
+  for _, id in ipairs(redis.call('ZRANGEBYSCORE', KEYS[2], '-inf', now)) do
+    leave(id)
+  end
+end
+`
+
+// enqueueScript adds the pipeline to the end of the client's round.
+// ARGV: pipelineId, clientId, instanceId, now, lease expiration time, round score.
+var enqueueScript = redis.NewScript(runQueueFunctions + `
+purge(tonumber(ARGV[4]))
+local waiting = tonumber(redis.call('HGET', KEYS[6], ARGV[2])) or 0
+redis.call('ZADD', KEYS[1], string.format('%.0f', waiting * tonumber(ARGV[6]) + tonumber(ARGV[4])), ARGV[1])
+redis.call('HSET', KEYS[4], ARGV[1], ARGV[3])
+redis.call('HSET', KEYS[5], ARGV[1], ARGV[2])
+redis.call('HINCRBY', KEYS[6], ARGV[2], 1)
+redis.call('ZADD', KEYS[2], ARGV[5], ARGV[1])
+return 1
+`)
+
+// acquireScript admits the pipeline if the concurrency limits allow it and it's its turn:
+// there are no pipelines of the same instance before it, and there are enough free slots for the pipelines
+// before it which can be admitted. Pipelines whose instance is at its limit can't be admitted, so they are skipped
+// and don't hold the free slots of the cluster from the pipelines of other instances.
+// Returns 1 if the pipeline is admitted, 0 if it should wait and -1 if it isn't queued.
+// ARGV: pipelineId, instanceId, now, lease expiration time, instance limit, cluster limit (0 means no limit).
+var acquireScript = redis.NewScript(runQueueFunctions + `
+purge(tonumber(ARGV[3]))
+if redis.call('HEXISTS', KEYS[3], ARGV[1]) == 1 then
+  redis.call('ZADD', KEYS[2], ARGV[4], ARGV[1])
+  return 1
+end
+local rank = redis.call('ZRANK', KEYS[1], ARGV[1])
+if not rank then
+  return -1
+end
+redis.call('ZADD', KEYS[2], ARGV[4], ARGV[1])
+redis.call('HSET', KEYS[8], ARGV[2], ARGV[5])
+local instanceLimit = tonumber(ARGV[5])
+local clusterLimit = tonumber(ARGV[6])
+if (tonumber(redis.call('HGET', KEYS[7], ARGV[2])) or 0) >= instanceLimit then
+  return 0
+end
+local free = clusterLimit - redis.call('HLEN', KEYS[3])
+if clusterLimit > 0 and free <= 0 then
+  return 0
+end
+if rank > 0 then
+  local instanceSlots = {}
+  for _, earlier in ipairs(redis.call('ZRANGE', KEYS[1], 0, rank - 1)) do
+    local owner = redis.call('HGET', KEYS[4], earlier) or ''
+    if owner == ARGV[2] then
+      return 0
+    end
+    if clusterLimit > 0 then
+      if instanceSlots[owner] == nil then
+        local limit = tonumber(redis.call('HGET', KEYS[8], owner))
+        if limit then
+          instanceSlots[owner] = limit - (tonumber(redis.call('HGET', KEYS[7], owner)) or 0)
+        else
+          instanceSlots[owner] = math.huge
+        end
+      end
+      if instanceSlots[owner] > 0 then
+        instanceSlots[owner] = instanceSlots[owner] - 1
+        free = free - 1
+        if free <= 0 then
+          return 0
+        end
+      end
+    end
+  end
+end
+leave(ARGV[1])
+redis.call('HSET', KEYS[3], ARGV[1], ARGV[2])
+redis.call('HINCRBY', KEYS[7], ARGV[2], 1)
+redis.call('ZADD', KEYS[2], ARGV[4], ARGV[1])
+return 1
+`)
+
+// renewScript renews the lease of the running pipeline.
+// Returns 0 if the pipeline isn't running.
+// ARGV: pipelineId, now, lease expiration time.
+var renewScript = redis.NewScript(runQueueFunctions + `
+purge(tonumber(ARGV[2]))
+if redis.call('HEXISTS', KEYS[3], ARGV[1]) == 0 then
+  return 0
+end
+redis.call('ZADD', KEYS[2], ARGV[3], ARGV[1])
+return 1
+`)
+
+// releaseScript removes the pipeline from the queue and frees its slot.
+// ARGV: pipelineId, now.
+var releaseScript = redis.NewScript(runQueueFunctions + `
+purge(tonumber(ARGV[2]))
+leave(ARGV[1])
+return 1
+`)
+
+// RunQueue is the Redis implementation of cache.RunQueue.
+// The queue is shared by all backend instances of the SDK using the same Redis cache, so the cluster-wide
+// concurrency limit is enforced across instances. Each operation is a Lua script, so it's atomic.
+// Leases of the pipelines are renewed by the instance processing them, and pipelines whose lease expired
+// are released by the next operation.
+type RunQueue struct {
+	client        *redis.Client
+	keys          []string
+	instanceId    string
+	instanceLimit int
+	clusterLimit  int
+}
+
+// NewRunQueue returns Redis implementation of cache.RunQueue for the pipelines of the sdk.
+// The instance with instanceId processes at most instanceLimit pipelines at the same time, and all instances
+// process at most clusterLimit pipelines at the same time. clusterLimit 0 means no cluster-wide limit.
+func NewRunQueue(client *redis.Client, sdk, instanceId string, instanceLimit, clusterLimit int) *RunQueue {
+	prefix := runQueueKeyPrefix + sdk + ":"
+	return &RunQueue{
+		client: client,
+		keys: []string{
+			prefix + "queued",
+			prefix + "leases",
+			prefix + "running",
+			prefix + "owners",
+			prefix + "clients",
+			prefix + "waiting",
+			prefix + "instances",
+			prefix + "limits",
+		},
+		instanceId:    instanceId,
+		instanceLimit: instanceLimit,
+		clusterLimit:  clusterLimit,
+	}
+}
+
+// Enqueue adds the pipeline of the client to the end of its round in the queue.
+func (rq *RunQueue) Enqueue(ctx context.Context, pipelineId uuid.UUID, clientId string) error {
+	now := time.Now()
+	if err := enqueueScript.Run(ctx, rq.client, rq.keys, pipelineId.String(), clientId, rq.instanceId, now.UnixMilli(), leaseExpiration(now), roundScore).Err(); err != nil {
+		logger.Errorf("Redis Cache: enqueue: error during enqueue script for key: %s, err: %s\n", pipelineId, err.Error())
+		return err
+	}
+	return nil
+}
+
+// TryAcquire reports whether the pipeline is admitted to be processed, and renews its lease.
+func (rq *RunQueue) TryAcquire(ctx context.Context, pipelineId uuid.UUID) (bool, error) {
+	now := time.Now()
+	result, err := acquireScript.Run(ctx, rq.client, rq.keys, pipelineId.String(), rq.instanceId, now.UnixMilli(), leaseExpiration(now), rq.instanceLimit, rq.clusterLimit).Int()
+	if err != nil {
+		logger.Errorf("Redis Cache: try acquire: error during acquire script for key: %s, err: %s\n", pipelineId, err.Error())
+		return false, err
+	}
+	if result < 0 {
+		return false, cache.ErrNotQueued
+	}
+	return result == 1, nil
+}
+
+// Renew renews the lease of the admitted pipeline.
+func (rq *RunQueue) Renew(ctx context.Context, pipelineId uuid.UUID) error {
+	now := time.Now()
+	result, err := renewScript.Run(ctx, rq.client, rq.keys, pipelineId.String(), now.UnixMilli(), leaseExpiration(now)).Int()
+	if err != nil {
+		logger.Errorf("Redis Cache: renew: error during renew script for key: %s, err: %s\n", pipelineId, err.Error())
+		return err
+	}
+	if result == 0 {
+		return cache.ErrNotQueued
+	}
+	return nil
+}
+
+// Release removes the pipeline from the queue and frees its slot if it was admitted.
+func (rq *RunQueue) Release(ctx context.Context, pipelineId uuid.UUID) error {
+	if err := releaseScript.Run(ctx, rq.client, rq.keys, pipelineId.String(), time.Now().UnixMilli()).Err(); err != nil {
+		logger.Errorf("Redis Cache: release: error during release script for key: %s, err: %s\n", pipelineId, err.Error())
+		return err
+	}
+	return nil
+}
+
+func leaseExpiration(now time.Time) int64 {
+	return now.Add(cache.RunLeaseDuration).UnixMilli()
+}
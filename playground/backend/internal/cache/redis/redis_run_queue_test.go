@@ -0,0 +1,178 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+
+	"beam.apache.org/playground/backend/internal/cache"
+)
+
+// newTestRunQueues returns run queues of the instances sharing a Redis server, which runs the Lua scripts.
+func newTestRunQueues(t *testing.T, instanceLimit, clusterLimit int, instanceIds ...string) (*miniredis.Miniredis, []*RunQueue) {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+	var queues []*RunQueue
+	for _, instanceId := range instanceIds {
+		queues = append(queues, NewRunQueue(client, "SDK_JAVA", instanceId, instanceLimit, clusterLimit))
+	}
+	return server, queues
+}
+
+func enqueue(t *testing.T, rq *RunQueue, clientId string) uuid.UUID {
+	pipelineId := uuid.New()
+	if err := rq.Enqueue(context.Background(), pipelineId, clientId); err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+	return pipelineId
+}
+
+func tryAcquire(t *testing.T, rq *RunQueue, pipelineId uuid.UUID) bool {
+	admitted, err := rq.TryAcquire(context.Background(), pipelineId)
+	if err != nil {
+		t.Fatalf("TryAcquire() error = %v", err)
+	}
+	return admitted
+}
+
+func TestRunQueue_InstanceLimit(t *testing.T) {
+	_, queues := newTestRunQueues(t, 1, 0, "instance_1", "instance_2")
+	first := enqueue(t, queues[0], "client_1")
+	second := enqueue(t, queues[0], "client_2")
+	other := enqueue(t, queues[1], "client_3")
+
+	if tryAcquire(t, queues[0], second) {
+		t.Errorf("TryAcquire() admitted a pipeline before an earlier pipeline of the instance")
+	}
+	if !tryAcquire(t, queues[0], first) {
+		t.Errorf("TryAcquire() didn't admit the first pipeline")
+	}
+	if tryAcquire(t, queues[0], second) {
+		t.Errorf("TryAcquire() admitted a pipeline over the instance limit")
+	}
+	if !tryAcquire(t, queues[1], other) {
+		t.Errorf("TryAcquire() didn't admit a pipeline of another instance")
+	}
+	if err := queues[0].Release(context.Background(), first); err != nil {
+		t.Fatalf("Release() error = %v", err)
+	}
+	if !tryAcquire(t, queues[0], second) {
+		t.Errorf("TryAcquire() didn't admit a pipeline after release")
+	}
+}
+
+func TestRunQueue_ClusterLimit(t *testing.T) {
+	_, queues := newTestRunQueues(t, 10, 1, "instance_1", "instance_2")
+	first := enqueue(t, queues[0], "client_1")
+	second := enqueue(t, queues[1], "client_2")
+
+	if tryAcquire(t, queues[1], second) {
+		t.Errorf("TryAcquire() admitted a pipeline before an earlier pipeline of the cluster")
+	}
+	if !tryAcquire(t, queues[0], first) {
+		t.Errorf("TryAcquire() didn't admit the first pipeline")
+	}
+	if tryAcquire(t, queues[1], second) {
+		t.Errorf("TryAcquire() admitted a pipeline over the cluster limit")
+	}
+	if err := queues[0].Release(context.Background(), first); err != nil {
+		t.Fatalf("Release() error = %v", err)
+	}
+	if !tryAcquire(t, queues[1], second) {
+		t.Errorf("TryAcquire() didn't admit a pipeline after release")
+	}
+}
+
+func TestRunQueue_ClusterLimitSkipsFullInstance(t *testing.T) {
+	_, queues := newTestRunQueues(t, 1, 2, "instance_1", "instance_2")
+	running := enqueue(t, queues[0], "client_1")
+	if !tryAcquire(t, queues[0], running) {
+		t.Fatalf("TryAcquire() didn't admit the first pipeline")
+	}
+	blocked := enqueue(t, queues[0], "client_2")
+	other := enqueue(t, queues[1], "client_3")
+
+	if tryAcquire(t, queues[0], blocked) {
+		t.Errorf("TryAcquire() admitted a pipeline over the instance limit")
+	}
+	if !tryAcquire(t, queues[1], other) {
+		t.Errorf("TryAcquire() didn't admit a pipeline behind a pipeline of a full instance")
+	}
+}
+
+func TestRunQueue_FairOrder(t *testing.T) {
+	_, queues := newTestRunQueues(t, 1, 0, "instance_1")
+	rq := queues[0]
+	busy := []uuid.UUID{enqueue(t, rq, "busy_client"), enqueue(t, rq, "busy_client"), enqueue(t, rq, "busy_client")}
+	other := enqueue(t, rq, "other_client")
+
+	// Rounds: busy[0] and other are in the first round, the rest of the busy client's pipelines follow.
+	want := []uuid.UUID{busy[0], other, busy[1], busy[2]}
+	for i, pipelineId := range want {
+		for _, waiting := range want[i+1:] {
+			if tryAcquire(t, rq, waiting) {
+				t.Fatalf("TryAcquire() admitted pipeline %d out of order", i)
+			}
+		}
+		if !tryAcquire(t, rq, pipelineId) {
+			t.Fatalf("TryAcquire() didn't admit pipeline %d", i)
+		}
+		if err := rq.Release(context.Background(), pipelineId); err != nil {
+			t.Fatalf("Release() error = %v", err)
+		}
+	}
+}
+
+func TestRunQueue_ExpiredLease(t *testing.T) {
+	server, queues := newTestRunQueues(t, 1, 1, "instance_1", "instance_2")
+	crashed := enqueue(t, queues[0], "client_1")
+	if !tryAcquire(t, queues[0], crashed) {
+		t.Fatalf("TryAcquire() didn't admit the first pipeline")
+	}
+	waiting := enqueue(t, queues[1], "client_2")
+	if tryAcquire(t, queues[1], waiting) {
+		t.Fatalf("TryAcquire() admitted a pipeline over the cluster limit")
+	}
+
+	// The lease of the pipeline of the crashed instance expires, while the waiting pipeline keeps renewing its lease.
+	leases := runQueueKeyPrefix + "SDK_JAVA:leases"
+	if _, err := server.ZAdd(leases, 0, crashed.String()); err != nil {
+		t.Fatalf("ZAdd() error = %v", err)
+	}
+	if !tryAcquire(t, queues[1], waiting) {
+		t.Errorf("TryAcquire() didn't admit a pipeline after the lease of the running pipeline expired")
+	}
+	if err := queues[0].Renew(context.Background(), crashed); !errors.Is(err, cache.ErrNotQueued) {
+		t.Errorf("Renew() error = %v, want %v", err, cache.ErrNotQueued)
+	}
+	if err := queues[1].Renew(context.Background(), waiting); err != nil {
+		t.Errorf("Renew() error = %v", err)
+	}
+}
+
+func TestRunQueue_TryAcquireNotQueued(t *testing.T) {
+	_, queues := newTestRunQueues(t, 1, 0, "instance_1")
+	if _, err := queues[0].TryAcquire(context.Background(), uuid.New()); !errors.Is(err, cache.ErrNotQueued) {
+		t.Errorf("TryAcquire() error = %v, want %v", err, cache.ErrNotQueued)
+	}
+}
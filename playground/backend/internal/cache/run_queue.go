@@ -0,0 +1,53 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RunLeaseDuration is how long a pipeline keeps its place in RunQueue without renewal.
+// Places of pipelines whose instance stopped renewing them are released, so that the runs
+// of a crashed instance don't block the queue.
+const RunLeaseDuration = 30 * time.Second
+
+// ErrNotQueued is returned by RunQueue when the pipeline is neither queued nor running,
+// e.g. because its lease expired.
+var ErrNotQueued = errors.New("pipeline is not queued")
+
+// RunQueue is a queue of pipelines waiting to be processed.
+// It limits the number of pipelines processed at the same time and admits the waiting pipelines
+// in a fair order: the pipelines of clients with fewer waiting pipelines go first, so a single client
+// submitting many pipelines can't starve the others.
+type RunQueue interface {
+	// Enqueue adds the pipeline of the client to the end of its round in the queue.
+	Enqueue(ctx context.Context, pipelineId uuid.UUID, clientId string) error
+
+	// TryAcquire reports whether the pipeline is admitted to be processed.
+	// The pipeline is admitted if it's its turn and the concurrency limits allow it,
+	// otherwise it stays in the queue and its lease is renewed.
+	TryAcquire(ctx context.Context, pipelineId uuid.UUID) (bool, error)
+
+	// Renew renews the lease of the admitted pipeline while it's being processed.
+	Renew(ctx context.Context, pipelineId uuid.UUID) error
+
+	// Release removes the pipeline from the queue and frees its slot if it was admitted.
+	Release(ctx context.Context, pipelineId uuid.UUID) error
+}
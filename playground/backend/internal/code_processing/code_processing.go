@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	pb "beam.apache.org/playground/backend/internal/api/v1"
 	"beam.apache.org/playground/backend/internal/cache"
@@ -36,6 +37,7 @@ import (
 	"beam.apache.org/playground/backend/internal/executors"
 	"beam.apache.org/playground/backend/internal/fs_tool"
 	"beam.apache.org/playground/backend/internal/logger"
+	"beam.apache.org/playground/backend/internal/sandbox"
 	"beam.apache.org/playground/backend/internal/setup_tools/builder"
 	"beam.apache.org/playground/backend/internal/streaming"
 	"beam.apache.org/playground/backend/internal/utils"
@@ -55,6 +57,7 @@ const (
 // - In case of compile step is completed with no errors saves compile output as cache.CompileOutput into cache.
 // - In case of run step is failed saves playground.Status_STATUS_RUN_ERROR as cache.Status and run logs as cache.RunError into cache.
 // - In case of run step is completed with no errors saves playground.Status_STATUS_FINISHED as cache.Status and run output as cache.RunOutput into cache.
+// - In case of run step is completed saves the final metrics of the pipeline as cache.Metrics into cache.
 // At the end of this method deletes all created folders.
 func Process(ctx context.Context, cacheService cache.Cache, lc *fs_tool.LifeCycle, pipelineId uuid.UUID, appEnv *environment.ApplicationEnvs, sdkEnv *environment.BeamEnvs, pipelineOptions string) {
 	pipelineLifeCycleCtx, finishCtxFunc := context.WithTimeout(ctx, appEnv.PipelineExecuteTimeout())
@@ -108,7 +111,7 @@ func Process(ctx context.Context, cacheService cache.Cache, lc *fs_tool.LifeCycl
 	}
 
 	// Run/RunTest
-	err = runStep(pipelineLifeCycleCtx, cacheService, &lc.Paths, pipelineId, isUnitTest, sdkEnv, pipelineOptions)
+	err = runStep(pipelineLifeCycleCtx, cacheService, &lc.Paths, pipelineId, isUnitTest, sdkEnv, pipelineOptions, appEnv.SandboxEnvs(), lc.GetEmulatorAddresses())
 	if err != nil {
 		var pipelineCanceledError perrors.PipelineCanceledError
 		if errors.As(err, &pipelineCanceledError) {
@@ -120,7 +123,97 @@ func Process(ctx context.Context, cacheService cache.Cache, lc *fs_tool.LifeCycl
 	}
 }
 
-func runStep(ctx context.Context, cacheService cache.Cache, paths *fs_tool.LifeCyclePaths, pipelineId uuid.UUID, isUnitTest bool, sdkEnv *environment.BeamEnvs, pipelineOptions string) error {
+// ProcessQueued waits until the pipeline is admitted by runQueue and processes it by Process.
+// The pipeline should be enqueued with playground.Status_STATUS_QUEUED as cache.Status beforehand.
+// - In case of the pipeline isn't admitted during the queue timeout saves playground.Status_STATUS_RUN_TIMEOUT as cache.Status into cache.
+// - In case of code processing has been canceled while waiting saves playground.Status_STATUS_CANCELED as cache.Status into cache.
+// - In case of the pipeline is admitted saves playground.Status_STATUS_VALIDATING as cache.Status into cache and renews
+// the lease of the pipeline until it's processed.
+// At the end of this method releases the pipeline's place in runQueue.
+func ProcessQueued(ctx context.Context, cacheService cache.Cache, runQueue cache.RunQueue, lc *fs_tool.LifeCycle, pipelineId uuid.UUID, appEnv *environment.ApplicationEnvs, sdkEnv *environment.BeamEnvs, pipelineOptions string) {
+	defer func() {
+		// Use background context to release the place even if ctx is done.
+		if err := runQueue.Release(context.Background(), pipelineId); err != nil {
+			logger.Errorf("%s: error during releasing the run queue: %s", pipelineId, err.Error())
+		}
+	}()
+
+	admitted, err := waitForAdmission(ctx, cacheService, runQueue, pipelineId, appEnv.QueueEnvs().QueueTimeout())
+	if err != nil {
+		logger.Errorf("%s: error during waiting in the run queue: %s", pipelineId, err.Error())
+		_ = utils.SetToCache(cacheService, pipelineId, cache.Status, pb.Status_STATUS_ERROR)
+	}
+	if !admitted {
+		DeleteResources(pipelineId, lc)
+		return
+	}
+
+	leaseCtx, stopRenewing := context.WithCancel(ctx)
+	defer stopRenewing()
+	go renewLease(leaseCtx, runQueue, pipelineId)
+
+	if err := utils.SetToCache(cacheService, pipelineId, cache.Status, pb.Status_STATUS_VALIDATING); err != nil {
+		DeleteResources(pipelineId, lc)
+		return
+	}
+	Process(ctx, cacheService, lc, pipelineId, appEnv, sdkEnv, pipelineOptions)
+}
+
+// waitForAdmission tries to acquire a place for the pipeline in runQueue each pauseDuration until the pipeline is admitted.
+// Returns false if the pipeline was canceled or timed out while waiting, and saves the corresponding status into cache.
+// Failed attempts to acquire a place are retried until the timeout, since the queue is shared with other instances
+// which may be processing the pipelines before this one.
+func waitForAdmission(ctx context.Context, cacheService cache.Cache, runQueue cache.RunQueue, pipelineId uuid.UUID, timeout time.Duration) (bool, error) {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ticker := time.NewTicker(pauseDuration)
+	defer ticker.Stop()
+	for {
+		admitted, err := runQueue.TryAcquire(ctxWithTimeout, pipelineId)
+		if errors.Is(err, cache.ErrNotQueued) {
+			return false, err
+		}
+		if err != nil {
+			logger.Warnf("%s: error during acquiring a place in the run queue: %s", pipelineId, err.Error())
+		} else if admitted {
+			return true, nil
+		}
+
+		select {
+		case <-ctxWithTimeout.Done():
+			if ctx.Err() != nil {
+				return false, ctx.Err()
+			}
+			return false, finishByTimeout(pipelineId, cacheService)
+		case <-ticker.C:
+		}
+
+		canceled, err := cacheService.GetValue(ctx, pipelineId, cache.Canceled)
+		if err != nil {
+			logger.Errorf("%s: Error during getting value from the cache: %s", pipelineId, err.Error())
+		} else if canceled != nil && canceled.(bool) {
+			return false, processCancel(cacheService, pipelineId)
+		}
+	}
+}
+
+// renewLease renews the lease of the admitted pipeline in runQueue until ctx is done.
+func renewLease(ctx context.Context, runQueue cache.RunQueue, pipelineId uuid.UUID) {
+	ticker := time.NewTicker(cache.RunLeaseDuration / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := runQueue.Renew(ctx, pipelineId); err != nil {
+				logger.Warnf("%s: error during renewing the lease in the run queue: %s", pipelineId, err.Error())
+			}
+		}
+	}
+}
+
+func runStep(ctx context.Context, cacheService cache.Cache, paths *fs_tool.LifeCyclePaths, pipelineId uuid.UUID, isUnitTest bool, sdkEnv *environment.BeamEnvs, pipelineOptions string, sandboxEnvs *environment.SandboxEnvs, emulatorAddresses []string) error {
 	errorChannel, successChannel := createStatusChannels()
 	stopReadLogsChannel := make(chan bool, 1)
 	finishReadLogsChannel := make(chan bool, 1)
@@ -138,6 +231,13 @@ func runStep(ctx context.Context, cacheService cache.Cache, paths *fs_tool.LifeC
 		}
 		return err
 	}
+	runSandbox, err := sandbox.New(sandboxEnvs, pipelineId, paths.AbsoluteBaseFolderPath, emulatorAddresses)
+	if err != nil {
+		if processingErr := processSetupError(err, pipelineId, cacheService); processingErr != nil {
+			return processingErr
+		}
+		return err
+	}
 
 	executor := executorBuilder.Build()
 	logger.Infof("%s: Run()/Test() ...\n", pipelineId)
@@ -153,14 +253,14 @@ func runStep(ctx context.Context, cacheService cache.Cache, paths *fs_tool.LifeC
 		if err != nil {
 			// If some error with creating a log file do the same as with other SDK.
 			logger.Errorf("%s: error during create log file (go sdk): %s", pipelineId, err.Error())
-			runCmdWithOutput(runCmd, &runOutput, &runError, successChannel, errorChannel)
+			runCmdInSandbox(runSandbox, runCmd, &runOutput, &runError, successChannel, errorChannel)
 		} else {
 			// Use the log file to write all stdErr into it.
-			runCmdWithOutput(runCmd, &runOutput, file, successChannel, errorChannel)
+			runCmdInSandbox(runSandbox, runCmd, &runOutput, file, successChannel, errorChannel)
 		}
 	} else {
 		// Other SDKs write logs to the log file on their own.
-		runCmdWithOutput(runCmd, &runOutput, &runError, successChannel, errorChannel)
+		runCmdInSandbox(runSandbox, runCmd, &runOutput, &runError, successChannel, errorChannel)
 	}
 
 	// Start of the monitoring of background tasks (run step/cancellation/timeout)
@@ -168,6 +268,8 @@ func runStep(ctx context.Context, cacheService cache.Cache, paths *fs_tool.LifeC
 	if err != nil {
 		return err
 	}
+	// The pipeline reports its metrics when it finishes, even if it finishes with an error
+	saveMetrics(cacheService, paths.AbsoluteMetricsFilePath, pipelineId)
 	if !ok {
 		// If unit test has some error then error output is placed as RunOutput
 		if isUnitTest {
@@ -186,7 +288,7 @@ func runStep(ctx context.Context, cacheService cache.Cache, paths *fs_tool.LifeC
 			}
 			runError.Write(errData)
 		}
-		processingErr := processRunError(errorChannel, runError.Bytes(), pipelineId, cacheService, stopReadLogsChannel, finishReadLogsChannel)
+		processingErr := processRunError(errorChannel, runError.Bytes(), runSandbox.TerminationReason(), pipelineId, cacheService, stopReadLogsChannel, finishReadLogsChannel)
 		if processingErr != nil {
 			return processingErr
 		}
@@ -207,7 +309,7 @@ func compileStep(ctx context.Context, cacheService cache.Cache, paths *fs_tool.L
 		if err := processCompileSuccess([]byte(""), pipelineId, cacheService); err != nil {
 			return err
 		}
-	} else { // in case of Java, Go (not unit test), Scala - need compile step
+	} else { // in case of Java, Go (not unit test), TypeScript - need compile step
 		executorBuilder, err := builder.Compiler(paths, sdkEnv)
 		if err != nil {
 			logger.Errorf("compileStep(): failed creating ExecutorBuilder = %v", executorBuilder)
@@ -406,6 +508,31 @@ func GetGraph(ctx context.Context, cacheService cache.Cache, key uuid.UUID, erro
 	return stringValue, nil
 }
 
+// GetRunMetrics gets the final metrics of the pipeline from cache by key.
+// In case key doesn't exist in cache - returns an errors.NotFoundError.
+// In case value from cache by key couldn't be converted to pb.GetRunMetricsResponse - returns an errors.InternalError.
+func GetRunMetrics(ctx context.Context, cacheService cache.Cache, key uuid.UUID, errorTitle string) (*pb.GetRunMetricsResponse, error) {
+	value, err := cacheService.GetValue(ctx, key, cache.Metrics)
+	if err != nil {
+		logger.Errorf("%s: GetRunMetrics(): cache.GetValue: error: %s", key, err.Error())
+		return nil, perrors.NotFoundError(errorTitle, "Error during getting metrics")
+	}
+	stringValue, converted := value.(string)
+	if !converted {
+		logger.Errorf("%s: couldn't convert value to string. value: %s type %s", key, value, reflect.TypeOf(value))
+		return nil, perrors.InternalError(errorTitle, "Error during getting metrics")
+	}
+	metrics := new(pb.GetRunMetricsResponse)
+	if stringValue == "" {
+		return metrics, nil
+	}
+	if err := protojson.Unmarshal([]byte(stringValue), metrics); err != nil {
+		logger.Errorf("%s: GetRunMetrics(): error during unmarshal metrics: %s", key, err.Error())
+		return nil, perrors.InternalError(errorTitle, "Error during getting metrics")
+	}
+	return metrics, nil
+}
+
 // runCmdWithOutput runs command with keeping stdOut and stdErr
 func runCmdWithOutput(cmd *exec.Cmd, stdOutput io.Writer, stdError io.Writer, successChannel chan bool, errorChannel chan error) {
 	cmd.Stdout = stdOutput
@@ -421,6 +548,21 @@ func runCmdWithOutput(cmd *exec.Cmd, stdOutput io.Writer, stdError io.Writer, su
 	}(cmd, successChannel, errorChannel)
 }
 
+// runCmdInSandbox runs command inside the sandbox with keeping stdOut and stdErr
+func runCmdInSandbox(runSandbox sandbox.Sandbox, cmd *exec.Cmd, stdOutput io.Writer, stdError io.Writer, successChannel chan bool, errorChannel chan error) {
+	cmd.Stdout = stdOutput
+	cmd.Stderr = stdError
+	go func(cmd *exec.Cmd, successChannel chan bool, errChannel chan error) {
+		err := runSandbox.Run(cmd)
+		if err != nil {
+			errChannel <- err
+			successChannel <- false
+		} else {
+			successChannel <- true
+		}
+	}(cmd, successChannel, errorChannel)
+}
+
 // reconcileBackgroundTask waits when first background task finishes.
 // If finishes by canceling, timeout or context is done - returns error.
 // If cmd operation (Validate/Prepare/Compile/Run/RunTest) finishes successfully with no error
@@ -511,6 +653,29 @@ func readGraphFile(pipelineLifeCycleCtx context.Context, cacheService cache.Cach
 	}
 }
 
+// saveMetrics reads the final metrics of the pipeline from the file and keeps them to the cache.
+// If the pipeline didn't report metrics, keeps the empty metrics set after the compile step.
+func saveMetrics(cacheService cache.Cache, metricsFilePath string, pipelineId uuid.UUID) {
+	data, err := os.ReadFile(metricsFilePath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			logger.Errorf("%s: Error during reading metrics from the file: %s", pipelineId, err.Error())
+		}
+		return
+	}
+	metrics := new(pb.GetRunMetricsResponse)
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, metrics); err != nil {
+		logger.Errorf("%s: Error during parsing metrics from the file: %s", pipelineId, err.Error())
+		return
+	}
+	value, err := protojson.Marshal(metrics)
+	if err != nil {
+		logger.Errorf("%s: Error during marshaling metrics: %s", pipelineId, err.Error())
+		return
+	}
+	_ = utils.SetToCache(cacheService, pipelineId, cache.Metrics, string(value))
+}
+
 // readLogFile reads logs from the log file and keeps it to the cache.
 // If context is done it means that the code processing was finished (successfully/with error/timeout). Write last logs to the cache.
 // If <-stopReadLogsChannel it means that the code processing was finished (canceled/timeout)
@@ -602,12 +767,16 @@ func processErrorWithSavingOutput(err error, errorOutput []byte, pipelineId uuid
 }
 
 // processRunError processes error received during processing run step.
+// If the run was terminated by the sandbox, the reason of the termination is added to the error.
 // This method sets error output to the cache and after that sets value to channel to stop goroutine which writes logs.
 //
 //	After receiving a signal that goroutine was finished (read value from finishReadLogsChannel) this method
 //	sets corresponding status to the cache.
-func processRunError(errorChannel chan error, errorOutput []byte, pipelineId uuid.UUID, cacheService cache.Cache, stopReadLogsChannel, finishReadLogsChannel chan bool) error {
+func processRunError(errorChannel chan error, errorOutput []byte, terminationReason string, pipelineId uuid.UUID, cacheService cache.Cache, stopReadLogsChannel, finishReadLogsChannel chan bool) error {
 	err := <-errorChannel
+	if terminationReason != "" {
+		err = fmt.Errorf("%s: %w", terminationReason, err)
+	}
 	logger.Errorf("%s: Run(): err: %s, output: %s\n", pipelineId, err.Error(), errorOutput)
 
 	if err := utils.SetToCache(cacheService, pipelineId, cache.RunError, fmt.Sprintf("error: %s\noutput: %s", err.Error(), string(errorOutput))); err != nil {
@@ -650,6 +819,9 @@ func processCompileSuccess(output []byte, pipelineId uuid.UUID, cacheService cac
 	if err := utils.SetToCache(cacheService, pipelineId, cache.Graph, ""); err != nil {
 		return err
 	}
+	if err := utils.SetToCache(cacheService, pipelineId, cache.Metrics, ""); err != nil {
+		return err
+	}
 	return utils.SetToCache(cacheService, pipelineId, cache.Status, pb.Status_STATUS_EXECUTING)
 }
 
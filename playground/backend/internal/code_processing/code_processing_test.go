@@ -33,6 +33,8 @@ import (
 	"github.com/go-redis/redismock/v8"
 	"github.com/google/uuid"
 	"go.uber.org/goleak"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 
 	pb "beam.apache.org/playground/backend/internal/api/v1"
 	"beam.apache.org/playground/backend/internal/cache"
@@ -1194,7 +1196,7 @@ func Test_runStep(t *testing.T) {
 				sources := []entity.FileEntity{{Name: "main.java", Content: tt.code, IsMain: true}}
 				_ = lc.CreateSourceCodeFiles(sources)
 			}
-			_ = runStep(tt.args.pipelineLifeCycleCtx, tt.args.cacheService, &lc.Paths, tt.args.pipelineId, tt.args.isUnitTest, tt.args.sdkEnv, tt.args.pipelineOptions)
+			_ = runStep(tt.args.pipelineLifeCycleCtx, tt.args.cacheService, &lc.Paths, tt.args.pipelineId, tt.args.isUnitTest, tt.args.sdkEnv, tt.args.pipelineOptions, nil, nil)
 			status, _ := cacheService.GetValue(tt.args.ctx, tt.args.pipelineId, cache.Status)
 			if status != tt.expectedStatus {
 				t.Errorf("runStep() got status = %v, want %v", status, tt.expectedStatus)
@@ -1285,6 +1287,69 @@ func TestGetGraph(t *testing.T) {
 	}
 }
 
+func TestGetRunMetrics(t *testing.T) {
+	ctx := context.Background()
+	metrics := &pb.GetRunMetricsResponse{
+		Counters: []*pb.CounterMetric{{Key: &pb.MetricKey{Step: "MOCK_STEP", Namespace: "MOCK_NAMESPACE", Name: "MOCK_NAME"}, Attempted: 1}},
+	}
+	metricsValue, _ := protojson.Marshal(metrics)
+	pipelineId1 := uuid.New()
+	if err := cacheService.SetValue(ctx, pipelineId1, cache.Metrics, string(metricsValue)); err != nil {
+		t.Fatal(err)
+	}
+	pipelineId2 := uuid.New()
+	if err := cacheService.SetValue(ctx, pipelineId2, cache.Metrics, ""); err != nil {
+		t.Fatal(err)
+	}
+	pipelineId3 := uuid.New()
+	if err := cacheService.SetValue(ctx, pipelineId3, cache.Metrics, 1); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		key     uuid.UUID
+		want    *pb.GetRunMetricsResponse
+		wantErr bool
+	}{
+		{
+			name:    "Get metrics when key exist in cache",
+			key:     pipelineId1,
+			want:    metrics,
+			wantErr: false,
+		},
+		{
+			name:    "Get metrics when the pipeline didn't report metrics",
+			key:     pipelineId2,
+			want:    &pb.GetRunMetricsResponse{},
+			wantErr: false,
+		},
+		{
+			name:    "Get metrics when key doesn't exist in cache",
+			key:     uuid.New(),
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "Get metrics when value from cache by key couldn't be converted to a string",
+			key:     pipelineId3,
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetRunMetrics(ctx, cacheService, tt.key, "error")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetRunMetrics error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("GetRunMetrics got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_processSetupError(t *testing.T) {
 	client, mock := redismock.NewClientMock()
 	pipelineId := uuid.New()
@@ -1418,13 +1483,41 @@ func Test_processRunError(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mocks()
-			if err := processRunError(tt.args.errorChannel, tt.args.errorOutput, tt.args.pipelineId, tt.args.cacheService, tt.args.stopReadLogsChannel, tt.args.finishReadLogsChannel); (err != nil) != tt.wantErr {
+			if err := processRunError(tt.args.errorChannel, tt.args.errorOutput, "", tt.args.pipelineId, tt.args.cacheService, tt.args.stopReadLogsChannel, tt.args.finishReadLogsChannel); (err != nil) != tt.wantErr {
 				t.Errorf("processRunError() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
 }
 
+func Test_processRunError_TerminationReason(t *testing.T) {
+	ctx := context.Background()
+	localCache := local.New(ctx)
+	pipelineId := uuid.New()
+	errorChannel := make(chan error, 1)
+	errorChannel <- fmt.Errorf("signal: killed")
+	stopReadLogsChannel := make(chan bool, 1)
+	finishReadLogsChannel := make(chan bool, 1)
+	finishReadLogsChannel <- true
+
+	terminationReason := "run was terminated: memory limit of 256 MB exceeded"
+	if err := processRunError(errorChannel, []byte("MOCK_OUTPUT"), terminationReason, pipelineId, localCache, stopReadLogsChannel, finishReadLogsChannel); err != nil {
+		t.Fatalf("processRunError() error = %v", err)
+	}
+	runError, err := localCache.GetValue(ctx, pipelineId, cache.RunError)
+	if err != nil {
+		t.Fatalf("processRunError() didn't save run error: %v", err)
+	}
+	want := "error: run was terminated: memory limit of 256 MB exceeded: signal: killed\noutput: MOCK_OUTPUT"
+	if runError != want {
+		t.Errorf("processRunError() run error = %v, want %v", runError, want)
+	}
+	status, _ := localCache.GetValue(ctx, pipelineId, cache.Status)
+	if status != pb.Status_STATUS_RUN_ERROR {
+		t.Errorf("processRunError() status = %v, want %v", status, pb.Status_STATUS_RUN_ERROR)
+	}
+}
+
 func Test_processCompileSuccess(t *testing.T) {
 	client, mock := redismock.NewClientMock()
 	pipelineId := uuid.New()
@@ -1434,6 +1527,7 @@ func Test_processCompileSuccess(t *testing.T) {
 	marshalCompileOutput, _ := json.Marshal(cache.CompileOutput)
 	marshalRunOutput, _ := json.Marshal(cache.RunOutput)
 	marshalRunError, _ := json.Marshal(cache.RunError)
+	marshalGraph, _ := json.Marshal(cache.Graph)
 	outputMarshal, _ := json.Marshal(output)
 	marshalEmptyString, _ := json.Marshal("")
 	type args struct {
@@ -1518,6 +1612,23 @@ func Test_processCompileSuccess(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Error during set value to Metrics subKey",
+			mocks: func() {
+				mock.ExpectHSet(pipelineId.String(), marshalCompileOutput, outputMarshal).SetVal(1)
+				mock.ExpectHSet(pipelineId.String(), marshalRunOutput, marshalEmptyString).SetVal(1)
+				mock.ExpectHSet(pipelineId.String(), marshalRunError, marshalEmptyString).SetVal(1)
+				mock.ExpectHSet(pipelineId.String(), marshalLogs, marshalEmptyString).SetVal(1)
+				mock.ExpectHSet(pipelineId.String(), marshalGraph, marshalEmptyString).SetVal(1)
+			},
+			args: args{
+				ctx:          context.Background(),
+				output:       []byte(output),
+				pipelineId:   pipelineId,
+				cacheService: cacheMock,
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1564,6 +1675,60 @@ func Test_readGraphFile(t *testing.T) {
 	}
 }
 
+func Test_saveMetrics(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	metricsFilePath := filepath.Join(dir, utils.MetricsFileName)
+	metricsFile := `{"counters": [{"key": {"step": "MOCK_STEP", "namespace": "MOCK_NAMESPACE", "name": "MOCK_NAME"}, "attempted": 2}], "gauges": [{"key": {"name": "MOCK_GAUGE"}, "attempted": {"value": 3, "timestamp": 1000}}]}`
+	if err := os.WriteFile(metricsFilePath, []byte(metricsFile), 0600); err != nil {
+		t.Fatal(err)
+	}
+	incorrectMetricsFilePath := filepath.Join(dir, "incorrect_"+utils.MetricsFileName)
+	if err := os.WriteFile(incorrectMetricsFilePath, []byte("MOCK_METRICS"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name            string
+		metricsFilePath string
+		want            *pb.GetRunMetricsResponse
+	}{
+		{
+			name:            "Successfully saving the metrics to the cache",
+			metricsFilePath: metricsFilePath,
+			want: &pb.GetRunMetricsResponse{
+				Counters: []*pb.CounterMetric{{Key: &pb.MetricKey{Step: "MOCK_STEP", Namespace: "MOCK_NAMESPACE", Name: "MOCK_NAME"}, Attempted: 2}},
+				Gauges:   []*pb.GaugeMetric{{Key: &pb.MetricKey{Name: "MOCK_GAUGE"}, Attempted: &pb.GaugeValue{Value: 3, Timestamp: 1000}}},
+			},
+		},
+		{
+			name:            "Keeping the empty metrics when the metrics file doesn't exist",
+			metricsFilePath: filepath.Join(dir, "MOCK_FILE"),
+			want:            &pb.GetRunMetricsResponse{},
+		},
+		{
+			name:            "Keeping the empty metrics when the metrics file is incorrect",
+			metricsFilePath: incorrectMetricsFilePath,
+			want:            &pb.GetRunMetricsResponse{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipelineId := uuid.New()
+			if err := cacheService.SetValue(ctx, pipelineId, cache.Metrics, ""); err != nil {
+				t.Fatal(err)
+			}
+			saveMetrics(cacheService, tt.metricsFilePath, pipelineId)
+			got, err := GetRunMetrics(ctx, cacheService, pipelineId, "error")
+			if err != nil {
+				t.Fatalf("GetRunMetrics() error = %v", err)
+			}
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("saveMetrics() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_cancelCheck(t *testing.T) {
 	type args struct {
 		timeout        time.Duration
@@ -1630,3 +1795,64 @@ func Test_cancelCheck(t *testing.T) {
 		})
 	}
 }
+
+func Test_waitForAdmission(t *testing.T) {
+	tests := []struct {
+		name         string
+		timeout      time.Duration
+		setCancel    bool
+		releaseFirst bool
+		wantAdmitted bool
+		wantStatus   interface{}
+	}{
+		{
+			name:         "Admitting the pipeline once the running pipeline is released",
+			timeout:      time.Minute,
+			releaseFirst: true,
+			wantAdmitted: true,
+			wantStatus:   pb.Status_STATUS_QUEUED,
+		},
+		{
+			name:         "Canceling the pipeline while it's waiting",
+			timeout:      time.Minute,
+			setCancel:    true,
+			wantAdmitted: false,
+			wantStatus:   pb.Status_STATUS_CANCELED,
+		},
+		{
+			name:         "Timing out the pipeline while it's waiting",
+			timeout:      2 * pauseDuration,
+			wantAdmitted: false,
+			wantStatus:   pb.Status_STATUS_RUN_TIMEOUT,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			localCache := local.New(ctx)
+			runQueue := local.NewRunQueue(1)
+			running, waiting := uuid.New(), uuid.New()
+			_ = runQueue.Enqueue(ctx, running, "client")
+			if admitted, _ := runQueue.TryAcquire(ctx, running); !admitted {
+				t.Fatalf("the first pipeline isn't admitted")
+			}
+			_ = localCache.SetValue(ctx, waiting, cache.Status, pb.Status_STATUS_QUEUED)
+			_ = localCache.SetValue(ctx, waiting, cache.Canceled, tt.setCancel)
+			_ = runQueue.Enqueue(ctx, waiting, "client")
+			if tt.releaseFirst {
+				time.AfterFunc(pauseDuration, func() { _ = runQueue.Release(ctx, running) })
+			}
+
+			admitted, err := waitForAdmission(ctx, localCache, runQueue, waiting, tt.timeout)
+			if err != nil {
+				t.Fatalf("waitForAdmission() error = %v", err)
+			}
+			if admitted != tt.wantAdmitted {
+				t.Errorf("waitForAdmission() = %v, want %v", admitted, tt.wantAdmitted)
+			}
+			if status, _ := localCache.GetValue(ctx, waiting, cache.Status); status != tt.wantStatus {
+				t.Errorf("status = %v, want %v", status, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -20,3 +20,11 @@ const (
 	TopicNameKey       = "topic"
 	BootstrapServerKey = "bootstrapServer"
 )
+
+// Emulator parameters for the preparers
+const (
+	PubSubEmulatorHostKey = "pubsubEmulatorHost"
+	GCSEndpointKey        = "gcsEndpoint"
+	BucketsDirKey         = "bucketsDir"
+	DatabasePathKey       = "databasePath"
+)
@@ -174,6 +174,31 @@ func (d *Datastore) GetSnippet(ctx context.Context, id string) (*entity.SnippetE
 	return snip, nil
 }
 
+// GetSnippetRevision returns the snippet entity by identifier without updating its last visited time and visit count
+func (d *Datastore) GetSnippetRevision(ctx context.Context, id string) (*entity.SnippetEntity, error) {
+	key := utils.GetSnippetKey(ctx, id)
+	snip := new(entity.SnippetEntity)
+	if err := d.Client.Get(ctx, key, snip); err != nil {
+		logger.Errorf("Datastore: GetSnippetRevision(): error during snippet getting, err: %s\n", err.Error())
+		return nil, err
+	}
+	snip.Key = key
+	return snip, nil
+}
+
+// GetSnippetChildren returns the snippet entities which are the next revisions of the snippet with the identifier
+func (d *Datastore) GetSnippetChildren(ctx context.Context, parentId string) ([]*entity.SnippetEntity, error) {
+	snippetQuery := datastore.NewQuery(constants.SnippetKind).
+		Namespace(utils.GetNamespace(ctx)).
+		FilterField("parentId", "=", parentId)
+	var snippets []*entity.SnippetEntity
+	if _, err := d.Client.GetAll(ctx, snippetQuery, &snippets); err != nil {
+		logger.Errorf("Datastore: GetSnippetChildren(): error during snippets getting, err: %s\n", err.Error())
+		return nil, err
+	}
+	return snippets, nil
+}
+
 func (d *Datastore) IncrementSnippetVisitorsCount(ctx context.Context, id string) error {
 	key := utils.GetSnippetKey(ctx, id)
 	snip := new(entity.SnippetEntity)
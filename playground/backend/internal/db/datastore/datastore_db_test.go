@@ -174,6 +174,94 @@ func TestDatastore_GetSnippet(t *testing.T) {
 	}
 }
 
+func TestDatastore_GetSnippetRevision(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	tests := []struct {
+		name      string
+		prepare   func()
+		args      args
+		wantErr   bool
+		cleanData func()
+	}{
+		{
+			name:      "GetSnippetRevision() with id that is no in the database",
+			prepare:   func() {},
+			args:      args{ctx: ctx, id: "MOCK_ID"},
+			wantErr:   true,
+			cleanData: func() {},
+		},
+		{
+			name: "GetSnippetRevision() in the usual case",
+			prepare: func() {
+				snip := makeMockSnippet(constants.UserSnippetOrigin, "")
+				snip.Snippet.ParentId = "MOCK_PARENT_ID"
+				snip.Snippet.Revision = 2
+				snip.Snippet.AuthorToken = "MOCK_AUTHOR_TOKEN"
+				_ = datastoreDb.PutSnippet(ctx, "MOCK_ID", snip)
+			},
+			args:    args{ctx: ctx, id: "MOCK_ID"},
+			wantErr: false,
+			cleanData: func() {
+				test_cleaner.CleanFiles(ctx, t, "MOCK_ID", 1)
+				test_cleaner.CleanSnippet(ctx, t, "MOCK_ID")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.prepare()
+			snip, err := datastoreDb.GetSnippetRevision(tt.args.ctx, tt.args.id)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetSnippetRevision() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err == nil {
+				if snip.Key.Name != "MOCK_ID" ||
+					snip.ParentId != "MOCK_PARENT_ID" ||
+					snip.Revision != 2 ||
+					snip.AuthorToken != "MOCK_AUTHOR_TOKEN" ||
+					snip.VisitCount != 0 {
+					t.Error("GetSnippetRevision() unexpected result")
+				}
+			}
+			tt.cleanData()
+		})
+	}
+}
+
+func TestDatastore_GetSnippetChildren(t *testing.T) {
+	parent := makeMockSnippet(constants.UserSnippetOrigin, "")
+	if err := datastoreDb.PutSnippet(ctx, "MOCK_PARENT_ID", parent); err != nil {
+		t.Fatalf("PutSnippet() error = %v", err)
+	}
+	defer test_cleaner.CleanSnippet(ctx, t, "MOCK_PARENT_ID")
+	defer test_cleaner.CleanFiles(ctx, t, "MOCK_PARENT_ID", 1)
+	child := makeMockSnippet(constants.UserSnippetOrigin, "")
+	child.Snippet.ParentId = "MOCK_PARENT_ID"
+	child.Snippet.Revision = 2
+	if err := datastoreDb.PutSnippet(ctx, "MOCK_CHILD_ID", child); err != nil {
+		t.Fatalf("PutSnippet() error = %v", err)
+	}
+	defer test_cleaner.CleanSnippet(ctx, t, "MOCK_CHILD_ID")
+	defer test_cleaner.CleanFiles(ctx, t, "MOCK_CHILD_ID", 1)
+
+	children, err := datastoreDb.GetSnippetChildren(ctx, "MOCK_PARENT_ID")
+	if err != nil {
+		t.Fatalf("GetSnippetChildren() error = %v", err)
+	}
+	if len(children) != 1 || children[0].Key.Name != "MOCK_CHILD_ID" || children[0].Revision != 2 {
+		t.Errorf("GetSnippetChildren() unexpected result")
+	}
+	children, err = datastoreDb.GetSnippetChildren(ctx, "MOCK_CHILD_ID")
+	if err != nil || len(children) != 0 {
+		t.Errorf("GetSnippetChildren() of the last revision = %v, %v, want no children", children, err)
+	}
+}
+
 func TestDatastore_SnippetWithPersistenceCode(t *testing.T) {
 	snip := makeMockSnippet(constants.TbUserSnippetOrigin, "MOCK_PERSISTENCE_CODE")
 	t.Log("PutSnippet, insert 1st version")
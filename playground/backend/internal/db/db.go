@@ -36,6 +36,10 @@ type SnippetDatabase interface {
 
 	GetSnippet(ctx context.Context, id string) (*entity.SnippetEntity, error)
 
+	GetSnippetRevision(ctx context.Context, id string) (*entity.SnippetEntity, error)
+
+	GetSnippetChildren(ctx context.Context, parentId string) ([]*entity.SnippetEntity, error)
+
 	GetFiles(ctx context.Context, snipId string, numberOfFiles int) ([]*entity.FileEntity, error)
 
 	DeleteUnusedSnippets(ctx context.Context, retentionPeriod time.Duration) error
@@ -20,6 +20,7 @@ import (
 	"encoding/base64"
 	"io"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -55,6 +56,23 @@ type SnippetEntity struct {
 	Complexity     string                 `datastore:"complexity"`
 	PersistenceKey string                 `datastore:"persistenceKey,omitempty"`
 	Datasets       []*DatasetNestedEntity `datastore:"datasets,omitempty"`
+	ParentId       string                 `datastore:"parentId,omitempty"`
+	Revision       int                    `datastore:"revision"`
+	AuthorToken    string                 `datastore:"authorToken,omitempty,noindex"`
+}
+
+// GetRevision returns the revision of the snippet.
+// Snippets saved before revisions were introduced are considered the first revisions.
+func (s *SnippetEntity) GetRevision() int {
+	if s.Revision < 1 {
+		return 1
+	}
+	return s.Revision
+}
+
+// NextRevision returns the revision of the snippet which is derived from this one
+func (s *SnippetEntity) NextRevision() int {
+	return s.GetRevision() + 1
 }
 
 type DatasetEntity struct {
@@ -97,6 +115,12 @@ func combineUniqueSnippetContent(snippet *Snippet) string {
 	contentBuilder.WriteString(strings.TrimSpace(snippet.Snippet.PipeOpts))
 	contentBuilder.WriteString(strings.TrimSpace(snippet.Snippet.Complexity))
 	contentBuilder.WriteString(snippet.Snippet.PersistenceKey)
+	// The first revisions keep the IDs they had before revisions were introduced
+	if snippet.Snippet.ParentId != "" {
+		contentBuilder.WriteString(snippet.Snippet.ParentId)
+		contentBuilder.WriteString(strconv.Itoa(snippet.Snippet.Revision))
+		contentBuilder.WriteString(snippet.Snippet.AuthorToken)
+	}
 
 	return contentBuilder.String()
 }
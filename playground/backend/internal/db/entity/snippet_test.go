@@ -73,3 +73,78 @@ func TestSnippet_ID(t *testing.T) {
 		})
 	}
 }
+
+func TestSnippet_IDOfRevision(t *testing.T) {
+	sdkKey := datastore.NameKey(constants.SdkKind, pb.Sdk_SDK_GO.String(), nil)
+	sdkKey.Namespace = constants.Namespace
+	newSnippet := func(parentId string, revision int, authorToken string) *Snippet {
+		return &Snippet{
+			Snippet: &SnippetEntity{
+				Sdk:         sdkKey,
+				PipeOpts:    "MOCK_OPTIONS",
+				ParentId:    parentId,
+				Revision:    revision,
+				AuthorToken: authorToken,
+			},
+			Files: []*FileEntity{{
+				Name:    "MOCK_NAME",
+				Content: "MOCK_CONTENT",
+				IsMain:  false,
+			}},
+			IDMeta: &IDMeta{
+				IdLength: 11,
+				Salt:     "MOCK_SALT",
+			},
+		}
+	}
+	ids := map[string]string{}
+	for name, snip := range map[string]*Snippet{
+		"first revision":                    newSnippet("", 1, ""),
+		"second revision":                   newSnippet("HLCGZQmHrRy", 2, ""),
+		"second revision of another author": newSnippet("HLCGZQmHrRy", 2, "MOCK_AUTHOR_TOKEN"),
+	} {
+		id, err := snip.ID()
+		if err != nil {
+			t.Fatalf("ID() of the %s error = %v", name, err)
+		}
+		if other, ok := ids[id]; ok {
+			t.Errorf("ID() of the %s is the same as the ID of the %s", name, other)
+		}
+		ids[id] = name
+	}
+	if ids["HLCGZQmHrRy"] != "first revision" {
+		t.Errorf("ID() of the first revision differs from the ID of the snippet without revisions")
+	}
+}
+
+func TestSnippetEntity_NextRevision(t *testing.T) {
+	tests := []struct {
+		name     string
+		revision int
+		want     int
+	}{
+		{
+			name:     "Next revision of the snippet saved before revisions were introduced",
+			revision: 0,
+			want:     2,
+		},
+		{
+			name:     "Next revision of the first revision",
+			revision: 1,
+			want:     2,
+		},
+		{
+			name:     "Next revision of the later revision",
+			revision: 5,
+			want:     6,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snippet := &SnippetEntity{Revision: tt.revision}
+			if got := snippet.NextRevision(); got != tt.want {
+				t.Errorf("NextRevision() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
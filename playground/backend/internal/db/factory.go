@@ -0,0 +1,51 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"beam.apache.org/playground/backend/internal/db/datastore"
+	"beam.apache.org/playground/backend/internal/db/mapper"
+	"beam.apache.org/playground/backend/internal/db/sqldb"
+	"beam.apache.org/playground/backend/internal/external_functions"
+)
+
+// DatastoreType is the database type of Cloud Datastore
+const DatastoreType = "datastore"
+
+// New returns the database of the type: DatastoreType, sqldb.PostgresDriver or sqldb.SQLiteDriver.
+// SQL databases are connected by the data source name, and Datastore by the Google Cloud project identifier.
+// Unknown database types are reported as configuration errors.
+func New(ctx context.Context, dbType, dataSourceName, projectId string, externalFunctions external_functions.ExternalFunctions) (Database, error) {
+	switch dbType {
+	case sqldb.PostgresDriver, sqldb.SQLiteDriver:
+		sqlDb, err := sqldb.New(ctx, mapper.NewPrecompiledObjectMapper(), dbType, dataSourceName)
+		if err != nil {
+			return nil, err
+		}
+		return sqlDb, nil
+	case DatastoreType:
+		datastoreDb, err := datastore.New(ctx, mapper.NewPrecompiledObjectMapper(), externalFunctions, projectId)
+		if err != nil {
+			return nil, err
+		}
+		return datastoreDb, nil
+	default:
+		return nil, fmt.Errorf("unknown database type %q, should be %s, %s or %s", dbType, DatastoreType, sqldb.PostgresDriver, sqldb.SQLiteDriver)
+	}
+}
@@ -0,0 +1,55 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"beam.apache.org/playground/backend/internal/db/sqldb"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		dbType  string
+		wantErr bool
+	}{
+		{
+			name:   "SQLite database",
+			dbType: sqldb.SQLiteDriver,
+		},
+		{
+			name:    "Unknown database type",
+			dbType:  "mysql",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := New(context.Background(), tt.dbType, ":memory:", "", nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && got != nil {
+				t.Errorf("New() = %v, want nil", got)
+			}
+			if !tt.wantErr && got == nil {
+				t.Errorf("New() = nil, want database")
+			}
+		})
+	}
+}
@@ -56,6 +56,9 @@ func (m *DatastoreMapper) ToSnippet(info *pb.SaveSnippetRequest) *entity.Snippet
 			NumberOfFiles:  len(info.Files),
 			Complexity:     info.Complexity.String(),
 			PersistenceKey: info.PersistenceKey,
+			ParentId:       info.ParentId,
+			Revision:       1,
+			AuthorToken:    info.AuthorToken,
 		},
 	}
 	return &snippet
@@ -31,7 +31,7 @@ var testable *DatastoreMapper
 var datastoreMapperCtx = context.Background()
 
 func TestMain(m *testing.M) {
-	appEnv := environment.NewApplicationEnvs("/app", "", "", "", "", "../../../.", "", "", "", "", "", nil, 0, 0)
+	appEnv := environment.NewApplicationEnvs("/app", "", "", "", "", "../../../.", "", "", "", "", "", nil, nil, nil, nil, 0, 0)
 	appEnv.SetSchemaVersion(1)
 	props, _ := environment.NewProperties(appEnv.PropertyPath())
 	testable = NewDatastoreMapper(datastoreMapperCtx, appEnv, props)
@@ -65,6 +65,7 @@ func TestEntityMapper_ToSnippet(t *testing.T) {
 					Origin:        constants.UserSnippetOrigin,
 					NumberOfFiles: 1,
 					Complexity:    pb.Complexity_COMPLEXITY_MEDIUM.String(),
+					Revision:      1,
 				},
 				Files: []*entity.FileEntity{
 					{
@@ -76,6 +77,34 @@ func TestEntityMapper_ToSnippet(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Snippet mapper of the next revision of a snippet",
+			input: &pb.SaveSnippetRequest{
+				Files:           []*pb.SnippetFile{{Name: "MOCK_NAME", Content: "MOCK_CONTENT"}},
+				Sdk:             pb.Sdk_SDK_JAVA,
+				PipelineOptions: "MOCK_OPTIONS",
+				Complexity:      pb.Complexity_COMPLEXITY_MEDIUM,
+				ParentId:        "MOCK_PARENT_ID",
+				AuthorToken:     "MOCK_AUTHOR_TOKEN",
+			},
+			expected: &entity.Snippet{
+				IDMeta: &entity.IDMeta{
+					Salt:     "Beam playground salt",
+					IdLength: 11,
+				},
+				Snippet: &entity.SnippetEntity{
+					SchVer:        utils.GetSchemaVerKey(datastoreMapperCtx, 1),
+					Sdk:           utils.GetSdkKey(datastoreMapperCtx, pb.Sdk_SDK_JAVA.String()),
+					PipeOpts:      "MOCK_OPTIONS",
+					Origin:        constants.UserSnippetOrigin,
+					NumberOfFiles: 1,
+					Complexity:    pb.Complexity_COMPLEXITY_MEDIUM.String(),
+					ParentId:      "MOCK_PARENT_ID",
+					Revision:      1,
+					AuthorToken:   "MOCK_AUTHOR_TOKEN",
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -86,7 +115,10 @@ func TestEntityMapper_ToSnippet(t *testing.T) {
 				result.Snippet.PipeOpts != tt.expected.Snippet.PipeOpts ||
 				result.Snippet.NumberOfFiles != 1 ||
 				result.Snippet.Origin != constants.UserSnippetOrigin ||
-				result.Snippet.Complexity != tt.expected.Snippet.Complexity {
+				result.Snippet.Complexity != tt.expected.Snippet.Complexity ||
+				result.Snippet.ParentId != tt.expected.Snippet.ParentId ||
+				result.Snippet.Revision != tt.expected.Snippet.Revision ||
+				result.Snippet.AuthorToken != tt.expected.Snippet.AuthorToken {
 				t.Error("Unexpected result")
 			}
 		})
@@ -140,6 +140,19 @@ func TestPrecompiledObjectMapper_ToDefaultPrecompiledObjects(t *testing.T) {
 			CloudPath:       "SDK_SCIO_4_MOCK_DEFAULT_EXAMPLE",
 			Sdk:             pb.Sdk_SDK_SCIO,
 		},
+		pb.Sdk_SDK_TYPESCRIPT: {
+			DefaultExample:  true,
+			Complexity:      pb.Complexity_COMPLEXITY_MEDIUM,
+			Name:            "5_MOCK_DEFAULT_EXAMPLE",
+			Multifile:       false,
+			Type:            pb.PrecompiledObjectType_PRECOMPILED_OBJECT_TYPE_EXAMPLE,
+			ContextLine:     32,
+			Link:            "MOCK_PATH",
+			Description:     "MOCK_DESCR",
+			PipelineOptions: "MOCK_OPTIONS",
+			CloudPath:       "SDK_TYPESCRIPT_5_MOCK_DEFAULT_EXAMPLE",
+			Sdk:             pb.Sdk_SDK_TYPESCRIPT,
+		},
 	}
 	assert.Equal(t, expected, actualResult)
 }
@@ -19,6 +19,7 @@ import (
 	pb "beam.apache.org/playground/backend/internal/api/v1"
 	ds "beam.apache.org/playground/backend/internal/db/datastore"
 	"beam.apache.org/playground/backend/internal/db/entity"
+	"beam.apache.org/playground/backend/internal/db/sqldb"
 	"beam.apache.org/playground/backend/internal/utils"
 	"cloud.google.com/go/datastore"
 	"context"
@@ -39,10 +40,104 @@ func GetMigration_v001() ds.Migration {
 
 func (m migrationV001) Apply(ctx context.Context, tx *datastore.Transaction, sdkConfigPath string) error {
 	// Init sdks
+	sdkEntities, err := getSdkEntities(sdkConfigPath)
+	if err != nil {
+		return err
+	}
+	if err := ds.TxPutSDKs(ctx, tx, sdkEntities); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// sqlTablesV001 are the tables of the entities kept in Datastore, identified by the names of their Datastore keys
+var sqlTablesV001 = []string{
+	`CREATE TABLE sdks (
+		name TEXT PRIMARY KEY,
+		default_example TEXT NOT NULL
+	)`,
+	`CREATE TABLE snippets (
+		id TEXT PRIMARY KEY,
+		owner_id TEXT NOT NULL,
+		sdk TEXT NOT NULL,
+		pipe_opts TEXT NOT NULL,
+		created TIMESTAMP NOT NULL,
+		l_visited TIMESTAMP NOT NULL,
+		origin TEXT NOT NULL,
+		visit_count INTEGER NOT NULL,
+		sch_ver INTEGER NOT NULL,
+		number_of_files INTEGER NOT NULL,
+		complexity TEXT NOT NULL,
+		persistence_key TEXT NOT NULL
+	)`,
+	`CREATE INDEX snippets_persistence_key ON snippets (persistence_key)`,
+	`CREATE INDEX snippets_origin_l_visited ON snippets (origin, l_visited)`,
+	`CREATE TABLE files (
+		snippet_id TEXT NOT NULL,
+		idx INTEGER NOT NULL,
+		name TEXT NOT NULL,
+		content TEXT NOT NULL,
+		cntx_line INTEGER NOT NULL,
+		is_main BOOLEAN NOT NULL,
+		PRIMARY KEY (snippet_id, idx)
+	)`,
+	`CREATE TABLE datasets (
+		id TEXT PRIMARY KEY,
+		path TEXT NOT NULL
+	)`,
+	`CREATE TABLE snippet_datasets (
+		snippet_id TEXT NOT NULL,
+		idx INTEGER NOT NULL,
+		config TEXT NOT NULL,
+		dataset_id TEXT NOT NULL,
+		emulator TEXT NOT NULL,
+		PRIMARY KEY (snippet_id, idx)
+	)`,
+	`CREATE TABLE examples (
+		id TEXT PRIMARY KEY,
+		name TEXT NOT NULL,
+		sdk TEXT NOT NULL,
+		descr TEXT NOT NULL,
+		tags TEXT NOT NULL,
+		cats TEXT NOT NULL,
+		path TEXT NOT NULL,
+		type TEXT NOT NULL,
+		origin TEXT NOT NULL,
+		sch_ver INTEGER NOT NULL,
+		url_vcs TEXT NOT NULL,
+		url_notebook TEXT NOT NULL,
+		always_run BOOLEAN NOT NULL,
+		never_run BOOLEAN NOT NULL
+	)`,
+	`CREATE TABLE pc_objects (
+		example_id TEXT NOT NULL,
+		type TEXT NOT NULL,
+		content TEXT NOT NULL,
+		PRIMARY KEY (example_id, type)
+	)`,
+}
+
+// ApplySQL creates the tables of SQL databases, and initializes the SDKs as Apply does for Datastore
+func (m migrationV001) ApplySQL(ctx context.Context, tx *sqldb.Tx, sdkConfigPath string) error {
+	for _, table := range sqlTablesV001 {
+		if _, err := tx.ExecContext(ctx, table); err != nil {
+			return err
+		}
+	}
+	sdkEntities, err := getSdkEntities(sdkConfigPath)
+	if err != nil {
+		return err
+	}
+	return sqldb.TxPutSDKs(ctx, tx, sdkEntities)
+}
+
+// getSdkEntities returns the entities of all SDKs with the default examples from the sdk config
+func getSdkEntities(sdkConfigPath string) ([]*entity.SDKEntity, error) {
 	var sdkEntities []*entity.SDKEntity
 	sdkConfig := new(SdkConfig)
 	if err := utils.ReadYamlFile(sdkConfigPath, sdkConfig); err != nil {
-		return err
+		return nil, err
 	}
 	for _, sdk := range pb.Sdk_name {
 		if sdk == pb.Sdk_SDK_UNSPECIFIED.String() {
@@ -54,19 +149,16 @@ func (m migrationV001) Apply(ctx context.Context, tx *datastore.Transaction, sdk
 			DefaultExample: defaultExample,
 		})
 	}
-	if err := ds.TxPutSDKs(ctx, tx, sdkEntities); err != nil {
-		return err
-	}
-
-	return nil
+	return sdkEntities, nil
 }
 
 type SdkConfig struct {
 	Sdks struct {
-		Go     *SdkProperties `yaml:"SDK_GO"`
-		Java   *SdkProperties `yaml:"SDK_JAVA"`
-		Python *SdkProperties `yaml:"SDK_PYTHON"`
-		Scio   *SdkProperties `yaml:"SDK_SCIO"`
+		Go         *SdkProperties `yaml:"SDK_GO"`
+		Java       *SdkProperties `yaml:"SDK_JAVA"`
+		Python     *SdkProperties `yaml:"SDK_PYTHON"`
+		Scio       *SdkProperties `yaml:"SDK_SCIO"`
+		TypeScript *SdkProperties `yaml:"SDK_TYPESCRIPT"`
 	}
 }
 
@@ -75,16 +167,22 @@ type SdkProperties struct {
 }
 
 func getDefaultExample(config *SdkConfig, sdk string) string {
+	var properties *SdkProperties
 	switch sdk {
 	case pb.Sdk_SDK_JAVA.String():
-		return config.Sdks.Java.DefaultExample
+		properties = config.Sdks.Java
 	case pb.Sdk_SDK_GO.String():
-		return config.Sdks.Go.DefaultExample
+		properties = config.Sdks.Go
 	case pb.Sdk_SDK_PYTHON.String():
-		return config.Sdks.Python.DefaultExample
+		properties = config.Sdks.Python
 	case pb.Sdk_SDK_SCIO.String():
-		return config.Sdks.Scio.DefaultExample
-	default:
+		properties = config.Sdks.Scio
+	case pb.Sdk_SDK_TYPESCRIPT.String():
+		properties = config.Sdks.TypeScript
+	}
+	// configs written before an SDK was added don't contain it
+	if properties == nil {
 		return ""
 	}
+	return properties.DefaultExample
 }
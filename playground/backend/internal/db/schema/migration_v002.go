@@ -0,0 +1,69 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	ds "beam.apache.org/playground/backend/internal/db/datastore"
+	"beam.apache.org/playground/backend/internal/db/entity"
+	"beam.apache.org/playground/backend/internal/db/sqldb"
+	"beam.apache.org/playground/backend/internal/utils"
+	"cloud.google.com/go/datastore"
+	"context"
+)
+
+type migrationV002 struct {
+	ds.MigrationBase
+}
+
+func GetMigration_v002() ds.Migration {
+	return &migrationV002{
+		MigrationBase: ds.MigrationBase{
+			Version:     2,
+			Description: "Add the TypeScript SDK",
+		},
+	}
+}
+
+func (m migrationV002) Apply(ctx context.Context, tx *datastore.Transaction, sdkConfigPath string) error {
+	sdkEntities, err := getTypeScriptSdkEntities(sdkConfigPath)
+	if err != nil {
+		return err
+	}
+	return ds.TxPutSDKs(ctx, tx, sdkEntities)
+}
+
+// ApplySQL initializes the TypeScript SDK as Apply does for Datastore
+func (m migrationV002) ApplySQL(ctx context.Context, tx *sqldb.Tx, sdkConfigPath string) error {
+	sdkEntities, err := getTypeScriptSdkEntities(sdkConfigPath)
+	if err != nil {
+		return err
+	}
+	return sqldb.TxPutSDKs(ctx, tx, sdkEntities)
+}
+
+// getTypeScriptSdkEntities returns the entity of the TypeScript SDK with the default example from the sdk config
+func getTypeScriptSdkEntities(sdkConfigPath string) ([]*entity.SDKEntity, error) {
+	sdkConfig := new(SdkConfig)
+	if err := utils.ReadYamlFile(sdkConfigPath, sdkConfig); err != nil {
+		return nil, err
+	}
+	sdk := pb.Sdk_SDK_TYPESCRIPT.String()
+	return []*entity.SDKEntity{{
+		Name:           sdk,
+		DefaultExample: getDefaultExample(sdkConfig, sdk),
+	}}, nil
+}
@@ -0,0 +1,62 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"context"
+
+	"cloud.google.com/go/datastore"
+
+	ds "beam.apache.org/playground/backend/internal/db/datastore"
+	"beam.apache.org/playground/backend/internal/db/sqldb"
+)
+
+type migrationV003 struct {
+	ds.MigrationBase
+}
+
+func GetMigration_v003() ds.Migration {
+	return &migrationV003{
+		MigrationBase: ds.MigrationBase{
+			Version:     3,
+			Description: "Add the revisions of snippets",
+		},
+	}
+}
+
+// Apply does nothing: Datastore entities get the new properties when they are saved
+func (m migrationV003) Apply(ctx context.Context, tx *datastore.Transaction, sdkConfigPath string) error {
+	return nil
+}
+
+// sqlColumnsV003 are the columns of the snippet revisions, with the values of the snippets saved before,
+// and the index to find the next revisions of a snippet
+var sqlColumnsV003 = []string{
+	`ALTER TABLE snippets ADD COLUMN parent_id TEXT NOT NULL DEFAULT ''`,
+	`ALTER TABLE snippets ADD COLUMN revision INTEGER NOT NULL DEFAULT 0`,
+	`ALTER TABLE snippets ADD COLUMN author_token TEXT NOT NULL DEFAULT ''`,
+	`CREATE INDEX snippets_parent_id ON snippets (parent_id)`,
+}
+
+// ApplySQL adds the columns of the snippet revisions to SQL databases
+func (m migrationV003) ApplySQL(ctx context.Context, tx *sqldb.Tx, sdkConfigPath string) error {
+	for _, column := range sqlColumnsV003 {
+		if _, err := tx.ExecContext(ctx, column); err != nil {
+			return err
+		}
+	}
+	return nil
+}
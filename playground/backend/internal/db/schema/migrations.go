@@ -20,4 +20,6 @@ import ds "beam.apache.org/playground/backend/internal/db/datastore"
 // Migrations List of all migrations
 var Migrations = []ds.Migration{
 	GetMigration_v001(),
+	GetMigration_v002(),
+	GetMigration_v003(),
 }
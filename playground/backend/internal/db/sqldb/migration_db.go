@@ -0,0 +1,145 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	ds "beam.apache.org/playground/backend/internal/db/datastore"
+	"beam.apache.org/playground/backend/internal/db/entity"
+	"beam.apache.org/playground/backend/internal/logger"
+)
+
+// Migration is a migration of the schema, which can also be applied to SQL databases
+type Migration interface {
+	ds.Migration
+	ApplySQL(ctx context.Context, tx *Tx, sdkConfigPath string) error
+}
+
+// Tx is a transaction of the SQL database, whose queries take '?' placeholders
+type Tx struct {
+	tx     *sql.Tx
+	driver string
+}
+
+func (tx *Tx) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return tx.tx.ExecContext(ctx, rebind(tx.driver, query), args...)
+}
+
+func (tx *Tx) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return tx.tx.QueryContext(ctx, rebind(tx.driver, query), args...)
+}
+
+func (tx *Tx) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return tx.tx.QueryRowContext(ctx, rebind(tx.driver, query), args...)
+}
+
+// GetCurrentDbMigrationVersion returns the current version of the schema
+func (d *SQL) GetCurrentDbMigrationVersion(ctx context.Context) (int, error) {
+	var version int
+	err := d.QueryRowContext(ctx, "SELECT version FROM schema_versions ORDER BY version DESC LIMIT 1").Scan(&version)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			logger.Errorf("SQL: GetCurrentDbMigrationVersion(): no schema versions found\n")
+			return -1, errors.New("no schema versions found")
+		}
+		logger.Errorf("SQL: GetCurrentDbMigrationVersion(): error during getting current version, err: %s\n", err.Error())
+		return -1, err
+	}
+	return version, nil
+}
+
+// ApplyMigrations applies all migrations to the database.
+// The migrations must implement Migration to be applied to SQL databases.
+func (d *SQL) ApplyMigrations(ctx context.Context, migrations []ds.Migration, sdkConfigPath string) error {
+	if _, err := d.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS schema_versions (version INTEGER PRIMARY KEY, descr TEXT NOT NULL)"); err != nil {
+		logger.Errorf("SQL: ApplyMigrations(): error during creating the schema versions table, err: %s\n", err.Error())
+		return err
+	}
+	for _, migration := range migrations {
+		if applied, err := d.hasSchemaVersion(ctx, migration.GetVersion()); err != nil {
+			logger.Errorf("SQL: ApplyMigrations(): Error checking migration \"%d: %s\" : %s", migration.GetVersion(), migration.GetDescription(), err.Error())
+			return err
+		} else if applied {
+			logger.Infof("SQL: ApplyMigrations(): migration \"%d: %s\" already applied, skipping\n", migration.GetVersion(), migration.GetDescription())
+			continue
+		}
+
+		sqlMigration, ok := migration.(Migration)
+		if !ok {
+			logger.Errorf("SQL: ApplyMigrations(): migration \"%d: %s\" doesn't support SQL databases", migration.GetVersion(), migration.GetDescription())
+			return fmt.Errorf("migration \"%d: %s\" doesn't support SQL databases", migration.GetVersion(), migration.GetDescription())
+		}
+		if err := d.applyMigration(ctx, sqlMigration, sdkConfigPath); err != nil {
+			logger.Errorf("SQL: ApplyMigrations(): Error applying migration \"%d: %s\" : %s", migration.GetVersion(), migration.GetDescription(), err.Error())
+			return err
+		}
+	}
+	return nil
+}
+
+// hasSchemaVersion returns true if the schema version is applied
+func (d *SQL) hasSchemaVersion(ctx context.Context, version int) (bool, error) {
+	var count int
+	if err := d.QueryRowContext(ctx, "SELECT COUNT(*) FROM schema_versions WHERE version = ?", version).Scan(&count); err != nil {
+		logger.Errorf("SQL: hasSchemaVersion(): error during getting schema version, err: %s\n", err.Error())
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// applyMigration applies the given migration to the database, and records its version in the same transaction.
+func (d *SQL) applyMigration(ctx context.Context, migration Migration, sdkConfigPath string) error {
+	logger.Infof("SQL: applyMigration(): applying migration \"%d: %s\"\n", migration.GetVersion(), migration.GetDescription())
+	err := d.runInTransaction(ctx, func(tx *Tx) error {
+		if err := migration.ApplySQL(ctx, tx, sdkConfigPath); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, "INSERT INTO schema_versions (version, descr) VALUES (?, ?)", migration.GetVersion(), migration.GetDescription())
+		return err
+	})
+	if err != nil {
+		logger.Errorf("SQL: applyMigration(): error during migration \"%d: %s\" applying, err: %s\n",
+			migration.GetVersion(),
+			migration.GetDescription(),
+			err.Error())
+		return err
+	}
+	logger.Infof("SQL: applyMigration(): migration \"%d: %s\" applied successfully\n", migration.GetVersion(), migration.GetDescription())
+	return nil
+}
+
+// TxPutSDKs puts the SDK entities to the database in a transaction
+func TxPutSDKs(ctx context.Context, tx *Tx, sdks []*entity.SDKEntity) error {
+	if len(sdks) == 0 {
+		logger.Errorf("SQL: TxPutSDKs(): sdks are empty")
+		return nil
+	}
+	for _, sdk := range sdks {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM sdks WHERE name = ?", sdk.Name); err != nil {
+			logger.Errorf("SQL: TxPutSDKs(): error during entity saving, err: %s\n", err.Error())
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "INSERT INTO sdks (name, default_example) VALUES (?, ?)", sdk.Name, sdk.DefaultExample); err != nil {
+			logger.Errorf("SQL: TxPutSDKs(): error during entity saving, err: %s\n", err.Error())
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqldb
+
+import "testing"
+
+func Test_rebind(t *testing.T) {
+	tests := []struct {
+		name   string
+		driver string
+		query  string
+		want   string
+	}{
+		{
+			name:   "Postgres placeholders",
+			driver: PostgresDriver,
+			query:  "SELECT * FROM snippets WHERE id = ? AND origin = ?",
+			want:   "SELECT * FROM snippets WHERE id = $1 AND origin = $2",
+		},
+		{
+			name:   "SQLite placeholders",
+			driver: SQLiteDriver,
+			query:  "SELECT * FROM snippets WHERE id = ? AND origin = ?",
+			want:   "SELECT * FROM snippets WHERE id = ? AND origin = ?",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rebind(tt.driver, tt.query); got != tt.want {
+				t.Errorf("rebind() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,728 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/datastore"
+	_ "github.com/lib/pq"
+	_ "modernc.org/sqlite"
+
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/constants"
+	"beam.apache.org/playground/backend/internal/db/dto"
+	"beam.apache.org/playground/backend/internal/db/entity"
+	"beam.apache.org/playground/backend/internal/db/mapper"
+	"beam.apache.org/playground/backend/internal/logger"
+	"beam.apache.org/playground/backend/internal/utils"
+)
+
+const (
+	// PostgresDriver is the driver name of Postgres databases
+	PostgresDriver = "postgres"
+	// SQLiteDriver is the driver name of SQLite databases
+	SQLiteDriver = "sqlite"
+)
+
+// SQL is the implementation of db.Database using Postgres or SQLite, for self-hosted Playground instances.
+// Entities are identified by the same names as in Datastore, and are returned as the same entities,
+// so that their keys can be used by the rest of the application. Unlike Datastore, namespaces aren't supported:
+// each database keeps a single namespace.
+//
+// To be handled the same way as in Datastore, missing entities are reported as datastore.ErrNoSuchEntity.
+type SQL struct {
+	DB             *sql.DB
+	ResponseMapper mapper.ResponseMapper
+	driver         string
+}
+
+// querier is implemented by SQL and Tx to run queries with '?' placeholders.
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// New returns the SQL database of the driver, either PostgresDriver or SQLiteDriver,
+// connected by the driver-specific data source name.
+func New(ctx context.Context, responseMapper mapper.ResponseMapper, driver, dataSourceName string) (*SQL, error) {
+	if driver != PostgresDriver && driver != SQLiteDriver {
+		return nil, fmt.Errorf("unsupported SQL driver: %s", driver)
+	}
+	db, err := sql.Open(driver, dataSourceName)
+	if err != nil {
+		logger.Errorf("SQL: connection to database: error during opening, err: %s\n", err.Error())
+		return nil, err
+	}
+	if driver == SQLiteDriver {
+		// SQLite allows a single writer at a time, and each connection to ":memory:" opens a new database
+		db.SetMaxOpenConns(1)
+	}
+	if err = db.PingContext(ctx); err != nil {
+		logger.Errorf("SQL: connection to database: error during Ping operation, err: %s\n", err.Error())
+		_ = db.Close()
+		return nil, err
+	}
+	return &SQL{
+		DB:             db,
+		ResponseMapper: responseMapper,
+		driver:         driver,
+	}, nil
+}
+
+func (d *SQL) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return d.DB.ExecContext(ctx, rebind(d.driver, query), args...)
+}
+
+func (d *SQL) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return d.DB.QueryContext(ctx, rebind(d.driver, query), args...)
+}
+
+func (d *SQL) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return d.DB.QueryRowContext(ctx, rebind(d.driver, query), args...)
+}
+
+// runInTransaction runs f in a transaction, which is committed if f succeeds and rolled back otherwise.
+func (d *SQL) runInTransaction(ctx context.Context, f func(tx *Tx) error) error {
+	sqlTx, err := d.DB.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Errorf("SQL: error during creating transaction, err: %s\n", err.Error())
+		return err
+	}
+	if err = f(&Tx{tx: sqlTx, driver: d.driver}); err != nil {
+		if rollbackErr := sqlTx.Rollback(); rollbackErr != nil {
+			logger.Errorf("SQL: error during transaction rollback, err: %s\n", rollbackErr.Error())
+		}
+		return err
+	}
+	if err = sqlTx.Commit(); err != nil {
+		logger.Errorf("SQL: error during transaction commit, err: %s\n", err.Error())
+		return err
+	}
+	return nil
+}
+
+// PutSnippet puts the snippet with its files to the database, replacing the previous version of the snippet
+// with the same persistence key.
+func (d *SQL) PutSnippet(ctx context.Context, snipId string, snip *entity.Snippet) error {
+	if snip == nil {
+		logger.Errorf("SQL: PutSnippet(): snippet is nil")
+		return nil
+	}
+	logger.Debugf("putting snippet %q, persistent key %q...", snipId, snip.Snippet.PersistenceKey)
+	err := d.runInTransaction(ctx, func(tx *Tx) error {
+		if err := deleteSnippets(ctx, tx, "id = ?", snipId); err != nil {
+			return err
+		}
+		s := snip.Snippet
+		if _, err := tx.ExecContext(ctx, `INSERT INTO snippets
+			(id, owner_id, sdk, pipe_opts, created, l_visited, origin, visit_count, sch_ver, number_of_files, complexity, persistence_key,
+			parent_id, revision, author_token)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			snipId, s.OwnerId, keyName(s.Sdk), s.PipeOpts, s.Created.UTC(), s.LVisited.UTC(), s.Origin, s.VisitCount,
+			schemaVersion(s.SchVer), s.NumberOfFiles, s.Complexity, s.PersistenceKey, s.ParentId, s.Revision, s.AuthorToken); err != nil {
+			logger.Errorf("SQL: PutSnippet(): error during the snippet entity saving, err: %s\n", err.Error())
+			return err
+		}
+		for index, file := range snip.Files {
+			if _, err := tx.ExecContext(ctx, `INSERT INTO files (snippet_id, idx, name, content, cntx_line, is_main)
+				VALUES (?, ?, ?, ?, ?, ?)`,
+				snipId, index, file.Name, file.Content, file.CntxLine, file.IsMain); err != nil {
+				logger.Errorf("SQL: PutSnippet(): error during the file entity saving, err: %s\n", err.Error())
+				return err
+			}
+		}
+		for index, dataset := range s.Datasets {
+			if _, err := tx.ExecContext(ctx, `INSERT INTO snippet_datasets (snippet_id, idx, config, dataset_id, emulator)
+				VALUES (?, ?, ?, ?, ?)`,
+				snipId, index, dataset.Config, keyName(dataset.Dataset), dataset.Emulator); err != nil {
+				logger.Errorf("SQL: PutSnippet(): error during the dataset saving, err: %s\n", err.Error())
+				return err
+			}
+		}
+		// Delete the previous version of the snippet
+		if s.PersistenceKey == "" {
+			return nil
+		}
+		return deleteSnippets(ctx, tx, "persistence_key = ? AND id <> ?", s.PersistenceKey, snipId)
+	})
+	if err != nil {
+		logger.Errorf("SQL: PutSnippet(): error during commit, err: %s\n", err.Error())
+		return err
+	}
+	return nil
+}
+
+// GetSnippet returns the snippet entity by identifier, and updates its last visited time and visit count
+func (d *SQL) GetSnippet(ctx context.Context, id string) (*entity.SnippetEntity, error) {
+	snip, err := getSnippet(ctx, d, id)
+	if err != nil {
+		logger.Errorf("SQL: GetSnippet(): error during snippet getting, err: %s\n", err.Error())
+		return nil, err
+	}
+	logger.Infof("SQL: GetSnippet(): snippet %s has %d view count", id, snip.VisitCount)
+
+	if _, err = d.ExecContext(ctx, "UPDATE snippets SET l_visited = ?, visit_count = visit_count + 1 WHERE id = ?",
+		time.Now().UTC(), id); err != nil {
+		logger.Errorf("SQL: GetSnippet(): Can't increment snippet visit count, skipping view increment, err: %s\n", err.Error())
+	}
+	return snip, nil
+}
+
+// GetSnippetRevision returns the snippet entity by identifier without updating its last visited time and visit count
+func (d *SQL) GetSnippetRevision(ctx context.Context, id string) (*entity.SnippetEntity, error) {
+	snip, err := getSnippet(ctx, d, id)
+	if err != nil {
+		logger.Errorf("SQL: GetSnippetRevision(): error during snippet getting, err: %s\n", err.Error())
+		return nil, err
+	}
+	return snip, nil
+}
+
+// GetSnippetChildren returns the snippet entities which are the next revisions of the snippet with the identifier
+func (d *SQL) GetSnippetChildren(ctx context.Context, parentId string) ([]*entity.SnippetEntity, error) {
+	snippets, err := querySnippets(ctx, d, `SELECT `+snippetColumns+` FROM snippets s WHERE s.parent_id = ? ORDER BY s.created, s.id`, parentId)
+	if err != nil {
+		logger.Errorf("SQL: GetSnippetChildren(): error during snippets getting, err: %s\n", err.Error())
+		return nil, err
+	}
+	return snippets, nil
+}
+
+// GetFiles returns the file entities by a snippet identifier
+func (d *SQL) GetFiles(ctx context.Context, snipId string, numberOfFiles int) ([]*entity.FileEntity, error) {
+	if numberOfFiles == 0 {
+		logger.Errorf("The number of files must be more than zero")
+		return []*entity.FileEntity{}, nil
+	}
+	files, err := getFiles(ctx, d, snipId)
+	if err != nil {
+		logger.Errorf("SQL: GetFiles(): error during file getting, err: %s\n", err.Error())
+		return nil, err
+	}
+	if len(files) < numberOfFiles {
+		logger.Errorf("SQL: GetFiles(): snippet %s has %d files, expected %d\n", snipId, len(files), numberOfFiles)
+		return nil, datastore.ErrNoSuchEntity
+	}
+	return files[:numberOfFiles], nil
+}
+
+// DeleteUnusedSnippets deletes all unused snippets older than retentionPeriod
+func (d *SQL) DeleteUnusedSnippets(ctx context.Context, retentionPeriod time.Duration) error {
+	boundaryDate := time.Now().Add(-retentionPeriod).UTC()
+	err := d.runInTransaction(ctx, func(tx *Tx) error {
+		return deleteSnippets(ctx, tx, "l_visited <= ? AND origin = ?", boundaryDate, constants.UserSnippetOrigin)
+	})
+	if err != nil {
+		logger.Errorf("SQL: DeleteUnusedSnippets(): error deleting unused snippets, err: %s\n", err.Error())
+		return err
+	}
+	return nil
+}
+
+// GetSDKs returns sdk entities
+func (d *SQL) GetSDKs(ctx context.Context) ([]*entity.SDKEntity, error) {
+	rows, err := d.QueryContext(ctx, "SELECT name, default_example FROM sdks ORDER BY name")
+	if err != nil {
+		logger.Errorf("SQL: GetSDKs(): error during the getting sdks, err: %s\n", err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+	var sdks []*entity.SDKEntity
+	for rows.Next() {
+		sdk := new(entity.SDKEntity)
+		if err = rows.Scan(&sdk.Name, &sdk.DefaultExample); err != nil {
+			logger.Errorf("SQL: GetSDKs(): error during the getting sdks, err: %s\n", err.Error())
+			return nil, err
+		}
+		if _, ok := pb.Sdk_value[sdk.Name]; ok && sdk.Name != pb.Sdk_SDK_UNSPECIFIED.String() {
+			sdks = append(sdks, sdk)
+		}
+	}
+	return sdks, rows.Err()
+}
+
+// GetCatalog returns all examples
+func (d *SQL) GetCatalog(ctx context.Context, sdkCatalog []*entity.SDKEntity) ([]*pb.Categories, error) {
+	examples, snippets, err := getExamples(ctx, d, "e.origin = ?", constants.ExampleOrigin)
+	if err != nil {
+		logger.Errorf("SQL: GetCatalog(): error during the getting examples, err: %s\n", err.Error())
+		return nil, err
+	}
+
+	ids := make([]string, len(snippets))
+	for i, snippet := range snippets {
+		ids[i] = snippet.Key.Name
+	}
+	filesBySnippet, err := getFilesBySnippet(ctx, d, ids)
+	if err != nil {
+		logger.Errorf("SQL: GetCatalog(): error during the getting files, err: %s\n", err.Error())
+		return nil, err
+	}
+
+	// Files are ordered the same way as examples, and the mapper takes the number of files of each snippet in turn
+	var files []*entity.FileEntity
+	for _, snippet := range snippets {
+		snippetFiles := filesBySnippet[snippet.Key.Name]
+		if len(snippetFiles) < snippet.NumberOfFiles {
+			logger.Errorf("SQL: GetCatalog(): snippet %s has %d files, expected %d\n", snippet.Key.Name, len(snippetFiles), snippet.NumberOfFiles)
+			return nil, datastore.ErrNoSuchEntity
+		}
+		files = append(files, snippetFiles[:snippet.NumberOfFiles]...)
+	}
+
+	datasets, err := getDatasets(ctx, d)
+	if err != nil {
+		logger.Errorf("SQL: GetCatalog(): error during the getting datasets, err: %s\n", err.Error())
+		return nil, err
+	}
+	var datasetBySnippetIDMap map[string][]*dto.DatasetDTO
+	if len(datasets) != 0 {
+		datasetBySnippetIDMap, err = d.ResponseMapper.ToDatasetBySnippetIDMap(datasets, snippets)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return d.ResponseMapper.ToArrayCategories(&dto.CatalogDTO{
+		Examples:              examples,
+		Snippets:              snippets,
+		Files:                 files,
+		SdkCatalog:            sdkCatalog,
+		DatasetBySnippetIDMap: datasetBySnippetIDMap,
+	}), nil
+}
+
+// GetDefaultExamples returns the default examples
+func (d *SQL) GetDefaultExamples(ctx context.Context, sdks []*entity.SDKEntity) (map[pb.Sdk]*pb.PrecompiledObject, error) {
+	var examples []*entity.ExampleEntity
+	var snippets []*entity.SnippetEntity
+	var files []*entity.FileEntity
+	for _, sdk := range sdks {
+		id := utils.GetIDWithDelimiter(sdk.Name, sdk.DefaultExample)
+		sdkExamples, sdkSnippets, err := getExamples(ctx, d, "e.id = ?", id)
+		if err != nil {
+			logger.Errorf("SQL: GetDefaultExamples(): error during the getting examples, err: %s\n", err.Error())
+			return nil, err
+		}
+		if len(sdkExamples) == 0 {
+			logger.Warnf("SQL: GetDefaultExamples(): default example %s not found\n", id)
+			continue
+		}
+		exampleFiles, err := getFiles(ctx, d, id)
+		if err != nil {
+			logger.Errorf("SQL: GetDefaultExamples(): error during the getting files, err: %s\n", err.Error())
+			return nil, err
+		}
+		if len(exampleFiles) == 0 {
+			logger.Warnf("SQL: GetDefaultExamples(): default example %s has no files\n", id)
+			continue
+		}
+		examples = append(examples, sdkExamples[0])
+		snippets = append(snippets, sdkSnippets[0])
+		files = append(files, exampleFiles[0])
+	}
+
+	if len(examples) == 0 {
+		logger.Error("no default example")
+		return nil, fmt.Errorf("no default example")
+	}
+
+	return d.ResponseMapper.ToDefaultPrecompiledObjects(&dto.DefaultExamplesDTO{
+		Examples: examples,
+		Snippets: snippets,
+		Files:    files,
+	}), nil
+}
+
+// GetExample returns the example by identifier
+func (d *SQL) GetExample(ctx context.Context, id string, sdks []*entity.SDKEntity) (*pb.PrecompiledObject, error) {
+	examples, snippets, err := getExamples(ctx, d, "e.id = ?", id)
+	if err != nil {
+		logger.Errorf("error during getting example by identifier, err: %s", err.Error())
+		return nil, err
+	}
+	if len(examples) == 0 {
+		logger.Warnf("error during getting example by identifier, err: %s", datastore.ErrNoSuchEntity.Error())
+		return nil, datastore.ErrNoSuchEntity
+	}
+	example, snippet := examples[0], snippets[0]
+
+	files, err := getFiles(ctx, d, id)
+	if err != nil {
+		logger.Errorf("error during getting file by identifier, err: %s", err.Error())
+		return nil, err
+	}
+	if len(files) == 0 {
+		logger.Errorf("error during getting file by identifier, err: %s", datastore.ErrNoSuchEntity.Error())
+		return nil, datastore.ErrNoSuchEntity
+	}
+
+	sdkToExample := make(map[string]string)
+	for _, sdk := range sdks {
+		sdkToExample[sdk.Name] = sdk.DefaultExample
+	}
+
+	var datasetDTOs []*dto.DatasetDTO
+	if len(snippet.Datasets) != 0 {
+		datasets, err := getDatasets(ctx, d)
+		if err != nil {
+			logger.Errorf("error during getting dataset by identifier, err: %s", err.Error())
+			return nil, err
+		}
+		datasetBySnippetIDMap, err := d.ResponseMapper.ToDatasetBySnippetIDMap(datasets, []*entity.SnippetEntity{snippet})
+		if err != nil {
+			return nil, err
+		}
+		datasetDTOs = datasetBySnippetIDMap[snippet.Key.Name]
+	}
+
+	return d.ResponseMapper.ToPrecompiledObj(id, &dto.ExampleDTO{
+		Example:            example,
+		Snippet:            snippet,
+		Files:              files[:1],
+		DefaultExampleName: sdkToExample[example.Sdk.Name],
+		Datasets:           datasetDTOs,
+	}), nil
+}
+
+// GetExampleCode returns the files of the example by identifier
+func (d *SQL) GetExampleCode(ctx context.Context, id string) ([]*entity.FileEntity, error) {
+	snippet, err := getSnippet(ctx, d, id)
+	if err != nil {
+		logger.Errorf("error during getting snippet by identifier, err: %s", err.Error())
+		return nil, err
+	}
+	files, err := getFiles(ctx, d, id)
+	if err != nil {
+		logger.Errorf("error during getting files by identifier, err: %s", err.Error())
+		return nil, err
+	}
+	if len(files) > snippet.NumberOfFiles {
+		files = files[:snippet.NumberOfFiles]
+	}
+	return files, nil
+}
+
+// GetExampleOutput returns the precompiled output of the example by identifier
+func (d *SQL) GetExampleOutput(ctx context.Context, id string) (string, error) {
+	return d.getPCObject(ctx, id, constants.PCOutputType)
+}
+
+// GetExampleLogs returns the precompiled logs of the example by identifier
+func (d *SQL) GetExampleLogs(ctx context.Context, id string) (string, error) {
+	return d.getPCObject(ctx, id, constants.PCLogType)
+}
+
+// GetExampleGraph returns the precompiled graph of the example by identifier
+func (d *SQL) GetExampleGraph(ctx context.Context, id string) (string, error) {
+	return d.getPCObject(ctx, id, constants.PCGraphType)
+}
+
+func (d *SQL) getPCObject(ctx context.Context, id, pcType string) (string, error) {
+	var content string
+	err := d.QueryRowContext(ctx, "SELECT content FROM pc_objects WHERE example_id = ? AND type = ?", id, pcType).Scan(&content)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			logger.Warnf("error during getting example %s by identifier, err: %s", strings.ToLower(pcType), datastore.ErrNoSuchEntity.Error())
+			return "", datastore.ErrNoSuchEntity
+		}
+		logger.Errorf("error during getting example %s by identifier, err: %s", strings.ToLower(pcType), err.Error())
+		return "", err
+	}
+	return content, nil
+}
+
+// getSnippet returns the snippet entity with its datasets by identifier
+func getSnippet(ctx context.Context, q querier, id string) (*entity.SnippetEntity, error) {
+	snippets, err := querySnippets(ctx, q, `SELECT `+snippetColumns+` FROM snippets s WHERE s.id = ?`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(snippets) == 0 {
+		return nil, datastore.ErrNoSuchEntity
+	}
+	return snippets[0], nil
+}
+
+const snippetColumns = `s.id, s.owner_id, s.sdk, s.pipe_opts, s.created, s.l_visited, s.origin, s.visit_count, s.sch_ver,
+	s.number_of_files, s.complexity, s.persistence_key, s.parent_id, s.revision, s.author_token`
+
+const exampleColumns = `e.name, e.sdk, e.descr, e.tags, e.cats, e.path, e.type, e.origin, e.sch_ver, e.url_vcs,
+	e.url_notebook, e.always_run, e.never_run`
+
+// scanner is implemented by sql.Row and sql.Rows.
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanSnippet(ctx context.Context, row scanner, dest ...interface{}) (*entity.SnippetEntity, error) {
+	snippet := new(entity.SnippetEntity)
+	var id, sdk string
+	var schVer int
+	dest = append(dest, &id, &snippet.OwnerId, &sdk, &snippet.PipeOpts, &snippet.Created, &snippet.LVisited, &snippet.Origin,
+		&snippet.VisitCount, &schVer, &snippet.NumberOfFiles, &snippet.Complexity, &snippet.PersistenceKey,
+		&snippet.ParentId, &snippet.Revision, &snippet.AuthorToken)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	snippet.Key = utils.GetSnippetKey(ctx, id)
+	snippet.Sdk = utils.GetSdkKey(ctx, sdk)
+	snippet.SchVer = utils.GetSchemaVerKey(ctx, schVer)
+	return snippet, nil
+}
+
+// querySnippets returns the snippets selected by the query of snippetColumns, with their datasets
+func querySnippets(ctx context.Context, q querier, query string, args ...interface{}) ([]*entity.SnippetEntity, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var snippets []*entity.SnippetEntity
+	for rows.Next() {
+		snippet, err := scanSnippet(ctx, rows)
+		if err != nil {
+			return nil, err
+		}
+		snippets = append(snippets, snippet)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+	if err = setSnippetDatasets(ctx, q, snippets); err != nil {
+		return nil, err
+	}
+	return snippets, nil
+}
+
+// getExamples returns the examples matching the condition, ordered by identifier, with their snippets
+func getExamples(ctx context.Context, q querier, condition string, args ...interface{}) ([]*entity.ExampleEntity, []*entity.SnippetEntity, error) {
+	rows, err := q.QueryContext(ctx, `SELECT `+exampleColumns+`, `+snippetColumns+`
+		FROM examples e JOIN snippets s ON s.id = e.id WHERE `+condition+` ORDER BY e.id`, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+	var examples []*entity.ExampleEntity
+	var snippets []*entity.SnippetEntity
+	for rows.Next() {
+		example := new(entity.ExampleEntity)
+		var sdk, tags, cats string
+		var schVer int
+		snippet, err := scanSnippet(ctx, rows, &example.Name, &sdk, &example.Descr, &tags, &cats, &example.Path, &example.Type,
+			&example.Origin, &schVer, &example.UrlVCS, &example.UrlNotebook, &example.AlwaysRun, &example.NeverRun)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err = json.Unmarshal([]byte(tags), &example.Tags); err != nil {
+			return nil, nil, err
+		}
+		if err = json.Unmarshal([]byte(cats), &example.Cats); err != nil {
+			return nil, nil, err
+		}
+		example.Sdk = utils.GetSdkKey(ctx, sdk)
+		example.SchVer = utils.GetSchemaVerKey(ctx, schVer)
+		examples = append(examples, example)
+		snippets = append(snippets, snippet)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	rows.Close()
+	if err = setSnippetDatasets(ctx, q, snippets); err != nil {
+		return nil, nil, err
+	}
+	return examples, snippets, nil
+}
+
+// getFiles returns the files of the snippet, ordered by index
+func getFiles(ctx context.Context, q querier, snipId string) ([]*entity.FileEntity, error) {
+	rows, err := q.QueryContext(ctx, "SELECT name, content, cntx_line, is_main FROM files WHERE snippet_id = ? ORDER BY idx", snipId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var files []*entity.FileEntity
+	for rows.Next() {
+		file := new(entity.FileEntity)
+		if err = rows.Scan(&file.Name, &file.Content, &file.CntxLine, &file.IsMain); err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, rows.Err()
+}
+
+// maxInArgs is the maximum number of identifiers in a single IN condition, within the parameter limits of the drivers
+const maxInArgs = 500
+
+// queryIn runs the query for the identifiers in batches of maxInArgs, and calls scan for each row.
+// The query must have a single %s placeholder for the identifiers of its IN condition.
+func queryIn(ctx context.Context, q querier, query string, ids []string, scan func(rows *sql.Rows) error) error {
+	for start := 0; start < len(ids); start += maxInArgs {
+		end := start + maxInArgs
+		if end > len(ids) {
+			end = len(ids)
+		}
+		args := make([]interface{}, end-start)
+		for i, id := range ids[start:end] {
+			args[i] = id
+		}
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(args)), ", ")
+		if err := queryRows(ctx, q, fmt.Sprintf(query, placeholders), args, scan); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func queryRows(ctx context.Context, q querier, query string, args []interface{}, scan func(rows *sql.Rows) error) error {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if err = scan(rows); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// getFilesBySnippet returns the files of the snippets by snippet identifier, ordered by index
+func getFilesBySnippet(ctx context.Context, q querier, snipIds []string) (map[string][]*entity.FileEntity, error) {
+	files := make(map[string][]*entity.FileEntity)
+	err := queryIn(ctx, q, "SELECT snippet_id, name, content, cntx_line, is_main FROM files WHERE snippet_id IN (%s) ORDER BY snippet_id, idx",
+		snipIds, func(rows *sql.Rows) error {
+			file := new(entity.FileEntity)
+			var snipId string
+			if err := rows.Scan(&snipId, &file.Name, &file.Content, &file.CntxLine, &file.IsMain); err != nil {
+				return err
+			}
+			files[snipId] = append(files[snipId], file)
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// setSnippetDatasets sets the datasets of the snippets, ordered by index
+func setSnippetDatasets(ctx context.Context, q querier, snippets []*entity.SnippetEntity) error {
+	if len(snippets) == 0 {
+		return nil
+	}
+	ids := make([]string, len(snippets))
+	for i, snippet := range snippets {
+		ids[i] = snippet.Key.Name
+	}
+	datasets := make(map[string][]*entity.DatasetNestedEntity)
+	err := queryIn(ctx, q, "SELECT snippet_id, config, dataset_id, emulator FROM snippet_datasets WHERE snippet_id IN (%s) ORDER BY snippet_id, idx",
+		ids, func(rows *sql.Rows) error {
+			dataset := new(entity.DatasetNestedEntity)
+			var snipId, datasetId string
+			if err := rows.Scan(&snipId, &dataset.Config, &datasetId, &dataset.Emulator); err != nil {
+				return err
+			}
+			dataset.Dataset = utils.GetDatasetKey(ctx, datasetId)
+			datasets[snipId] = append(datasets[snipId], dataset)
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+	for _, snippet := range snippets {
+		snippet.Datasets = datasets[snippet.Key.Name]
+	}
+	return nil
+}
+
+func getDatasets(ctx context.Context, q querier) ([]*entity.DatasetEntity, error) {
+	rows, err := q.QueryContext(ctx, "SELECT id, path FROM datasets")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var datasets []*entity.DatasetEntity
+	for rows.Next() {
+		dataset := new(entity.DatasetEntity)
+		var id string
+		if err = rows.Scan(&id, &dataset.Path); err != nil {
+			return nil, err
+		}
+		dataset.Key = utils.GetDatasetKey(ctx, id)
+		datasets = append(datasets, dataset)
+	}
+	return datasets, rows.Err()
+}
+
+// deleteSnippets deletes the snippets matching the condition with their files and datasets
+func deleteSnippets(ctx context.Context, tx *Tx, condition string, args ...interface{}) error {
+	for _, table := range []string{"files", "snippet_datasets"} {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM `+table+` WHERE snippet_id IN (SELECT id FROM snippets WHERE `+condition+`)`, args...); err != nil {
+			logger.Errorf("SQL: deleteSnippets(): error during deleting from %s, err: %s\n", table, err.Error())
+			return err
+		}
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM snippets WHERE `+condition, args...); err != nil {
+		logger.Errorf("SQL: deleteSnippets(): error during deleting snippets, err: %s\n", err.Error())
+		return err
+	}
+	return nil
+}
+
+// keyName returns the name of the key, or an empty string for nil keys
+func keyName(key *datastore.Key) string {
+	if key == nil {
+		return ""
+	}
+	return key.Name
+}
+
+// schemaVersion returns the version of the schema version key
+func schemaVersion(key *datastore.Key) int {
+	version, _ := strconv.Atoi(keyName(key))
+	return version
+}
+
+// rebind replaces the '?' placeholders of the query with the numbered placeholders of Postgres
+func rebind(driver, query string) string {
+	if driver != PostgresDriver {
+		return query
+	}
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
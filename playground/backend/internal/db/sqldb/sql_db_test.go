@@ -0,0 +1,398 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqldb_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/datastore"
+	"github.com/stretchr/testify/assert"
+
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/constants"
+	"beam.apache.org/playground/backend/internal/db/entity"
+	"beam.apache.org/playground/backend/internal/db/mapper"
+	"beam.apache.org/playground/backend/internal/db/schema"
+	"beam.apache.org/playground/backend/internal/db/sqldb"
+	"beam.apache.org/playground/backend/internal/utils"
+)
+
+const sdkConfigPath = "../../../../sdks-emulator.yaml"
+
+var ctx = context.Background()
+
+// newSQLite returns an in-memory SQLite database with all migrations applied.
+func newSQLite(t *testing.T) *sqldb.SQL {
+	t.Helper()
+	d, err := sqldb.New(ctx, mapper.NewPrecompiledObjectMapper(), sqldb.SQLiteDriver, ":memory:")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	t.Cleanup(func() { _ = d.DB.Close() })
+	if err = d.ApplyMigrations(ctx, schema.Migrations, sdkConfigPath); err != nil {
+		t.Fatalf("ApplyMigrations() error = %v", err)
+	}
+	return d
+}
+
+func makeSnippet(origin, persistenceKey string, lVisited time.Time, numberOfFiles int) *entity.Snippet {
+	var files []*entity.FileEntity
+	for i := 0; i < numberOfFiles; i++ {
+		files = append(files, &entity.FileEntity{
+			Name:     fmt.Sprintf("%s_%d", "MOCK_NAME", i),
+			Content:  fmt.Sprintf("%s_%d", "MOCK_CONTENT", i),
+			CntxLine: 32,
+			IsMain:   i == 0,
+		})
+	}
+	return &entity.Snippet{
+		IDMeta: &entity.IDMeta{Salt: "MOCK_SALT", IdLength: 11},
+		Snippet: &entity.SnippetEntity{
+			Sdk:            utils.GetSdkKey(ctx, pb.Sdk_SDK_JAVA.String()),
+			PipeOpts:       "MOCK_OPTIONS",
+			Created:        lVisited,
+			LVisited:       lVisited,
+			Origin:         origin,
+			SchVer:         utils.GetSchemaVerKey(ctx, 1),
+			NumberOfFiles:  numberOfFiles,
+			Complexity:     pb.Complexity_COMPLEXITY_MEDIUM.String(),
+			PersistenceKey: persistenceKey,
+		},
+		Files: files,
+	}
+}
+
+// saveExample saves the example, its snippet with the given number of files and its precompiled objects.
+func saveExample(t *testing.T, d *sqldb.SQL, sdk, name, origin string, numberOfFiles int) string {
+	t.Helper()
+	id := utils.GetIDWithDelimiter(sdk, name)
+	snippet := makeSnippet(constants.ExampleOrigin, "", time.Now(), numberOfFiles)
+	snippet.Snippet.Sdk = utils.GetSdkKey(ctx, sdk)
+	if err := d.PutSnippet(ctx, id, snippet); err != nil {
+		t.Fatalf("PutSnippet() error = %v", err)
+	}
+	tags, _ := json.Marshal([]string{"MOCK_TAG"})
+	cats, _ := json.Marshal([]string{"MOCK_CATEGORY"})
+	if _, err := d.DB.ExecContext(ctx, `INSERT INTO examples
+		(id, name, sdk, descr, tags, cats, path, type, origin, sch_ver, url_vcs, url_notebook, always_run, never_run)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		id, name, sdk, "MOCK_DESCR", string(tags), string(cats), "MOCK_PATH",
+		pb.PrecompiledObjectType_PRECOMPILED_OBJECT_TYPE_EXAMPLE.String(), origin, 1, "MOCK_URL_VCS", "", false, true); err != nil {
+		t.Fatalf("saving example error = %v", err)
+	}
+	for _, pcType := range []string{constants.PCOutputType, constants.PCLogType, constants.PCGraphType} {
+		if _, err := d.DB.ExecContext(ctx, "INSERT INTO pc_objects (example_id, type, content) VALUES (?, ?, ?)",
+			id, pcType, "MOCK_CONTENT_"+pcType); err != nil {
+			t.Fatalf("saving precompiled object error = %v", err)
+		}
+	}
+	return id
+}
+
+func TestSQL_ApplyMigrations(t *testing.T) {
+	d := newSQLite(t)
+
+	version, err := d.GetCurrentDbMigrationVersion(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, version)
+
+	sdks, err := d.GetSDKs(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, sdks, len(pb.Sdk_name)-1)
+	for _, sdk := range sdks {
+		assert.Equal(t, "MOCK_DEFAULT_EXAMPLE", sdk.DefaultExample)
+	}
+
+	// Applied migrations are skipped
+	assert.NoError(t, d.ApplyMigrations(ctx, schema.Migrations, sdkConfigPath))
+}
+
+func TestSQL_GetCurrentDbMigrationVersion_NoVersions(t *testing.T) {
+	d, err := sqldb.New(ctx, mapper.NewPrecompiledObjectMapper(), sqldb.SQLiteDriver, ":memory:")
+	assert.NoError(t, err)
+	defer d.DB.Close()
+	assert.NoError(t, d.ApplyMigrations(ctx, nil, sdkConfigPath))
+
+	_, err = d.GetCurrentDbMigrationVersion(ctx)
+	assert.Error(t, err)
+}
+
+func TestSQL_PutSnippet(t *testing.T) {
+	d := newSQLite(t)
+	now := time.Now().Truncate(time.Second)
+	assert.NoError(t, d.PutSnippet(ctx, "MOCK_ID", makeSnippet(constants.UserSnippetOrigin, "", now, 2)))
+
+	snippet, err := d.GetSnippet(ctx, "MOCK_ID")
+	assert.NoError(t, err)
+	assert.Equal(t, "MOCK_ID", snippet.Key.Name)
+	assert.Equal(t, pb.Sdk_SDK_JAVA.String(), snippet.Sdk.Name)
+	assert.Equal(t, "MOCK_OPTIONS", snippet.PipeOpts)
+	assert.Equal(t, constants.UserSnippetOrigin, snippet.Origin)
+	assert.Equal(t, 2, snippet.NumberOfFiles)
+	assert.Equal(t, pb.Complexity_COMPLEXITY_MEDIUM.String(), snippet.Complexity)
+	assert.True(t, now.Equal(snippet.Created), "Created = %v, want %v", snippet.Created, now)
+	assert.Equal(t, 0, snippet.VisitCount)
+
+	// Getting the snippet counts the visit
+	snippet, err = d.GetSnippet(ctx, "MOCK_ID")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, snippet.VisitCount)
+	assert.True(t, snippet.LVisited.After(now.Add(-time.Second)))
+
+	files, err := d.GetFiles(ctx, "MOCK_ID", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []*entity.FileEntity{
+		{Name: "MOCK_NAME_0", Content: "MOCK_CONTENT_0", CntxLine: 32, IsMain: true},
+		{Name: "MOCK_NAME_1", Content: "MOCK_CONTENT_1", CntxLine: 32, IsMain: false},
+	}, files)
+
+	_, err = d.GetFiles(ctx, "MOCK_ID", 3)
+	assert.ErrorIs(t, err, datastore.ErrNoSuchEntity)
+	_, err = d.GetSnippet(ctx, "MOCK_MISSING_ID")
+	assert.ErrorIs(t, err, datastore.ErrNoSuchEntity)
+}
+
+func TestSQL_PutSnippet_PersistenceKey(t *testing.T) {
+	d := newSQLite(t)
+	assert.NoError(t, d.PutSnippet(ctx, "MOCK_ID_1", makeSnippet(constants.UserSnippetOrigin, "MOCK_PERSISTENCE_KEY", time.Now(), 1)))
+	assert.NoError(t, d.PutSnippet(ctx, "MOCK_ID_2", makeSnippet(constants.UserSnippetOrigin, "MOCK_PERSISTENCE_KEY", time.Now(), 2)))
+
+	_, err := d.GetSnippet(ctx, "MOCK_ID_1")
+	assert.ErrorIs(t, err, datastore.ErrNoSuchEntity)
+	_, err = d.GetSnippet(ctx, "MOCK_ID_2")
+	assert.NoError(t, err)
+
+	// Saving the same snippet again replaces it
+	assert.NoError(t, d.PutSnippet(ctx, "MOCK_ID_2", makeSnippet(constants.UserSnippetOrigin, "MOCK_PERSISTENCE_KEY", time.Now(), 1)))
+	files, err := d.GetFiles(ctx, "MOCK_ID_2", 1)
+	assert.NoError(t, err)
+	assert.Len(t, files, 1)
+}
+
+func TestSQL_GetSnippetRevision(t *testing.T) {
+	d := newSQLite(t)
+	snippet := makeSnippet(constants.UserSnippetOrigin, "", time.Now(), 1)
+	snippet.Snippet.ParentId = "MOCK_PARENT_ID"
+	snippet.Snippet.Revision = 2
+	snippet.Snippet.AuthorToken = "MOCK_AUTHOR_TOKEN"
+	assert.NoError(t, d.PutSnippet(ctx, "MOCK_ID", snippet))
+
+	revision, err := d.GetSnippetRevision(ctx, "MOCK_ID")
+	assert.NoError(t, err)
+	assert.Equal(t, "MOCK_ID", revision.Key.Name)
+	assert.Equal(t, "MOCK_PARENT_ID", revision.ParentId)
+	assert.Equal(t, 2, revision.Revision)
+	assert.Equal(t, "MOCK_AUTHOR_TOKEN", revision.AuthorToken)
+
+	// Getting the revision doesn't count the visit
+	revision, err = d.GetSnippetRevision(ctx, "MOCK_ID")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, revision.VisitCount)
+
+	_, err = d.GetSnippetRevision(ctx, "MOCK_MISSING_ID")
+	assert.ErrorIs(t, err, datastore.ErrNoSuchEntity)
+}
+
+func TestSQL_GetSnippetChildren(t *testing.T) {
+	d := newSQLite(t)
+	now := time.Now()
+	assert.NoError(t, d.PutSnippet(ctx, "MOCK_PARENT_ID", makeSnippet(constants.UserSnippetOrigin, "", now, 1)))
+	for i, id := range []string{"MOCK_CHILD_ID_1", "MOCK_CHILD_ID_2"} {
+		child := makeSnippet(constants.UserSnippetOrigin, "", now.Add(time.Duration(i)*time.Second), 1)
+		child.Snippet.ParentId = "MOCK_PARENT_ID"
+		child.Snippet.Revision = 2
+		assert.NoError(t, d.PutSnippet(ctx, id, child))
+	}
+
+	children, err := d.GetSnippetChildren(ctx, "MOCK_PARENT_ID")
+	assert.NoError(t, err)
+	if assert.Len(t, children, 2) {
+		assert.Equal(t, "MOCK_CHILD_ID_1", children[0].Key.Name)
+		assert.Equal(t, "MOCK_CHILD_ID_2", children[1].Key.Name)
+		assert.Equal(t, "MOCK_PARENT_ID", children[0].ParentId)
+	}
+
+	children, err = d.GetSnippetChildren(ctx, "MOCK_CHILD_ID_1")
+	assert.NoError(t, err)
+	assert.Empty(t, children)
+}
+
+func TestSQL_DeleteUnusedSnippets(t *testing.T) {
+	d := newSQLite(t)
+	now := time.Now()
+	assert.NoError(t, d.PutSnippet(ctx, "MOCK_ID_OLD", makeSnippet(constants.UserSnippetOrigin, "", now.Add(-2*time.Hour), 2)))
+	assert.NoError(t, d.PutSnippet(ctx, "MOCK_ID_RECENT", makeSnippet(constants.UserSnippetOrigin, "", now, 1)))
+	assert.NoError(t, d.PutSnippet(ctx, "MOCK_ID_EXAMPLE", makeSnippet(constants.ExampleOrigin, "", now.Add(-2*time.Hour), 1)))
+
+	assert.NoError(t, d.DeleteUnusedSnippets(ctx, time.Hour))
+
+	_, err := d.GetSnippet(ctx, "MOCK_ID_OLD")
+	assert.ErrorIs(t, err, datastore.ErrNoSuchEntity)
+	var files int
+	assert.NoError(t, d.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM files WHERE snippet_id = ?", "MOCK_ID_OLD").Scan(&files))
+	assert.Equal(t, 0, files)
+	_, err = d.GetSnippet(ctx, "MOCK_ID_RECENT")
+	assert.NoError(t, err)
+	_, err = d.GetSnippet(ctx, "MOCK_ID_EXAMPLE")
+	assert.NoError(t, err)
+}
+
+func TestSQL_GetCatalog(t *testing.T) {
+	d := newSQLite(t)
+	saveExample(t, d, pb.Sdk_SDK_JAVA.String(), "MOCK_EXAMPLE", constants.ExampleOrigin, 2)
+	saveExample(t, d, pb.Sdk_SDK_JAVA.String(), "MOCK_DEFAULT_EXAMPLE", constants.ExampleOrigin, 1)
+	saveExample(t, d, pb.Sdk_SDK_GO.String(), "MOCK_EXAMPLE_DIFFERENT_ORIGIN", "MOCK_ORIGIN", 1)
+	sdks, err := d.GetSDKs(ctx)
+	assert.NoError(t, err)
+
+	catalog, err := d.GetCatalog(ctx, sdks)
+	assert.NoError(t, err)
+	if assert.Len(t, catalog, 1) {
+		assert.Equal(t, pb.Sdk_SDK_JAVA, catalog[0].GetSdk())
+		assert.Equal(t, "MOCK_CATEGORY", catalog[0].GetCategories()[0].GetCategoryName())
+		objs := catalog[0].GetCategories()[0].GetPrecompiledObjects()
+		if assert.Len(t, objs, 2) {
+			assert.Equal(t, "MOCK_DEFAULT_EXAMPLE", objs[0].Name)
+			assert.True(t, objs[0].DefaultExample)
+			assert.Equal(t, "MOCK_EXAMPLE", objs[1].Name)
+			assert.Equal(t, "SDK_JAVA_MOCK_EXAMPLE", objs[1].CloudPath)
+			assert.True(t, objs[1].Multifile)
+			assert.True(t, objs[1].NeverRun)
+			assert.Equal(t, int32(32), objs[1].ContextLine)
+			assert.Equal(t, []string{"MOCK_TAG"}, objs[1].Tags)
+			assert.Equal(t, pb.Complexity_COMPLEXITY_MEDIUM, objs[1].Complexity)
+		}
+	}
+}
+
+func TestSQL_GetCatalog_ManyExamples(t *testing.T) {
+	d := newSQLite(t)
+	// More examples than the identifiers queried at once, so that their files are queried in several batches
+	const count = 1200
+	for i := 0; i < count; i++ {
+		saveExample(t, d, pb.Sdk_SDK_JAVA.String(), fmt.Sprintf("MOCK_EXAMPLE_%04d", i), constants.ExampleOrigin, 2)
+	}
+	sdks, err := d.GetSDKs(ctx)
+	assert.NoError(t, err)
+
+	catalog, err := d.GetCatalog(ctx, sdks)
+	assert.NoError(t, err)
+	if assert.Len(t, catalog, 1) {
+		objs := catalog[0].GetCategories()[0].GetPrecompiledObjects()
+		if assert.Len(t, objs, count) {
+			for i, obj := range objs {
+				assert.Equal(t, fmt.Sprintf("MOCK_EXAMPLE_%04d", i), obj.Name)
+				assert.True(t, obj.Multifile)
+			}
+		}
+	}
+}
+
+func TestSQL_GetDefaultExamples(t *testing.T) {
+	d := newSQLite(t)
+	saveExample(t, d, pb.Sdk_SDK_GO.String(), "MOCK_DEFAULT_EXAMPLE", constants.ExampleOrigin, 1)
+	sdks, err := d.GetSDKs(ctx)
+	assert.NoError(t, err)
+
+	examples, err := d.GetDefaultExamples(ctx, sdks)
+	assert.NoError(t, err)
+	if assert.Len(t, examples, 1) {
+		example := examples[pb.Sdk_SDK_GO]
+		assert.Equal(t, "MOCK_DEFAULT_EXAMPLE", example.Name)
+		assert.Equal(t, "MOCK_OPTIONS", example.PipelineOptions)
+		assert.True(t, example.DefaultExample)
+	}
+
+	_, err = d.GetDefaultExamples(ctx, []*entity.SDKEntity{{Name: pb.Sdk_SDK_JAVA.String(), DefaultExample: "MOCK_MISSING"}})
+	assert.Error(t, err)
+}
+
+func TestSQL_GetExample(t *testing.T) {
+	d := newSQLite(t)
+	id := saveExample(t, d, pb.Sdk_SDK_PYTHON.String(), "MOCK_EXAMPLE", constants.ExampleOrigin, 1)
+	_, err := d.DB.ExecContext(ctx, "INSERT INTO datasets (id, path) VALUES (?, ?)", "MOCK_DATASET", "MOCK_DATASET_PATH")
+	assert.NoError(t, err)
+	_, err = d.DB.ExecContext(ctx, "INSERT INTO snippet_datasets (snippet_id, idx, config, dataset_id, emulator) VALUES (?, ?, ?, ?, ?)",
+		id, 0, `{"topic": "MOCK_TOPIC"}`, "MOCK_DATASET", "kafka")
+	assert.NoError(t, err)
+	sdks, err := d.GetSDKs(ctx)
+	assert.NoError(t, err)
+
+	example, err := d.GetExample(ctx, id, sdks)
+	assert.NoError(t, err)
+	assert.Equal(t, id, example.CloudPath)
+	assert.Equal(t, "MOCK_EXAMPLE", example.Name)
+	assert.Equal(t, "MOCK_DESCR", example.Description)
+	assert.Equal(t, "MOCK_URL_VCS", example.UrlVcs)
+	assert.Equal(t, pb.Sdk_SDK_PYTHON, example.Sdk)
+	assert.False(t, example.DefaultExample)
+	assert.Equal(t, []*pb.Dataset{{
+		Type:        pb.EmulatorType_EMULATOR_TYPE_KAFKA,
+		Options:     map[string]string{"topic": "MOCK_TOPIC"},
+		DatasetPath: "MOCK_DATASET_PATH",
+	}}, example.Datasets)
+
+	_, err = d.GetExample(ctx, "MOCK_MISSING_ID", sdks)
+	assert.Equal(t, datastore.ErrNoSuchEntity, err)
+}
+
+func TestSQL_GetExampleCode(t *testing.T) {
+	d := newSQLite(t)
+	id := saveExample(t, d, pb.Sdk_SDK_JAVA.String(), "MOCK_EXAMPLE", constants.ExampleOrigin, 2)
+
+	files, err := d.GetExampleCode(ctx, id)
+	assert.NoError(t, err)
+	if assert.Len(t, files, 2) {
+		assert.Equal(t, "MOCK_CONTENT_0", files[0].Content)
+		assert.Equal(t, "MOCK_CONTENT_1", files[1].Content)
+	}
+
+	_, err = d.GetExampleCode(ctx, "MOCK_MISSING_ID")
+	assert.Equal(t, datastore.ErrNoSuchEntity, err)
+}
+
+func TestSQL_GetPrecompiledObjects(t *testing.T) {
+	d := newSQLite(t)
+	id := saveExample(t, d, pb.Sdk_SDK_JAVA.String(), "MOCK_EXAMPLE", constants.ExampleOrigin, 1)
+	tests := []struct {
+		name   string
+		get    func(ctx context.Context, id string) (string, error)
+		pcType string
+	}{
+		{name: "Getting example output", get: d.GetExampleOutput, pcType: constants.PCOutputType},
+		{name: "Getting example logs", get: d.GetExampleLogs, pcType: constants.PCLogType},
+		{name: "Getting example graph", get: d.GetExampleGraph, pcType: constants.PCGraphType},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := tt.get(ctx, id)
+			assert.NoError(t, err)
+			assert.Equal(t, "MOCK_CONTENT_"+tt.pcType, content)
+
+			_, err = tt.get(ctx, "MOCK_MISSING_ID")
+			assert.Equal(t, datastore.ErrNoSuchEntity, err)
+		})
+	}
+}
+
+func TestNew_UnsupportedDriver(t *testing.T) {
+	_, err := sqldb.New(ctx, mapper.NewPrecompiledObjectMapper(), "mysql", "")
+	assert.Error(t, err)
+}
@@ -16,10 +16,10 @@
 package emulators
 
 import (
-	"beam.apache.org/playground/backend/internal/constants"
-	"errors"
+	"fmt"
 	"os"
 	"path"
+	"sort"
 
 	pb "beam.apache.org/playground/backend/internal/api/v1"
 	"beam.apache.org/playground/backend/internal/logger"
@@ -38,66 +38,71 @@ type EmulatorProducer interface {
 type EmulatorConfiguration struct {
 	KafkaEmulatorExecutablePath string
 	DatasetsPath                string
-	Datasets                    []*pb.Dataset
+	// WorkingDir is the folder where emulators keep files of datasets, i.e. the pipeline folder
+	WorkingDir string
+	Datasets   []*pb.Dataset
 }
 
+// EmulatorFactory starts an emulator and loads the given datasets into it
+type EmulatorFactory func(configuration EmulatorConfiguration, datasets []*DatasetDTO) (EmulatorMockCluster, error)
+
+var emulatorFactories = map[pb.EmulatorType]EmulatorFactory{}
+
+// RegisterEmulator makes the emulator available for datasets of the given emulator type
+func RegisterEmulator(emulatorType pb.EmulatorType, factory EmulatorFactory) {
+	emulatorFactories[emulatorType] = factory
+}
+
+// PrepareMockClusters starts one emulator for each emulator type used by the datasets
 func PrepareMockClusters(configuration EmulatorConfiguration) ([]EmulatorMockCluster, error) {
 	datasetsByEmulatorTypeMap := map[pb.EmulatorType][]*pb.Dataset{}
 	for _, dataset := range configuration.Datasets {
-		datasets, ok := datasetsByEmulatorTypeMap[dataset.Type]
-		if !ok {
-			datasets = make([]*pb.Dataset, 0)
-			datasets = append(datasets, dataset)
-			datasetsByEmulatorTypeMap[dataset.Type] = datasets
-			continue
-		}
-		datasets = append(datasets, dataset)
-		datasetsByEmulatorTypeMap[dataset.Type] = datasets
+		datasetsByEmulatorTypeMap[dataset.Type] = append(datasetsByEmulatorTypeMap[dataset.Type], dataset)
 	}
-
-	var mockClusters = make([]EmulatorMockCluster, 0)
-
-	kafkaMockCluster, err := NewKafkaMockCluster(configuration.KafkaEmulatorExecutablePath)
-	if err != nil {
-		logger.Errorf("failed to run a kafka mock cluster, %v", err)
-		return nil, err
+	emulatorTypes := make([]pb.EmulatorType, 0, len(datasetsByEmulatorTypeMap))
+	for emulatorType := range datasetsByEmulatorTypeMap {
+		emulatorTypes = append(emulatorTypes, emulatorType)
 	}
-	kafkaMockCluster.preparerParameters[constants.BootstrapServerKey] = kafkaMockCluster.GetAddress()
-	mockClusters = append(mockClusters, kafkaMockCluster)
+	sort.Slice(emulatorTypes, func(i, j int) bool { return emulatorTypes[i] < emulatorTypes[j] })
 
-	for emulatorType, datasets := range datasetsByEmulatorTypeMap {
-		datasetDTOs, err := toDatasetDTOs(configuration.DatasetsPath, datasets)
+	var mockClusters = make([]EmulatorMockCluster, 0, len(emulatorTypes))
+	stopAll := func() {
+		for _, mockCluster := range mockClusters {
+			mockCluster.Stop()
+		}
+	}
+	for _, emulatorType := range emulatorTypes {
+		factory, ok := emulatorFactories[emulatorType]
+		if !ok {
+			stopAll()
+			return nil, fmt.Errorf("unsupported emulator type: %s", emulatorType)
+		}
+		datasetDTOs, err := toDatasetDTOs(configuration.DatasetsPath, datasetsByEmulatorTypeMap[emulatorType])
 		if err != nil {
 			logger.Errorf("failed to get datasets from the repository, %v", err)
+			stopAll()
 			return nil, err
 		}
-
-		switch emulatorType {
-		case pb.EmulatorType_EMULATOR_TYPE_KAFKA:
-			producer, err := NewKafkaProducer(kafkaMockCluster)
-			if err != nil {
-				logger.Errorf("failed to create a producer, %v", err)
-				kafkaMockCluster.Stop()
-				return nil, err
-			}
-			if err = producer.ProduceDatasets(datasetDTOs); err != nil {
-				logger.Errorf("failed to produce a dataset, %v", err)
-				kafkaMockCluster.Stop()
-				return nil, err
-			}
-			for _, dataset := range datasets {
-				for k, v := range dataset.Options {
-					kafkaMockCluster.preparerParameters[k] = v
-				}
-			}
-
-		default:
-			return nil, errors.New("unsupported emulator type")
+		mockCluster, err := factory(configuration, datasetDTOs)
+		if err != nil {
+			logger.Errorf("failed to run a mock cluster for %s, %v", emulatorType, err)
+			stopAll()
+			return nil, err
 		}
+		mockClusters = append(mockClusters, mockCluster)
 	}
 	return mockClusters, nil
 }
 
+// addDatasetOptions adds options of the datasets to the preparer parameters
+func addDatasetOptions(preparerParameters map[string]string, datasets []*DatasetDTO) {
+	for _, dataset := range datasets {
+		for k, v := range dataset.Dataset.Options {
+			preparerParameters[k] = v
+		}
+	}
+}
+
 func toDatasetDTOs(datasetsPath string, datasets []*pb.Dataset) ([]*DatasetDTO, error) {
 	result := make([]*DatasetDTO, 0, len(datasets))
 	for _, dataset := range datasets {
@@ -0,0 +1,128 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package emulators
+
+import (
+	"testing"
+
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/constants"
+)
+
+const (
+	datasetsPath    = "../../datasets"
+	jsonDatasetPath = "CountWordsJson.json"
+	avroDatasetPath = "CountWordsAvro.avro"
+)
+
+func TestPrepareMockClusters(t *testing.T) {
+	tests := []struct {
+		name       string
+		datasets   []*pb.Dataset
+		wantParams []string
+		wantErr    bool
+	}{
+		{
+			name: "Pub/Sub, GCS and JDBC datasets",
+			datasets: []*pb.Dataset{
+				{Type: pb.EmulatorType_EMULATOR_TYPE_PUBSUB, DatasetPath: jsonDatasetPath, Options: map[string]string{constants.TopicNameKey: "words"}},
+				{Type: pb.EmulatorType_EMULATOR_TYPE_GCS, DatasetPath: jsonDatasetPath, Options: map[string]string{constants.TopicNameKey: "words"}},
+				{Type: pb.EmulatorType_EMULATOR_TYPE_JDBC, DatasetPath: avroDatasetPath, Options: map[string]string{constants.TopicNameKey: "words"}},
+			},
+			wantParams: []string{constants.PubSubEmulatorHostKey, constants.GCSEndpointKey, constants.BucketsDirKey, constants.DatabasePathKey, constants.TopicNameKey},
+		},
+		{
+			name: "Kafka dataset without the emulator executable",
+			datasets: []*pb.Dataset{
+				{Type: pb.EmulatorType_EMULATOR_TYPE_KAFKA, DatasetPath: jsonDatasetPath, Options: map[string]string{constants.TopicNameKey: "words"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Unsupported emulator type",
+			datasets: []*pb.Dataset{
+				{Type: pb.EmulatorType_EMULATOR_TYPE_UNSPECIFIED, DatasetPath: jsonDatasetPath},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Dataset which does not exist",
+			datasets: []*pb.Dataset{
+				{Type: pb.EmulatorType_EMULATOR_TYPE_GCS, DatasetPath: "not_existing.json", Options: map[string]string{constants.TopicNameKey: "words"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClusters, err := PrepareMockClusters(EmulatorConfiguration{
+				DatasetsPath: datasetsPath,
+				WorkingDir:   t.TempDir(),
+				Datasets:     tt.datasets,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PrepareMockClusters() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			defer func() {
+				for _, mockCluster := range mockClusters {
+					mockCluster.Stop()
+				}
+			}()
+			if len(mockClusters) != len(tt.datasets) {
+				t.Fatalf("PrepareMockClusters() got %d mock clusters, want %d", len(mockClusters), len(tt.datasets))
+			}
+			params := map[string]string{}
+			for _, mockCluster := range mockClusters {
+				for k, v := range mockCluster.GetPreparerParameters() {
+					params[k] = v
+				}
+			}
+			for _, key := range tt.wantParams {
+				if params[key] == "" {
+					t.Errorf("PrepareMockClusters() preparer parameter %s is not set", key)
+				}
+			}
+		})
+	}
+}
+
+func TestPubSubMockCluster_ProduceDatasets(t *testing.T) {
+	datasets, err := toDatasetDTOs(datasetsPath, []*pb.Dataset{
+		{Type: pb.EmulatorType_EMULATOR_TYPE_PUBSUB, DatasetPath: jsonDatasetPath, Options: map[string]string{constants.TopicNameKey: "words"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries, err := unmarshallDatasets(datasets[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubSubMockCluster := NewPubSubMockCluster()
+	defer pubSubMockCluster.Stop()
+
+	if err = pubSubMockCluster.ProduceDatasets(datasets); err != nil {
+		t.Fatalf("ProduceDatasets() error = %v", err)
+	}
+	if got := len(pubSubMockCluster.server.Messages()); got != len(entries) {
+		t.Errorf("ProduceDatasets() published %d messages, want %d", got, len(entries))
+	}
+	if err = pubSubMockCluster.ProduceDatasets(datasets); err == nil {
+		t.Errorf("ProduceDatasets() expected an error for the existing topic")
+	}
+}
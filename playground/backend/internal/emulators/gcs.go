@@ -0,0 +1,316 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package emulators
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/constants"
+	"beam.apache.org/playground/backend/internal/logger"
+)
+
+const (
+	bucketsFolderName   = "buckets"
+	gcsApiPrefix        = "/storage/v1/b/"
+	gcsDownloadPrefix   = "/download/storage/v1/b/"
+	gcsObjectsSeparator = "/o"
+	gcsMediaAlt         = "media"
+	gcsEndpointPattern  = "http://%s"
+	defaultContentType  = "application/octet-stream"
+	datasetFileMode     = 0644
+)
+
+func init() {
+	RegisterEmulator(pb.EmulatorType_EMULATOR_TYPE_GCS, newGCSEmulator)
+}
+
+// GCSMockCluster serves datasets as objects of GCS buckets using a subset of the GCS JSON API.
+// Each dataset is stored as the file "{workingDir}/buckets/{topic}/{datasetFileName}"
+// and is available as the object "gs://{topic}/{datasetFileName}".
+type GCSMockCluster struct {
+	listener           net.Listener
+	server             *http.Server
+	rootDir            string
+	preparerParameters map[string]string
+}
+
+// newGCSEmulator copies the datasets to the working dir and starts a GCS stand-in to serve them
+func newGCSEmulator(configuration EmulatorConfiguration, datasets []*DatasetDTO) (EmulatorMockCluster, error) {
+	rootDir := filepath.Join(configuration.WorkingDir, bucketsFolderName)
+	if err := storeDatasets(rootDir, datasets); err != nil {
+		return nil, err
+	}
+	gcsMockCluster, err := NewGCSMockCluster(rootDir)
+	if err != nil {
+		return nil, err
+	}
+	gcsMockCluster.preparerParameters[constants.GCSEndpointKey] = fmt.Sprintf(gcsEndpointPattern, gcsMockCluster.GetAddress())
+	gcsMockCluster.preparerParameters[constants.BucketsDirKey] = rootDir
+	addDatasetOptions(gcsMockCluster.preparerParameters, datasets)
+	return gcsMockCluster, nil
+}
+
+// storeDatasets writes the datasets to the rootDir grouping them by buckets
+func storeDatasets(rootDir string, datasets []*DatasetDTO) error {
+	for _, dataset := range datasets {
+		bucket := dataset.Dataset.Options[constants.TopicNameKey]
+		if !isValidBucketName(bucket) {
+			return fmt.Errorf("wrong bucket name of the dataset: \"%s\"", bucket)
+		}
+		bucketDir := filepath.Join(rootDir, bucket)
+		if err := os.MkdirAll(bucketDir, os.ModePerm); err != nil {
+			return err
+		}
+		objectPath := filepath.Join(bucketDir, filepath.Base(dataset.Dataset.DatasetPath))
+		if err := os.WriteFile(objectPath, dataset.Data, datasetFileMode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func NewGCSMockCluster(rootDir string) (*GCSMockCluster, error) {
+	listener, err := net.Listen(networkType, "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	gcsMockCluster := &GCSMockCluster{
+		listener:           listener,
+		rootDir:            rootDir,
+		preparerParameters: make(map[string]string),
+	}
+	gcsMockCluster.server = &http.Server{Handler: http.HandlerFunc(gcsMockCluster.handle)}
+	go func() {
+		if err := gcsMockCluster.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Errorf("GCS emulator: %s", err.Error())
+		}
+	}()
+	return gcsMockCluster, nil
+}
+
+// gcsObject is the object resource of the GCS JSON API
+type gcsObject struct {
+	Kind        string `json:"kind"`
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Bucket      string `json:"bucket"`
+	Size        string `json:"size"`
+	ContentType string `json:"contentType"`
+	Updated     string `json:"updated"`
+}
+
+// gcsObjects is the response of the objects list request of the GCS JSON API
+type gcsObjects struct {
+	Kind  string       `json:"kind"`
+	Items []*gcsObject `json:"items"`
+}
+
+// gcsBucket is the bucket resource of the GCS JSON API
+type gcsBucket struct {
+	Kind string `json:"kind"`
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// handle supports the following requests:
+//   - GET /storage/v1/b/{bucket}
+//   - GET /storage/v1/b/{bucket}/o?prefix={prefix}
+//   - GET /storage/v1/b/{bucket}/o/{object}[?alt=media]
+//   - GET /download/storage/v1/b/{bucket}/o/{object}?alt=media
+//   - GET /{bucket}/{object}
+func (gmc *GCSMockCluster) handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method is not supported", http.StatusMethodNotAllowed)
+		return
+	}
+	media := r.URL.Query().Get("alt") == gcsMediaAlt
+	urlPath := r.URL.Path
+	switch {
+	case strings.HasPrefix(urlPath, gcsDownloadPrefix):
+		urlPath = strings.TrimPrefix(urlPath, gcsDownloadPrefix)
+	case strings.HasPrefix(urlPath, gcsApiPrefix):
+		urlPath = strings.TrimPrefix(urlPath, gcsApiPrefix)
+	default:
+		bucket, object, _ := strings.Cut(strings.TrimPrefix(urlPath, "/"), "/")
+		gmc.writeObjectMedia(w, r, bucket, object)
+		return
+	}
+
+	bucket, objectsPath, hasObjects := strings.Cut(urlPath, "/")
+	if !hasObjects {
+		gmc.writeBucket(w, bucket)
+		return
+	}
+	objectsPath = "/" + objectsPath
+	switch {
+	case objectsPath == gcsObjectsSeparator:
+		gmc.writeObjects(w, bucket, r.URL.Query().Get("prefix"))
+	case strings.HasPrefix(objectsPath, gcsObjectsSeparator+"/"):
+		object := strings.TrimPrefix(objectsPath, gcsObjectsSeparator+"/")
+		if media {
+			gmc.writeObjectMedia(w, r, bucket, object)
+			return
+		}
+		gmc.writeObject(w, bucket, object)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func isValidBucketName(bucket string) bool {
+	return bucket != "" && bucket != "." && bucket != ".." && !strings.ContainsAny(bucket, "/\\")
+}
+
+func (gmc *GCSMockCluster) bucketExists(bucket string) bool {
+	if !isValidBucketName(bucket) {
+		return false
+	}
+	info, err := os.Stat(filepath.Join(gmc.rootDir, bucket))
+	return err == nil && info.IsDir()
+}
+
+// objectPath returns a path to the file of the object or an empty string if there is no such object
+func (gmc *GCSMockCluster) objectPath(bucket, object string) string {
+	if !isValidBucketName(bucket) || object == "" {
+		return ""
+	}
+	objectPath := filepath.Join(gmc.rootDir, bucket, filepath.FromSlash(object))
+	if !strings.HasPrefix(objectPath, filepath.Join(gmc.rootDir, bucket)+string(filepath.Separator)) {
+		return ""
+	}
+	return objectPath
+}
+
+func (gmc *GCSMockCluster) getObject(bucket, object string) (*gcsObject, error) {
+	objectPath := gmc.objectPath(bucket, object)
+	if objectPath == "" {
+		return nil, os.ErrNotExist
+	}
+	info, err := os.Stat(objectPath)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, os.ErrNotExist
+	}
+	contentType := mime.TypeByExtension(filepath.Ext(object))
+	if contentType == "" {
+		contentType = defaultContentType
+	}
+	return &gcsObject{
+		Kind:        "storage#object",
+		Id:          bucket + "/" + object,
+		Name:        object,
+		Bucket:      bucket,
+		Size:        strconv.FormatInt(info.Size(), 10),
+		ContentType: contentType,
+		Updated:     info.ModTime().UTC().Format("2006-01-02T15:04:05.000Z"),
+	}, nil
+}
+
+func (gmc *GCSMockCluster) writeBucket(w http.ResponseWriter, bucket string) {
+	if !gmc.bucketExists(bucket) {
+		http.Error(w, "bucket not found", http.StatusNotFound)
+		return
+	}
+	writeJson(w, &gcsBucket{Kind: "storage#bucket", Id: bucket, Name: bucket})
+}
+
+func (gmc *GCSMockCluster) writeObjects(w http.ResponseWriter, bucket, prefix string) {
+	bucketDir := filepath.Join(gmc.rootDir, bucket)
+	if !gmc.bucketExists(bucket) {
+		http.Error(w, "bucket not found", http.StatusNotFound)
+		return
+	}
+	result := &gcsObjects{Kind: "storage#objects", Items: make([]*gcsObject, 0)}
+	err := filepath.Walk(bucketDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		relativePath, err := filepath.Rel(bucketDir, path)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(relativePath)
+		if !strings.HasPrefix(name, prefix) {
+			return nil
+		}
+		object, err := gmc.getObject(bucket, name)
+		if err != nil {
+			return err
+		}
+		result.Items = append(result.Items, object)
+		return nil
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	sort.Slice(result.Items, func(i, j int) bool { return result.Items[i].Name < result.Items[j].Name })
+	writeJson(w, result)
+}
+
+func (gmc *GCSMockCluster) writeObject(w http.ResponseWriter, bucket, object string) {
+	result, err := gmc.getObject(bucket, object)
+	if err != nil {
+		http.Error(w, "object not found", http.StatusNotFound)
+		return
+	}
+	writeJson(w, result)
+}
+
+func (gmc *GCSMockCluster) writeObjectMedia(w http.ResponseWriter, r *http.Request, bucket, object string) {
+	result, err := gmc.getObject(bucket, object)
+	if err != nil {
+		http.Error(w, "object not found", http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", result.ContentType)
+	http.ServeFile(w, r, gmc.objectPath(bucket, object))
+}
+
+func writeJson(w http.ResponseWriter, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(value); err != nil {
+		logger.Errorf("GCS emulator: failed to write a response: %s", err.Error())
+	}
+}
+
+func (gmc *GCSMockCluster) Stop() {
+	logger.Infof("Stopping GCS emulator")
+	if err := gmc.server.Close(); err != nil {
+		logger.Errorf("Failed to stop GCS emulator: %v", err)
+	}
+}
+
+func (gmc *GCSMockCluster) GetAddress() string {
+	return gmc.listener.Addr().String()
+}
+
+func (gmc *GCSMockCluster) GetPreparerParameters() map[string]string {
+	return gmc.preparerParameters
+}
@@ -0,0 +1,143 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package emulators
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGCSMockCluster(t *testing.T) {
+	rootDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(rootDir, "bucket"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(rootDir, "bucket", "words.json"), []byte("[]"), datasetFileMode); err != nil {
+		t.Fatal(err)
+	}
+	gcsMockCluster, err := NewGCSMockCluster(rootDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer gcsMockCluster.Stop()
+	endpoint := "http://" + gcsMockCluster.GetAddress()
+
+	tests := []struct {
+		name       string
+		path       string
+		wantStatus int
+		wantBody   string
+		wantObject string
+		wantItems  int
+	}{
+		{
+			name:       "Get bucket",
+			path:       "/storage/v1/b/bucket",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "List objects",
+			path:       "/storage/v1/b/bucket/o",
+			wantStatus: http.StatusOK,
+			wantItems:  1,
+		},
+		{
+			name:       "List objects with prefix",
+			path:       "/storage/v1/b/bucket/o?prefix=other",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "Get object metadata",
+			path:       "/storage/v1/b/bucket/o/words.json",
+			wantStatus: http.StatusOK,
+			wantObject: "words.json",
+		},
+		{
+			name:       "Get object media",
+			path:       "/storage/v1/b/bucket/o/words.json?alt=media",
+			wantStatus: http.StatusOK,
+			wantBody:   "[]",
+		},
+		{
+			name:       "Download object media",
+			path:       "/download/storage/v1/b/bucket/o/words.json?alt=media",
+			wantStatus: http.StatusOK,
+			wantBody:   "[]",
+		},
+		{
+			name:       "Get object by path",
+			path:       "/bucket/words.json",
+			wantStatus: http.StatusOK,
+			wantBody:   "[]",
+		},
+		{
+			name:       "Object which does not exist",
+			path:       "/storage/v1/b/bucket/o/other.json",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "Bucket which does not exist",
+			path:       "/storage/v1/b/other/o",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "Object outside of the bucket",
+			path:       "/storage/v1/b/bucket/o/..%2F..%2Fwords.json?alt=media",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, err := http.Get(endpoint + tt.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer response.Body.Close()
+			body, err := io.ReadAll(response.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if response.StatusCode != tt.wantStatus {
+				t.Fatalf("GET %s status = %d, want %d", tt.path, response.StatusCode, tt.wantStatus)
+			}
+			if tt.wantBody != "" && string(body) != tt.wantBody {
+				t.Errorf("GET %s body = %s, want %s", tt.path, body, tt.wantBody)
+			}
+			if tt.wantObject != "" {
+				object := &gcsObject{}
+				if err = json.Unmarshal(body, object); err != nil {
+					t.Fatal(err)
+				}
+				if object.Name != tt.wantObject || object.Bucket != "bucket" || object.Size != "2" {
+					t.Errorf("GET %s object = %+v", tt.path, object)
+				}
+			}
+			if tt.wantItems != 0 {
+				objects := &gcsObjects{}
+				if err = json.Unmarshal(body, objects); err != nil {
+					t.Fatal(err)
+				}
+				if len(objects.Items) != tt.wantItems {
+					t.Errorf("GET %s items = %d, want %d", tt.path, len(objects.Items), tt.wantItems)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package emulators
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	_ "modernc.org/sqlite"
+
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/constants"
+	"beam.apache.org/playground/backend/internal/logger"
+)
+
+const (
+	sqliteDriver          = "sqlite"
+	databaseFileName      = "playground_database.db"
+	integerColumnType     = "INTEGER"
+	realColumnType        = "REAL"
+	textColumnType        = "TEXT"
+	createTableStatement  = "CREATE TABLE %s (%s)"
+	insertIntoStatement   = "INSERT INTO %s (%s) VALUES (%s)"
+	sqlIdentifierTemplate = `^[A-Za-z_][A-Za-z0-9_]*$`
+)
+
+var sqlIdentifierRegexp = regexp.MustCompile(sqlIdentifierTemplate)
+
+func init() {
+	RegisterEmulator(pb.EmulatorType_EMULATOR_TYPE_JDBC, newJDBCEmulator)
+}
+
+// JDBCMockCluster is an SQLite database file available via JDBC (jdbc:sqlite:{databasePath}).
+// Each dataset is loaded to the table named as the topic of the dataset.
+// Columns of the table are the fields of the dataset entries.
+type JDBCMockCluster struct {
+	databasePath       string
+	preparerParameters map[string]string
+}
+
+// newJDBCEmulator creates an SQLite database in the working dir and loads the datasets to it
+func newJDBCEmulator(configuration EmulatorConfiguration, datasets []*DatasetDTO) (EmulatorMockCluster, error) {
+	jdbcMockCluster := NewJDBCMockCluster(filepath.Join(configuration.WorkingDir, databaseFileName))
+	if err := jdbcMockCluster.ProduceDatasets(datasets); err != nil {
+		logger.Errorf("failed to load a dataset to the database, %v", err)
+		return nil, err
+	}
+	jdbcMockCluster.preparerParameters[constants.DatabasePathKey] = jdbcMockCluster.databasePath
+	addDatasetOptions(jdbcMockCluster.preparerParameters, datasets)
+	return jdbcMockCluster, nil
+}
+
+func NewJDBCMockCluster(databasePath string) *JDBCMockCluster {
+	return &JDBCMockCluster{databasePath: databasePath, preparerParameters: make(map[string]string)}
+}
+
+func (jmc *JDBCMockCluster) ProduceDatasets(datasets []*DatasetDTO) error {
+	db, err := sql.Open(sqliteDriver, jmc.databasePath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	for _, dataset := range datasets {
+		table := *getTopic(dataset)
+		if !sqlIdentifierRegexp.MatchString(table) {
+			return fmt.Errorf("wrong table name of the dataset: \"%s\"", table)
+		}
+		entries, err := unmarshallDatasets(dataset)
+		if err != nil {
+			return err
+		}
+		if err = loadTable(db, table, entries); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadTable creates the table with columns for all fields of the entries and inserts the entries
+func loadTable(db *sql.DB, table string, entries []map[string]interface{}) error {
+	columnValues := map[string][]interface{}{}
+	for _, entry := range entries {
+		for column, value := range entry {
+			if !sqlIdentifierRegexp.MatchString(column) {
+				return fmt.Errorf("wrong column name of the table %s: \"%s\"", table, column)
+			}
+			columnValues[column] = append(columnValues[column], value)
+		}
+	}
+	if len(columnValues) == 0 {
+		return fmt.Errorf("dataset of the table %s is empty", table)
+	}
+	columns := make([]string, 0, len(columnValues))
+	for column := range columnValues {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	definitions := make([]string, 0, len(columns))
+	for _, column := range columns {
+		definitions = append(definitions, fmt.Sprintf("%s %s", column, getColumnType(columnValues[column])))
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err = tx.Exec(fmt.Sprintf(createTableStatement, table, strings.Join(definitions, ", "))); err != nil {
+		return fmt.Errorf("failed to create the table %s, err: %v", table, err)
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", ")
+	insert, err := tx.Prepare(fmt.Sprintf(insertIntoStatement, table, strings.Join(columns, ", "), placeholders))
+	if err != nil {
+		return err
+	}
+	defer insert.Close()
+	for _, entry := range entries {
+		args := make([]interface{}, 0, len(columns))
+		for _, column := range columns {
+			value, err := toColumnValue(entry[column])
+			if err != nil {
+				return err
+			}
+			args = append(args, value)
+		}
+		if _, err = insert.Exec(args...); err != nil {
+			return fmt.Errorf("failed to insert data to the table %s, err: %v", table, err)
+		}
+	}
+	return tx.Commit()
+}
+
+// getColumnType returns the SQLite type which fits all the values of the column
+func getColumnType(values []interface{}) string {
+	columnType := ""
+	for _, value := range values {
+		valueType := textColumnType
+		switch v := value.(type) {
+		case nil:
+			continue
+		case bool, int, int32, int64:
+			valueType = integerColumnType
+		case float32:
+			valueType = realColumnType
+		case float64:
+			valueType = realColumnType
+			if v == math.Trunc(v) && math.Abs(v) < math.MaxInt64 {
+				valueType = integerColumnType
+			}
+		}
+		switch {
+		case columnType == "" || columnType == valueType:
+			columnType = valueType
+		case columnType != textColumnType && valueType != textColumnType:
+			columnType = realColumnType
+		default:
+			columnType = textColumnType
+		}
+	}
+	if columnType == "" {
+		return textColumnType
+	}
+	return columnType
+}
+
+// toColumnValue converts the value of the dataset entry to the value of the column.
+// Nested values are stored in JSON format.
+func toColumnValue(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case nil, bool, int, int32, int64, float32, float64, string:
+		return v, nil
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal data, err: %v", err)
+		}
+		return string(data), nil
+	}
+}
+
+// Stop does nothing since the database file is removed with the pipeline folder
+func (jmc *JDBCMockCluster) Stop() {
+}
+
+// GetAddress returns an empty string since the database is available as a file of the pipeline folder
+func (jmc *JDBCMockCluster) GetAddress() string {
+	return ""
+}
+
+func (jmc *JDBCMockCluster) GetPreparerParameters() map[string]string {
+	return jmc.preparerParameters
+}
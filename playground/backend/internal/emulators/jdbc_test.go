@@ -0,0 +1,91 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package emulators
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/constants"
+)
+
+func Test_getColumnType(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []interface{}
+		want   string
+	}{
+		{name: "Integers", values: []interface{}{float64(1), int32(2), nil}, want: integerColumnType},
+		{name: "Reals", values: []interface{}{float64(1), 1.5}, want: realColumnType},
+		{name: "Strings", values: []interface{}{"a", float64(1)}, want: textColumnType},
+		{name: "Nested values", values: []interface{}{map[string]interface{}{"a": 1}}, want: textColumnType},
+		{name: "Only nulls", values: []interface{}{nil}, want: textColumnType},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getColumnType(tt.values); got != tt.want {
+				t.Errorf("getColumnType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJDBCMockCluster_ProduceDatasets(t *testing.T) {
+	databasePath := filepath.Join(t.TempDir(), databaseFileName)
+	tests := []struct {
+		name    string
+		table   string
+		data    string
+		wantErr bool
+	}{
+		{name: "Load a dataset", table: "words", data: `[{"key": 1, "value": "a"}, {"key": 2, "other": {"a": 1}}]`},
+		{name: "Wrong table name", table: "words; DROP TABLE words", data: `[{"key": 1}]`, wantErr: true},
+		{name: "Wrong column name", table: "columns", data: `[{"key value": 1}]`, wantErr: true},
+		{name: "Empty dataset", table: "empty", data: `[]`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			datasets := []*DatasetDTO{{
+				Dataset: &pb.Dataset{
+					Type:        pb.EmulatorType_EMULATOR_TYPE_JDBC,
+					DatasetPath: jsonDatasetPath,
+					Options:     map[string]string{constants.TopicNameKey: tt.table},
+				},
+				Data: []byte(tt.data),
+			}}
+			err := NewJDBCMockCluster(databasePath).ProduceDatasets(datasets)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ProduceDatasets() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	db, err := sql.Open(sqliteDriver, databasePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	var key int64
+	var other, value sql.NullString
+	if err = db.QueryRow("SELECT key, other, value FROM words WHERE key = 2").Scan(&key, &other, &value); err != nil {
+		t.Fatal(err)
+	}
+	if other.String != `{"a":1}` || value.Valid {
+		t.Errorf("ProduceDatasets() loaded other = %v, value = %v", other, value)
+	}
+}
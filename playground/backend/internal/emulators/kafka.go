@@ -27,6 +27,7 @@ import (
 	"strings"
 	"time"
 
+	pb "beam.apache.org/playground/backend/internal/api/v1"
 	"beam.apache.org/playground/backend/internal/constants"
 	"beam.apache.org/playground/backend/internal/logger"
 	"github.com/confluentinc/confluent-kafka-go/kafka"
@@ -43,6 +44,10 @@ const (
 	avroExt            = ".avro"
 )
 
+func init() {
+	RegisterEmulator(pb.EmulatorType_EMULATOR_TYPE_KAFKA, newKafkaEmulator)
+}
+
 type KafkaMockCluster struct {
 	cmd                *exec.Cmd
 	host               string
@@ -50,6 +55,32 @@ type KafkaMockCluster struct {
 	preparerParameters map[string]string
 }
 
+// newKafkaEmulator starts a Kafka mock cluster and produces the datasets to its topics
+func newKafkaEmulator(configuration EmulatorConfiguration, datasets []*DatasetDTO) (EmulatorMockCluster, error) {
+	if configuration.KafkaEmulatorExecutablePath == "" {
+		return nil, errors.New("kafka emulator executable path is empty")
+	}
+	kafkaMockCluster, err := NewKafkaMockCluster(configuration.KafkaEmulatorExecutablePath)
+	if err != nil {
+		return nil, err
+	}
+	kafkaMockCluster.preparerParameters[constants.BootstrapServerKey] = kafkaMockCluster.GetAddress()
+
+	producer, err := NewKafkaProducer(kafkaMockCluster)
+	if err != nil {
+		logger.Errorf("failed to create a producer, %v", err)
+		kafkaMockCluster.Stop()
+		return nil, err
+	}
+	if err = producer.ProduceDatasets(datasets); err != nil {
+		logger.Errorf("failed to produce a dataset, %v", err)
+		kafkaMockCluster.Stop()
+		return nil, err
+	}
+	addDatasetOptions(kafkaMockCluster.preparerParameters, datasets)
+	return kafkaMockCluster, nil
+}
+
 func NewKafkaMockCluster(emulatorExecutablePath string) (*KafkaMockCluster, error) {
 	cmd := exec.Command("java", "-jar", emulatorExecutablePath)
 	stdout, err := cmd.StdoutPipe()
@@ -0,0 +1,119 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package emulators
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"cloud.google.com/go/pubsub/pstest"
+	pubsubpb "google.golang.org/genproto/googleapis/pubsub/v1"
+
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/constants"
+	"beam.apache.org/playground/backend/internal/logger"
+)
+
+const (
+	pubSubProjectId           = "playground"
+	pubSubTopicPattern        = "projects/%s/topics/%s"
+	pubSubSubscriptionPattern = "projects/%s/subscriptions/%s"
+	pubSubAckDeadlineSeconds  = 10
+)
+
+func init() {
+	RegisterEmulator(pb.EmulatorType_EMULATOR_TYPE_PUBSUB, newPubSubEmulator)
+}
+
+// PubSubMockCluster is an in-process Pub/Sub fake.
+// Each dataset is published to the topic "projects/playground/topics/{topic}" which has the
+// subscription "projects/playground/subscriptions/{topic}" created before publishing.
+type PubSubMockCluster struct {
+	server             *pstest.Server
+	preparerParameters map[string]string
+}
+
+// newPubSubEmulator starts a Pub/Sub fake and publishes the datasets to its topics
+func newPubSubEmulator(_ EmulatorConfiguration, datasets []*DatasetDTO) (EmulatorMockCluster, error) {
+	pubSubMockCluster := NewPubSubMockCluster()
+	if err := pubSubMockCluster.ProduceDatasets(datasets); err != nil {
+		logger.Errorf("failed to publish a dataset, %v", err)
+		pubSubMockCluster.Stop()
+		return nil, err
+	}
+	pubSubMockCluster.preparerParameters[constants.PubSubEmulatorHostKey] = pubSubMockCluster.GetAddress()
+	addDatasetOptions(pubSubMockCluster.preparerParameters, datasets)
+	return pubSubMockCluster, nil
+}
+
+func NewPubSubMockCluster() *PubSubMockCluster {
+	return &PubSubMockCluster{
+		server:             pstest.NewServer(),
+		preparerParameters: make(map[string]string),
+	}
+}
+
+func (pmc *PubSubMockCluster) ProduceDatasets(datasets []*DatasetDTO) error {
+	ctx := context.Background()
+	for _, dataset := range datasets {
+		topicName := *getTopic(dataset)
+		if topicName == "" {
+			return errors.New("topic of the dataset is empty")
+		}
+		topic := fmt.Sprintf(pubSubTopicPattern, pubSubProjectId, topicName)
+		if _, err := pmc.server.GServer.CreateTopic(ctx, &pubsubpb.Topic{Name: topic}); err != nil {
+			return fmt.Errorf("failed to create a topic, err: %v", err)
+		}
+		subscription := &pubsubpb.Subscription{
+			Name:               fmt.Sprintf(pubSubSubscriptionPattern, pubSubProjectId, topicName),
+			Topic:              topic,
+			AckDeadlineSeconds: pubSubAckDeadlineSeconds,
+		}
+		if _, err := pmc.server.GServer.CreateSubscription(ctx, subscription); err != nil {
+			return fmt.Errorf("failed to create a subscription, err: %v", err)
+		}
+
+		entries, err := unmarshallDatasets(dataset)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			entryBytes, err := json.Marshal(entry)
+			if err != nil {
+				return fmt.Errorf("failed to marshal data, err: %v", err)
+			}
+			pmc.server.Publish(topic, entryBytes, nil)
+		}
+	}
+	return nil
+}
+
+func (pmc *PubSubMockCluster) Stop() {
+	logger.Infof("Stopping Pub/Sub emulator")
+	if err := pmc.server.Close(); err != nil {
+		logger.Errorf("Failed to stop Pub/Sub emulator: %v", err)
+	}
+}
+
+func (pmc *PubSubMockCluster) GetAddress() string {
+	return pmc.server.Addr
+}
+
+func (pmc *PubSubMockCluster) GetPreparerParameters() map[string]string {
+	return pmc.preparerParameters
+}
@@ -25,11 +25,13 @@ type NetworkEnvs struct {
 	ip       string
 	port     int
 	protocol string
+	// trustedProxyHops is the number of proxies in front of the server which append to the X-Forwarded-For header
+	trustedProxyHops int
 }
 
 // NewNetworkEnvs constructor for NetworkEnvs
-func NewNetworkEnvs(ip string, port int, protocol string) *NetworkEnvs {
-	return &NetworkEnvs{ip: ip, port: port, protocol: protocol}
+func NewNetworkEnvs(ip string, port int, protocol string, trustedProxyHops int) *NetworkEnvs {
+	return &NetworkEnvs{ip: ip, port: port, protocol: protocol, trustedProxyHops: trustedProxyHops}
 }
 
 // Address returns concatenated ip and port through ':'
@@ -42,6 +44,11 @@ func (serverEnvs *NetworkEnvs) Protocol() string {
 	return serverEnvs.protocol
 }
 
+// TrustedProxyHops returns the number of trusted proxies in front of the server
+func (serverEnvs *NetworkEnvs) TrustedProxyHops() int {
+	return serverEnvs.trustedProxyHops
+}
+
 // CacheEnvs contains all environment variables that needed to use cache
 type CacheEnvs struct {
 	// cacheType is type of cache (local/redis)
@@ -78,6 +85,250 @@ func NewCacheEnvs(cacheType, cacheAddress string, cacheExpirationTime time.Durat
 	}
 }
 
+// DatabaseEnvs contains all environment variables that needed to use database
+type DatabaseEnvs struct {
+	// databaseType is type of database (datastore/postgres/sqlite)
+	databaseType string
+
+	// dataSourceName is a driver-specific string to connect to postgres and sqlite databases
+	dataSourceName string
+}
+
+// DatabaseType returns database type
+func (de *DatabaseEnvs) DatabaseType() string {
+	return de.databaseType
+}
+
+// DataSourceName returns data source name to connect to SQL database
+func (de *DatabaseEnvs) DataSourceName() string {
+	return de.dataSourceName
+}
+
+// NewDatabaseEnvs constructor for DatabaseEnvs
+func NewDatabaseEnvs(databaseType, dataSourceName string) *DatabaseEnvs {
+	return &DatabaseEnvs{
+		databaseType:   databaseType,
+		dataSourceName: dataSourceName,
+	}
+}
+
+// SandboxEnvs contains all environment variables that needed to isolate pipeline runs
+type SandboxEnvs struct {
+	// sandboxType is type of sandbox (process/cgroup)
+	sandboxType string
+
+	// cgroupRoot is a cgroup v2 directory under which a cgroup is created for each run
+	cgroupRoot string
+
+	// cpuLimit is a number of CPUs available to a run, 0 means no limit
+	cpuLimit float64
+
+	// memoryLimit is a memory limit of a run in bytes, 0 means no limit
+	memoryLimit int64
+
+	// processLimit is a maximum number of processes of a run, 0 means no limit
+	processLimit int64
+
+	// diskLimit is a maximum size of the pipeline folder in bytes, 0 means no limit.
+	// Only the pipeline folder, including TMPDIR of the run, is counted, files written elsewhere aren't restricted
+	diskLimit int64
+
+	// denyNetwork disables network access of a run except for allowedAddresses and emulators
+	denyNetwork bool
+
+	// allowedAddresses are host:port addresses available to a run when network is denied
+	allowedAddresses []string
+}
+
+// SandboxType returns sandbox type
+func (se *SandboxEnvs) SandboxType() string {
+	return se.sandboxType
+}
+
+// CgroupRoot returns the parent cgroup of all runs
+func (se *SandboxEnvs) CgroupRoot() string {
+	return se.cgroupRoot
+}
+
+// CPULimit returns a number of CPUs available to a run
+func (se *SandboxEnvs) CPULimit() float64 {
+	return se.cpuLimit
+}
+
+// MemoryLimit returns a memory limit of a run in bytes
+func (se *SandboxEnvs) MemoryLimit() int64 {
+	return se.memoryLimit
+}
+
+// ProcessLimit returns a maximum number of processes of a run
+func (se *SandboxEnvs) ProcessLimit() int64 {
+	return se.processLimit
+}
+
+// DiskLimit returns a maximum size of the pipeline folder in bytes
+func (se *SandboxEnvs) DiskLimit() int64 {
+	return se.diskLimit
+}
+
+// DenyNetwork returns true if network access of a run should be disabled
+func (se *SandboxEnvs) DenyNetwork() bool {
+	return se.denyNetwork
+}
+
+// AllowedAddresses returns addresses available to a run when network is denied
+func (se *SandboxEnvs) AllowedAddresses() []string {
+	return se.allowedAddresses
+}
+
+// NewSandboxEnvs constructor for SandboxEnvs
+func NewSandboxEnvs(sandboxType, cgroupRoot string, cpuLimit float64, memoryLimit, processLimit, diskLimit int64, denyNetwork bool, allowedAddresses []string) *SandboxEnvs {
+	return &SandboxEnvs{
+		sandboxType:      sandboxType,
+		cgroupRoot:       cgroupRoot,
+		cpuLimit:         cpuLimit,
+		memoryLimit:      memoryLimit,
+		processLimit:     processLimit,
+		diskLimit:        diskLimit,
+		denyNetwork:      denyNetwork,
+		allowedAddresses: allowedAddresses,
+	}
+}
+
+// QueueEnvs contains all environment variables that needed to queue pipeline runs
+type QueueEnvs struct {
+	// maxConcurrentRuns is a maximum number of runs processed by the instance at the same time, 0 means the number of parallel jobs
+	maxConcurrentRuns int
+
+	// maxClusterConcurrentRuns is a maximum number of runs processed by all instances at the same time, 0 means no limit
+	maxClusterConcurrentRuns int
+
+	// queueTimeout is a maximum time a run waits in the queue
+	queueTimeout time.Duration
+}
+
+// MaxConcurrentRuns returns a maximum number of runs processed by the instance at the same time
+func (qe *QueueEnvs) MaxConcurrentRuns() int {
+	return qe.maxConcurrentRuns
+}
+
+// MaxClusterConcurrentRuns returns a maximum number of runs processed by all instances at the same time
+func (qe *QueueEnvs) MaxClusterConcurrentRuns() int {
+	return qe.maxClusterConcurrentRuns
+}
+
+// QueueTimeout returns a maximum time a run waits in the queue
+func (qe *QueueEnvs) QueueTimeout() time.Duration {
+	return qe.queueTimeout
+}
+
+// NewQueueEnvs constructor for QueueEnvs
+func NewQueueEnvs(maxConcurrentRuns, maxClusterConcurrentRuns int, queueTimeout time.Duration) *QueueEnvs {
+	return &QueueEnvs{
+		maxConcurrentRuns:        maxConcurrentRuns,
+		maxClusterConcurrentRuns: maxClusterConcurrentRuns,
+		queueTimeout:             queueTimeout,
+	}
+}
+
+// RateLimitQuota is the max number of requests of a client in a time window
+type RateLimitQuota struct {
+	// Requests is the max number of requests in the window
+	Requests int
+
+	// Window is the length of the window
+	Window time.Duration
+}
+
+// RateLimitEnvs contains all environment variables that needed to protect the backend from abusive clients
+type RateLimitEnvs struct {
+	// quotas are the quotas of clients by the names of the RPCs, the quota by "*" is used for the rest of the RPCs
+	quotas map[string]RateLimitQuota
+
+	// maxCodeSize is the max size of the code of a request in bytes, 0 means no limit
+	maxCodeSize int
+
+	// apiTokens are the tokens identifying clients instead of their IP addresses
+	apiTokens []string
+}
+
+// Quota returns the quota of the RPC with the name and true, or false if the RPC isn't limited
+func (re *RateLimitEnvs) Quota(rpcName string) (RateLimitQuota, bool) {
+	if quota, ok := re.quotas[rpcName]; ok {
+		return quota, true
+	}
+	quota, ok := re.quotas[allRpcsQuotaName]
+	return quota, ok
+}
+
+// MaxCodeSize returns the max size of the code of a request in bytes
+func (re *RateLimitEnvs) MaxCodeSize() int {
+	return re.maxCodeSize
+}
+
+// APITokens returns the tokens identifying clients instead of their IP addresses
+func (re *RateLimitEnvs) APITokens() []string {
+	return re.apiTokens
+}
+
+// NewRateLimitEnvs constructor for RateLimitEnvs
+func NewRateLimitEnvs(quotas map[string]RateLimitQuota, maxCodeSize int, apiTokens []string) *RateLimitEnvs {
+	return &RateLimitEnvs{
+		quotas:      quotas,
+		maxCodeSize: maxCodeSize,
+		apiTokens:   apiTokens,
+	}
+}
+
+// VerificationEnvs contains all environment variables that needed to verify the catalog examples against their golden outputs
+type VerificationEnvs struct {
+	// reportPath is a path of the verification report, the verification mode is disabled if it's empty
+	reportPath string
+
+	// reportFormat is a format of the verification report: junit or json
+	reportFormat string
+
+	// orderInsensitive is used to compare the lines of the outputs regardless of their order
+	orderInsensitive bool
+
+	// maskPatterns are regular expressions of the volatile parts of the outputs which are ignored during comparison
+	maskPatterns []string
+}
+
+// Enabled returns true if the backend should verify the catalog examples instead of serving requests
+func (ve *VerificationEnvs) Enabled() bool {
+	return ve.reportPath != ""
+}
+
+// ReportPath returns a path of the verification report
+func (ve *VerificationEnvs) ReportPath() string {
+	return ve.reportPath
+}
+
+// ReportFormat returns a format of the verification report
+func (ve *VerificationEnvs) ReportFormat() string {
+	return ve.reportFormat
+}
+
+// OrderInsensitive returns true if the lines of the outputs are compared regardless of their order
+func (ve *VerificationEnvs) OrderInsensitive() bool {
+	return ve.orderInsensitive
+}
+
+// MaskPatterns returns regular expressions of the volatile parts of the outputs
+func (ve *VerificationEnvs) MaskPatterns() []string {
+	return ve.maskPatterns
+}
+
+// NewVerificationEnvs constructor for VerificationEnvs
+func NewVerificationEnvs(reportPath, reportFormat string, orderInsensitive bool, maskPatterns []string) *VerificationEnvs {
+	return &VerificationEnvs{
+		reportPath:       reportPath,
+		reportFormat:     reportFormat,
+		orderInsensitive: orderInsensitive,
+		maskPatterns:     maskPatterns,
+	}
+}
+
 // ApplicationEnvs contains all environment variables that needed to run backend processes
 type ApplicationEnvs struct {
 	// workingDir is a root working directory of application.
@@ -88,6 +339,15 @@ type ApplicationEnvs struct {
 	// cacheEnvs contains environment variables for cache
 	cacheEnvs *CacheEnvs
 
+	// databaseEnvs contains environment variables for database
+	databaseEnvs *DatabaseEnvs
+
+	// sandboxEnvs contains environment variables for isolation of pipeline runs
+	sandboxEnvs *SandboxEnvs
+
+	// queueEnvs contains environment variables for queueing of pipeline runs
+	queueEnvs *QueueEnvs
+
 	// pipelineExecuteTimeout is timeout for code processing
 	pipelineExecuteTimeout time.Duration
 
@@ -142,11 +402,17 @@ func NewApplicationEnvs(
 	putSnippetFunctionsUrl,
 	incrementSnippetViewsFunctionsUrl string,
 	cacheEnvs *CacheEnvs,
+	databaseEnvs *DatabaseEnvs,
+	sandboxEnvs *SandboxEnvs,
+	queueEnvs *QueueEnvs,
 	pipelineExecuteTimeout, cacheRequestTimeout time.Duration,
 ) *ApplicationEnvs {
 	return &ApplicationEnvs{
 		workingDir:                        workingDir,
 		cacheEnvs:                         cacheEnvs,
+		databaseEnvs:                      databaseEnvs,
+		sandboxEnvs:                       sandboxEnvs,
+		queueEnvs:                         queueEnvs,
 		pipelineExecuteTimeout:            pipelineExecuteTimeout,
 		launchSite:                        launchSite,
 		projectId:                         projectId,
@@ -172,6 +438,21 @@ func (ae *ApplicationEnvs) CacheEnvs() *CacheEnvs {
 	return ae.cacheEnvs
 }
 
+// DatabaseEnvs returns database environments
+func (ae *ApplicationEnvs) DatabaseEnvs() *DatabaseEnvs {
+	return ae.databaseEnvs
+}
+
+// SandboxEnvs returns sandbox environments
+func (ae *ApplicationEnvs) SandboxEnvs() *SandboxEnvs {
+	return ae.sandboxEnvs
+}
+
+// QueueEnvs returns queue environments
+func (ae *ApplicationEnvs) QueueEnvs() *QueueEnvs {
+	return ae.queueEnvs
+}
+
 // PipelineExecuteTimeout returns timeout for code processing
 func (ae *ApplicationEnvs) PipelineExecuteTimeout() time.Duration {
 	return ae.pipelineExecuteTimeout
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
+	"net"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -41,10 +42,33 @@ const (
 	numOfParallelJobsKey                     = "NUM_PARALLEL_JOBS"
 	cacheTypeKey                             = "CACHE_TYPE"
 	cacheAddressKey                          = "CACHE_ADDRESS"
+	databaseTypeKey                          = "DATABASE_TYPE"
+	databaseDataSourceNameKey                = "DATABASE_DSN"
+	sandboxTypeKey                           = "SANDBOX_TYPE"
+	sandboxCgroupRootKey                     = "SANDBOX_CGROUP_ROOT"
+	sandboxCPULimitKey                       = "SANDBOX_CPU_LIMIT"
+	sandboxMemoryLimitKey                    = "SANDBOX_MEMORY_LIMIT_MB"
+	sandboxProcessLimitKey                   = "SANDBOX_PROCESS_LIMIT"
+	sandboxDiskLimitKey                      = "SANDBOX_DISK_LIMIT_MB"
+	sandboxDenyNetworkKey                    = "SANDBOX_DENY_NETWORK"
+	sandboxAllowedAddressesKey               = "SANDBOX_ALLOWED_ADDRESSES"
+	maxConcurrentRunsKey                     = "MAX_CONCURRENT_RUNS"
+	maxClusterConcurrentRunsKey              = "MAX_CLUSTER_CONCURRENT_RUNS"
+	queueTimeoutKey                          = "QUEUE_TIMEOUT"
+	rateLimitQuotasKey                       = "RATE_LIMIT_QUOTAS"
+	maxCodeSizeKey                           = "MAX_CODE_SIZE"
+	apiTokensKey                             = "API_TOKENS"
+	allRpcsQuotaName                         = "*"
+	verificationReportPathKey                = "VERIFICATION_REPORT_PATH"
+	verificationReportFormatKey              = "VERIFICATION_REPORT_FORMAT"
+	verificationOrderInsensitiveKey          = "VERIFICATION_ORDER_INSENSITIVE"
+	verificationMaskPatternsKey              = "VERIFICATION_MASK_PATTERNS"
+	verificationMaskPatternsSeparator        = ";"
 	beamPathKey                              = "BEAM_PATH"
 	cacheKeyExpirationTimeKey                = "KEY_EXPIRATION_TIME"
 	pipelineExecuteTimeoutKey                = "PIPELINE_EXPIRATION_TIMEOUT"
 	protocolTypeKey                          = "PROTOCOL_TYPE"
+	trustedProxyHopsKey                      = "TRUSTED_PROXY_HOPS"
 	launchSiteKey                            = "LAUNCH_SITE"
 	projectIdKey                             = "GOOGLE_CLOUD_PROJECT"
 	pipelinesFolderKey                       = "PIPELINES_FOLDER_NAME"
@@ -60,6 +84,12 @@ const (
 	defaultKafkaEmulatorExecutablePath       = "/opt/playground/backend/kafka-emulator/beam-playground-kafka-emulator.jar"
 	defaultCacheType                         = "local"
 	defaultCacheAddress                      = "localhost:6379"
+	defaultDatabaseType                      = "datastore"
+	defaultSandboxType                       = "process"
+	defaultSandboxCgroupRoot                 = "/sys/fs/cgroup/playground"
+	bytesInMegabyte                          = 1024 * 1024
+	defaultQueueTimeout                      = time.Minute * 5
+	defaultVerificationReportFormat          = "junit"
 	defaultCacheKeyExpirationTime            = time.Minute * 15
 	defaultPipelineExecuteTimeout            = time.Minute * 10
 	jsonExt                                  = ".json"
@@ -111,6 +141,9 @@ func NewEnvironment(networkEnvs NetworkEnvs, beamEnvs BeamEnvs, appEnvs Applicat
 //   - cache expiration time: 15 minutes
 //   - type of cache: local
 //   - cache address: localhost:6379
+//   - type of sandbox: process, without resource limits and network restrictions
+//   - concurrent runs: limited by the number of parallel jobs of the instance, unlimited for the cluster
+//   - queue timeout: 5 minutes
 //
 // If os environment variables don't contain a value for app working dir - returns error.
 func GetApplicationEnvsFromOsEnvs() (*ApplicationEnvs, error) {
@@ -118,6 +151,13 @@ func GetApplicationEnvsFromOsEnvs() (*ApplicationEnvs, error) {
 	cacheExpirationTime := getEnvAsDuration(cacheKeyExpirationTimeKey, defaultCacheKeyExpirationTime, "couldn't convert provided cache expiration time. Using default %s\n")
 	cacheType := getEnv(cacheTypeKey, defaultCacheType)
 	cacheAddress := getEnv(cacheAddressKey, defaultCacheAddress)
+	databaseType := getEnv(databaseTypeKey, defaultDatabaseType)
+	databaseDataSourceName := os.Getenv(databaseDataSourceNameKey)
+	sandboxEnvs, err := getSandboxEnvsFromOsEnvs()
+	if err != nil {
+		return nil, err
+	}
+	queueEnvs := getQueueEnvsFromOsEnvs()
 	launchSite := getEnv(launchSiteKey, defaultLaunchSite)
 	projectId := os.Getenv(projectIdKey)
 	pipelinesFolder := getEnv(pipelinesFolderKey, defaultPipelinesFolder)
@@ -148,6 +188,12 @@ func GetApplicationEnvsFromOsEnvs() (*ApplicationEnvs, error) {
 				cacheAddress,
 				cacheExpirationTime,
 			),
+			NewDatabaseEnvs(
+				databaseType,
+				databaseDataSourceName,
+			),
+			sandboxEnvs,
+			queueEnvs,
 			pipelineExecuteTimeout,
 			cacheRequestTimeout,
 		), nil
@@ -155,11 +201,135 @@ func GetApplicationEnvsFromOsEnvs() (*ApplicationEnvs, error) {
 	return nil, errors.New("APP_WORK_DIR env should be provided with os.env")
 }
 
+// getSandboxEnvsFromOsEnvs returns SandboxEnvs.
+// Lookups in os environment variables and takes values for the sandbox type and limits of pipeline runs.
+// Limits which aren't provided are disabled.
+// If the allowed addresses contain a non-loopback address - returns error.
+func getSandboxEnvsFromOsEnvs() (*SandboxEnvs, error) {
+	var allowedAddresses []string
+	for _, address := range strings.Split(os.Getenv(sandboxAllowedAddressesKey), ",") {
+		if address = strings.TrimSpace(address); address == "" {
+			continue
+		}
+		if err := checkLoopbackAddress(address); err != nil {
+			return nil, fmt.Errorf("%s env contains incorrect address: %s", sandboxAllowedAddressesKey, err.Error())
+		}
+		allowedAddresses = append(allowedAddresses, address)
+	}
+	return NewSandboxEnvs(
+		getEnv(sandboxTypeKey, defaultSandboxType),
+		getEnv(sandboxCgroupRootKey, defaultSandboxCgroupRoot),
+		getEnvAsFloat(sandboxCPULimitKey, 0),
+		int64(getEnvAsInt(sandboxMemoryLimitKey, 0))*bytesInMegabyte,
+		int64(getEnvAsInt(sandboxProcessLimitKey, 0)),
+		int64(getEnvAsInt(sandboxDiskLimitKey, 0))*bytesInMegabyte,
+		getEnvAsBool(sandboxDenyNetworkKey, false),
+		allowedAddresses,
+	), nil
+}
+
+// checkLoopbackAddress returns error if the address isn't a host:port address of the loopback interface.
+// Runs have only a loopback interface in their network namespace, where the allowed addresses are forwarded
+// from the loopback interface of the server with the same host and port.
+func checkLoopbackAddress(address string) error {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if _, err = strconv.ParseUint(port, 10, 16); err != nil {
+		return fmt.Errorf("port of %s should be a number", address)
+	}
+	if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+		return fmt.Errorf("%s isn't a loopback address", address)
+	}
+	return nil
+}
+
+// getQueueEnvsFromOsEnvs returns QueueEnvs.
+// Lookups in os environment variables and takes values for the concurrency limits of pipeline runs and the queue timeout.
+func getQueueEnvsFromOsEnvs() *QueueEnvs {
+	return NewQueueEnvs(
+		getEnvAsInt(maxConcurrentRunsKey, 0),
+		getEnvAsInt(maxClusterConcurrentRunsKey, 0),
+		getEnvAsDuration(queueTimeoutKey, defaultQueueTimeout, "couldn't convert provided queue timeout. Using default %s\n"),
+	)
+}
+
+// GetRateLimitEnvsFromOsEnvs returns RateLimitEnvs.
+// Lookups in os environment variables and takes values for the quotas of the RPCs, the max code size and the API tokens.
+// The quotas are separated by semicolons and look like "RunCode=10/1m", where "*" instead of the name of the RPC
+// sets the quota of the rest of the RPCs. The API tokens are separated by commas.
+// Quotas and limits which aren't provided or are incorrect are disabled.
+func GetRateLimitEnvsFromOsEnvs() *RateLimitEnvs {
+	quotas := make(map[string]RateLimitQuota)
+	for _, quota := range strings.Split(os.Getenv(rateLimitQuotasKey), ";") {
+		if quota = strings.TrimSpace(quota); quota == "" {
+			continue
+		}
+		rpcName, rateLimitQuota, err := parseRateLimitQuota(quota)
+		if err != nil {
+			logger.Errorf("Incorrect value for %s: %s. The quota will be skipped", rateLimitQuotasKey, err.Error())
+			continue
+		}
+		quotas[rpcName] = rateLimitQuota
+	}
+	var apiTokens []string
+	for _, token := range strings.Split(os.Getenv(apiTokensKey), ",") {
+		if token = strings.TrimSpace(token); token != "" {
+			apiTokens = append(apiTokens, token)
+		}
+	}
+	return NewRateLimitEnvs(quotas, getEnvAsInt(maxCodeSizeKey, 0), apiTokens)
+}
+
+// parseRateLimitQuota parses the quota of the RPC like "RunCode=10/1m"
+func parseRateLimitQuota(quota string) (string, RateLimitQuota, error) {
+	rpcName, limit, found := strings.Cut(quota, "=")
+	if !found || rpcName == "" {
+		return "", RateLimitQuota{}, fmt.Errorf("quota %q should look like <rpc>=<requests>/<window>", quota)
+	}
+	requests, window, found := strings.Cut(limit, "/")
+	if !found {
+		return "", RateLimitQuota{}, fmt.Errorf("quota %q should look like <rpc>=<requests>/<window>", quota)
+	}
+	requestsNumber, err := strconv.Atoi(requests)
+	if err != nil || requestsNumber <= 0 {
+		return "", RateLimitQuota{}, fmt.Errorf("number of requests of quota %q should be a positive integer", quota)
+	}
+	windowDuration, err := time.ParseDuration(window)
+	if err != nil || windowDuration <= 0 {
+		return "", RateLimitQuota{}, fmt.Errorf("window of quota %q should be a positive duration", quota)
+	}
+	return strings.TrimSpace(rpcName), RateLimitQuota{Requests: requestsNumber, Window: windowDuration}, nil
+}
+
+// GetVerificationEnvsFromOsEnvs returns VerificationEnvs.
+// Lookups in os environment variables and takes values for the verification of the catalog examples.
+// The mask patterns are separated by semicolons. In case some value doesn't exist sets default values:
+//   - report path: empty, i.e. the verification mode is disabled
+//   - report format: junit
+//   - order insensitive: false
+func GetVerificationEnvsFromOsEnvs() *VerificationEnvs {
+	var maskPatterns []string
+	for _, pattern := range strings.Split(os.Getenv(verificationMaskPatternsKey), verificationMaskPatternsSeparator) {
+		if pattern != "" {
+			maskPatterns = append(maskPatterns, pattern)
+		}
+	}
+	return NewVerificationEnvs(
+		os.Getenv(verificationReportPathKey),
+		getEnv(verificationReportFormatKey, defaultVerificationReportFormat),
+		getEnvAsBool(verificationOrderInsensitiveKey, false),
+		maskPatterns,
+	)
+}
+
 // GetNetworkEnvsFromOsEnvs returns NetworkEnvs.
-// Lookups in os environment variables and takes values for ip and port.
+// Lookups in os environment variables and takes values for ip, port, protocol and trusted proxy hops.
 // In case some value doesn't exist sets default values:
 //   - ip:	localhost
 //   - port: 8080
+//   - trusted proxy hops: 0, the X-Forwarded-For header isn't trusted
 func GetNetworkEnvsFromOsEnvs() (*NetworkEnvs, error) {
 	ip := getEnv(serverIpKey, defaultIp)
 	port := defaultPort
@@ -171,7 +341,7 @@ func GetNetworkEnvsFromOsEnvs() (*NetworkEnvs, error) {
 			return nil, err
 		}
 	}
-	return NewNetworkEnvs(ip, port, protocol), nil
+	return NewNetworkEnvs(ip, port, protocol, getEnvAsInt(trustedProxyHopsKey, 0)), nil
 }
 
 // ConfigureBeamEnvs returns BeamEnvs.
@@ -199,6 +369,8 @@ func ConfigureBeamEnvs(workDir string) (*BeamEnvs, error) {
 			sdk = pb.Sdk_SDK_PYTHON
 		case pb.Sdk_SDK_SCIO.String():
 			sdk = pb.Sdk_SDK_SCIO
+		case pb.Sdk_SDK_TYPESCRIPT.String():
+			sdk = pb.Sdk_SDK_TYPESCRIPT
 		}
 	}
 	if sdk == pb.Sdk_SDK_UNSPECIFIED {
@@ -234,6 +406,8 @@ func createExecutorConfig(apacheBeamSdk pb.Sdk, configPath string) (*ExecutorCon
 		// Python sdk doesn't need any additional arguments from the config file
 	case pb.Sdk_SDK_SCIO:
 		// Scala sdk doesn't need any additional arguments from the config file
+	case pb.Sdk_SDK_TYPESCRIPT:
+		// TypeScript sdk doesn't need any additional arguments from the config file
 	}
 	return executorConfig, nil
 }
@@ -288,6 +462,32 @@ func getEnvAsInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+// getEnvAsFloat returns an environment variable or default value as a positive float
+func getEnvAsFloat(key string, defaultValue float64) float64 {
+	if value, present := os.LookupEnv(key); present {
+		convertedValue, err := strconv.ParseFloat(value, 64)
+		if err != nil || convertedValue <= 0 {
+			logger.Errorf("Incorrect value for %s. Should be a positive number. Will be used default value: %v", key, defaultValue)
+			return defaultValue
+		}
+		return convertedValue
+	}
+	return defaultValue
+}
+
+// getEnvAsBool returns an environment variable or default value as boolean
+func getEnvAsBool(key string, defaultValue bool) bool {
+	if value, present := os.LookupEnv(key); present {
+		convertedValue, err := strconv.ParseBool(value)
+		if err != nil {
+			logger.Errorf("Incorrect value for %s. Should be boolean. Will be used default value: %t", key, defaultValue)
+			return defaultValue
+		}
+		return convertedValue
+	}
+	return defaultValue
+}
+
 // getEnvAsDuration returns an environment variable or default value as duration
 func getEnvAsDuration(key string, defaultValue time.Duration, errMsg string) time.Duration {
 	if value, present := os.LookupEnv(key); present {
@@ -31,6 +31,7 @@ const (
 	javaConfig       = "{\n  \"compile_cmd\": \"javac\",\n  \"run_cmd\": \"java\",\n  \"test_cmd\": \"java\",\n  \"compile_args\": [\n    \"-d\",\n    \"bin\",\n    \"-parameters\",\n    \"-classpath\"\n  ],\n  \"run_args\": [\n    \"-cp\",\n    \"bin:\"\n  ],\n  \"test_args\": [\n    \"-cp\",\n    \"bin:\",\n    \"org.junit.runner.JUnitCore\"\n  ]\n}"
 	goConfig         = "{\n  \"compile_cmd\": \"go\",\n  \"run_cmd\": \"\",\n  \"test_cmd\": \"go\",\n  \"compile_args\": [\n    \"build\",\n    \"-o\",\n    \"bin\"\n  ],\n  \"run_args\": [\n  ],\n  \"test_args\": [\n    \"test\",\n    \"-v\"\n  ]\n}\n"
 	pythonConfig     = "{\n  \"compile_cmd\": \"\",\n  \"run_cmd\": \"python3\",\n  \"test_cmd\": \"pytest\",\n  \"compile_args\": [],\n  \"run_args\": [],\n  \"test_args\": []\n}\n"
+	typeScriptConfig = "{\n  \"compile_cmd\": \"tsc\",\n  \"run_cmd\": \"node\",\n  \"test_cmd\": \"mocha\",\n  \"compile_args\": [\n    \"--outDir\",\n    \"bin\"\n  ],\n  \"run_args\": [],\n  \"test_args\": []\n}\n"
 	scioConfig       = "{\n  \"compile_cmd\": \"\",\n  \"run_cmd\": \"sbt\",\n  \"test_cmd\": \"sbt\",\n  \"compile_args\": [],\n  \"run_args\": [\n    \"runMain\"\n  ],\n  \"test_args\": []\n}\n"
 	defaultProjectId = ""
 	dirPermission    = 0600
@@ -60,6 +61,8 @@ func setup() error {
 	err = os.WriteFile(pythonConfigPath, []byte(pythonConfig), dirPermission)
 	scioConfigPath := filepath.Join(configFolderName, pb.Sdk_SDK_SCIO.String()+jsonExt)
 	err = os.WriteFile(scioConfigPath, []byte(scioConfig), dirPermission)
+	typeScriptConfigPath := filepath.Join(configFolderName, pb.Sdk_SDK_TYPESCRIPT.String()+jsonExt)
+	err = os.WriteFile(typeScriptConfigPath, []byte(typeScriptConfig), dirPermission)
 	if err != nil {
 		return err
 	}
@@ -103,7 +106,7 @@ func TestNewEnvironment(t *testing.T) {
 		want *Environment
 	}{
 		{name: "Create env service with default envs", want: &Environment{
-			NetworkEnvs: *NewNetworkEnvs(defaultIp, defaultPort, defaultProtocol),
+			NetworkEnvs: *NewNetworkEnvs(defaultIp, defaultPort, defaultProtocol, 0),
 			BeamSdkEnvs: *NewBeamEnvs(defaultSdk, defaultBeamVersion, executorConfig, preparedModDir, 0),
 			ApplicationEnvs: *NewApplicationEnvs(
 				"/app",
@@ -122,6 +125,12 @@ func TestNewEnvironment(t *testing.T) {
 					defaultCacheAddress,
 					defaultCacheKeyExpirationTime,
 				},
+				&DatabaseEnvs{
+					defaultDatabaseType,
+					"",
+				},
+				NewSandboxEnvs(defaultSandboxType, defaultSandboxCgroupRoot, 0, 0, 0, 0, false, nil),
+				NewQueueEnvs(0, 0, defaultQueueTimeout),
 				defaultPipelineExecuteTimeout,
 				defaultCacheRequestTimeout,
 			),
@@ -130,7 +139,7 @@ func TestNewEnvironment(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			if got := NewEnvironment(
-				*NewNetworkEnvs(defaultIp, defaultPort, defaultProtocol),
+				*NewNetworkEnvs(defaultIp, defaultPort, defaultProtocol, 0),
 				*NewBeamEnvs(defaultSdk, defaultBeamVersion, executorConfig, preparedModDir, 0),
 				*NewApplicationEnvs(
 					"/app",
@@ -149,6 +158,12 @@ func TestNewEnvironment(t *testing.T) {
 						defaultCacheAddress,
 						defaultCacheKeyExpirationTime,
 					},
+					&DatabaseEnvs{
+						defaultDatabaseType,
+						"",
+					},
+					NewSandboxEnvs(defaultSandboxType, defaultSandboxCgroupRoot, 0, 0, 0, 0, false, nil),
+					NewQueueEnvs(0, 0, defaultQueueTimeout),
 					defaultPipelineExecuteTimeout,
 					defaultCacheRequestTimeout,
 				)); !reflect.DeepEqual(got, tt.want) {
@@ -219,12 +234,12 @@ func Test_getNetworkEnvsFromOsEnvs(t *testing.T) {
 	}{
 		{
 			name: "Default values",
-			want: NewNetworkEnvs(defaultIp, defaultPort, defaultProtocol),
+			want: NewNetworkEnvs(defaultIp, defaultPort, defaultProtocol, 0),
 		},
 		{
 			name:      "Values from os envs",
-			want:      NewNetworkEnvs("12.12.12.21", 1234, "TCP"),
-			envsToSet: map[string]string{serverIpKey: "12.12.12.21", serverPortKey: "1234", protocolTypeKey: "TCP"},
+			want:      NewNetworkEnvs("12.12.12.21", 1234, "TCP", 2),
+			envsToSet: map[string]string{serverIpKey: "12.12.12.21", serverPortKey: "1234", protocolTypeKey: "TCP", trustedProxyHopsKey: "2"},
 		},
 		{
 			name:      "Not int port in os env, should be default",
@@ -251,6 +266,250 @@ func Test_getNetworkEnvsFromOsEnvs(t *testing.T) {
 	os.Clearenv()
 }
 
+func Test_getSandboxEnvsFromOsEnvs(t *testing.T) {
+	tests := []struct {
+		name      string
+		want      *SandboxEnvs
+		envsToSet map[string]string
+		wantErr   bool
+	}{
+		{
+			name: "Default values",
+			want: NewSandboxEnvs(defaultSandboxType, defaultSandboxCgroupRoot, 0, 0, 0, 0, false, nil),
+		},
+		{
+			name: "Values from os envs",
+			want: NewSandboxEnvs("cgroup", "/sys/fs/cgroup/runs", 1.5, 512*bytesInMegabyte, 100, 64*bytesInMegabyte, true, []string{"127.0.0.1:9092", "127.0.0.1:8085"}),
+			envsToSet: map[string]string{
+				sandboxTypeKey:             "cgroup",
+				sandboxCgroupRootKey:       "/sys/fs/cgroup/runs",
+				sandboxCPULimitKey:         "1.5",
+				sandboxMemoryLimitKey:      "512",
+				sandboxProcessLimitKey:     "100",
+				sandboxDiskLimitKey:        "64",
+				sandboxDenyNetworkKey:      "true",
+				sandboxAllowedAddressesKey: "127.0.0.1:9092, 127.0.0.1:8085",
+			},
+		},
+		{
+			name: "Incorrect values in os envs, should be default",
+			want: NewSandboxEnvs(defaultSandboxType, defaultSandboxCgroupRoot, 0, 0, 0, 0, false, nil),
+			envsToSet: map[string]string{
+				sandboxCPULimitKey:     "-1",
+				sandboxMemoryLimitKey:  "1a",
+				sandboxDenyNetworkKey:  "maybe",
+				sandboxProcessLimitKey: "-5",
+			},
+		},
+		{
+			name: "Loopback allowed addresses",
+			want: NewSandboxEnvs(defaultSandboxType, defaultSandboxCgroupRoot, 0, 0, 0, 0, false, []string{"localhost:9092", "[::1]:8085"}),
+			envsToSet: map[string]string{
+				sandboxAllowedAddressesKey: "localhost:9092,[::1]:8085",
+			},
+		},
+		{
+			name: "Non-loopback allowed address",
+			envsToSet: map[string]string{
+				sandboxAllowedAddressesKey: "127.0.0.1:9092,kafka.example.com:9092",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Allowed address without port",
+			envsToSet: map[string]string{
+				sandboxAllowedAddressesKey: "127.0.0.1",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os.Clearenv()
+			if err := setOsEnvs(tt.envsToSet); err != nil {
+				t.Fatalf("couldn't setup os env")
+			}
+			got, err := getSandboxEnvsFromOsEnvs()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getSandboxEnvsFromOsEnvs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getSandboxEnvsFromOsEnvs() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	os.Clearenv()
+}
+
+func Test_getQueueEnvsFromOsEnvs(t *testing.T) {
+	tests := []struct {
+		name      string
+		want      *QueueEnvs
+		envsToSet map[string]string
+	}{
+		{
+			name: "Default values",
+			want: NewQueueEnvs(0, 0, defaultQueueTimeout),
+		},
+		{
+			name: "Values from os envs",
+			want: NewQueueEnvs(4, 50, time.Minute),
+			envsToSet: map[string]string{
+				maxConcurrentRunsKey:        "4",
+				maxClusterConcurrentRunsKey: "50",
+				queueTimeoutKey:             "1m",
+			},
+		},
+		{
+			name: "Incorrect values in os envs, should be default",
+			want: NewQueueEnvs(0, 0, defaultQueueTimeout),
+			envsToSet: map[string]string{
+				maxConcurrentRunsKey:        "-1",
+				maxClusterConcurrentRunsKey: "many",
+				queueTimeoutKey:             "1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os.Clearenv()
+			if err := setOsEnvs(tt.envsToSet); err != nil {
+				t.Fatalf("couldn't setup os env")
+			}
+			if got := getQueueEnvsFromOsEnvs(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getQueueEnvsFromOsEnvs() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	os.Clearenv()
+}
+
+func TestGetRateLimitEnvsFromOsEnvs(t *testing.T) {
+	tests := []struct {
+		name      string
+		want      *RateLimitEnvs
+		envsToSet map[string]string
+	}{
+		{
+			name: "Default values",
+			want: NewRateLimitEnvs(map[string]RateLimitQuota{}, 0, nil),
+		},
+		{
+			name: "Values from os envs",
+			want: NewRateLimitEnvs(map[string]RateLimitQuota{
+				"RunCode":     {Requests: 10, Window: time.Minute},
+				"SaveSnippet": {Requests: 20, Window: time.Hour},
+				"*":           {Requests: 600, Window: time.Minute},
+			}, 100000, []string{"token_1", "token_2"}),
+			envsToSet: map[string]string{
+				rateLimitQuotasKey: "RunCode=10/1m; SaveSnippet=20/1h;*=600/1m",
+				maxCodeSizeKey:     "100000",
+				apiTokensKey:       "token_1, token_2,",
+			},
+		},
+		{
+			name: "Incorrect values in os envs, should be skipped",
+			want: NewRateLimitEnvs(map[string]RateLimitQuota{
+				"RunCode": {Requests: 10, Window: time.Minute},
+			}, 0, nil),
+			envsToSet: map[string]string{
+				rateLimitQuotasKey: "RunCode=10/1m;SaveSnippet=20;GetLogs=-1/1m;GetGraph=1/1;=1/1m",
+				maxCodeSizeKey:     "big",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os.Clearenv()
+			if err := setOsEnvs(tt.envsToSet); err != nil {
+				t.Fatalf("couldn't setup os env")
+			}
+			if got := GetRateLimitEnvsFromOsEnvs(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetRateLimitEnvsFromOsEnvs() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	os.Clearenv()
+}
+
+func TestRateLimitEnvs_Quota(t *testing.T) {
+	tests := []struct {
+		name    string
+		quotas  map[string]RateLimitQuota
+		rpcName string
+		want    RateLimitQuota
+		wantOk  bool
+	}{
+		{
+			name:    "Quota of the RPC",
+			quotas:  map[string]RateLimitQuota{"RunCode": {Requests: 10, Window: time.Minute}, "*": {Requests: 100, Window: time.Minute}},
+			rpcName: "RunCode",
+			want:    RateLimitQuota{Requests: 10, Window: time.Minute},
+			wantOk:  true,
+		},
+		{
+			name:    "Quota of the rest of the RPCs",
+			quotas:  map[string]RateLimitQuota{"RunCode": {Requests: 10, Window: time.Minute}, "*": {Requests: 100, Window: time.Minute}},
+			rpcName: "GetLogs",
+			want:    RateLimitQuota{Requests: 100, Window: time.Minute},
+			wantOk:  true,
+		},
+		{
+			name:    "RPC isn't limited",
+			quotas:  map[string]RateLimitQuota{"RunCode": {Requests: 10, Window: time.Minute}},
+			rpcName: "GetLogs",
+			wantOk:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := NewRateLimitEnvs(tt.quotas, 0, nil).Quota(tt.rpcName)
+			if ok != tt.wantOk || got != tt.want {
+				t.Errorf("Quota() got = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestGetVerificationEnvsFromOsEnvs(t *testing.T) {
+	tests := []struct {
+		name      string
+		want      *VerificationEnvs
+		envsToSet map[string]string
+	}{
+		{
+			name: "Default values",
+			want: NewVerificationEnvs("", defaultVerificationReportFormat, false, nil),
+		},
+		{
+			name: "Values from os envs",
+			want: NewVerificationEnvs("/tmp/report.json", "json", true, []string{`\d+ms`, `[0-9a-f-]{36}`}),
+			envsToSet: map[string]string{
+				verificationReportPathKey:       "/tmp/report.json",
+				verificationReportFormatKey:     "json",
+				verificationOrderInsensitiveKey: "true",
+				verificationMaskPatternsKey:     `\d+ms;;[0-9a-f-]{36}`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os.Clearenv()
+			if err := setOsEnvs(tt.envsToSet); err != nil {
+				t.Fatalf("couldn't setup os env")
+			}
+			got := GetVerificationEnvsFromOsEnvs()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetVerificationEnvsFromOsEnvs() got = %v, want %v", got, tt.want)
+			}
+			if got.Enabled() != (tt.want.ReportPath() != "") {
+				t.Errorf("Enabled() got = %v, want %v", got.Enabled(), tt.want.ReportPath() != "")
+			}
+		})
+	}
+	os.Clearenv()
+}
+
 func Test_getApplicationEnvsFromOsEnvs(t *testing.T) {
 	hour := "1h"
 	convertedTime, _ := time.ParseDuration(hour)
@@ -279,6 +538,12 @@ func Test_getApplicationEnvsFromOsEnvs(t *testing.T) {
 					defaultCacheAddress,
 					defaultCacheKeyExpirationTime,
 				},
+				&DatabaseEnvs{
+					defaultDatabaseType,
+					"",
+				},
+				NewSandboxEnvs(defaultSandboxType, defaultSandboxCgroupRoot, 0, 0, 0, 0, false, nil),
+				NewQueueEnvs(0, 0, defaultQueueTimeout),
 				defaultPipelineExecuteTimeout,
 				defaultCacheRequestTimeout,
 			),
@@ -309,6 +574,12 @@ func Test_getApplicationEnvsFromOsEnvs(t *testing.T) {
 					defaultCacheAddress,
 					convertedTime,
 				},
+				&DatabaseEnvs{
+					defaultDatabaseType,
+					"",
+				},
+				NewSandboxEnvs(defaultSandboxType, defaultSandboxCgroupRoot, 0, 0, 0, 0, false, nil),
+				NewQueueEnvs(0, 0, defaultQueueTimeout),
 				defaultPipelineExecuteTimeout,
 				defaultCacheRequestTimeout),
 			wantErr:   false,
@@ -333,6 +604,12 @@ func Test_getApplicationEnvsFromOsEnvs(t *testing.T) {
 					defaultCacheAddress,
 					defaultCacheKeyExpirationTime,
 				},
+				&DatabaseEnvs{
+					defaultDatabaseType,
+					"",
+				},
+				NewSandboxEnvs(defaultSandboxType, defaultSandboxCgroupRoot, 0, 0, 0, 0, false, nil),
+				NewQueueEnvs(0, 0, defaultQueueTimeout),
 				defaultPipelineExecuteTimeout,
 				defaultCacheRequestTimeout,
 			),
@@ -358,6 +635,12 @@ func Test_getApplicationEnvsFromOsEnvs(t *testing.T) {
 					defaultCacheAddress,
 					defaultCacheKeyExpirationTime,
 				},
+				&DatabaseEnvs{
+					defaultDatabaseType,
+					"",
+				},
+				NewSandboxEnvs(defaultSandboxType, defaultSandboxCgroupRoot, 0, 0, 0, 0, false, nil),
+				NewQueueEnvs(0, 0, defaultQueueTimeout),
 				convertedTime,
 				defaultCacheRequestTimeout,
 			),
@@ -383,6 +666,12 @@ func Test_getApplicationEnvsFromOsEnvs(t *testing.T) {
 					defaultCacheAddress,
 					defaultCacheKeyExpirationTime,
 				},
+				&DatabaseEnvs{
+					defaultDatabaseType,
+					"",
+				},
+				NewSandboxEnvs(defaultSandboxType, defaultSandboxCgroupRoot, 0, 0, 0, 0, false, nil),
+				NewQueueEnvs(0, 0, defaultQueueTimeout),
 				defaultPipelineExecuteTimeout,
 				defaultCacheRequestTimeout,
 			),
@@ -506,6 +795,14 @@ func TestConfigureBeamEnvs(t *testing.T) {
 		[]string{"runMain"},
 		[]string{},
 	)
+	typeScriptExecutorConfig := NewExecutorConfig(
+		"tsc",
+		"node",
+		"mocha",
+		[]string{"--outDir", "bin"},
+		[]string{},
+		[]string{},
+	)
 	type args struct {
 		workingDir string
 	}
@@ -549,6 +846,12 @@ func TestConfigureBeamEnvs(t *testing.T) {
 			wantErr:   false,
 			envsToSet: map[string]string{beamSdkKey: "SDK_SCIO"},
 		},
+		{
+			name:      "BeamSdkKey set to TypeScript sdk",
+			want:      NewBeamEnvs(pb.Sdk_SDK_TYPESCRIPT, defaultBeamVersion, typeScriptExecutorConfig, modDir, defaultNumOfParallelJobs),
+			wantErr:   false,
+			envsToSet: map[string]string{beamSdkKey: "SDK_TYPESCRIPT"},
+		},
 		{
 			name:      "NumOfParallelJobsKey is set with a positive number",
 			want:      NewBeamEnvs(pb.Sdk_SDK_PYTHON, defaultBeamVersion, pythonExecutorConfig, modDir, 1),
@@ -44,3 +44,15 @@ func ResourceExhaustedError(title string, formatMessage string, args ...interfac
 	message := fmt.Sprintf(formatMessage, args...)
 	return status.Errorf(codes.ResourceExhausted, "%s: %s", title, message)
 }
+
+// UnauthenticatedError returns error with Unauthenticated code error and message like "title: message"
+func UnauthenticatedError(title string, formatMessage string, args ...interface{}) error {
+	message := fmt.Sprintf(formatMessage, args...)
+	return status.Errorf(codes.Unauthenticated, "%s: %s", title, message)
+}
+
+// UnimplementedError returns error with Unimplemented code error and message like "title: message"
+func UnimplementedError(title string, formatMessage string, args ...interface{}) error {
+	message := fmt.Sprintf(formatMessage, args...)
+	return status.Errorf(codes.Unimplemented, "%s: %s", title, message)
+}
@@ -156,3 +156,91 @@ func TestNotFoundError(t *testing.T) {
 		})
 	}
 }
+
+func TestUnauthenticatedError(t *testing.T) {
+	type args struct {
+		title         string
+		formatMessage string
+		arg           []interface{}
+	}
+	tests := []struct {
+		name     string
+		args     args
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "correct count of args",
+			args:     args{title: "TEST_TITLE", formatMessage: "TEST_FORMAT_MESSAGE %s", arg: []interface{}{"TEST_ARG"}},
+			expected: "rpc error: code = Unauthenticated desc = TEST_TITLE: TEST_FORMAT_MESSAGE TEST_ARG",
+			wantErr:  true,
+		},
+		{
+			name:     "too many args",
+			args:     args{title: "TEST_TITLE", formatMessage: "TEST_FORMAT_MESSAGE %s", arg: []interface{}{"TEST_ARG", "TEST_ARG"}},
+			expected: "rpc error: code = Unauthenticated desc = TEST_TITLE: TEST_FORMAT_MESSAGE TEST_ARG%!(EXTRA string=TEST_ARG)",
+			wantErr:  true,
+		},
+		{
+			name:     "too few args",
+			args:     args{title: "TEST_TITLE", formatMessage: "TEST_FORMAT_MESSAGE %s", arg: []interface{}{}},
+			expected: "rpc error: code = Unauthenticated desc = TEST_TITLE: TEST_FORMAT_MESSAGE %!s(MISSING)",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := UnauthenticatedError(tt.args.title, tt.args.formatMessage, tt.args.arg...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnauthenticatedError() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !strings.EqualFold(err.Error(), tt.expected) {
+				t.Errorf("UnauthenticatedError() error = %v, wantErr %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestUnimplementedError(t *testing.T) {
+	type args struct {
+		title         string
+		formatMessage string
+		arg           []interface{}
+	}
+	tests := []struct {
+		name     string
+		args     args
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "correct count of args",
+			args:     args{title: "TEST_TITLE", formatMessage: "TEST_FORMAT_MESSAGE %s", arg: []interface{}{"TEST_ARG"}},
+			expected: "rpc error: code = Unimplemented desc = TEST_TITLE: TEST_FORMAT_MESSAGE TEST_ARG",
+			wantErr:  true,
+		},
+		{
+			name:     "too many args",
+			args:     args{title: "TEST_TITLE", formatMessage: "TEST_FORMAT_MESSAGE %s", arg: []interface{}{"TEST_ARG", "TEST_ARG"}},
+			expected: "rpc error: code = Unimplemented desc = TEST_TITLE: TEST_FORMAT_MESSAGE TEST_ARG%!(EXTRA string=TEST_ARG)",
+			wantErr:  true,
+		},
+		{
+			name:     "too few args",
+			args:     args{title: "TEST_TITLE", formatMessage: "TEST_FORMAT_MESSAGE %s", arg: []interface{}{}},
+			expected: "rpc error: code = Unimplemented desc = TEST_TITLE: TEST_FORMAT_MESSAGE %!s(MISSING)",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := UnimplementedError(tt.args.title, tt.args.formatMessage, tt.args.arg...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnimplementedError() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !strings.EqualFold(err.Error(), tt.expected) {
+				t.Errorf("UnimplementedError() error = %v, wantErr %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"os"
 
 	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/constants"
 	"beam.apache.org/playground/backend/internal/db/entity"
 	"beam.apache.org/playground/backend/internal/emulators"
 	"beam.apache.org/playground/backend/internal/logger"
@@ -44,6 +45,7 @@ type LifeCyclePaths struct {
 	AbsoluteBaseFolderPath           string // /path/to/workingDir/pipelinesFolder/{pipelineId}
 	AbsoluteLogFilePath              string // /path/to/workingDir/pipelinesFolder/{pipelineId}/logs.log
 	AbsoluteGraphFilePath            string // /path/to/workingDir/pipelinesFolder/{pipelineId}/graph.dot
+	AbsoluteMetricsFilePath          string // /path/to/workingDir/pipelinesFolder/{pipelineId}/metrics.json
 	ProjectDir                       string // /path/to/workingDir/
 	FindExecutableName               func(context.Context, string) (string, error)
 	FindTestExecutableName           func(context.Context, string) (string, error)
@@ -51,9 +53,9 @@ type LifeCyclePaths struct {
 
 // LifeCycle is used for preparing folders and files to process code for one code processing request.
 type LifeCycle struct {
-	folderGlobs         []string // folders that should be created to process code
-	Paths               LifeCyclePaths
-	emulatorMockCluster emulators.EmulatorMockCluster
+	folderGlobs          []string // folders that should be created to process code
+	Paths                LifeCyclePaths
+	emulatorMockClusters []emulators.EmulatorMockCluster
 }
 
 // NewLifeCycle returns a corresponding LifeCycle depending on the given SDK.
@@ -67,6 +69,8 @@ func NewLifeCycle(sdk pb.Sdk, pipelineId uuid.UUID, pipelinesFolder string) (*Li
 		return newPythonLifeCycle(pipelineId, pipelinesFolder), nil
 	case pb.Sdk_SDK_SCIO:
 		return newScioLifeCycle(pipelineId, pipelinesFolder), nil
+	case pb.Sdk_SDK_TYPESCRIPT:
+		return newTypeScriptLifeCycle(pipelineId, pipelinesFolder), nil
 	default:
 		return nil, fmt.Errorf("%s isn't supported now", sdk)
 	}
@@ -113,25 +117,49 @@ func (lc *LifeCycle) CreateSourceCodeFiles(sources []entity.FileEntity) error {
 	return nil
 }
 
+// GetPreparerParameters returns parameters of all emulators started for the pipeline
+// and the paths of the files which the pipeline writes its graph and metrics to.
 func (lc *LifeCycle) GetPreparerParameters() map[string]string {
-	if lc.emulatorMockCluster == nil {
-		return map[string]string{}
+	preparerParameters := map[string]string{
+		constants.GraphFilePathKey:   lc.Paths.AbsoluteGraphFilePath,
+		constants.MetricsFilePathKey: lc.Paths.AbsoluteMetricsFilePath,
 	}
-	return lc.emulatorMockCluster.GetPreparerParameters()
+	for _, mockCluster := range lc.emulatorMockClusters {
+		for k, v := range mockCluster.GetPreparerParameters() {
+			preparerParameters[k] = v
+		}
+	}
+	return preparerParameters
 }
 
+// GetEmulatorAddresses returns addresses of emulators started for the pipeline.
+func (lc *LifeCycle) GetEmulatorAddresses() []string {
+	var addresses []string
+	for _, mockCluster := range lc.emulatorMockClusters {
+		if address := mockCluster.GetAddress(); address != "" {
+			addresses = append(addresses, address)
+		}
+	}
+	return addresses
+}
+
+// StartEmulators starts emulators for datasets of the pipeline.
+// Emulators keep files of datasets in the pipeline folder.
 func (lc *LifeCycle) StartEmulators(configuration emulators.EmulatorConfiguration) error {
-	kafkaMockClusters, err := emulators.PrepareMockClusters(configuration)
+	if configuration.WorkingDir == "" {
+		configuration.WorkingDir = lc.Paths.AbsoluteBaseFolderPath
+	}
+	mockClusters, err := emulators.PrepareMockClusters(configuration)
 	if err != nil {
 		logger.Errorf("Failed to start mock emulator: %v", err)
 		return err
 	}
-	lc.emulatorMockCluster = kafkaMockClusters[0]
+	lc.emulatorMockClusters = mockClusters
 	return nil
 }
 
 func (lc *LifeCycle) StopEmulators() {
-	if lc.emulatorMockCluster != nil {
-		lc.emulatorMockCluster.Stop()
+	for _, mockCluster := range lc.emulatorMockClusters {
+		mockCluster.Stop()
 	}
 }
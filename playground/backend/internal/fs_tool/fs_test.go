@@ -314,6 +314,7 @@ func TestNewLifeCycle(t *testing.T) {
 					AbsoluteBaseFolderPath:           baseFileFolder,
 					AbsoluteLogFilePath:              filepath.Join(baseFileFolder, logFileName),
 					AbsoluteGraphFilePath:            filepath.Join(baseFileFolder, utils.GraphFileName),
+					AbsoluteMetricsFilePath:          filepath.Join(baseFileFolder, utils.MetricsFileName),
 				},
 			},
 		},
@@ -336,6 +337,7 @@ func TestNewLifeCycle(t *testing.T) {
 					AbsoluteBaseFolderPath:           baseFileFolder,
 					AbsoluteLogFilePath:              filepath.Join(baseFileFolder, logFileName),
 					AbsoluteGraphFilePath:            filepath.Join(baseFileFolder, utils.GraphFileName),
+					AbsoluteMetricsFilePath:          filepath.Join(baseFileFolder, utils.MetricsFileName),
 				},
 			},
 		},
@@ -358,6 +360,7 @@ func TestNewLifeCycle(t *testing.T) {
 					AbsoluteBaseFolderPath:           baseFileFolder,
 					AbsoluteLogFilePath:              filepath.Join(baseFileFolder, logFileName),
 					AbsoluteGraphFilePath:            filepath.Join(baseFileFolder, utils.GraphFileName),
+					AbsoluteMetricsFilePath:          filepath.Join(baseFileFolder, utils.MetricsFileName),
 				},
 			},
 		},
@@ -382,6 +385,29 @@ func TestNewLifeCycle(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "TypeScript LifeCycle",
+			args: args{
+				sdk:             pb.Sdk_SDK_TYPESCRIPT,
+				pipelineId:      pipelineId,
+				pipelinesFolder: pipelinesFolder,
+			},
+			want: &LifeCycle{
+				folderGlobs: []string{baseFileFolder, srcFileFolder, execFileFolder},
+				Paths: LifeCyclePaths{
+					SourceFileName:                   fmt.Sprintf("%s%s", pipelineId.String(), TypeScriptSourceFileExtension),
+					AbsoluteSourceFileFolderPath:     srcFileFolder,
+					AbsoluteSourceFilePath:           filepath.Join(srcFileFolder, fmt.Sprintf("%s%s", pipelineId.String(), TypeScriptSourceFileExtension)),
+					ExecutableFileName:               fmt.Sprintf("%s%s", pipelineId.String(), typeScriptExecutableFileExtension),
+					AbsoluteExecutableFileFolderPath: execFileFolder,
+					AbsoluteExecutableFilePath:       filepath.Join(execFileFolder, fmt.Sprintf("%s%s", pipelineId.String(), typeScriptExecutableFileExtension)),
+					AbsoluteBaseFolderPath:           baseFileFolder,
+					AbsoluteLogFilePath:              filepath.Join(baseFileFolder, logFileName),
+					AbsoluteGraphFilePath:            filepath.Join(baseFileFolder, utils.GraphFileName),
+					AbsoluteMetricsFilePath:          filepath.Join(baseFileFolder, utils.MetricsFileName),
+				},
+			},
+		},
 		{
 			name: "Unavailable SDK",
 			args: args{
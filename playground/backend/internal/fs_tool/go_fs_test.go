@@ -59,6 +59,7 @@ func Test_newGoLifeCycle(t *testing.T) {
 					AbsoluteBaseFolderPath:           baseFileFolder,
 					AbsoluteLogFilePath:              filepath.Join(baseFileFolder, logFileName),
 					AbsoluteGraphFilePath:            filepath.Join(baseFileFolder, utils.GraphFileName),
+					AbsoluteMetricsFilePath:          filepath.Join(baseFileFolder, utils.MetricsFileName),
 				},
 			},
 		},
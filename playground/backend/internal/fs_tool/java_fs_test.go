@@ -63,6 +63,7 @@ func Test_newJavaLifeCycle(t *testing.T) {
 					AbsoluteBaseFolderPath:           baseFileFolder,
 					AbsoluteLogFilePath:              filepath.Join(baseFileFolder, logFileName),
 					AbsoluteGraphFilePath:            filepath.Join(baseFileFolder, utils.GraphFileName),
+					AbsoluteMetricsFilePath:          filepath.Join(baseFileFolder, utils.MetricsFileName),
 					FindExecutableName:               findExecutableName,
 				},
 			},
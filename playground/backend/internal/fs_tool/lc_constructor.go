@@ -41,6 +41,7 @@ func newCompilingLifeCycle(pipelineId uuid.UUID, pipelinesFolder, sourceFileExte
 	absBaseFolderPath, _ := filepath.Abs(baseFileFolder)
 	absLogFilePath, _ := filepath.Abs(filepath.Join(absBaseFolderPath, logFileName))
 	absGraphFilePath, _ := filepath.Abs(filepath.Join(absBaseFolderPath, utils.GraphFileName))
+	absMetricsFilePath, _ := filepath.Abs(filepath.Join(absBaseFolderPath, utils.MetricsFileName))
 
 	return &LifeCycle{
 		folderGlobs: []string{baseFileFolder, srcFileFolder, binFileFolder},
@@ -54,6 +55,7 @@ func newCompilingLifeCycle(pipelineId uuid.UUID, pipelinesFolder, sourceFileExte
 			AbsoluteBaseFolderPath:           absBaseFolderPath,
 			AbsoluteLogFilePath:              absLogFilePath,
 			AbsoluteGraphFilePath:            absGraphFilePath,
+			AbsoluteMetricsFilePath:          absMetricsFilePath,
 		},
 	}
 }
@@ -67,6 +69,7 @@ func newInterpretedLifeCycle(pipelineId uuid.UUID, pipelinesFolder, sourceFileEx
 	absFilePath, _ := filepath.Abs(filepath.Join(absFileFolderPath, fileName))
 	absLogFilePath, _ := filepath.Abs(filepath.Join(absFileFolderPath, logFileName))
 	absGraphFilePath, _ := filepath.Abs(filepath.Join(absFileFolderPath, utils.GraphFileName))
+	absMetricsFilePath, _ := filepath.Abs(filepath.Join(absFileFolderPath, utils.MetricsFileName))
 
 	return &LifeCycle{
 		folderGlobs: []string{sourceFileFolder},
@@ -80,6 +83,7 @@ func newInterpretedLifeCycle(pipelineId uuid.UUID, pipelinesFolder, sourceFileEx
 			AbsoluteBaseFolderPath:           absFileFolderPath,
 			AbsoluteLogFilePath:              absLogFilePath,
 			AbsoluteGraphFilePath:            absGraphFilePath,
+			AbsoluteMetricsFilePath:          absMetricsFilePath,
 		},
 	}
 }
@@ -57,6 +57,7 @@ func Test_newPythonLifeCycle(t *testing.T) {
 					AbsoluteBaseFolderPath:           baseFileFolder,
 					AbsoluteLogFilePath:              filepath.Join(baseFileFolder, logFileName),
 					AbsoluteGraphFilePath:            filepath.Join(baseFileFolder, utils.GraphFileName),
+					AbsoluteMetricsFilePath:          filepath.Join(baseFileFolder, utils.MetricsFileName),
 				},
 			},
 		},
@@ -16,18 +16,101 @@
 package preparers
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
+
+	"beam.apache.org/playground/backend/internal/constants"
+	"beam.apache.org/playground/backend/internal/logger"
 )
 
 const (
-	goName  = "go"
-	fmtArgs = "fmt"
-	sep     = "."
+	goName                 = "go"
+	fmtArgs                = "fmt"
+	sep                    = "."
+	goFileMode             = 0600
+	goRunHandlerFileName   = "playground_run.go"
+	goRunWithMetricsSuffix = "WithMetrics"
+	goRunFuncName          = "Run"
+	goRunCallReplacement   = "playgroundRun%s(%sRunWithMetrics, "
+	goBeamxImportPath      = "github.com/apache/beam/sdks/v2/go/pkg/beam/x/beamx"
+	goBeamxPackageName     = "beamx"
+	goRunHandlerCode       = `// Code generated by Beam Playground. DO NOT EDIT.
+
+package %s
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"os"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/metrics"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/runners/dot"
+)
+
+type playgroundRunner func(context.Context, *beam.Pipeline) (beam.PipelineResult, error)
+
+// playgroundRun runs the pipeline saving its graph and final metrics
+func playgroundRun(run playgroundRunner, ctx context.Context, p *beam.Pipeline) error {
+	_, err := playgroundRunWithMetrics(run, ctx, p)
+	return err
+}
+
+// playgroundRunWithMetrics runs the pipeline saving its graph and final metrics
+func playgroundRunWithMetrics(run playgroundRunner, ctx context.Context, p *beam.Pipeline) (beam.PipelineResult, error) {
+	if err := flag.Set("dot_file", %q); err == nil {
+		_, _ = dot.Execute(ctx, p)
+	}
+	result, err := run(ctx, p)
+	if result != nil {
+		playgroundSaveMetrics(result.Metrics().AllMetrics())
+	}
+	return result, err
+}
+
+func playgroundSaveMetrics(results metrics.QueryResults) {
+	key := func(k metrics.StepKey) map[string]string {
+		return map[string]string{"step": k.Step, "namespace": k.Namespace, "name": k.Name}
+	}
+	distribution := func(v metrics.DistributionValue) map[string]int64 {
+		return map[string]int64{"count": v.Count, "sum": v.Sum, "min": v.Min, "max": v.Max}
+	}
+	gauge := func(v metrics.GaugeValue) map[string]int64 {
+		value := map[string]int64{"value": v.Value}
+		if !v.Timestamp.IsZero() {
+			value["timestamp"] = v.Timestamp.UnixMilli()
+		}
+		return value
+	}
+	counters := []interface{}{}
+	for _, c := range results.Counters() {
+		counters = append(counters, map[string]interface{}{"key": key(c.Key), "attempted": c.Attempted, "committed": c.Committed})
+	}
+	distributions := []interface{}{}
+	for _, d := range results.Distributions() {
+		distributions = append(distributions, map[string]interface{}{"key": key(d.Key), "attempted": distribution(d.Attempted), "committed": distribution(d.Committed)})
+	}
+	gauges := []interface{}{}
+	for _, g := range results.Gauges() {
+		gauges = append(gauges, map[string]interface{}{"key": key(g.Key), "attempted": gauge(g.Attempted), "committed": gauge(g.Committed)})
+	}
+	data, err := json.Marshal(map[string]interface{}{"counters": counters, "distributions": distributions, "gauges": gauges})
+	if err != nil {
+		return
+	}
+	_ = os.WriteFile(%q, data, 0600)
+}
+`
 )
 
 // GoPreparersBuilder facet of PreparersBuilder
@@ -60,6 +143,20 @@ func (builder *GoPreparersBuilder) WithFileNameChanger() *GoPreparersBuilder {
 	return builder
 }
 
+// WithMetricsHandler adds preparer to save the graph and the final metrics of the pipeline
+func (builder *GoPreparersBuilder) WithMetricsHandler() *GoPreparersBuilder {
+	metricsFilePath, ok := builder.params[constants.MetricsFilePathKey]
+	if !ok {
+		return builder
+	}
+	addMetricsHandler := Preparer{
+		Prepare: addGoMetricsHandler,
+		Args:    []interface{}{builder.filePath, builder.params[constants.GraphFilePathKey], metricsFilePath},
+	}
+	builder.AddPreparer(addMetricsHandler)
+	return builder
+}
+
 // GetGoPreparers returns reparation methods that should be applied to Go code
 func GetGoPreparers(builder *PreparersBuilder, isUnitTest bool) {
 	builder.
@@ -67,6 +164,9 @@ func GetGoPreparers(builder *PreparersBuilder, isUnitTest bool) {
 		WithCodeFormatter()
 	if isUnitTest {
 		builder.GoPreparers().WithFileNameChanger()
+	} else {
+		builder.GoPreparers().WithMetricsHandler()
+		builder.WithEmulatorParametersChanger()
 	}
 }
 
@@ -91,3 +191,96 @@ func changeGoTestFileName(args ...interface{}) error {
 	}
 	return nil
 }
+
+// addGoMetricsHandler replaces the calls which run the pipeline with the calls of the functions
+// which also save the graph and the final metrics of the pipeline, and adds these functions next to the code
+func addGoMetricsHandler(args ...interface{}) error {
+	filePath := args[0].(string)
+	graphFilePath := args[1].(string)
+	metricsFilePath := args[2].(string)
+	code, err := os.ReadFile(filePath)
+	if err != nil {
+		logger.Errorf("Preparation: Error during read file: %s, err: %s\n", filePath, err.Error())
+		return err
+	}
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, filePath, code, 0)
+	if err != nil {
+		logger.Errorf("Preparation: Error during parse file: %s, err: %s\n", filePath, err.Error())
+		return err
+	}
+	calls := findGoRunCalls(file)
+	if len(calls) == 0 {
+		return nil
+	}
+	var prepared bytes.Buffer
+	last := 0
+	for _, call := range calls {
+		start := fileSet.Position(call.Fun.Pos()).Offset
+		prepared.Write(code[last:start])
+		qualifier, name := "", ""
+		switch fun := call.Fun.(type) {
+		case *ast.SelectorExpr:
+			qualifier, name = fun.X.(*ast.Ident).Name+sep, fun.Sel.Name
+		case *ast.Ident:
+			name = fun.Name
+		}
+		prepared.WriteString(fmt.Sprintf(goRunCallReplacement, strings.TrimPrefix(name, goRunFuncName), qualifier))
+		last = fileSet.Position(call.Lparen).Offset + 1
+	}
+	prepared.Write(code[last:])
+	code = prepared.Bytes()
+	if err = os.WriteFile(filePath, code, goFileMode); err != nil {
+		logger.Errorf("Preparation: Error during write file: %s, err: %s\n", filePath, err.Error())
+		return err
+	}
+	handlerCode := fmt.Sprintf(goRunHandlerCode, file.Name.Name, graphFilePath, metricsFilePath)
+	return os.WriteFile(filepath.Join(filepath.Dir(filePath), goRunHandlerFileName), []byte(handlerCode), goFileMode)
+}
+
+// findGoRunCalls returns the calls of beamx.Run and beamx.RunWithMetrics in the file in the order of their positions.
+// The calls are found by the name under which the beamx package is imported, so aliased and dot imports are supported.
+func findGoRunCalls(file *ast.File) []*ast.CallExpr {
+	packageNames := make(map[string]bool)
+	isDotImported := false
+	for _, spec := range file.Imports {
+		if path, err := strconv.Unquote(spec.Path.Value); err != nil || path != goBeamxImportPath {
+			continue
+		}
+		switch {
+		case spec.Name == nil:
+			packageNames[goBeamxPackageName] = true
+		case spec.Name.Name == ".":
+			isDotImported = true
+		case spec.Name.Name != "_":
+			packageNames[spec.Name.Name] = true
+		}
+	}
+	if len(packageNames) == 0 && !isDotImported {
+		return nil
+	}
+	isRunFunc := func(ident *ast.Ident) bool {
+		return ident.Name == goRunFuncName || ident.Name == goRunFuncName+goRunWithMetricsSuffix
+	}
+	var calls []*ast.CallExpr
+	ast.Inspect(file, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		switch fun := call.Fun.(type) {
+		case *ast.SelectorExpr:
+			// the identifiers of the imported packages are not resolved by the parser,
+			// so a local variable which shadows the package name has an object
+			if x, ok := fun.X.(*ast.Ident); ok && x.Obj == nil && packageNames[x.Name] && isRunFunc(fun.Sel) {
+				calls = append(calls, call)
+			}
+		case *ast.Ident:
+			if isDotImported && fun.Obj == nil && isRunFunc(fun) {
+				calls = append(calls, call)
+			}
+		}
+		return true
+	})
+	return calls
+}
@@ -17,8 +17,14 @@ package preparers
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
+
+	"beam.apache.org/playground/backend/internal/constants"
 )
 
 // getPreparedArgs returns array of received arguments
@@ -58,6 +64,34 @@ func TestGetGoPreparers(t *testing.T) {
 	}
 }
 
+func TestGetGoPreparers_WithMetricsHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		prepareParams map[string]string
+		want          int
+	}{
+		{
+			name:          "Get number of go preparers without the metrics file path",
+			prepareParams: map[string]string{},
+			want:          1,
+		},
+		{
+			name:          "Get number of go preparers with the metrics file path",
+			prepareParams: map[string]string{constants.GraphFilePathKey: "graph.dot", constants.MetricsFilePathKey: "metrics.json"},
+			want:          2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := NewPreparersBuilder("MOCK_FILEPATH", tt.prepareParams)
+			GetGoPreparers(builder, false)
+			if got := builder.Build().GetPreparers(); len(*got) != tt.want {
+				t.Errorf("GetGoPreparers() returns %v Preparers, want %v", len(*got), tt.want)
+			}
+		})
+	}
+}
+
 func Test_formatCode(t *testing.T) {
 	preparedArgs1 := getPreparedArgs(correctGoFile)
 	preparedArgs2 := getPreparedArgs(incorrectGoFile)
@@ -90,3 +124,83 @@ func Test_formatCode(t *testing.T) {
 		})
 	}
 }
+
+func Test_addGoMetricsHandler(t *testing.T) {
+	const (
+		graphFilePath   = "/tmp/graph.dot"
+		metricsFilePath = "/tmp/metrics.json"
+	)
+	tests := []struct {
+		name              string
+		code              string
+		wantCode          string
+		wantHandlerExists bool
+	}{
+		{
+			name:              "Code which runs the pipeline",
+			code:              "package main\n\nimport \"github.com/apache/beam/sdks/v2/go/pkg/beam/x/beamx\"\n\nfunc main() {\n\tbeamx.Run(ctx, p)\n\tbeamx.RunWithMetrics(ctx, p)\n}\n",
+			wantCode:          "package main\n\nimport \"github.com/apache/beam/sdks/v2/go/pkg/beam/x/beamx\"\n\nfunc main() {\n\tplaygroundRun(beamx.RunWithMetrics, ctx, p)\n\tplaygroundRunWithMetrics(beamx.RunWithMetrics, ctx, p)\n}\n",
+			wantHandlerExists: true,
+		},
+		{
+			name:              "Code which runs the pipeline using the aliased import",
+			code:              "package main\n\nimport bx \"github.com/apache/beam/sdks/v2/go/pkg/beam/x/beamx\"\n\nfunc main() {\n\tif err := bx.Run(ctx, p); err != nil {\n\t}\n}\n",
+			wantCode:          "package main\n\nimport bx \"github.com/apache/beam/sdks/v2/go/pkg/beam/x/beamx\"\n\nfunc main() {\n\tif err := playgroundRun(bx.RunWithMetrics, ctx, p); err != nil {\n\t}\n}\n",
+			wantHandlerExists: true,
+		},
+		{
+			name:              "Code which runs the pipeline using the dot import",
+			code:              "package main\n\nimport . \"github.com/apache/beam/sdks/v2/go/pkg/beam/x/beamx\"\n\nfunc main() {\n\tRunWithMetrics(ctx, p)\n}\n",
+			wantCode:          "package main\n\nimport . \"github.com/apache/beam/sdks/v2/go/pkg/beam/x/beamx\"\n\nfunc main() {\n\tplaygroundRunWithMetrics(RunWithMetrics, ctx, p)\n}\n",
+			wantHandlerExists: true,
+		},
+		{
+			name:              "Code which calls Run of another package",
+			code:              "package main\n\nimport beamx \"example.com/runner\"\n\nfunc main() {\n\tbeamx.Run(ctx, p)\n}\n",
+			wantCode:          "package main\n\nimport beamx \"example.com/runner\"\n\nfunc main() {\n\tbeamx.Run(ctx, p)\n}\n",
+			wantHandlerExists: false,
+		},
+		{
+			name:              "Code which shadows the package name",
+			code:              "package main\n\nimport \"github.com/apache/beam/sdks/v2/go/pkg/beam/x/beamx\"\n\nfunc main() {\n\tbeamx := runner{}\n\tbeamx.Run(ctx, p)\n}\n",
+			wantCode:          "package main\n\nimport \"github.com/apache/beam/sdks/v2/go/pkg/beam/x/beamx\"\n\nfunc main() {\n\tbeamx := runner{}\n\tbeamx.Run(ctx, p)\n}\n",
+			wantHandlerExists: false,
+		},
+		{
+			name:              "Code which doesn't run the pipeline",
+			code:              "package main\n\nfunc main() {\n}\n",
+			wantCode:          "package main\n\nfunc main() {\n}\n",
+			wantHandlerExists: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			filePath := filepath.Join(dir, "main.go")
+			if err := os.WriteFile(filePath, []byte(tt.code), 0600); err != nil {
+				t.Fatal(err)
+			}
+			if err := addGoMetricsHandler(filePath, graphFilePath, metricsFilePath); err != nil {
+				t.Fatalf("addGoMetricsHandler() error = %v", err)
+			}
+			code, err := os.ReadFile(filePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(code) != tt.wantCode {
+				t.Errorf("addGoMetricsHandler() code = %q, want %q", code, tt.wantCode)
+			}
+			handlerCode, err := os.ReadFile(filepath.Join(dir, goRunHandlerFileName))
+			if (err == nil) != tt.wantHandlerExists {
+				t.Fatalf("addGoMetricsHandler() handler exists = %v, want %v", err == nil, tt.wantHandlerExists)
+			}
+			if tt.wantHandlerExists {
+				for _, want := range []string{"package main", strconv.Quote(graphFilePath), strconv.Quote(metricsFilePath)} {
+					if !strings.Contains(string(handlerCode), want) {
+						t.Errorf("addGoMetricsHandler() handler code doesn't contain %s", want)
+					}
+				}
+			}
+		})
+	}
+}
@@ -168,6 +168,7 @@ func GetJavaPreparers(builder *PreparersBuilder, isUnitTest bool, isKata bool) {
 			WithGraphHandler().
 			WithBootstrapServersChanger().
 			WithTopicNameChanger()
+		builder.WithEmulatorParametersChanger()
 	}
 	if isUnitTest {
 		builder.JavaPreparers().
@@ -181,6 +182,7 @@ func GetJavaPreparers(builder *PreparersBuilder, isUnitTest bool, isKata bool) {
 			WithGraphHandler().
 			WithBootstrapServersChanger().
 			WithTopicNameChanger()
+		builder.WithEmulatorParametersChanger()
 	}
 }
 
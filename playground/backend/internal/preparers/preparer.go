@@ -17,12 +17,23 @@ package preparers
 
 import (
 	"fmt"
+	"regexp"
+	"sort"
 	"sync"
 
 	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/constants"
 	"beam.apache.org/playground/backend/internal/validators"
 )
 
+// emulatorParameterPatterns contains tokens in the example source which are replaced with values of the emulator parameters
+var emulatorParameterPatterns = map[string]string{
+	constants.PubSubEmulatorHostKey: "pubsub_emulator:8085",
+	constants.GCSEndpointKey:        "http://gcs_emulator:4443",
+	constants.BucketsDirKey:         "/playground/buckets",
+	constants.DatabasePathKey:       "playground_database.db",
+}
+
 // Preparer is used to make preparations with file with code.
 type Preparer struct {
 	Prepare func(args ...interface{}) error
@@ -58,6 +69,26 @@ func (builder *PreparersBuilder) AddPreparer(newPreparer Preparer) {
 	*builder.preparers.functions = append(*builder.preparers.functions, newPreparer)
 }
 
+// WithEmulatorParametersChanger adds preparers to replace tokens in the example source to values of the emulator parameters
+func (builder *PreparersBuilder) WithEmulatorParametersChanger() *PreparersBuilder {
+	keys := make([]string, 0, len(emulatorParameterPatterns))
+	for key := range emulatorParameterPatterns {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value, ok := builder.params[key]
+		if !ok {
+			continue
+		}
+		builder.AddPreparer(Preparer{
+			Prepare: replace,
+			Args:    []interface{}{builder.filePath, regexp.QuoteMeta(emulatorParameterPatterns[key]), value},
+		})
+	}
+	return builder
+}
+
 // GetPreparers returns slice of preparers.Preparer according to sdk
 func GetPreparers(sdk pb.Sdk, filepath string, valResults *sync.Map, prepareParams map[string]string) (*[]Preparer, error) {
 	isUnitTest, ok := valResults.Load(validators.UnitTestValidatorName)
@@ -78,8 +109,21 @@ func GetPreparers(sdk pb.Sdk, filepath string, valResults *sync.Map, preparePara
 		GetPythonPreparers(builder, isUnitTest.(bool))
 	case pb.Sdk_SDK_SCIO:
 		GetScioPreparers(builder)
+	case pb.Sdk_SDK_TYPESCRIPT:
+		GetTypeScriptPreparers(builder, isUnitTest.(bool))
 	default:
 		return nil, fmt.Errorf("incorrect sdk: %s", sdk)
 	}
 	return builder.Build().GetPreparers(), nil
 }
+
+// IsRunMetricsSupported returns true if the preparers of the sdk add the code
+// which saves the final metrics of the pipeline after the run.
+func IsRunMetricsSupported(sdk pb.Sdk) bool {
+	switch sdk {
+	case pb.Sdk_SDK_GO, pb.Sdk_SDK_PYTHON:
+		return true
+	default:
+		return false
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/constants"
 	"beam.apache.org/playground/backend/internal/logger"
 	"beam.apache.org/playground/backend/internal/validators"
 )
@@ -171,6 +172,23 @@ func TestGetPreparers(t *testing.T) {
 				GetPreparers(),
 			wantErr: false,
 		},
+		{
+			// Test case with calling GetPreparers method with TypeScript sdk.
+			// As a result, want to receive 1 preparer
+			name: "Get TypeScript preparer",
+			args: args{
+				sdk:           pb.Sdk_SDK_TYPESCRIPT,
+				filepath:      filepath,
+				prepareParams: prepareParams,
+				valResults:    &validationResults,
+			},
+			want: NewPreparersBuilder(filepath, prepareParams).
+				TypeScriptPreparers().
+				WithLogHandler().
+				Build().
+				GetPreparers(),
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -185,3 +203,63 @@ func TestGetPreparers(t *testing.T) {
 		})
 	}
 }
+
+func TestPreparersBuilder_WithEmulatorParametersChanger(t *testing.T) {
+	const (
+		code       = "host = \"pubsub_emulator:8085\"\nendpoint = \"http://gcs_emulator:4443\"\ndb = \"jdbc:sqlite:playground_database.db\"\n"
+		wantCode   = "host = \"127.0.0.1:8085\"\nendpoint = \"http://127.0.0.1:4443\"\ndb = \"jdbc:sqlite:/tmp/playground_database.db\""
+		sourceFile = "emulators.py"
+	)
+	if err := createFile(sourceFile, code); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(sourceFile)
+
+	params := map[string]string{
+		constants.PubSubEmulatorHostKey: "127.0.0.1:8085",
+		constants.GCSEndpointKey:        "http://127.0.0.1:4443",
+		constants.DatabasePathKey:       "/tmp/playground_database.db",
+	}
+	preparers := NewPreparersBuilder(sourceFile, params).WithEmulatorParametersChanger().Build().GetPreparers()
+	if len(*preparers) != len(params) {
+		t.Fatalf("WithEmulatorParametersChanger() got %d preparers, want %d", len(*preparers), len(params))
+	}
+	for _, preparer := range *preparers {
+		if err := preparer.Prepare(preparer.Args...); err != nil {
+			t.Fatalf("WithEmulatorParametersChanger() preparer error = %v", err)
+		}
+	}
+	got, err := os.ReadFile(sourceFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != wantCode {
+		t.Errorf("WithEmulatorParametersChanger() got code %q, want %q", got, wantCode)
+	}
+
+	emptyPreparers := NewPreparersBuilder(sourceFile, map[string]string{}).WithEmulatorParametersChanger().Build().GetPreparers()
+	if len(*emptyPreparers) != 0 {
+		t.Errorf("WithEmulatorParametersChanger() got %d preparers without parameters, want 0", len(*emptyPreparers))
+	}
+}
+
+func TestIsRunMetricsSupported(t *testing.T) {
+	tests := []struct {
+		sdk  pb.Sdk
+		want bool
+	}{
+		{sdk: pb.Sdk_SDK_GO, want: true},
+		{sdk: pb.Sdk_SDK_PYTHON, want: true},
+		{sdk: pb.Sdk_SDK_JAVA, want: false},
+		{sdk: pb.Sdk_SDK_SCIO, want: false},
+		{sdk: pb.Sdk_SDK_TYPESCRIPT, want: false},
+		{sdk: pb.Sdk_SDK_UNSPECIFIED, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sdk.String(), func(t *testing.T) {
+			if got := IsRunMetricsSupported(tt.sdk); got != tt.want {
+				t.Errorf("IsRunMetricsSupported() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -16,6 +16,7 @@
 package preparers
 
 import (
+	"beam.apache.org/playground/backend/internal/constants"
 	"beam.apache.org/playground/backend/internal/logger"
 	"beam.apache.org/playground/backend/internal/utils"
 	"bufio"
@@ -32,6 +33,38 @@ const (
 	indentationPattern      = `^(%s){0,1}\w+`
 	findPipelinePattern     = `^(\s*)(.+) = beam.Pipeline`
 	runPipelinePattern      = `^(\s*).*%s.run\(\)`
+	addMetricsHandlerCode   = `import atexit as _playground_atexit
+import json as _playground_json
+import apache_beam as _playground_beam
+_playground_results = []
+_playground_pipeline_run = _playground_beam.Pipeline.run
+def _playground_run(self, *args, **kwargs):
+    result = _playground_pipeline_run(self, *args, **kwargs)
+    _playground_results.append(result)
+    return result
+_playground_beam.Pipeline.run = _playground_run
+def _playground_save_metrics():
+    def key(metric_key):
+        return {"step": metric_key.step, "namespace": metric_key.metric.namespace, "name": metric_key.metric.name}
+    def value(metric_value):
+        if metric_value is None or isinstance(metric_value, int):
+            return metric_value
+        if hasattr(metric_value, "timestamp"):
+            return {"value": metric_value.value, "timestamp": int(metric_value.timestamp * 1000)}
+        return {"count": metric_value.count, "sum": metric_value.sum, "min": metric_value.min, "max": metric_value.max}
+    metrics = {"counters": [], "distributions": [], "gauges": []}
+    for result in _playground_results:
+        try:
+            query = result.metrics().query()
+        except Exception:
+            continue
+        for kind in metrics:
+            for metric in query.get(kind, []):
+                metrics[kind].append({"key": key(metric.key), "attempted": value(metric.attempted), "committed": value(metric.committed)})
+    with open(%q, "w") as file:
+        _playground_json.dump(metrics, file)
+_playground_atexit.register(_playground_save_metrics)
+`
 )
 
 // GetPythonPreparers returns preparation methods that should be applied to Python code
@@ -42,7 +75,9 @@ func GetPythonPreparers(builder *PreparersBuilder, isUnitTest bool) {
 	if !isUnitTest {
 		builder.
 			PythonPreparers().
-			WithGraphHandler()
+			WithGraphHandler().
+			WithMetricsHandler()
+		builder.WithEmulatorParametersChanger()
 	}
 }
 
@@ -76,6 +111,20 @@ func (builder *PythonPreparersBuilder) WithGraphHandler() *PythonPreparersBuilde
 	return builder
 }
 
+// WithMetricsHandler adds code to save the final metrics of the pipeline
+func (builder *PythonPreparersBuilder) WithMetricsHandler() *PythonPreparersBuilder {
+	metricsFilePath, ok := builder.params[constants.MetricsFilePathKey]
+	if !ok {
+		return builder
+	}
+	addMetricsHandler := Preparer{
+		Prepare: addCodeToFile,
+		Args:    []interface{}{builder.filePath, saveMetrics(metricsFilePath)},
+	}
+	builder.AddPreparer(addMetricsHandler)
+	return builder
+}
+
 // addCodeToFile processes file by filePath and adds additional code
 func addCodeToFile(args ...interface{}) error {
 	filePath := args[0].(string)
@@ -112,7 +161,20 @@ func addCodeToFile(args ...interface{}) error {
 // saveLogs rewrites all lines from file with adding additional code to another file
 // New code is added to the top of the file.
 func saveLogs(from *os.File, to *os.File) error {
-	if err := writeToFile(to, addLogHandlerCode); err != nil {
+	return addCodeToTop(from, to, addLogHandlerCode)
+}
+
+// saveMetrics returns the method which rewrites all lines from file with adding additional code to another file
+// New code is added to the top of the file and saves the final metrics of the pipeline to the metricsFilePath.
+func saveMetrics(metricsFilePath string) func(*os.File, *os.File) error {
+	return func(from *os.File, to *os.File) error {
+		return addCodeToTop(from, to, fmt.Sprintf(addMetricsHandlerCode, metricsFilePath))
+	}
+}
+
+// addCodeToTop rewrites all lines from file to another file adding the code to the top of it
+func addCodeToTop(from *os.File, to *os.File, code string) error {
+	if err := writeToFile(to, code); err != nil {
 		return err
 	}
 	scanner := bufio.NewScanner(from)
@@ -19,9 +19,11 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	"beam.apache.org/playground/backend/internal/constants"
 	"beam.apache.org/playground/backend/internal/utils"
 )
 
@@ -40,6 +42,11 @@ func TestGetPythonPreparers(t *testing.T) {
 			args: args{"MOCK_FILEPATH", make(map[string]string)},
 			want: 2,
 		},
+		{
+			name: "Get number of python preparers with the metrics file path",
+			args: args{"MOCK_FILEPATH", map[string]string{constants.MetricsFilePathKey: "metrics.json"}},
+			want: 3,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -142,6 +149,38 @@ func Test_saveLogs(t *testing.T) {
 	}
 }
 
+func Test_saveMetrics(t *testing.T) {
+	const metricsFilePath = "/tmp/metrics.json"
+	dir := t.TempDir()
+	from := filepath.Join(dir, "main.py")
+	to := filepath.Join(dir, "tmp_main.py")
+	if err := os.WriteFile(from, []byte(pyCode), 0600); err != nil {
+		t.Fatal(err)
+	}
+	fromFile, err := os.Open(from)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fromFile.Close()
+	toFile, err := os.Create(to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer toFile.Close()
+
+	if err := saveMetrics(metricsFilePath)(fromFile, toFile); err != nil {
+		t.Fatalf("saveMetrics() error = %v", err)
+	}
+	got, err := os.ReadFile(to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantCode := fmt.Sprintf(addMetricsHandlerCode, metricsFilePath) + pyCode
+	if !strings.HasPrefix(string(got), wantCode) {
+		t.Errorf("saveMetrics() code = %q, want %q", got, wantCode)
+	}
+}
+
 func Test_writeToFile(t *testing.T) {
 	tmp, _ := utils.CreateTempFile(correctPyFile)
 	defer tmp.Close()
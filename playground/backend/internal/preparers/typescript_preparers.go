@@ -0,0 +1,58 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preparers
+
+import (
+	"os"
+)
+
+// addTypeScriptLogHandlerCode redirects logs of the TypeScript SDK to the log file, so only console.log() calls are in the run output
+const addTypeScriptLogHandlerCode = "import * as playgroundFs from \"fs\";\nimport * as playgroundUtil from \"util\";\nconst playgroundLogFile = playgroundFs.openSync(\"logs.log\", \"a\");\nfor (const playgroundLogLevel of [\"debug\", \"info\", \"warn\"] as const) {\n  console[playgroundLogLevel] = (...args: any[]) => playgroundFs.writeSync(playgroundLogFile, playgroundUtil.format(...args) + \"\\n\");\n}\n"
+
+// GetTypeScriptPreparers returns preparation methods that should be applied to TypeScript code
+func GetTypeScriptPreparers(builder *PreparersBuilder, isUnitTest bool) {
+	builder.
+		TypeScriptPreparers().
+		WithLogHandler()
+	if !isUnitTest {
+		builder.WithEmulatorParametersChanger()
+	}
+}
+
+// TypeScriptPreparersBuilder facet of PreparersBuilder
+type TypeScriptPreparersBuilder struct {
+	PreparersBuilder
+}
+
+// TypeScriptPreparers chains to type *PreparersBuilder and returns a *TypeScriptPreparersBuilder
+func (builder *PreparersBuilder) TypeScriptPreparers() *TypeScriptPreparersBuilder {
+	return &TypeScriptPreparersBuilder{*builder}
+}
+
+// WithLogHandler adds code to write logs to the log file
+func (builder *TypeScriptPreparersBuilder) WithLogHandler() *TypeScriptPreparersBuilder {
+	addLogHandler := Preparer{
+		Prepare: addCodeToFile,
+		Args:    []interface{}{builder.filePath, saveTypeScriptLogs},
+	}
+	builder.AddPreparer(addLogHandler)
+	return builder
+}
+
+// saveTypeScriptLogs rewrites all lines from file with adding the log handler code to the top of another file
+func saveTypeScriptLogs(from *os.File, to *os.File) error {
+	return addCodeToTop(from, to, addTypeScriptLogHandlerCode)
+}
@@ -0,0 +1,88 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preparers
+
+import (
+	"beam.apache.org/playground/backend/internal/constants"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestGetTypeScriptPreparers(t *testing.T) {
+	type args struct {
+		filePath      string
+		prepareParams map[string]string
+		isUnitTest    bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want *[]Preparer
+	}{
+		{
+			// getting the expected preparers for the unit test
+			name: "Get expected preparers for the unit test",
+			args: args{filePath: "", prepareParams: make(map[string]string), isUnitTest: true},
+			want: &[]Preparer{{Prepare: addCodeToFile, Args: []interface{}{"", saveTypeScriptLogs}}},
+		},
+		{
+			// getting the expected preparers for the pipeline
+			name: "Get expected preparers for the pipeline",
+			args: args{filePath: "", prepareParams: map[string]string{constants.PubSubEmulatorHostKey: "localhost:8085"}, isUnitTest: false},
+			want: &[]Preparer{
+				{Prepare: addCodeToFile, Args: []interface{}{"", saveTypeScriptLogs}},
+				{Prepare: replace, Args: []interface{}{"", regexp.QuoteMeta("pubsub_emulator:8085"), "localhost:8085"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := NewPreparersBuilder(tt.args.filePath, tt.args.prepareParams)
+			GetTypeScriptPreparers(builder, tt.args.isUnitTest)
+			if got := builder.Build().GetPreparers(); !reflect.DeepEqual(fmt.Sprint(got), fmt.Sprint(tt.want)) {
+				t.Errorf("GetTypeScriptPreparers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_saveTypeScriptLogs(t *testing.T) {
+	code := "console.log(\"Hello, World!\");\n"
+	dir := t.TempDir()
+	sourcePath := filepath.Join(dir, "main.ts")
+	if err := os.WriteFile(sourcePath, []byte(code), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := addCodeToFile(sourcePath, saveTypeScriptLogs); err != nil {
+		t.Fatalf("addCodeToFile() error = %v", err)
+	}
+	got, err := os.ReadFile(sourcePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(got), addTypeScriptLogHandlerCode) {
+		t.Errorf("saveTypeScriptLogs() got = %q, want the log handler code at the top", got)
+	}
+	if !strings.HasSuffix(string(got), code) {
+		t.Errorf("saveTypeScriptLogs() got = %q, want the source code after the log handler code", got)
+	}
+}
@@ -0,0 +1,216 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sandbox
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+
+	"beam.apache.org/playground/backend/internal/environment"
+	"beam.apache.org/playground/backend/internal/logger"
+)
+
+const (
+	cgroupControllers      = "+cpu +memory +pids"
+	cgroupCPUPeriod        = 100000
+	cgroupFileMode         = 0644
+	cgroupDirMode          = 0755
+	cgroupRemoveRetries    = 10
+	cgroupRemoveRetryPause = 100 * time.Millisecond
+
+	// cgroupExecArg is the first argument of the server binary started as the wrapper of a command, see Init
+	cgroupExecArg = "playground-sandbox-exec"
+	// cgroupExecFailureCode is the exit code of the wrapper if it fails to execute the command, like in shells
+	cgroupExecFailureCode = 127
+)
+
+// cgroupLimiter restricts CPU, memory and the number of processes of a run using a cgroup v2.
+type cgroupLimiter struct {
+	path         string
+	memoryLimit  int64
+	processLimit int64
+}
+
+// newCgroupLimiter creates a cgroup of the pipeline under the configured root cgroup and writes all limits to it.
+func newCgroupLimiter(envs *environment.SandboxEnvs, pipelineId uuid.UUID) (*cgroupLimiter, error) {
+	root := envs.CgroupRoot()
+	if err := os.MkdirAll(root, cgroupDirMode); err != nil {
+		return nil, fmt.Errorf("error during creating root cgroup: %s", err.Error())
+	}
+	// Controllers have to be enabled in the parent to be available in the cgroup of the pipeline
+	if err := os.WriteFile(filepath.Join(root, "cgroup.subtree_control"), []byte(cgroupControllers), cgroupFileMode); err != nil {
+		return nil, fmt.Errorf("error during enabling cgroup controllers: %s", err.Error())
+	}
+
+	l := &cgroupLimiter{
+		path:         filepath.Join(root, pipelineId.String()),
+		memoryLimit:  envs.MemoryLimit(),
+		processLimit: envs.ProcessLimit(),
+	}
+	if err := os.Mkdir(l.path, cgroupDirMode); err != nil {
+		return nil, fmt.Errorf("error during creating cgroup: %s", err.Error())
+	}
+
+	limits := map[string]string{}
+	if envs.CPULimit() > 0 {
+		limits["cpu.max"] = fmt.Sprintf("%d %d", int64(envs.CPULimit()*cgroupCPUPeriod), cgroupCPUPeriod)
+	}
+	if l.memoryLimit > 0 {
+		limits["memory.max"] = strconv.FormatInt(l.memoryLimit, 10)
+	}
+	if l.processLimit > 0 {
+		limits["pids.max"] = strconv.FormatInt(l.processLimit, 10)
+	}
+	for file, value := range limits {
+		if err := l.write(file, value); err != nil {
+			l.cleanup()
+			return nil, fmt.Errorf("error during setting %s: %s", file, err.Error())
+		}
+	}
+	if l.memoryLimit > 0 {
+		// Swap isn't accounted on some hosts, the memory limit works without it
+		if err := l.write("memory.swap.max", "0"); err != nil && !errors.Is(err, os.ErrNotExist) {
+			logger.Warnf("%s: Sandbox: error during disabling swap: %s", pipelineId, err.Error())
+		}
+	}
+	return l, nil
+}
+
+// prepare makes the command start the server binary as a wrapper, which moves its own process to the cgroup
+// and only then executes the command, see Init. Moving the process after the start would leave the processes
+// forked by the command before the move outside of the limits.
+func (l *cgroupLimiter) prepare(cmd *exec.Cmd) error {
+	self, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	cmd.Args = append([]string{cgroupExecArg, filepath.Join(l.path, "cgroup.procs"), cmd.Path}, cmd.Args...)
+	cmd.Path = self
+	return nil
+}
+
+// Init runs the wrapper of the cgroup sandbox if the binary was started as one, and returns immediately otherwise.
+// The wrapper moves its process to the cgroup of the run and replaces itself with the command of the run.
+// Init must be called at the start of main of the binaries which run pipelines in sandboxes.
+func Init() {
+	if len(os.Args) < 4 || os.Args[0] != cgroupExecArg {
+		return
+	}
+	procs, path, args := os.Args[1], os.Args[2], os.Args[3:]
+	if err := os.WriteFile(procs, []byte(strconv.Itoa(os.Getpid())), cgroupFileMode); err != nil {
+		fmt.Fprintf(os.Stderr, "error during placing the process into the sandbox: %s\n", err.Error())
+		os.Exit(cgroupExecFailureCode)
+	}
+	err := syscall.Exec(path, args, os.Environ())
+	fmt.Fprintf(os.Stderr, "error during starting %s: %s\n", path, err.Error())
+	os.Exit(cgroupExecFailureCode)
+}
+
+func (l *cgroupLimiter) kill() error {
+	err := l.write("cgroup.kill", "1")
+	if err == nil {
+		return nil
+	}
+	// cgroup.kill is available since Linux 5.14, kill processes one by one on older kernels
+	pids, readErr := l.readLines("cgroup.procs")
+	if readErr != nil {
+		return readErr
+	}
+	for _, pid := range pids {
+		id, err := strconv.Atoi(pid)
+		if err != nil {
+			continue
+		}
+		if process, err := os.FindProcess(id); err == nil {
+			_ = process.Kill()
+		}
+	}
+	return nil
+}
+
+func (l *cgroupLimiter) terminationReason() string {
+	if l.memoryLimit > 0 && l.event("memory.events", "oom_kill") > 0 {
+		return fmt.Sprintf("run was terminated: memory limit of %d MB exceeded", l.memoryLimit/bytesInMegabyte)
+	}
+	if l.processLimit > 0 && l.event("pids.events", "max") > 0 {
+		return fmt.Sprintf("run was restricted: process limit of %d exceeded", l.processLimit)
+	}
+	return ""
+}
+
+// cleanup kills remaining processes of the run and removes the cgroup.
+func (l *cgroupLimiter) cleanup() {
+	if err := l.kill(); err != nil {
+		logger.Warnf("Sandbox: error during killing processes of %s: %s", l.path, err.Error())
+	}
+	// The cgroup can be removed only after all killed processes exit
+	for i := 0; i < cgroupRemoveRetries; i++ {
+		err := syscall.Rmdir(l.path)
+		if err == nil || errors.Is(err, os.ErrNotExist) {
+			return
+		}
+		if !errors.Is(err, syscall.EBUSY) {
+			logger.Warnf("Sandbox: error during removing cgroup %s: %s", l.path, err.Error())
+			return
+		}
+		time.Sleep(cgroupRemoveRetryPause)
+	}
+	logger.Warnf("Sandbox: cgroup %s is still busy and wasn't removed", l.path)
+}
+
+// event returns the counter of the event from the events file of the cgroup.
+func (l *cgroupLimiter) event(file, name string) int64 {
+	lines, err := l.readLines(file)
+	if err != nil {
+		return 0
+	}
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[0] == name {
+			value, _ := strconv.ParseInt(fields[1], 10, 64)
+			return value
+		}
+	}
+	return 0
+}
+
+func (l *cgroupLimiter) write(file, value string) error {
+	return os.WriteFile(filepath.Join(l.path, file), []byte(value), cgroupFileMode)
+}
+
+func (l *cgroupLimiter) readLines(file string) ([]string, error) {
+	f, err := os.Open(filepath.Join(l.path, file))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return lines, scanner.Err()
+}
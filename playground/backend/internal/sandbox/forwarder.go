@@ -0,0 +1,120 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sandbox
+
+import (
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"beam.apache.org/playground/backend/internal/logger"
+)
+
+const forwarderDialTimeout = 5 * time.Second
+
+// forwarder accepts connections from the sandbox and forwards them to the allowed addresses.
+type forwarder struct {
+	listeners []net.Listener
+
+	mu     sync.Mutex
+	conns  map[net.Conn]struct{}
+	closed bool
+}
+
+func newForwarder(listeners []net.Listener, addresses []string) *forwarder {
+	f := &forwarder{listeners: listeners, conns: map[net.Conn]struct{}{}}
+	for i, listener := range listeners {
+		go f.serve(listener, addresses[i])
+	}
+	return f
+}
+
+func (f *forwarder) serve(listener net.Listener, address string) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		go f.forward(conn, address)
+	}
+}
+
+func (f *forwarder) forward(conn net.Conn, address string) {
+	target, err := net.DialTimeout("tcp", address, forwarderDialTimeout)
+	if err != nil {
+		logger.Warnf("Sandbox: error during connecting to %s: %s", address, err.Error())
+		_ = conn.Close()
+		return
+	}
+	if !f.track(conn, target) {
+		return
+	}
+	defer f.untrack(conn, target)
+
+	done := make(chan struct{}, 2)
+	pipe := func(dst, src net.Conn) {
+		_, _ = io.Copy(dst, src)
+		done <- struct{}{}
+	}
+	go pipe(target, conn)
+	go pipe(conn, target)
+	<-done
+}
+
+// track registers connections to close them with the forwarder.
+// Returns false and closes connections if the forwarder is already closed.
+func (f *forwarder) track(conns ...net.Conn) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.closed {
+		for _, conn := range conns {
+			_ = conn.Close()
+		}
+		return false
+	}
+	for _, conn := range conns {
+		f.conns[conn] = struct{}{}
+	}
+	return true
+}
+
+func (f *forwarder) untrack(conns ...net.Conn) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, conn := range conns {
+		_ = conn.Close()
+		delete(f.conns, conn)
+	}
+}
+
+// close stops accepting connections and closes all forwarded connections.
+func (f *forwarder) close() {
+	closeListeners(f.listeners)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.closed = true
+	for conn := range f.conns {
+		_ = conn.Close()
+	}
+	f.conns = map[net.Conn]struct{}{}
+}
+
+func closeListeners(listeners []net.Listener) {
+	for _, listener := range listeners {
+		_ = listener.Close()
+	}
+}
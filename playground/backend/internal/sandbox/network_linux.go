@@ -0,0 +1,133 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sandbox
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"runtime"
+
+	"golang.org/x/sys/unix"
+
+	"beam.apache.org/playground/backend/internal/logger"
+)
+
+const (
+	networkIsolationSupported = true
+	loopbackInterface         = "lo"
+	loopbackHost              = "127.0.0.1"
+)
+
+// startInNetworkNamespace starts the command in a new network namespace which has only a loopback interface.
+// Each of the allowed addresses, which are loopback addresses of the server, is listened on the loopback interface
+// inside the namespace with the same host and port, and connections are forwarded to the address
+// from the namespace of the server.
+func startInNetworkNamespace(cmd *exec.Cmd, allowedAddresses []string) (*forwarder, error) {
+	type result struct {
+		listeners []net.Listener
+		err       error
+	}
+	resultCh := make(chan result, 1)
+	go func() {
+		// The thread of the goroutine is moved to the new namespace, and the command inherits it on start.
+		// The thread is unlocked only after it's moved back, otherwise the runtime terminates it.
+		runtime.LockOSThread()
+		listeners, err := startInNewNamespace(cmd, allowedAddresses)
+		resultCh <- result{listeners: listeners, err: err}
+	}()
+	res := <-resultCh
+	if res.err != nil {
+		return nil, res.err
+	}
+	return newForwarder(res.listeners, allowedAddresses), nil
+}
+
+// startInNewNamespace must be called on a locked thread.
+func startInNewNamespace(cmd *exec.Cmd, allowedAddresses []string) ([]net.Listener, error) {
+	origin, err := os.Open(fmt.Sprintf("/proc/self/task/%d/ns/net", unix.Gettid()))
+	if err != nil {
+		runtime.UnlockOSThread()
+		return nil, fmt.Errorf("error during opening network namespace: %s", err.Error())
+	}
+	defer origin.Close()
+	if err = unix.Unshare(unix.CLONE_NEWNET); err != nil {
+		runtime.UnlockOSThread()
+		return nil, fmt.Errorf("error during creating network namespace: %s", err.Error())
+	}
+
+	listeners, err := setupNamespace(allowedAddresses)
+	if err == nil {
+		err = cmd.Start()
+	}
+	if err != nil {
+		closeListeners(listeners)
+	}
+
+	if setnsErr := unix.Setns(int(origin.Fd()), unix.CLONE_NEWNET); setnsErr != nil {
+		logger.Errorf("Sandbox: error during restoring network namespace: %s", setnsErr.Error())
+	} else {
+		runtime.UnlockOSThread()
+	}
+	return listeners, err
+}
+
+// setupNamespace brings the loopback interface of the current network namespace up
+// and listens on it for all allowed addresses.
+func setupNamespace(allowedAddresses []string) ([]net.Listener, error) {
+	if err := setLoopbackUp(); err != nil {
+		return nil, fmt.Errorf("error during setting up loopback interface: %s", err.Error())
+	}
+	var listeners []net.Listener
+	for _, address := range allowedAddresses {
+		host, port, err := net.SplitHostPort(address)
+		if err != nil {
+			closeListeners(listeners)
+			return nil, fmt.Errorf("incorrect allowed address %s: %s", address, err.Error())
+		}
+		if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+			// Hosts are resolved outside the namespace, so only localhost is listened by name
+			host = loopbackHost
+		}
+		listener, err := net.Listen("tcp", net.JoinHostPort(host, port))
+		if err != nil {
+			closeListeners(listeners)
+			return nil, fmt.Errorf("error during listening for %s: %s", address, err.Error())
+		}
+		listeners = append(listeners, listener)
+	}
+	return listeners, nil
+}
+
+func setLoopbackUp() error {
+	fd, err := unix.Socket(unix.AF_INET, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return err
+	}
+	defer unix.Close(fd)
+	ifreq, err := unix.NewIfreq(loopbackInterface)
+	if err != nil {
+		return err
+	}
+	if err = unix.IoctlIfreq(fd, unix.SIOCGIFFLAGS, ifreq); err != nil {
+		return err
+	}
+	ifreq.SetUint16(ifreq.Uint16() | unix.IFF_UP)
+	return unix.IoctlIfreq(fd, unix.SIOCSIFFLAGS, ifreq)
+}
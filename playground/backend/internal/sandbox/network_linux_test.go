@@ -0,0 +1,133 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package sandbox
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"beam.apache.org/playground/backend/internal/environment"
+)
+
+const (
+	helperProcessEnv = "SANDBOX_HELPER_PROCESS"
+	helperAddressEnv = "SANDBOX_HELPER_ADDRESS"
+	helperGreeting   = "MOCK_GREETING"
+)
+
+// TestHelperProcess isn't a real test, it's started by the tests as a command in the sandbox.
+// It connects to the address and prints the received line.
+func TestHelperProcess(t *testing.T) {
+	if os.Getenv(helperProcessEnv) != "1" {
+		return
+	}
+	conn, err := net.Dial("tcp", os.Getenv(helperAddressEnv))
+	if err != nil {
+		fmt.Print("dial error")
+		os.Exit(1)
+	}
+	line, _ := bufio.NewReader(conn).ReadString('\n')
+	fmt.Print(strings.TrimSpace(line))
+	os.Exit(0)
+}
+
+func helperCommand(address string) *exec.Cmd {
+	cmd := exec.Command(os.Args[0], "-test.run=TestHelperProcess")
+	cmd.Env = append(os.Environ(), helperProcessEnv+"=1", helperAddressEnv+"="+address)
+	return cmd
+}
+
+func TestSandbox_Run_DenyNetwork(t *testing.T) {
+	allowed := listen(t)
+	denied := listen(t)
+
+	tests := []struct {
+		name       string
+		address    string
+		wantErr    bool
+		wantOutput string
+	}{
+		{
+			// Test case with connecting to the allowed address from the sandbox.
+			// As a result, want to receive the data sent by the server.
+			name:       "Allowed address",
+			address:    allowed,
+			wantErr:    false,
+			wantOutput: helperGreeting,
+		},
+		{
+			// Test case with connecting to the address which isn't allowed from the sandbox.
+			// As a result, want to receive an error of the connection.
+			name:       "Denied address",
+			address:    denied,
+			wantErr:    true,
+			wantOutput: "dial error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := New(environment.NewSandboxEnvs(ProcessSandboxType, "", 0, 0, 0, 0, true, nil), uuid.New(), t.TempDir(), []string{allowed})
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+			cmd := helperCommand(tt.address)
+			var output bytes.Buffer
+			cmd.Stdout = &output
+			err = s.Run(cmd)
+			if errors.Is(err, syscall.EPERM) || (err != nil && strings.Contains(err.Error(), "operation not permitted")) {
+				t.Skipf("network namespaces aren't permitted: %v", err)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if output.String() != tt.wantOutput {
+				t.Errorf("Run() output = %v, want %v", output.String(), tt.wantOutput)
+			}
+		})
+	}
+}
+
+// listen starts a server on the loopback interface which greets every connection.
+func listen(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			_, _ = fmt.Fprintln(conn, helperGreeting)
+			_ = conn.Close()
+		}
+	}()
+	return listener.Addr().String()
+}
@@ -0,0 +1,69 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sandbox
+
+import (
+	"errors"
+	"os/exec"
+	"sync"
+	"syscall"
+
+	"beam.apache.org/playground/backend/internal/logger"
+)
+
+// processLimiter doesn't restrict resources of a run and only tracks its command to be able to kill it.
+// The command is started in its own process group, so the processes forked by it are killed together with it.
+type processLimiter struct {
+	mu  sync.Mutex
+	cmd *exec.Cmd
+}
+
+func (l *processLimiter) prepare(cmd *exec.Cmd) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Setpgid = true
+	l.cmd = cmd
+	return nil
+}
+
+// kill is called only after the command is started, so its process is set.
+func (l *processLimiter) kill() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.cmd == nil || l.cmd.Process == nil {
+		return nil
+	}
+	// The group of the command has the identifier of its process, the negative one addresses the whole group
+	err := syscall.Kill(-l.cmd.Process.Pid, syscall.SIGKILL)
+	if errors.Is(err, syscall.ESRCH) {
+		return nil
+	}
+	return err
+}
+
+func (l *processLimiter) terminationReason() string {
+	return ""
+}
+
+// cleanup kills the processes left in the group of the command after it finished.
+func (l *processLimiter) cleanup() {
+	if err := l.kill(); err != nil {
+		logger.Warnf("Sandbox: error during killing processes of the run: %s", err.Error())
+	}
+}
@@ -0,0 +1,238 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sandbox
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"beam.apache.org/playground/backend/internal/environment"
+	"beam.apache.org/playground/backend/internal/logger"
+)
+
+const (
+	// ProcessSandboxType runs pipelines as plain child processes of the server
+	ProcessSandboxType = "process"
+	// CgroupSandboxType runs pipelines in a dedicated cgroup v2 with CPU, memory and process limits
+	CgroupSandboxType = "cgroup"
+
+	bytesInMegabyte   = 1024 * 1024
+	diskCheckInterval = time.Second
+	tempDirName       = "tmp"
+	tempDirMode       = 0755
+)
+
+// Sandbox runs the command of a single pipeline in isolation from the server and other pipelines.
+type Sandbox interface {
+	// Run starts the command inside the sandbox and waits for it to complete.
+	Run(cmd *exec.Cmd) error
+
+	// TerminationReason returns the reason why the sandbox terminated or restricted the command,
+	// or an empty string if the command finished on its own.
+	TerminationReason() string
+}
+
+// limiter enforces resource limits on the processes of a run.
+type limiter interface {
+	// prepare changes the command to start its process under the limits. It must be called before the start.
+	prepare(cmd *exec.Cmd) error
+	// kill terminates all processes under the limits.
+	kill() error
+	// terminationReason returns the reason of the termination if one of the limits was hit.
+	// It must be called before cleanup.
+	terminationReason() string
+	// cleanup releases all resources of the limiter.
+	cleanup()
+}
+
+// New returns a Sandbox for the pipeline configured by the sandbox environment.
+// dir is the pipeline folder whose size is restricted by the disk limit. When the disk limit is set,
+// the temporary folder of the run is moved into dir, so temporary files are restricted too.
+// emulatorAddresses are available to the pipeline in addition to the configured allowed addresses
+// when the network access is denied.
+func New(envs *environment.SandboxEnvs, pipelineId uuid.UUID, dir string, emulatorAddresses []string) (Sandbox, error) {
+	if envs == nil {
+		envs = environment.NewSandboxEnvs(ProcessSandboxType, "", 0, 0, 0, 0, false, nil)
+	}
+	if envs.DenyNetwork() && !networkIsolationSupported {
+		return nil, errors.New("network isolation isn't supported on this platform")
+	}
+
+	var l limiter
+	switch envs.SandboxType() {
+	case ProcessSandboxType:
+		l = &processLimiter{}
+	case CgroupSandboxType:
+		cgroup, err := newCgroupLimiter(envs, pipelineId)
+		if err != nil {
+			return nil, err
+		}
+		l = cgroup
+	default:
+		return nil, fmt.Errorf("unknown sandbox type: %s", envs.SandboxType())
+	}
+
+	var allowedAddresses []string
+	allowedAddresses = append(allowedAddresses, envs.AllowedAddresses()...)
+	allowedAddresses = append(allowedAddresses, emulatorAddresses...)
+	return &sandbox{
+		pipelineId:       pipelineId,
+		dir:              dir,
+		diskLimit:        envs.DiskLimit(),
+		denyNetwork:      envs.DenyNetwork(),
+		allowedAddresses: allowedAddresses,
+		limiter:          l,
+	}, nil
+}
+
+// sandbox runs the command under the limiter and additionally restricts the disk usage and the network access.
+type sandbox struct {
+	pipelineId       uuid.UUID
+	dir              string
+	diskLimit        int64
+	denyNetwork      bool
+	allowedAddresses []string
+	limiter          limiter
+
+	mu     sync.Mutex
+	reason string
+}
+
+func (s *sandbox) Run(cmd *exec.Cmd) error {
+	defer s.cleanup()
+	if s.diskLimit > 0 {
+		if err := s.useTempDir(cmd); err != nil {
+			return fmt.Errorf("error during creating the temporary folder of the run: %s", err.Error())
+		}
+	}
+	if err := s.limiter.prepare(cmd); err != nil {
+		return fmt.Errorf("error during placing the process into the sandbox: %s", err.Error())
+	}
+	if s.denyNetwork {
+		proxy, err := startInNetworkNamespace(cmd, s.allowedAddresses)
+		if err != nil {
+			return err
+		}
+		defer proxy.close()
+	} else if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	done := make(chan struct{})
+	if s.diskLimit > 0 {
+		go s.watchDisk(done)
+	}
+	err := cmd.Wait()
+	close(done)
+	return err
+}
+
+func (s *sandbox) TerminationReason() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.reason
+}
+
+// cleanup keeps the termination reason of the limiter, unless the run was already terminated by the sandbox,
+// and releases the resources of the limiter, after which the reason can't be read.
+func (s *sandbox) cleanup() {
+	reason := s.limiter.terminationReason()
+	s.mu.Lock()
+	if s.reason == "" {
+		s.reason = reason
+	}
+	s.mu.Unlock()
+	s.limiter.cleanup()
+}
+
+// useTempDir points TMPDIR of the command to a folder inside the pipeline folder, so the temporary files
+// of the run are counted by the disk limit and removed together with the pipeline folder.
+// Files written to other locations, e.g. to java.io.tmpdir of JVM, which ignores TMPDIR, aren't counted.
+func (s *sandbox) useTempDir(cmd *exec.Cmd) error {
+	dir := filepath.Join(s.dir, tempDirName)
+	if err := os.MkdirAll(dir, tempDirMode); err != nil {
+		return err
+	}
+	env := cmd.Env
+	if env == nil {
+		env = os.Environ()
+	}
+	cmd.Env = append(env, "TMPDIR="+dir)
+	return nil
+}
+
+// watchDisk periodically checks the size of the pipeline folder and kills the run when it exceeds the disk limit.
+func (s *sandbox) watchDisk(done <-chan struct{}) {
+	ticker := time.NewTicker(diskCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			size, err := dirSize(s.dir)
+			if err != nil {
+				logger.Warnf("%s: Sandbox: error during calculating disk usage: %s", s.pipelineId, err.Error())
+				continue
+			}
+			if size <= s.diskLimit {
+				continue
+			}
+			s.mu.Lock()
+			s.reason = fmt.Sprintf("run was terminated: disk limit of %d MB exceeded", s.diskLimit/bytesInMegabyte)
+			s.mu.Unlock()
+			if err := s.limiter.kill(); err != nil {
+				logger.Errorf("%s: Sandbox: error during killing the run: %s", s.pipelineId, err.Error())
+			}
+			return
+		}
+	}
+}
+
+// dirSize returns the total size of regular files in the directory.
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// Files may be removed by the run while walking
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
+}
@@ -0,0 +1,304 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sandbox
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"beam.apache.org/playground/backend/internal/environment"
+	"beam.apache.org/playground/backend/internal/logger"
+)
+
+func TestMain(m *testing.M) {
+	// The test binary is started as the wrapper of the cgroup sandbox by the tests
+	Init()
+	logger.SetupLogger(context.Background(), "local", "some_google_project_id")
+	os.Exit(m.Run())
+}
+
+func TestNew(t *testing.T) {
+	cgroupRoot := t.TempDir()
+	tests := []struct {
+		name    string
+		envs    *environment.SandboxEnvs
+		wantErr bool
+	}{
+		{
+			// Test case with calling New method without sandbox environment.
+			// As a result, want to receive a process sandbox.
+			name:    "Without environment",
+			envs:    nil,
+			wantErr: false,
+		},
+		{
+			// Test case with calling New method with the process sandbox type.
+			// As a result, want to receive a process sandbox.
+			name:    "Process sandbox",
+			envs:    environment.NewSandboxEnvs(ProcessSandboxType, "", 0, 0, 0, 0, false, nil),
+			wantErr: false,
+		},
+		{
+			// Test case with calling New method with the cgroup sandbox type.
+			// As a result, want to receive a cgroup sandbox.
+			name:    "Cgroup sandbox",
+			envs:    environment.NewSandboxEnvs(CgroupSandboxType, cgroupRoot, 1, 256*bytesInMegabyte, 64, 0, false, nil),
+			wantErr: false,
+		},
+		{
+			// Test case with calling New method with an unknown sandbox type.
+			// As a result, want to receive an error.
+			name:    "Unknown sandbox type",
+			envs:    environment.NewSandboxEnvs("MOCK_TYPE", "", 0, 0, 0, 0, false, nil),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := New(tt.envs, uuid.New(), t.TempDir(), nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("New() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got == nil {
+				t.Errorf("New() returned nil sandbox")
+			}
+		})
+	}
+}
+
+func TestSandbox_Run(t *testing.T) {
+	tests := []struct {
+		name       string
+		cmd        *exec.Cmd
+		diskLimit  int64
+		wantErr    bool
+		wantReason string
+	}{
+		{
+			// Test case with running a command which finishes successfully.
+			// As a result, want to receive no error and no termination reason.
+			name:       "Successful run",
+			cmd:        exec.Command("true"),
+			wantErr:    false,
+			wantReason: "",
+		},
+		{
+			// Test case with running a command which fails on its own.
+			// As a result, want to receive an error and no termination reason.
+			name:       "Failed run",
+			cmd:        exec.Command("false"),
+			wantErr:    true,
+			wantReason: "",
+		},
+		{
+			// Test case with running a command which writes more temporary data than allowed by the disk limit.
+			// As a result, want to receive an error and the disk limit termination reason.
+			name:       "Disk limit exceeded by temporary files",
+			cmd:        exec.Command("sh", "-c", "head -c 2097152 /dev/zero > $TMPDIR/data && sleep 30"),
+			diskLimit:  bytesInMegabyte,
+			wantErr:    true,
+			wantReason: "run was terminated: disk limit of 1 MB exceeded",
+		},
+		{
+			// Test case with running a command whose child process keeps the output open after the disk limit is exceeded.
+			// As a result, want to receive an error and the disk limit termination reason without waiting for the child.
+			name:       "Disk limit exceeded with child process",
+			cmd:        exec.Command("sh", "-c", "sleep 30 & head -c 2097152 /dev/zero > data; wait"),
+			diskLimit:  bytesInMegabyte,
+			wantErr:    true,
+			wantReason: "run was terminated: disk limit of 1 MB exceeded",
+		},
+		{
+			// Test case with running a command which writes more data than allowed by the disk limit.
+			// As a result, want to receive an error and the disk limit termination reason.
+			name:       "Disk limit exceeded",
+			cmd:        exec.Command("sh", "-c", "head -c 2097152 /dev/zero > data && sleep 30"),
+			diskLimit:  bytesInMegabyte,
+			wantErr:    true,
+			wantReason: "run was terminated: disk limit of 1 MB exceeded",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			tt.cmd.Dir = dir
+			// The run waits for the output to be closed by all processes, like when it's streamed to the cache
+			tt.cmd.Stdout = &bytes.Buffer{}
+			s, err := New(environment.NewSandboxEnvs(ProcessSandboxType, "", 0, 0, 0, tt.diskLimit, false, nil), uuid.New(), dir, nil)
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+			start := time.Now()
+			if err = s.Run(tt.cmd); (err != nil) != tt.wantErr {
+				t.Errorf("Run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if time.Since(start) > 10*time.Second {
+				t.Errorf("Run() wasn't terminated in time")
+			}
+			if got := s.TerminationReason(); got != tt.wantReason {
+				t.Errorf("TerminationReason() = %v, want %v", got, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestNewCgroupLimiter(t *testing.T) {
+	root := t.TempDir()
+	pipelineId := uuid.New()
+	envs := environment.NewSandboxEnvs(CgroupSandboxType, root, 0.5, 256*bytesInMegabyte, 64, 0, false, nil)
+
+	_, err := newCgroupLimiter(envs, pipelineId)
+	if err != nil {
+		t.Fatalf("newCgroupLimiter() error = %v", err)
+	}
+	want := map[string]string{
+		filepath.Join(root, "cgroup.subtree_control"):               cgroupControllers,
+		filepath.Join(root, pipelineId.String(), "cpu.max"):         "50000 100000",
+		filepath.Join(root, pipelineId.String(), "memory.max"):      "268435456",
+		filepath.Join(root, pipelineId.String(), "memory.swap.max"): "0",
+		filepath.Join(root, pipelineId.String(), "pids.max"):        "64",
+	}
+	for file, value := range want {
+		got, err := os.ReadFile(file)
+		if err != nil {
+			t.Errorf("newCgroupLimiter() didn't write %s: %v", file, err)
+			continue
+		}
+		if string(got) != value {
+			t.Errorf("newCgroupLimiter() wrote %s = %v, want %v", file, string(got), value)
+		}
+	}
+
+	if _, err = newCgroupLimiter(envs, pipelineId); err == nil {
+		t.Errorf("newCgroupLimiter() for existing cgroup error = nil, want error")
+	}
+}
+
+func TestCgroupLimiter_prepare(t *testing.T) {
+	l := &cgroupLimiter{path: t.TempDir()}
+	// The command prints the identifier of its process, which must be moved to the cgroup before the command starts
+	cmd := exec.Command("sh", "-c", "echo $$")
+	if err := l.prepare(cmd); err != nil {
+		t.Fatalf("prepare() error = %v", err)
+	}
+	output, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("running prepared command error = %v", err)
+	}
+	procs, err := os.ReadFile(filepath.Join(l.path, "cgroup.procs"))
+	if err != nil {
+		t.Fatalf("prepare() didn't write cgroup.procs: %v", err)
+	}
+	pid := strconv.Itoa(cmd.Process.Pid)
+	if string(procs) != pid {
+		t.Errorf("prepare() wrote cgroup.procs = %v, want %v", string(procs), pid)
+	}
+	if got := strings.TrimSpace(string(output)); got != pid {
+		t.Errorf("prepared command ran as process %v, want %v", got, pid)
+	}
+}
+
+// removingLimiter removes the files of the cgroup on cleanup, like the removal of a cgroup does.
+type removingLimiter struct {
+	*cgroupLimiter
+}
+
+func (l removingLimiter) cleanup() {
+	_ = os.RemoveAll(l.path)
+}
+
+func TestSandbox_Run_terminationReasonBeforeCleanup(t *testing.T) {
+	tests := []struct {
+		name   string
+		events map[string]string
+		want   string
+	}{
+		{
+			// Test case with no events of the cgroup.
+			// As a result, want to receive an empty reason.
+			name:   "No events",
+			events: map[string]string{"memory.events": "low 0\nhigh 0\nmax 3\noom 0\noom_kill 0\n", "pids.events": "max 0\n"},
+			want:   "",
+		},
+		{
+			// Test case with a process killed by the OOM killer of the cgroup.
+			// As a result, want to receive the memory limit reason after the cgroup is removed.
+			name:   "Memory limit",
+			events: map[string]string{"memory.events": "low 0\nhigh 0\nmax 12\noom 1\noom_kill 1\n", "pids.events": "max 0\n"},
+			want:   "run was terminated: memory limit of 256 MB exceeded",
+		},
+		{
+			// Test case with a failed fork because of the process limit of the cgroup.
+			// As a result, want to receive the process limit reason after the cgroup is removed.
+			name:   "Process limit",
+			events: map[string]string{"memory.events": "oom_kill 0\n", "pids.events": "max 2\n"},
+			want:   "run was restricted: process limit of 64 exceeded",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := &cgroupLimiter{path: filepath.Join(t.TempDir(), "cgroup"), memoryLimit: 256 * bytesInMegabyte, processLimit: 64}
+			if err := os.Mkdir(l.path, cgroupDirMode); err != nil {
+				t.Fatal(err)
+			}
+			for file, content := range tt.events {
+				if err := os.WriteFile(filepath.Join(l.path, file), []byte(content), cgroupFileMode); err != nil {
+					t.Fatal(err)
+				}
+			}
+			s := &sandbox{pipelineId: uuid.New(), dir: t.TempDir(), limiter: removingLimiter{l}}
+			if err := s.Run(exec.Command("true")); err != nil {
+				t.Fatalf("Run() error = %v", err)
+			}
+			if _, err := os.Stat(l.path); !os.IsNotExist(err) {
+				t.Errorf("Run() didn't clean up the cgroup")
+			}
+			if got := s.TerminationReason(); got != tt.want {
+				t.Errorf("TerminationReason() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_dirSize(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "bin"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "src.java"), []byte(strings.Repeat("a", 100)), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "bin", "src.class"), []byte(strings.Repeat("a", 50)), 0644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := dirSize(dir)
+	if err != nil {
+		t.Fatalf("dirSize() error = %v", err)
+	}
+	if got != 150 {
+		t.Errorf("dirSize() = %v, want 150", got)
+	}
+}
@@ -94,6 +94,15 @@ func Compiler(paths *fs_tool.LifeCyclePaths, sdkEnv *environment.BeamEnvs) (*exe
 			WithCompiler().
 			WithFileNames(goSources...).
 			ExecutorBuilder
+	case pb.Sdk_SDK_TYPESCRIPT:
+		typeScriptSources, err := GetFilesFromFolder(paths.AbsoluteSourceFileFolderPath, fs_tool.TypeScriptSourceFileExtension)
+		if err != nil {
+			return nil, err
+		}
+		builder = builder.
+			WithCompiler().
+			WithFileNames(typeScriptSources...).
+			ExecutorBuilder
 	default:
 		builder = builder.
 			WithCompiler().
@@ -143,6 +152,13 @@ func Runner(ctx context.Context, paths *fs_tool.LifeCyclePaths, pipelineOptions
 			WithExecutableFileNames(paths.AbsoluteExecutableFilePath).
 			WithPipelineOptions(strings.Split(pipelineOptions, " ")).
 			ExecutorBuilder
+	case pb.Sdk_SDK_TYPESCRIPT: // logs are written to the base folder, so the pipeline runs there
+		builder = builder.
+			WithRunner().
+			WithWorkingDir(paths.AbsoluteBaseFolderPath).
+			WithExecutableFileNames(paths.AbsoluteExecutableFilePath).
+			WithPipelineOptions(strings.Split(pipelineOptions, " ")).
+			ExecutorBuilder
 	case pb.Sdk_SDK_SCIO:
 		className, err := paths.FindExecutableName(ctx, paths.AbsoluteBaseFolderPath)
 		if err != nil {
@@ -183,6 +199,11 @@ func TestRunner(ctx context.Context, paths *fs_tool.LifeCyclePaths, sdkEnv *envi
 		builder = builder.WithTestRunner().
 			WithExecutableFileName(paths.AbsoluteSourceFileFolderPath). // run all tests in folder
 			ExecutorBuilder
+	case pb.Sdk_SDK_TYPESCRIPT:
+		builder = builder.WithTestRunner().
+			WithExecutableFileName(paths.AbsoluteExecutableFilePath).
+			WithWorkingDir(paths.AbsoluteBaseFolderPath).
+			ExecutorBuilder
 	default:
 		builder = builder.WithTestRunner().
 			WithExecutableFileName(paths.AbsoluteExecutableFilePath).
@@ -50,6 +50,9 @@ var goPaths *fs_tool.LifeCyclePaths
 var goSdkEnv *environment.BeamEnvs
 var scioPaths *fs_tool.LifeCyclePaths
 var scioSdkEnv *environment.BeamEnvs
+var typeScriptLC *fs_tool.LifeCycle
+var typeScriptPaths *fs_tool.LifeCyclePaths
+var typeScriptSdkEnv *environment.BeamEnvs
 
 func TestMain(m *testing.M) {
 	err := setup()
@@ -127,6 +130,17 @@ func setup() error {
 		return err
 	}
 
+	typeScriptPipelineId := uuid.New()
+	typeScriptLC, err = fs_tool.NewLifeCycle(pb.Sdk_SDK_TYPESCRIPT, typeScriptPipelineId, "")
+	if err != nil {
+		return err
+	}
+	err = typeScriptLC.CreateFolders()
+	if err != nil {
+		return err
+	}
+	typeScriptPaths = &typeScriptLC.Paths
+
 	executorConfig := &environment.ExecutorConfig{
 		CompileCmd:  "MOCK_COMPILE_CMD",
 		CompileArgs: []string{"MOCK_COMPILE_ARG"},
@@ -136,6 +150,7 @@ func setup() error {
 	javaSdkEnv = environment.NewBeamEnvs(pb.Sdk_SDK_JAVA, "", executorConfig, "", 0)
 	goSdkEnv = environment.NewBeamEnvs(pb.Sdk_SDK_GO, "", executorConfig, "", 0)
 	scioSdkEnv = environment.NewBeamEnvs(pb.Sdk_SDK_SCIO, "", executorConfig, "", 0)
+	typeScriptSdkEnv = environment.NewBeamEnvs(pb.Sdk_SDK_TYPESCRIPT, "", executorConfig, "", 0)
 
 	return nil
 }
@@ -161,6 +176,10 @@ func teardown() error {
 	if err != nil {
 		return err
 	}
+	err = typeScriptLC.DeleteFolders()
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -508,6 +527,14 @@ func TestRunnerBuilder(t *testing.T) {
 		WithCommand(scioSdkEnv.ExecutorConfig.RunCmd).
 		WithArgs([]string{stringArg})
 
+	wantTypeScriptExecutor := executors.NewExecutorBuilder().
+		WithRunner().
+		WithExecutableFileNames(typeScriptPaths.AbsoluteExecutableFilePath).
+		WithWorkingDir(typeScriptPaths.AbsoluteBaseFolderPath).
+		WithCommand(typeScriptSdkEnv.ExecutorConfig.RunCmd).
+		WithArgs(typeScriptSdkEnv.ExecutorConfig.RunArgs).
+		WithPipelineOptions(strings.Split("", " "))
+
 	type args struct {
 		paths           *fs_tool.LifeCyclePaths
 		pipelineOptions string
@@ -568,6 +595,14 @@ func TestRunnerBuilder(t *testing.T) {
 			},
 			want: nil,
 		},
+		{
+			name: "Test correct run builder with TypeScript sdk",
+			args: args{
+				paths:  typeScriptPaths,
+				sdkEnv: typeScriptSdkEnv,
+			},
+			want: &wantTypeScriptExecutor.ExecutorBuilder,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -614,6 +649,13 @@ func TestTestRunner(t *testing.T) {
 		WithArgs(pythonSdkEnv.ExecutorConfig.TestArgs).
 		WithWorkingDir(pythonPaths.AbsoluteSourceFileFolderPath)
 
+	wantTypeScriptExecutor := executors.NewExecutorBuilder().
+		WithTestRunner().
+		WithExecutableFileName(typeScriptPaths.AbsoluteExecutableFilePath).
+		WithCommand(typeScriptSdkEnv.ExecutorConfig.TestCmd).
+		WithArgs(typeScriptSdkEnv.ExecutorConfig.TestArgs).
+		WithWorkingDir(typeScriptPaths.AbsoluteBaseFolderPath)
+
 	type args struct {
 		paths  *fs_tool.LifeCyclePaths
 		sdkEnv *environment.BeamEnvs
@@ -655,6 +697,14 @@ func TestTestRunner(t *testing.T) {
 			},
 			want: &wantPythonExecutor.ExecutorBuilder,
 		},
+		{
+			name: "Test correct run builder with TypeScript sdk",
+			args: args{
+				paths:  typeScriptPaths,
+				sdkEnv: typeScriptSdkEnv,
+			},
+			want: &wantTypeScriptExecutor.ExecutorBuilder,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -107,7 +107,7 @@ func Setup(sdk pb.Sdk, sources []entity.FileEntity, pipelineId uuid.UUID, workin
 	}
 
 	// start emulators
-	if emulatorConfiguration.KafkaEmulatorExecutablePath != "" {
+	if len(emulatorConfiguration.Datasets) > 0 {
 		err = lc.StartEmulators(emulatorConfiguration)
 		if err != nil {
 			logger.Errorf("error during starting emulators: %s", err.Error())
@@ -118,8 +118,6 @@ func Setup(sdk pb.Sdk, sources []entity.FileEntity, pipelineId uuid.UUID, workin
 			lc.StopEmulators()
 			return nil, err
 		}
-	} else {
-		logger.Warnf("kafka emulator executable path is empty, emulators will not be started")
 	}
 
 	return lc, nil
@@ -207,6 +205,7 @@ func prepareSbtFiles(lc *fs_tool.LifeCycle, pipelineFolder string, workingDir st
 	absFilePath, _ := filepath.Abs(filepath.Join(absFileFolderPath, fileName))
 	absLogFilePath, _ := filepath.Abs(filepath.Join(absFileFolderPath, logFileName))
 	absGraphFilePath, _ := filepath.Abs(filepath.Join(absFileFolderPath, utils.GraphFileName))
+	absMetricsFilePath, _ := filepath.Abs(filepath.Join(absFileFolderPath, utils.MetricsFileName))
 	projectFolder, _ := filepath.Abs(filepath.Join(pipelineFolder, scioProjectName))
 	executableName := lc.Paths.FindExecutableName
 
@@ -230,6 +229,7 @@ func prepareSbtFiles(lc *fs_tool.LifeCycle, pipelineFolder string, workingDir st
 		AbsoluteBaseFolderPath:           absFileFolderPath,
 		AbsoluteLogFilePath:              absLogFilePath,
 		AbsoluteGraphFilePath:            absGraphFilePath,
+		AbsoluteMetricsFilePath:          absMetricsFilePath,
 		ProjectDir:                       projectFolder,
 		FindExecutableName:               executableName,
 	}
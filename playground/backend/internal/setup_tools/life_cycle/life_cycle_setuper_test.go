@@ -164,6 +164,7 @@ func TestSetup(t *testing.T) {
 					AbsoluteBaseFolderPath:           baseFileFolder,
 					AbsoluteLogFilePath:              filepath.Join(baseFileFolder, logFileName),
 					AbsoluteGraphFilePath:            filepath.Join(baseFileFolder, utils.GraphFileName),
+					AbsoluteMetricsFilePath:          filepath.Join(baseFileFolder, utils.MetricsFileName),
 				},
 			},
 			wantErr: false,
@@ -247,6 +248,7 @@ func TestSetup(t *testing.T) {
 					AbsoluteBaseFolderPath:           baseFileFolder,
 					AbsoluteLogFilePath:              filepath.Join(baseFileFolder, logFileName),
 					AbsoluteGraphFilePath:            filepath.Join(baseFileFolder, utils.GraphFileName),
+					AbsoluteMetricsFilePath:          filepath.Join(baseFileFolder, utils.MetricsFileName),
 				},
 			},
 			wantErr: false,
@@ -308,6 +310,7 @@ func TestSetup(t *testing.T) {
 					AbsoluteBaseFolderPath:           absScalaFileFolderPath,
 					AbsoluteLogFilePath:              filepath.Join(absScalaFileFolderPath, logFileName),
 					AbsoluteGraphFilePath:            filepath.Join(absScalaFileFolderPath, utils.GraphFileName),
+					AbsoluteMetricsFilePath:          filepath.Join(absScalaFileFolderPath, utils.MetricsFileName),
 					ProjectDir:                       filepath.Join(pipelinesFolder, scioProjectName),
 				},
 			},
@@ -0,0 +1,74 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streaming
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/logger"
+)
+
+// Watch calls update to process the current values of the pipeline from the cache,
+// and then again each time the notifier notifies about an update of the pipeline,
+// until update returns true or an error, or ctx is done.
+// Notifications might be lost, e.g. if Redis reconnects, so update is also called each recheckInterval.
+func Watch(ctx context.Context, notifier cache.Notifier, pipelineId uuid.UUID, recheckInterval time.Duration, update func() (bool, error)) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Subscribe before the first update, so that no updates are missed in between.
+	notifications, err := notifier.Subscribe(ctx, pipelineId)
+	if err != nil {
+		// Recheck periodically without notifications, since a nil channel is never ready.
+		logger.Warnf("%s: Watch(): error during subscription, falling back to polling: %s", pipelineId, err.Error())
+	}
+	ticker := time.NewTicker(recheckInterval)
+	defer ticker.Stop()
+
+	for {
+		done, err := update()
+		if err != nil || done {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case _, ok := <-notifications:
+			if !ok {
+				// Subscriptions are only closed once ctx is done.
+				return ctx.Err()
+			}
+		case <-ticker.C:
+		}
+	}
+}
+
+// IsFinished reports whether the status is final, i.e. the pipeline's processing is over
+// and its values won't be updated anymore.
+func IsFinished(status pb.Status) bool {
+	switch status {
+	case pb.Status_STATUS_UNSPECIFIED, pb.Status_STATUS_QUEUED, pb.Status_STATUS_VALIDATING, pb.Status_STATUS_PREPARING,
+		pb.Status_STATUS_COMPILING, pb.Status_STATUS_EXECUTING:
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,116 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streaming
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/cache/local"
+)
+
+func TestWatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pipelineId := uuid.New()
+	cacheService := &cache.NotifyingCache{Cache: local.New(ctx), Notifier: local.NewNotifier()}
+	_ = cacheService.SetValue(ctx, pipelineId, cache.Status, pb.Status_STATUS_EXECUTING)
+
+	var statuses []pb.Status
+	err := Watch(ctx, cacheService, pipelineId, time.Hour, func() (bool, error) {
+		value, err := cacheService.GetValue(ctx, pipelineId, cache.Status)
+		if err != nil {
+			return false, err
+		}
+		status := value.(pb.Status)
+		statuses = append(statuses, status)
+		if len(statuses) == 1 {
+			// Updates after the first call are only noticed through notifications, given the recheck interval.
+			go func() {
+				_ = cacheService.SetValue(ctx, pipelineId, cache.Status, pb.Status_STATUS_FINISHED)
+			}()
+		}
+		return IsFinished(status), nil
+	})
+	if err != nil {
+		t.Fatalf("Watch() error = %v", err)
+	}
+	if len(statuses) != 2 || statuses[0] != pb.Status_STATUS_EXECUTING || statuses[1] != pb.Status_STATUS_FINISHED {
+		t.Errorf("Watch() updated with %v, want [%v %v]", statuses, pb.Status_STATUS_EXECUTING, pb.Status_STATUS_FINISHED)
+	}
+}
+
+func TestWatch_Recheck(t *testing.T) {
+	ctx := context.Background()
+	calls := 0
+	err := Watch(ctx, local.NewNotifier(), uuid.New(), time.Millisecond, func() (bool, error) {
+		calls++
+		return calls == 3, nil
+	})
+	if err != nil || calls != 3 {
+		t.Errorf("Watch() error = %v after %d calls, want nil after 3 calls", err, calls)
+	}
+}
+
+func TestWatch_Error(t *testing.T) {
+	wantErr := fmt.Errorf("MOCK_ERROR")
+	err := Watch(context.Background(), local.NewNotifier(), uuid.New(), time.Hour, func() (bool, error) {
+		return false, wantErr
+	})
+	if err != wantErr {
+		t.Errorf("Watch() error = %v, want %v", err, wantErr)
+	}
+}
+
+func TestWatch_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	err := Watch(ctx, local.NewNotifier(), uuid.New(), time.Hour, func() (bool, error) {
+		cancel()
+		return false, nil
+	})
+	if err != context.Canceled {
+		t.Errorf("Watch() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestIsFinished(t *testing.T) {
+	tests := []struct {
+		status pb.Status
+		want   bool
+	}{
+		{status: pb.Status_STATUS_QUEUED, want: false},
+		{status: pb.Status_STATUS_VALIDATING, want: false},
+		{status: pb.Status_STATUS_COMPILING, want: false},
+		{status: pb.Status_STATUS_EXECUTING, want: false},
+		{status: pb.Status_STATUS_FINISHED, want: true},
+		{status: pb.Status_STATUS_COMPILE_ERROR, want: true},
+		{status: pb.Status_STATUS_RUN_TIMEOUT, want: true},
+		{status: pb.Status_STATUS_CANCELED, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status.String(), func(t *testing.T) {
+			if got := IsFinished(tt.status); got != tt.want {
+				t.Errorf("IsFinished() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import "testing"
+
+func TestGetUnifiedDiff(t *testing.T) {
+	type args struct {
+		fileName string
+		from     string
+		to       string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "Diff of equal files",
+			args:    args{fileName: "main.go", from: "line1\nline2\n", to: "line1\nline2\n"},
+			want:    "",
+			wantErr: false,
+		},
+		{
+			name:    "Diff of changed files",
+			args:    args{fileName: "main.go", from: "line1\nline2\n", to: "line1\nline3\n"},
+			want:    "--- main.go\n+++ main.go\n@@ -1,2 +1,2 @@\n line1\n-line2\n+line3\n",
+			wantErr: false,
+		},
+		{
+			name:    "Diff of added file",
+			args:    args{fileName: "main.go", from: "", to: "line1\n"},
+			want:    "--- main.go\n+++ main.go\n@@ -0,0 +1 @@\n+line1\n",
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetUnifiedDiff(tt.args.fileName, tt.args.from, tt.args.to)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetUnifiedDiff() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("GetUnifiedDiff() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
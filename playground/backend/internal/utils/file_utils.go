@@ -25,19 +25,22 @@ import (
 )
 
 const (
-	javaMainMethod        = "public static void main(String[] args)"
-	goMainMethod          = "func main()"
-	pythonMainMethod      = "if __name__ == '__main__'"
-	scioMainMethod        = "def main(cmdlineArgs: Array[String])"
-	defaultJavaFileName   = "main.java"
-	defaultGoFileName     = "main.go"
-	defaultPythonFileName = "main.py"
-	defaultScioFileName   = "main.scala"
-	javaExt               = ".java"
-	classExt              = ".class"
-	goExt                 = ".go"
-	pythonExt             = ".py"
-	scioExt               = ".scala"
+	javaMainMethod            = "public static void main(String[] args)"
+	goMainMethod              = "func main()"
+	pythonMainMethod          = "if __name__ == '__main__'"
+	scioMainMethod            = "def main(cmdlineArgs: Array[String])"
+	typeScriptMainMethod      = "function main("
+	defaultJavaFileName       = "main.java"
+	defaultGoFileName         = "main.go"
+	defaultPythonFileName     = "main.py"
+	defaultScioFileName       = "main.scala"
+	defaultTypeScriptFileName = "main.ts"
+	javaExt                   = ".java"
+	classExt                  = ".class"
+	goExt                     = ".go"
+	pythonExt                 = ".py"
+	scioExt                   = ".scala"
+	typeScriptExt             = ".ts"
 
 	mismatchExtAndSDKErrMsg = "file extension doesn't match to the specified SDK"
 )
@@ -55,6 +58,8 @@ func GetFileName(name, content string, sdk pb.Sdk) (string, error) {
 			return defaultPythonFileName, nil
 		case pb.Sdk_SDK_SCIO:
 			return defaultScioFileName, nil
+		case pb.Sdk_SDK_TYPESCRIPT:
+			return defaultTypeScriptFileName, nil
 		}
 	}
 	return getCorrectFileName(name, content, sdk)
@@ -75,6 +80,8 @@ func getCorrectFileName(name, content string, sdk pb.Sdk) (string, error) {
 		return getCorrectNameOrDefault(ext, pythonExt, defaultPythonFileName, name), nil
 	case pb.Sdk_SDK_SCIO:
 		return getCorrectNameOrDefault(ext, scioExt, defaultScioFileName, name), nil
+	case pb.Sdk_SDK_TYPESCRIPT:
+		return getCorrectNameOrDefault(ext, typeScriptExt, defaultTypeScriptFileName, name), nil
 	default:
 		return name, nil
 	}
@@ -91,6 +98,8 @@ func isValidFileExtensionAndSDK(ext string, sdk pb.Sdk) bool {
 		return sdk == pb.Sdk_SDK_PYTHON
 	case scioExt:
 		return sdk == pb.Sdk_SDK_SCIO
+	case typeScriptExt:
+		return sdk == pb.Sdk_SDK_TYPESCRIPT
 	default:
 		return false
 	}
@@ -118,6 +127,9 @@ func getExtBasedOnContent(content string) string {
 	if strings.Contains(content, scioMainMethod) {
 		return scioExt
 	}
+	if strings.Contains(content, typeScriptMainMethod) {
+		return typeScriptExt
+	}
 	return ""
 }
 
@@ -151,6 +163,8 @@ func IsFileMain(content string, sdk pb.Sdk) bool {
 		return strings.Contains(content, pythonMainMethod)
 	case pb.Sdk_SDK_SCIO:
 		return strings.Contains(content, scioMainMethod)
+	case pb.Sdk_SDK_TYPESCRIPT:
+		return strings.Contains(content, typeScriptMainMethod)
 	default:
 		return false
 	}
@@ -167,6 +181,8 @@ func ToSDKFromExt(ext string) pb.Sdk {
 		return pb.Sdk_SDK_SCIO
 	case pythonExt:
 		return pb.Sdk_SDK_PYTHON
+	case typeScriptExt:
+		return pb.Sdk_SDK_TYPESCRIPT
 	default:
 		return pb.Sdk_SDK_UNSPECIFIED
 	}
@@ -64,6 +64,14 @@ func TestGetFileName(t *testing.T) {
 			},
 			expectedResult: defaultScioFileName,
 		},
+		{
+			name: "Get file name when name is empty and sdk is TYPESCRIPT",
+			args: args{
+				name: "",
+				sdk:  pb.Sdk_SDK_TYPESCRIPT,
+			},
+			expectedResult: defaultTypeScriptFileName,
+		},
 		{
 			name: "Get file name when name is a random string and sdk is JAVA",
 			args: args{
@@ -249,6 +257,22 @@ func TestIsFileMain(t *testing.T) {
 			},
 			expectedResult: true,
 		},
+		{
+			name: "Is file main when content doesn't have main method and sdk is TYPESCRIPT",
+			args: args{
+				content: "MOCK_CONTENT",
+				sdk:     pb.Sdk_SDK_TYPESCRIPT,
+			},
+			expectedResult: false,
+		},
+		{
+			name: "Is file main when content has main method and sdk is TYPESCRIPT",
+			args: args{
+				content: "MOCK_CONTENTasync function main()MOCK_CONTENT",
+				sdk:     pb.Sdk_SDK_TYPESCRIPT,
+			},
+			expectedResult: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -286,6 +310,11 @@ func TestToSDKFromExt(t *testing.T) {
 			input:    scioExt,
 			expected: pb.Sdk_SDK_SCIO,
 		},
+		{
+			name:     "Transformation a typescript extension to a TypeScript SDK",
+			input:    typeScriptExt,
+			expected: pb.Sdk_SDK_TYPESCRIPT,
+		},
 		{
 			name:     "Transformation a random string",
 			input:    "MOCK",
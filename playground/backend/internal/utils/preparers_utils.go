@@ -32,6 +32,7 @@ const (
 	indentationReplacement = "$0"
 	EmptyLine              = ""
 	GraphFileName          = "graph.dot"
+	MetricsFileName        = "metrics.json"
 	pythonGraphCodePattern = "$0# Write graph to file\n$0from apache_beam.runners.interactive.display import pipeline_graph\n$0dot = pipeline_graph.PipelineGraph(%s).get_dot()\n$0with open('%s', 'w') as file:\n$0  file.write(dot)\n"
 	newLinePattern         = "\n"
 	tmpFileSuffix          = "tmp"
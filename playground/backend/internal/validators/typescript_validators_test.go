@@ -0,0 +1,106 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validators
+
+import (
+	"testing"
+
+	"beam.apache.org/playground/backend/internal/fs_tool"
+)
+
+const (
+	tsUnitTestFilePath = "unitTestCode.ts"
+	tsKataFilePath     = "kataCode.ts"
+	tsCodePath         = "code.ts"
+	tsUnitTestCode     = "import * as beam from \"apache-beam\";\n\ndescribe(\"wordcount\", function () {\n  it(\"wordcount\", async function () {});\n});\n"
+	tsKataCode         = "import * as beam from \"apache-beam\";\nimport { log } from \"../../learning/katas/util\";\n\nasync function main() {}\n"
+	tsCode             = "import * as beam from \"apache-beam\";\n\nasync function main() {}\n\nmain();\n"
+)
+
+func TestCheckIsUnitTestTypeScript(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []interface{}
+		want    bool
+		wantErr bool
+	}{
+		{
+			// Test if code is unit test code
+			name: "Is unit test",
+			args: []interface{}{tsUnitTestFilePath, fs_tool.TypeScriptSourceFileExtension},
+			want: true,
+		},
+		{
+			// Test if code is not unit test code
+			name: "Is not unit test",
+			args: []interface{}{tsCodePath, fs_tool.TypeScriptSourceFileExtension},
+			want: false,
+		},
+		{
+			// Test if file doesn't exist
+			name:    "File not exists",
+			args:    []interface{}{"fileNotExists.ts", fs_tool.TypeScriptSourceFileExtension},
+			want:    false,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := checkIsUnitTestTypeScript(tt.args...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkIsUnitTestTypeScript() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("checkIsUnitTestTypeScript() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckIsKataTypeScript(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []interface{}
+		want    bool
+		wantErr bool
+	}{
+		{
+			// Test if code is kata
+			name: "Is kata",
+			args: []interface{}{tsKataFilePath, fs_tool.TypeScriptSourceFileExtension},
+			want: true,
+		},
+		{
+			// Test if code is not kata
+			name: "Is not kata",
+			args: []interface{}{tsUnitTestFilePath, fs_tool.TypeScriptSourceFileExtension},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := checkIsKataTypeScript(tt.args...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkIsKataTypeScript() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("checkIsKataTypeScript() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
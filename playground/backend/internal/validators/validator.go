@@ -43,6 +43,8 @@ func GetValidators(sdk pb.Sdk, filepath string) (*[]Validator, error) {
 		val = GetPyValidators(filepath)
 	case pb.Sdk_SDK_SCIO:
 		val = GetScioValidators(filepath)
+	case pb.Sdk_SDK_TYPESCRIPT:
+		val = GetTypeScriptValidators(filepath)
 	default:
 		return nil, fmt.Errorf("incorrect sdk: %s", sdk)
 	}
@@ -25,7 +25,7 @@ import (
 
 // TestMain setups and teardown all necessary functionality for tests
 // in 'validators' package (i.e. for java_validators_test, go_validators_test,
-// python_validators_test)
+// python_validators_test, typescript_validators_test)
 func TestMain(m *testing.M) {
 	setup()
 	defer teardown()
@@ -40,6 +40,9 @@ func setup() {
 	writeFile(goCodePath, goCode)
 	writeFile(pyUnitTestFilePath, pyUnitTestCode)
 	writeFile(pyCodeFilePath, pyTestCode)
+	writeFile(tsUnitTestFilePath, tsUnitTestCode)
+	writeFile(tsKataFilePath, tsKataCode)
+	writeFile(tsCodePath, tsCode)
 }
 
 func teardown() {
@@ -50,6 +53,9 @@ func teardown() {
 	removeFile(javaKataFilePath)
 	removeFile(pyUnitTestFilePath)
 	removeFile(pyCodeFilePath)
+	removeFile(tsUnitTestFilePath)
+	removeFile(tsKataFilePath)
+	removeFile(tsCodePath)
 }
 
 func removeFile(path string) {
@@ -132,6 +138,17 @@ func TestGetValidators(t *testing.T) {
 			want:    GetScioValidators(""),
 			wantErr: false,
 		},
+		{
+			// Test case with calling GetValidators method with correct SDK.
+			// As a result, want to receive an expected slice of validators.
+			name: "Correct typescript sdk",
+			args: args{
+				sdk:      playground.Sdk_SDK_TYPESCRIPT,
+				filepath: "",
+			},
+			want:    GetTypeScriptValidators(""),
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
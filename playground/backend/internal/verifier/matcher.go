@@ -0,0 +1,76 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verifier
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"beam.apache.org/playground/backend/internal/utils"
+)
+
+const (
+	maskReplacement = "<masked>"
+	outputFileName  = "output"
+)
+
+// Matcher compares the output of an example with its golden output.
+// Parts of the outputs which match the mask patterns, e.g. timestamps or identifiers, are ignored.
+// Empty lines and trailing spaces are ignored as well.
+type Matcher struct {
+	orderInsensitive bool
+	masks            []*regexp.Regexp
+}
+
+// NewMatcher returns a new Matcher.
+// If orderInsensitive is true, the lines of the outputs are compared regardless of their order,
+// since the order of elements of a PCollection isn't guaranteed.
+func NewMatcher(orderInsensitive bool, maskPatterns []string) (*Matcher, error) {
+	masks := make([]*regexp.Regexp, 0, len(maskPatterns))
+	for _, pattern := range maskPatterns {
+		mask, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("incorrect mask pattern %q: %w", pattern, err)
+		}
+		masks = append(masks, mask)
+	}
+	return &Matcher{orderInsensitive: orderInsensitive, masks: masks}, nil
+}
+
+// Match compares the output with the golden output.
+// Returns the unified diff of the normalized outputs, or an empty string if they match.
+func (m *Matcher) Match(golden, output string) (string, error) {
+	return utils.GetUnifiedDiff(outputFileName, m.normalize(golden), m.normalize(output))
+}
+
+// normalize masks the output and removes the differences which are ignored during comparison
+func (m *Matcher) normalize(output string) string {
+	for _, mask := range m.masks {
+		output = mask.ReplaceAllString(output, maskReplacement)
+	}
+	lines := make([]string, 0)
+	for _, line := range strings.Split(output, "\n") {
+		if line = strings.TrimRight(line, " \t\r"); line != "" {
+			lines = append(lines, line+"\n")
+		}
+	}
+	if m.orderInsensitive {
+		sort.Strings(lines)
+	}
+	return strings.Join(lines, "")
+}
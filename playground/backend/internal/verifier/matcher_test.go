@@ -0,0 +1,119 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verifier
+
+import "testing"
+
+func TestNewMatcher(t *testing.T) {
+	tests := []struct {
+		name         string
+		maskPatterns []string
+		wantErr      bool
+	}{
+		{
+			name:         "Correct mask patterns",
+			maskPatterns: []string{`\d+`, `[a-f0-9-]{36}`},
+			wantErr:      false,
+		},
+		{
+			name:         "Incorrect mask pattern",
+			maskPatterns: []string{`(\d+`},
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewMatcher(false, tt.maskPatterns)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewMatcher() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMatcher_Match(t *testing.T) {
+	type args struct {
+		golden string
+		output string
+	}
+	tests := []struct {
+		name             string
+		orderInsensitive bool
+		maskPatterns     []string
+		args             args
+		wantMatch        bool
+	}{
+		{
+			name:      "Equal outputs",
+			args:      args{golden: "a: 1\nb: 2\n", output: "a: 1\nb: 2\n"},
+			wantMatch: true,
+		},
+		{
+			name:      "Empty lines and trailing spaces are ignored",
+			args:      args{golden: "a: 1\nb: 2\n", output: "\na: 1  \r\n\nb: 2"},
+			wantMatch: true,
+		},
+		{
+			name:      "Different outputs",
+			args:      args{golden: "a: 1\nb: 2\n", output: "a: 1\nb: 3\n"},
+			wantMatch: false,
+		},
+		{
+			name:      "Different order of lines in order sensitive mode",
+			args:      args{golden: "a: 1\nb: 2\n", output: "b: 2\na: 1\n"},
+			wantMatch: false,
+		},
+		{
+			name:             "Different order of lines in order insensitive mode",
+			orderInsensitive: true,
+			args:             args{golden: "a: 1\nb: 2\n", output: "b: 2\na: 1\n"},
+			wantMatch:        true,
+		},
+		{
+			name:             "Duplicated lines in order insensitive mode",
+			orderInsensitive: true,
+			args:             args{golden: "a: 1\nb: 2\n", output: "b: 2\na: 1\na: 1\n"},
+			wantMatch:        false,
+		},
+		{
+			name:         "Masked parts are ignored",
+			maskPatterns: []string{`\d{4}-\d{2}-\d{2}T[\d:.]+Z`},
+			args:         args{golden: "2022-01-01T10:00:00.000Z a: 1\n", output: "2023-05-17T12:34:56.789Z a: 1\n"},
+			wantMatch:    true,
+		},
+		{
+			name:         "Unmasked parts are compared",
+			maskPatterns: []string{`\d{4}-\d{2}-\d{2}T[\d:.]+Z`},
+			args:         args{golden: "2022-01-01T10:00:00.000Z a: 1\n", output: "2023-05-17T12:34:56.789Z a: 2\n"},
+			wantMatch:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := NewMatcher(tt.orderInsensitive, tt.maskPatterns)
+			if err != nil {
+				t.Fatalf("NewMatcher() error = %v", err)
+			}
+			diff, err := m.Match(tt.args.golden, tt.args.output)
+			if err != nil {
+				t.Fatalf("Match() error = %v", err)
+			}
+			if (diff == "") != tt.wantMatch {
+				t.Errorf("Match() diff = %q, wantMatch %v", diff, tt.wantMatch)
+			}
+		})
+	}
+}
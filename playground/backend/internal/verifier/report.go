@@ -0,0 +1,190 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verifier
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+const (
+	JSONFormat  = "json"
+	JUnitFormat = "junit"
+
+	reportFileMode = 0644
+)
+
+// Status is the result of the verification of an example
+type Status string
+
+const (
+	StatusPassed  Status = "passed"
+	StatusFailed  Status = "failed"
+	StatusError   Status = "error"
+	StatusSkipped Status = "skipped"
+)
+
+// Result is the verification result of an example
+type Result struct {
+	Name      string        `json:"name"`
+	CloudPath string        `json:"cloudPath"`
+	Status    Status        `json:"status"`
+	Message   string        `json:"message,omitempty"`
+	Duration  time.Duration `json:"-"`
+}
+
+// Report contains the verification results of the examples of an SDK
+type Report struct {
+	Sdk     string    `json:"sdk"`
+	Results []*Result `json:"results"`
+}
+
+// Count returns the number of the results with the status
+func (r *Report) Count(status Status) int {
+	count := 0
+	for _, result := range r.Results {
+		if result.Status == status {
+			count++
+		}
+	}
+	return count
+}
+
+// Passed returns true if no example has failed or errored
+func (r *Report) Passed() bool {
+	return r.Count(StatusFailed) == 0 && r.Count(StatusError) == 0
+}
+
+// MarshalJSON marshals the result with the duration in milliseconds
+func (r *Result) MarshalJSON() ([]byte, error) {
+	type result Result
+	return json.Marshal(&struct {
+		*result
+		Duration int64 `json:"durationMs"`
+	}{
+		result:   (*result)(r),
+		Duration: r.Duration.Milliseconds(),
+	})
+}
+
+// WriteJSON writes the report in JSON format
+func (r *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+type junitTestSuites struct {
+	XMLName    xml.Name         `xml:"testsuites"`
+	TestSuites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Content string `xml:",chardata"`
+}
+
+// WriteJUnit writes the report in JUnit XML format, each example is a test case of the SDK test suite
+func (r *Report) WriteJUnit(w io.Writer) error {
+	suite := junitTestSuite{
+		Name:     r.Sdk,
+		Tests:    len(r.Results),
+		Failures: r.Count(StatusFailed),
+		Errors:   r.Count(StatusError),
+		Skipped:  r.Count(StatusSkipped),
+	}
+	var total time.Duration
+	for _, result := range r.Results {
+		total += result.Duration
+		testCase := junitTestCase{
+			Name:      result.Name,
+			ClassName: result.CloudPath,
+			Time:      formatSeconds(result.Duration),
+		}
+		message := &junitMessage{Message: string(result.Status), Content: result.Message}
+		switch result.Status {
+		case StatusFailed:
+			testCase.Failure = message
+		case StatusError:
+			testCase.Error = message
+		case StatusSkipped:
+			testCase.Skipped = message
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+	suite.Time = formatSeconds(total)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(junitTestSuites{TestSuites: []junitTestSuite{suite}}); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// WriteFile writes the report to the file in the format: junit or json
+func (r *Report) WriteFile(path, format string) error {
+	var write func(w io.Writer) error
+	switch format {
+	case JSONFormat:
+		write = r.WriteJSON
+	case JUnitFormat:
+		write = r.WriteJUnit
+	default:
+		return fmt.Errorf("unknown report format: %s", format)
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, reportFileMode)
+	if err != nil {
+		return err
+	}
+	if err = write(file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// formatSeconds formats the duration in seconds as JUnit reports do
+func formatSeconds(duration time.Duration) string {
+	return fmt.Sprintf("%.3f", duration.Seconds())
+}
@@ -0,0 +1,123 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verifier
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func getTestReport() *Report {
+	return &Report{
+		Sdk: "SDK_GO",
+		Results: []*Result{
+			{Name: "Passed", CloudPath: "SDK_GO_Passed", Status: StatusPassed, Duration: 1500 * time.Millisecond},
+			{Name: "Failed", CloudPath: "SDK_GO_Failed", Status: StatusFailed, Message: "-a\n+b", Duration: time.Second},
+			{Name: "Skipped", CloudPath: "SDK_GO_Skipped", Status: StatusSkipped, Message: "The example is never run"},
+		},
+	}
+}
+
+func TestReport_Passed(t *testing.T) {
+	report := getTestReport()
+	if report.Passed() {
+		t.Errorf("Passed() got = true, want false")
+	}
+	report.Results = report.Results[:1]
+	if !report.Passed() {
+		t.Errorf("Passed() got = false, want true")
+	}
+}
+
+func TestReport_WriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := getTestReport().WriteJSON(&buf); err != nil {
+		t.Fatalf("WriteJSON() error = %v", err)
+	}
+	var got struct {
+		Sdk     string
+		Results []map[string]interface{}
+	}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("WriteJSON() wrote incorrect json: %v", err)
+	}
+	if got.Sdk != "SDK_GO" || len(got.Results) != 3 {
+		t.Fatalf("WriteJSON() got = %v", got)
+	}
+	if got.Results[0]["durationMs"] != float64(1500) || got.Results[1]["status"] != string(StatusFailed) {
+		t.Errorf("WriteJSON() got = %v", got.Results)
+	}
+}
+
+func TestReport_WriteJUnit(t *testing.T) {
+	var buf bytes.Buffer
+	if err := getTestReport().WriteJUnit(&buf); err != nil {
+		t.Fatalf("WriteJUnit() error = %v", err)
+	}
+	got := buf.String()
+	wants := []string{
+		`<testsuite name="SDK_GO" tests="3" failures="1" errors="0" skipped="1" time="2.500">`,
+		`<testcase name="Passed" classname="SDK_GO_Passed" time="1.500"></testcase>`,
+		`<failure message="failed">-a&#xA;+b</failure>`,
+		`<skipped message="skipped">The example is never run</skipped>`,
+	}
+	for _, want := range wants {
+		if !strings.Contains(got, want) {
+			t.Errorf("WriteJUnit() got = %s, want to contain %s", got, want)
+		}
+	}
+}
+
+func TestReport_WriteFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		wantErr bool
+	}{
+		{
+			name:    "JSON format",
+			format:  JSONFormat,
+			wantErr: false,
+		},
+		{
+			name:    "JUnit format",
+			format:  JUnitFormat,
+			wantErr: false,
+		},
+		{
+			name:    "Unknown format",
+			format:  "html",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "report")
+			err := getTestReport().WriteFile(path, tt.format)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("WriteFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if _, err = os.Stat(path); (err != nil) != tt.wantErr {
+				t.Errorf("WriteFile() report exists = %v, want %v", err == nil, !tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/datastore"
+	"github.com/google/uuid"
+
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/cache"
+	"beam.apache.org/playground/backend/internal/code_processing"
+	"beam.apache.org/playground/backend/internal/db"
+	"beam.apache.org/playground/backend/internal/db/entity"
+	"beam.apache.org/playground/backend/internal/emulators"
+	"beam.apache.org/playground/backend/internal/environment"
+	"beam.apache.org/playground/backend/internal/logger"
+	"beam.apache.org/playground/backend/internal/setup_tools/life_cycle"
+	"beam.apache.org/playground/backend/internal/utils"
+)
+
+const errorTitleVerification = "Error during verification of the example"
+
+// errorOutputs are the outputs of the code processing which explain why it hasn't finished successfully
+var errorOutputs = map[pb.Status]cache.SubKey{
+	pb.Status_STATUS_VALIDATION_ERROR:  cache.ValidationOutput,
+	pb.Status_STATUS_PREPARATION_ERROR: cache.PreparationOutput,
+	pb.Status_STATUS_COMPILE_ERROR:     cache.CompileOutput,
+	pb.Status_STATUS_RUN_ERROR:         cache.RunError,
+}
+
+// Verifier runs the catalog examples of the SDK and compares their outputs with the golden outputs stored in the database
+type Verifier struct {
+	env          *environment.Environment
+	cacheService cache.Cache
+	database     db.Database
+	matcher      *Matcher
+}
+
+// New returns a new Verifier of the examples of the SDK of the environment
+func New(env *environment.Environment, cacheService cache.Cache, database db.Database, matcher *Matcher) *Verifier {
+	return &Verifier{
+		env:          env,
+		cacheService: cacheService,
+		database:     database,
+		matcher:      matcher,
+	}
+}
+
+// VerifyCatalog verifies all examples of the SDK from the catalog one by one.
+// Examples which are never run or don't have a golden output are skipped.
+func (v *Verifier) VerifyCatalog(ctx context.Context) (*Report, error) {
+	sdk := v.env.BeamSdkEnvs.ApacheBeamSdk
+	if sdk == pb.Sdk_SDK_UNSPECIFIED {
+		return nil, errors.New("sdk is unspecified, examples can be verified only by the backend of the sdk")
+	}
+	sdks, err := v.database.GetSDKs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	catalog, err := v.database.GetCatalog(ctx, sdks)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{Sdk: sdk.String(), Results: make([]*Result, 0)}
+	verified := make(map[string]bool)
+	for _, sdkCategories := range catalog {
+		if sdkCategories.Sdk != sdk {
+			continue
+		}
+		for _, category := range sdkCategories.Categories {
+			for _, example := range category.PrecompiledObjects {
+				// The same example can be in several categories
+				if verified[example.CloudPath] {
+					continue
+				}
+				verified[example.CloudPath] = true
+				result := v.VerifyExample(ctx, example)
+				logger.Infof("VerifyCatalog(): %s: %s\n", example.CloudPath, result.Status)
+				report.Results = append(report.Results, result)
+			}
+		}
+	}
+	return report, nil
+}
+
+// VerifyExample runs the example and compares its run output with the golden output
+func (v *Verifier) VerifyExample(ctx context.Context, example *pb.PrecompiledObject) *Result {
+	result := &Result{Name: example.Name, CloudPath: example.CloudPath}
+	startTime := time.Now()
+	defer func() {
+		result.Duration = time.Since(startTime)
+	}()
+
+	if example.NeverRun {
+		result.Status, result.Message = StatusSkipped, "The example is never run"
+		return result
+	}
+	golden, err := v.database.GetExampleOutput(ctx, example.CloudPath)
+	if err != nil && !errors.Is(err, datastore.ErrNoSuchEntity) {
+		result.Status, result.Message = StatusError, fmt.Sprintf("Error during getting the golden output: %s", err.Error())
+		return result
+	}
+	if golden == "" {
+		result.Status, result.Message = StatusSkipped, "The example doesn't have a golden output"
+		return result
+	}
+	files, err := v.database.GetExampleCode(ctx, example.CloudPath)
+	if err != nil {
+		result.Status, result.Message = StatusError, fmt.Sprintf("Error during getting the code: %s", err.Error())
+		return result
+	}
+
+	status, output, err := v.run(ctx, example, files)
+	if err != nil {
+		result.Status, result.Message = StatusError, err.Error()
+		return result
+	}
+	if status != pb.Status_STATUS_FINISHED {
+		result.Status, result.Message = StatusFailed, fmt.Sprintf("The code processing finished with %s status:\n%s", status, output)
+		return result
+	}
+	diff, err := v.matcher.Match(golden, output)
+	if err != nil {
+		result.Status, result.Message = StatusError, fmt.Sprintf("Error during comparison of the outputs: %s", err.Error())
+		return result
+	}
+	if diff != "" {
+		result.Status, result.Message = StatusFailed, fmt.Sprintf("The output doesn't match the golden output:\n%s", diff)
+		return result
+	}
+	result.Status = StatusPassed
+	return result
+}
+
+// run processes the code of the example the same way as RunCode does, but synchronously.
+// Returns the final status of the code processing and its run output or error output.
+func (v *Verifier) run(ctx context.Context, example *pb.PrecompiledObject, files []*entity.FileEntity) (pb.Status, string, error) {
+	appEnv, sdkEnv := &v.env.ApplicationEnvs, &v.env.BeamSdkEnvs
+	sources := make([]entity.FileEntity, 0, len(files))
+	for _, file := range files {
+		sources = append(sources, *file)
+	}
+	emulatorConfiguration := emulators.EmulatorConfiguration{
+		Datasets:                    example.Datasets,
+		DatasetsPath:                appEnv.DatasetsPath(),
+		KafkaEmulatorExecutablePath: appEnv.KafkaExecutablePath(),
+	}
+
+	pipelineId := uuid.New()
+	lc, err := life_cycle.Setup(sdkEnv.ApacheBeamSdk, sources, pipelineId, appEnv.WorkingDir(), appEnv.PipelinesFolder(), sdkEnv.PreparedModDir(), emulatorConfiguration)
+	if err != nil {
+		return pb.Status_STATUS_UNSPECIFIED, "", fmt.Errorf("error during setup file system for the code processing: %w", err)
+	}
+	initialValues := []struct {
+		subKey cache.SubKey
+		value  interface{}
+	}{
+		{cache.Status, pb.Status_STATUS_VALIDATING},
+		{cache.RunOutputIndex, 0},
+		{cache.LogsIndex, 0},
+		{cache.Canceled, false},
+	}
+	for _, initialValue := range initialValues {
+		if err = utils.SetToCache(v.cacheService, pipelineId, initialValue.subKey, initialValue.value); err != nil {
+			code_processing.DeleteResources(pipelineId, lc)
+			return pb.Status_STATUS_UNSPECIFIED, "", fmt.Errorf("error during saving initial values of the code processing: %w", err)
+		}
+	}
+	if err = v.cacheService.SetExpTime(ctx, pipelineId, appEnv.CacheEnvs().KeyExpirationTime()); err != nil {
+		code_processing.DeleteResources(pipelineId, lc)
+		return pb.Status_STATUS_UNSPECIFIED, "", fmt.Errorf("error during setting expiration time of the code processing: %w", err)
+	}
+
+	code_processing.Process(ctx, v.cacheService, lc, pipelineId, appEnv, sdkEnv, example.PipelineOptions)
+
+	status, err := code_processing.GetProcessingStatus(ctx, v.cacheService, pipelineId, errorTitleVerification)
+	if err != nil {
+		return pb.Status_STATUS_UNSPECIFIED, "", err
+	}
+	subKey := cache.RunOutput
+	if status != pb.Status_STATUS_FINISHED {
+		if subKey = errorOutputs[status]; subKey == "" {
+			return status, "", nil
+		}
+	}
+	output, err := code_processing.GetProcessingOutput(ctx, v.cacheService, pipelineId, subKey, errorTitleVerification)
+	if err != nil {
+		return pb.Status_STATUS_UNSPECIFIED, "", err
+	}
+	return status, output, nil
+}
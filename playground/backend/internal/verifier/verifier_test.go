@@ -0,0 +1,106 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verifier
+
+import (
+	"context"
+	"testing"
+
+	pb "beam.apache.org/playground/backend/internal/api/v1"
+	"beam.apache.org/playground/backend/internal/db/mapper"
+	"beam.apache.org/playground/backend/internal/db/schema"
+	"beam.apache.org/playground/backend/internal/db/sqldb"
+	"beam.apache.org/playground/backend/internal/environment"
+)
+
+const sdkConfigPath = "../../../sdks-emulator.yaml"
+
+// newTestVerifier returns a Verifier of the SDK with an empty in-memory SQLite database
+func newTestVerifier(t *testing.T, sdk pb.Sdk) *Verifier {
+	t.Helper()
+	ctx := context.Background()
+	d, err := sqldb.New(ctx, mapper.NewPrecompiledObjectMapper(), sqldb.SQLiteDriver, ":memory:")
+	if err != nil {
+		t.Fatalf("sqldb.New() error = %v", err)
+	}
+	t.Cleanup(func() { _ = d.DB.Close() })
+	if err = d.ApplyMigrations(ctx, schema.Migrations, sdkConfigPath); err != nil {
+		t.Fatalf("ApplyMigrations() error = %v", err)
+	}
+	matcher, err := NewMatcher(false, nil)
+	if err != nil {
+		t.Fatalf("NewMatcher() error = %v", err)
+	}
+	env := &environment.Environment{BeamSdkEnvs: *environment.NewBeamEnvs(sdk, "", nil, "", 1)}
+	return New(env, nil, d, matcher)
+}
+
+func TestVerifier_VerifyCatalog(t *testing.T) {
+	tests := []struct {
+		name    string
+		sdk     pb.Sdk
+		wantErr bool
+	}{
+		{
+			name:    "Unspecified sdk",
+			sdk:     pb.Sdk_SDK_UNSPECIFIED,
+			wantErr: true,
+		},
+		{
+			name:    "Empty catalog",
+			sdk:     pb.Sdk_SDK_GO,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newTestVerifier(t, tt.sdk).VerifyCatalog(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("VerifyCatalog() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && (got.Sdk != tt.sdk.String() || len(got.Results) != 0) {
+				t.Errorf("VerifyCatalog() got = %v", got)
+			}
+		})
+	}
+}
+
+func TestVerifier_VerifyExample(t *testing.T) {
+	tests := []struct {
+		name       string
+		example    *pb.PrecompiledObject
+		wantStatus Status
+	}{
+		{
+			name:       "Example is never run",
+			example:    &pb.PrecompiledObject{CloudPath: "SDK_GO_MOCK_EXAMPLE", Name: "MOCK_EXAMPLE", NeverRun: true},
+			wantStatus: StatusSkipped,
+		},
+		{
+			name:       "Example doesn't have a golden output",
+			example:    &pb.PrecompiledObject{CloudPath: "SDK_GO_MOCK_EXAMPLE", Name: "MOCK_EXAMPLE"},
+			wantStatus: StatusSkipped,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newTestVerifier(t, pb.Sdk_SDK_GO).VerifyExample(context.Background(), tt.example)
+			if got.Status != tt.wantStatus || got.Name != tt.example.Name || got.CloudPath != tt.example.CloudPath {
+				t.Errorf("VerifyExample() got = %v, want status %v", got, tt.wantStatus)
+			}
+		})
+	}
+}
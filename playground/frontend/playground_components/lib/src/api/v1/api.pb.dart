@@ -879,6 +879,288 @@ class GetLogsResponse extends $pb.GeneratedMessage {
   void clearOutput() => clearField(1);
 }
 
+class StreamRunOutputRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'StreamRunOutputRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineUuid')
+    ..hasRequiredFields = false
+  ;
+
+  StreamRunOutputRequest._() : super();
+  factory StreamRunOutputRequest({
+    $core.String? pipelineUuid,
+  }) {
+    final _result = create();
+    if (pipelineUuid != null) {
+      _result.pipelineUuid = pipelineUuid;
+    }
+    return _result;
+  }
+  factory StreamRunOutputRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory StreamRunOutputRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  StreamRunOutputRequest clone() => StreamRunOutputRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  StreamRunOutputRequest copyWith(void Function(StreamRunOutputRequest) updates) => super.copyWith((message) => updates(message as StreamRunOutputRequest)) as StreamRunOutputRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static StreamRunOutputRequest create() => StreamRunOutputRequest._();
+  StreamRunOutputRequest createEmptyInstance() => create();
+  static $pb.PbList<StreamRunOutputRequest> createRepeated() => $pb.PbList<StreamRunOutputRequest>();
+  @$core.pragma('dart2js:noInline')
+  static StreamRunOutputRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<StreamRunOutputRequest>(create);
+  static StreamRunOutputRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get pipelineUuid => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set pipelineUuid($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasPipelineUuid() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearPipelineUuid() => clearField(1);
+}
+
+class StreamRunOutputResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'StreamRunOutputResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'output')
+    ..hasRequiredFields = false
+  ;
+
+  StreamRunOutputResponse._() : super();
+  factory StreamRunOutputResponse({
+    $core.String? output,
+  }) {
+    final _result = create();
+    if (output != null) {
+      _result.output = output;
+    }
+    return _result;
+  }
+  factory StreamRunOutputResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory StreamRunOutputResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  StreamRunOutputResponse clone() => StreamRunOutputResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  StreamRunOutputResponse copyWith(void Function(StreamRunOutputResponse) updates) => super.copyWith((message) => updates(message as StreamRunOutputResponse)) as StreamRunOutputResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static StreamRunOutputResponse create() => StreamRunOutputResponse._();
+  StreamRunOutputResponse createEmptyInstance() => create();
+  static $pb.PbList<StreamRunOutputResponse> createRepeated() => $pb.PbList<StreamRunOutputResponse>();
+  @$core.pragma('dart2js:noInline')
+  static StreamRunOutputResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<StreamRunOutputResponse>(create);
+  static StreamRunOutputResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get output => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set output($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasOutput() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearOutput() => clearField(1);
+}
+
+class StreamLogsRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'StreamLogsRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineUuid')
+    ..hasRequiredFields = false
+  ;
+
+  StreamLogsRequest._() : super();
+  factory StreamLogsRequest({
+    $core.String? pipelineUuid,
+  }) {
+    final _result = create();
+    if (pipelineUuid != null) {
+      _result.pipelineUuid = pipelineUuid;
+    }
+    return _result;
+  }
+  factory StreamLogsRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory StreamLogsRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  StreamLogsRequest clone() => StreamLogsRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  StreamLogsRequest copyWith(void Function(StreamLogsRequest) updates) => super.copyWith((message) => updates(message as StreamLogsRequest)) as StreamLogsRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static StreamLogsRequest create() => StreamLogsRequest._();
+  StreamLogsRequest createEmptyInstance() => create();
+  static $pb.PbList<StreamLogsRequest> createRepeated() => $pb.PbList<StreamLogsRequest>();
+  @$core.pragma('dart2js:noInline')
+  static StreamLogsRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<StreamLogsRequest>(create);
+  static StreamLogsRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get pipelineUuid => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set pipelineUuid($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasPipelineUuid() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearPipelineUuid() => clearField(1);
+}
+
+class StreamLogsResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'StreamLogsResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'output')
+    ..hasRequiredFields = false
+  ;
+
+  StreamLogsResponse._() : super();
+  factory StreamLogsResponse({
+    $core.String? output,
+  }) {
+    final _result = create();
+    if (output != null) {
+      _result.output = output;
+    }
+    return _result;
+  }
+  factory StreamLogsResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory StreamLogsResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  StreamLogsResponse clone() => StreamLogsResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  StreamLogsResponse copyWith(void Function(StreamLogsResponse) updates) => super.copyWith((message) => updates(message as StreamLogsResponse)) as StreamLogsResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static StreamLogsResponse create() => StreamLogsResponse._();
+  StreamLogsResponse createEmptyInstance() => create();
+  static $pb.PbList<StreamLogsResponse> createRepeated() => $pb.PbList<StreamLogsResponse>();
+  @$core.pragma('dart2js:noInline')
+  static StreamLogsResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<StreamLogsResponse>(create);
+  static StreamLogsResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get output => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set output($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasOutput() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearOutput() => clearField(1);
+}
+
+class StreamStatusRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'StreamStatusRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineUuid')
+    ..hasRequiredFields = false
+  ;
+
+  StreamStatusRequest._() : super();
+  factory StreamStatusRequest({
+    $core.String? pipelineUuid,
+  }) {
+    final _result = create();
+    if (pipelineUuid != null) {
+      _result.pipelineUuid = pipelineUuid;
+    }
+    return _result;
+  }
+  factory StreamStatusRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory StreamStatusRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  StreamStatusRequest clone() => StreamStatusRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  StreamStatusRequest copyWith(void Function(StreamStatusRequest) updates) => super.copyWith((message) => updates(message as StreamStatusRequest)) as StreamStatusRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static StreamStatusRequest create() => StreamStatusRequest._();
+  StreamStatusRequest createEmptyInstance() => create();
+  static $pb.PbList<StreamStatusRequest> createRepeated() => $pb.PbList<StreamStatusRequest>();
+  @$core.pragma('dart2js:noInline')
+  static StreamStatusRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<StreamStatusRequest>(create);
+  static StreamStatusRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get pipelineUuid => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set pipelineUuid($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasPipelineUuid() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearPipelineUuid() => clearField(1);
+}
+
+class StreamStatusResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'StreamStatusResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..e<Status>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'status', $pb.PbFieldType.OE, defaultOrMaker: Status.STATUS_UNSPECIFIED, valueOf: Status.valueOf, enumValues: Status.values)
+    ..hasRequiredFields = false
+  ;
+
+  StreamStatusResponse._() : super();
+  factory StreamStatusResponse({
+    Status? status,
+  }) {
+    final _result = create();
+    if (status != null) {
+      _result.status = status;
+    }
+    return _result;
+  }
+  factory StreamStatusResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory StreamStatusResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  StreamStatusResponse clone() => StreamStatusResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  StreamStatusResponse copyWith(void Function(StreamStatusResponse) updates) => super.copyWith((message) => updates(message as StreamStatusResponse)) as StreamStatusResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static StreamStatusResponse create() => StreamStatusResponse._();
+  StreamStatusResponse createEmptyInstance() => create();
+  static $pb.PbList<StreamStatusResponse> createRepeated() => $pb.PbList<StreamStatusResponse>();
+  @$core.pragma('dart2js:noInline')
+  static StreamStatusResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<StreamStatusResponse>(create);
+  static StreamStatusResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  Status get status => $_getN(0);
+  @$pb.TagNumber(1)
+  set status(Status v) { setField(1, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasStatus() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearStatus() => clearField(1);
+}
+
 class GetGraphRequest extends $pb.GeneratedMessage {
   static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetGraphRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
     ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineUuid')
@@ -973,14 +1255,14 @@ class GetGraphResponse extends $pb.GeneratedMessage {
   void clearGraph() => clearField(1);
 }
 
-class CancelRequest extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'CancelRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+class GetRunMetricsRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetRunMetricsRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
     ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineUuid')
     ..hasRequiredFields = false
   ;
 
-  CancelRequest._() : super();
-  factory CancelRequest({
+  GetRunMetricsRequest._() : super();
+  factory GetRunMetricsRequest({
     $core.String? pipelineUuid,
   }) {
     final _result = create();
@@ -989,26 +1271,26 @@ class CancelRequest extends $pb.GeneratedMessage {
     }
     return _result;
   }
-  factory CancelRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory CancelRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetRunMetricsRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetRunMetricsRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  CancelRequest clone() => CancelRequest()..mergeFromMessage(this);
+  GetRunMetricsRequest clone() => GetRunMetricsRequest()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  CancelRequest copyWith(void Function(CancelRequest) updates) => super.copyWith((message) => updates(message as CancelRequest)) as CancelRequest; // ignore: deprecated_member_use
+  GetRunMetricsRequest copyWith(void Function(GetRunMetricsRequest) updates) => super.copyWith((message) => updates(message as GetRunMetricsRequest)) as GetRunMetricsRequest; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static CancelRequest create() => CancelRequest._();
-  CancelRequest createEmptyInstance() => create();
-  static $pb.PbList<CancelRequest> createRepeated() => $pb.PbList<CancelRequest>();
+  static GetRunMetricsRequest create() => GetRunMetricsRequest._();
+  GetRunMetricsRequest createEmptyInstance() => create();
+  static $pb.PbList<GetRunMetricsRequest> createRepeated() => $pb.PbList<GetRunMetricsRequest>();
   @$core.pragma('dart2js:noInline')
-  static CancelRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<CancelRequest>(create);
-  static CancelRequest? _defaultInstance;
+  static GetRunMetricsRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetRunMetricsRequest>(create);
+  static GetRunMetricsRequest? _defaultInstance;
 
   @$pb.TagNumber(1)
   $core.String get pipelineUuid => $_getSZ(0);
@@ -1020,1429 +1302,2523 @@ class CancelRequest extends $pb.GeneratedMessage {
   void clearPipelineUuid() => clearField(1);
 }
 
-class CancelResponse extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'CancelResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+class MetricKey extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'MetricKey', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'step')
+    ..aOS(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'namespace')
+    ..aOS(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'name')
     ..hasRequiredFields = false
   ;
 
-  CancelResponse._() : super();
-  factory CancelResponse() => create();
-  factory CancelResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory CancelResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  MetricKey._() : super();
+  factory MetricKey({
+    $core.String? step,
+    $core.String? namespace,
+    $core.String? name,
+  }) {
+    final _result = create();
+    if (step != null) {
+      _result.step = step;
+    }
+    if (namespace != null) {
+      _result.namespace = namespace;
+    }
+    if (name != null) {
+      _result.name = name;
+    }
+    return _result;
+  }
+  factory MetricKey.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory MetricKey.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  CancelResponse clone() => CancelResponse()..mergeFromMessage(this);
+  MetricKey clone() => MetricKey()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  CancelResponse copyWith(void Function(CancelResponse) updates) => super.copyWith((message) => updates(message as CancelResponse)) as CancelResponse; // ignore: deprecated_member_use
+  MetricKey copyWith(void Function(MetricKey) updates) => super.copyWith((message) => updates(message as MetricKey)) as MetricKey; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static CancelResponse create() => CancelResponse._();
-  CancelResponse createEmptyInstance() => create();
-  static $pb.PbList<CancelResponse> createRepeated() => $pb.PbList<CancelResponse>();
+  static MetricKey create() => MetricKey._();
+  MetricKey createEmptyInstance() => create();
+  static $pb.PbList<MetricKey> createRepeated() => $pb.PbList<MetricKey>();
   @$core.pragma('dart2js:noInline')
-  static CancelResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<CancelResponse>(create);
-  static CancelResponse? _defaultInstance;
+  static MetricKey getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<MetricKey>(create);
+  static MetricKey? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get step => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set step($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasStep() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearStep() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get namespace => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set namespace($core.String v) { $_setString(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasNamespace() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearNamespace() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get name => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set name($core.String v) { $_setString(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasName() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearName() => clearField(3);
 }
 
-class PrecompiledObject extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'PrecompiledObject', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'cloudPath')
-    ..aOS(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'name')
-    ..aOS(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'description')
-    ..e<PrecompiledObjectType>(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'type', $pb.PbFieldType.OE, defaultOrMaker: PrecompiledObjectType.PRECOMPILED_OBJECT_TYPE_UNSPECIFIED, valueOf: PrecompiledObjectType.valueOf, enumValues: PrecompiledObjectType.values)
-    ..aOS(5, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineOptions')
-    ..aOS(6, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'link')
-    ..aOB(7, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'multifile')
-    ..a<$core.int>(8, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'contextLine', $pb.PbFieldType.O3)
-    ..aOB(9, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'defaultExample')
-    ..e<Sdk>(10, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdk', $pb.PbFieldType.OE, defaultOrMaker: Sdk.SDK_UNSPECIFIED, valueOf: Sdk.valueOf, enumValues: Sdk.values)
-    ..e<Complexity>(11, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'complexity', $pb.PbFieldType.OE, defaultOrMaker: Complexity.COMPLEXITY_UNSPECIFIED, valueOf: Complexity.valueOf, enumValues: Complexity.values)
-    ..pPS(12, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'tags')
-    ..pc<Dataset>(13, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'datasets', $pb.PbFieldType.PM, subBuilder: Dataset.create)
-    ..aOS(14, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'urlVcs')
-    ..aOS(15, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'urlNotebook')
-    ..aOB(16, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'alwaysRun')
-    ..aOB(17, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'neverRun')
+class CounterMetric extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'CounterMetric', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOM<MetricKey>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'key', subBuilder: MetricKey.create)
+    ..aInt64(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'attempted')
+    ..aInt64(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'committed')
     ..hasRequiredFields = false
   ;
 
-  PrecompiledObject._() : super();
-  factory PrecompiledObject({
-    $core.String? cloudPath,
-    $core.String? name,
-    $core.String? description,
-    PrecompiledObjectType? type,
-    $core.String? pipelineOptions,
-    $core.String? link,
-    $core.bool? multifile,
-    $core.int? contextLine,
-    $core.bool? defaultExample,
-    Sdk? sdk,
-    Complexity? complexity,
-    $core.Iterable<$core.String>? tags,
-    $core.Iterable<Dataset>? datasets,
-    $core.String? urlVcs,
-    $core.String? urlNotebook,
-    $core.bool? alwaysRun,
-    $core.bool? neverRun,
+  CounterMetric._() : super();
+  factory CounterMetric({
+    MetricKey? key,
+    $fixnum.Int64? attempted,
+    $fixnum.Int64? committed,
   }) {
     final _result = create();
-    if (cloudPath != null) {
-      _result.cloudPath = cloudPath;
-    }
-    if (name != null) {
-      _result.name = name;
-    }
-    if (description != null) {
-      _result.description = description;
-    }
-    if (type != null) {
-      _result.type = type;
-    }
-    if (pipelineOptions != null) {
-      _result.pipelineOptions = pipelineOptions;
-    }
-    if (link != null) {
-      _result.link = link;
-    }
-    if (multifile != null) {
-      _result.multifile = multifile;
-    }
-    if (contextLine != null) {
-      _result.contextLine = contextLine;
-    }
-    if (defaultExample != null) {
-      _result.defaultExample = defaultExample;
-    }
-    if (sdk != null) {
-      _result.sdk = sdk;
-    }
-    if (complexity != null) {
-      _result.complexity = complexity;
-    }
-    if (tags != null) {
-      _result.tags.addAll(tags);
-    }
-    if (datasets != null) {
-      _result.datasets.addAll(datasets);
-    }
-    if (urlVcs != null) {
-      _result.urlVcs = urlVcs;
-    }
-    if (urlNotebook != null) {
-      _result.urlNotebook = urlNotebook;
+    if (key != null) {
+      _result.key = key;
     }
-    if (alwaysRun != null) {
-      _result.alwaysRun = alwaysRun;
+    if (attempted != null) {
+      _result.attempted = attempted;
     }
-    if (neverRun != null) {
-      _result.neverRun = neverRun;
+    if (committed != null) {
+      _result.committed = committed;
     }
     return _result;
   }
-  factory PrecompiledObject.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory PrecompiledObject.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory CounterMetric.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory CounterMetric.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  PrecompiledObject clone() => PrecompiledObject()..mergeFromMessage(this);
+  CounterMetric clone() => CounterMetric()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  PrecompiledObject copyWith(void Function(PrecompiledObject) updates) => super.copyWith((message) => updates(message as PrecompiledObject)) as PrecompiledObject; // ignore: deprecated_member_use
+  CounterMetric copyWith(void Function(CounterMetric) updates) => super.copyWith((message) => updates(message as CounterMetric)) as CounterMetric; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static PrecompiledObject create() => PrecompiledObject._();
-  PrecompiledObject createEmptyInstance() => create();
-  static $pb.PbList<PrecompiledObject> createRepeated() => $pb.PbList<PrecompiledObject>();
+  static CounterMetric create() => CounterMetric._();
+  CounterMetric createEmptyInstance() => create();
+  static $pb.PbList<CounterMetric> createRepeated() => $pb.PbList<CounterMetric>();
   @$core.pragma('dart2js:noInline')
-  static PrecompiledObject getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<PrecompiledObject>(create);
-  static PrecompiledObject? _defaultInstance;
+  static CounterMetric getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<CounterMetric>(create);
+  static CounterMetric? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get cloudPath => $_getSZ(0);
+  MetricKey get key => $_getN(0);
   @$pb.TagNumber(1)
-  set cloudPath($core.String v) { $_setString(0, v); }
+  set key(MetricKey v) { setField(1, v); }
   @$pb.TagNumber(1)
-  $core.bool hasCloudPath() => $_has(0);
+  $core.bool hasKey() => $_has(0);
   @$pb.TagNumber(1)
-  void clearCloudPath() => clearField(1);
+  void clearKey() => clearField(1);
+  @$pb.TagNumber(1)
+  MetricKey ensureKey() => $_ensure(0);
 
   @$pb.TagNumber(2)
-  $core.String get name => $_getSZ(1);
+  $fixnum.Int64 get attempted => $_getI64(1);
   @$pb.TagNumber(2)
-  set name($core.String v) { $_setString(1, v); }
+  set attempted($fixnum.Int64 v) { $_setInt64(1, v); }
   @$pb.TagNumber(2)
-  $core.bool hasName() => $_has(1);
+  $core.bool hasAttempted() => $_has(1);
   @$pb.TagNumber(2)
-  void clearName() => clearField(2);
+  void clearAttempted() => clearField(2);
 
   @$pb.TagNumber(3)
-  $core.String get description => $_getSZ(2);
+  $fixnum.Int64 get committed => $_getI64(2);
   @$pb.TagNumber(3)
-  set description($core.String v) { $_setString(2, v); }
+  set committed($fixnum.Int64 v) { $_setInt64(2, v); }
   @$pb.TagNumber(3)
-  $core.bool hasDescription() => $_has(2);
+  $core.bool hasCommitted() => $_has(2);
   @$pb.TagNumber(3)
-  void clearDescription() => clearField(3);
-
-  @$pb.TagNumber(4)
-  PrecompiledObjectType get type => $_getN(3);
-  @$pb.TagNumber(4)
-  set type(PrecompiledObjectType v) { setField(4, v); }
-  @$pb.TagNumber(4)
-  $core.bool hasType() => $_has(3);
-  @$pb.TagNumber(4)
-  void clearType() => clearField(4);
-
-  @$pb.TagNumber(5)
-  $core.String get pipelineOptions => $_getSZ(4);
-  @$pb.TagNumber(5)
-  set pipelineOptions($core.String v) { $_setString(4, v); }
-  @$pb.TagNumber(5)
-  $core.bool hasPipelineOptions() => $_has(4);
-  @$pb.TagNumber(5)
-  void clearPipelineOptions() => clearField(5);
-
-  @$pb.TagNumber(6)
-  $core.String get link => $_getSZ(5);
-  @$pb.TagNumber(6)
-  set link($core.String v) { $_setString(5, v); }
-  @$pb.TagNumber(6)
-  $core.bool hasLink() => $_has(5);
-  @$pb.TagNumber(6)
-  void clearLink() => clearField(6);
-
-  @$pb.TagNumber(7)
-  $core.bool get multifile => $_getBF(6);
-  @$pb.TagNumber(7)
-  set multifile($core.bool v) { $_setBool(6, v); }
-  @$pb.TagNumber(7)
-  $core.bool hasMultifile() => $_has(6);
-  @$pb.TagNumber(7)
-  void clearMultifile() => clearField(7);
-
-  @$pb.TagNumber(8)
-  $core.int get contextLine => $_getIZ(7);
-  @$pb.TagNumber(8)
-  set contextLine($core.int v) { $_setSignedInt32(7, v); }
-  @$pb.TagNumber(8)
-  $core.bool hasContextLine() => $_has(7);
-  @$pb.TagNumber(8)
-  void clearContextLine() => clearField(8);
-
-  @$pb.TagNumber(9)
-  $core.bool get defaultExample => $_getBF(8);
-  @$pb.TagNumber(9)
-  set defaultExample($core.bool v) { $_setBool(8, v); }
-  @$pb.TagNumber(9)
-  $core.bool hasDefaultExample() => $_has(8);
-  @$pb.TagNumber(9)
-  void clearDefaultExample() => clearField(9);
-
-  @$pb.TagNumber(10)
-  Sdk get sdk => $_getN(9);
-  @$pb.TagNumber(10)
-  set sdk(Sdk v) { setField(10, v); }
-  @$pb.TagNumber(10)
-  $core.bool hasSdk() => $_has(9);
-  @$pb.TagNumber(10)
-  void clearSdk() => clearField(10);
-
-  @$pb.TagNumber(11)
-  Complexity get complexity => $_getN(10);
-  @$pb.TagNumber(11)
-  set complexity(Complexity v) { setField(11, v); }
-  @$pb.TagNumber(11)
-  $core.bool hasComplexity() => $_has(10);
-  @$pb.TagNumber(11)
-  void clearComplexity() => clearField(11);
-
-  @$pb.TagNumber(12)
-  $core.List<$core.String> get tags => $_getList(11);
-
-  @$pb.TagNumber(13)
-  $core.List<Dataset> get datasets => $_getList(12);
-
-  @$pb.TagNumber(14)
-  $core.String get urlVcs => $_getSZ(13);
-  @$pb.TagNumber(14)
-  set urlVcs($core.String v) { $_setString(13, v); }
-  @$pb.TagNumber(14)
-  $core.bool hasUrlVcs() => $_has(13);
-  @$pb.TagNumber(14)
-  void clearUrlVcs() => clearField(14);
-
-  @$pb.TagNumber(15)
-  $core.String get urlNotebook => $_getSZ(14);
-  @$pb.TagNumber(15)
-  set urlNotebook($core.String v) { $_setString(14, v); }
-  @$pb.TagNumber(15)
-  $core.bool hasUrlNotebook() => $_has(14);
-  @$pb.TagNumber(15)
-  void clearUrlNotebook() => clearField(15);
-
-  @$pb.TagNumber(16)
-  $core.bool get alwaysRun => $_getBF(15);
-  @$pb.TagNumber(16)
-  set alwaysRun($core.bool v) { $_setBool(15, v); }
-  @$pb.TagNumber(16)
-  $core.bool hasAlwaysRun() => $_has(15);
-  @$pb.TagNumber(16)
-  void clearAlwaysRun() => clearField(16);
-
-  @$pb.TagNumber(17)
-  $core.bool get neverRun => $_getBF(16);
-  @$pb.TagNumber(17)
-  set neverRun($core.bool v) { $_setBool(16, v); }
-  @$pb.TagNumber(17)
-  $core.bool hasNeverRun() => $_has(16);
-  @$pb.TagNumber(17)
-  void clearNeverRun() => clearField(17);
+  void clearCommitted() => clearField(3);
 }
 
-class Categories_Category extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'Categories.Category', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'categoryName')
-    ..pc<PrecompiledObject>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'precompiledObjects', $pb.PbFieldType.PM, subBuilder: PrecompiledObject.create)
+class DistributionValue extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'DistributionValue', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aInt64(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'count')
+    ..aInt64(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sum')
+    ..aInt64(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'min')
+    ..aInt64(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'max')
     ..hasRequiredFields = false
   ;
 
-  Categories_Category._() : super();
-  factory Categories_Category({
-    $core.String? categoryName,
-    $core.Iterable<PrecompiledObject>? precompiledObjects,
+  DistributionValue._() : super();
+  factory DistributionValue({
+    $fixnum.Int64? count,
+    $fixnum.Int64? sum,
+    $fixnum.Int64? min,
+    $fixnum.Int64? max,
   }) {
     final _result = create();
-    if (categoryName != null) {
-      _result.categoryName = categoryName;
+    if (count != null) {
+      _result.count = count;
     }
-    if (precompiledObjects != null) {
-      _result.precompiledObjects.addAll(precompiledObjects);
+    if (sum != null) {
+      _result.sum = sum;
+    }
+    if (min != null) {
+      _result.min = min;
+    }
+    if (max != null) {
+      _result.max = max;
     }
     return _result;
   }
-  factory Categories_Category.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory Categories_Category.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory DistributionValue.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory DistributionValue.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  Categories_Category clone() => Categories_Category()..mergeFromMessage(this);
+  DistributionValue clone() => DistributionValue()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  Categories_Category copyWith(void Function(Categories_Category) updates) => super.copyWith((message) => updates(message as Categories_Category)) as Categories_Category; // ignore: deprecated_member_use
+  DistributionValue copyWith(void Function(DistributionValue) updates) => super.copyWith((message) => updates(message as DistributionValue)) as DistributionValue; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static Categories_Category create() => Categories_Category._();
-  Categories_Category createEmptyInstance() => create();
-  static $pb.PbList<Categories_Category> createRepeated() => $pb.PbList<Categories_Category>();
+  static DistributionValue create() => DistributionValue._();
+  DistributionValue createEmptyInstance() => create();
+  static $pb.PbList<DistributionValue> createRepeated() => $pb.PbList<DistributionValue>();
   @$core.pragma('dart2js:noInline')
-  static Categories_Category getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<Categories_Category>(create);
-  static Categories_Category? _defaultInstance;
+  static DistributionValue getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<DistributionValue>(create);
+  static DistributionValue? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get categoryName => $_getSZ(0);
+  $fixnum.Int64 get count => $_getI64(0);
   @$pb.TagNumber(1)
-  set categoryName($core.String v) { $_setString(0, v); }
+  set count($fixnum.Int64 v) { $_setInt64(0, v); }
   @$pb.TagNumber(1)
-  $core.bool hasCategoryName() => $_has(0);
+  $core.bool hasCount() => $_has(0);
   @$pb.TagNumber(1)
-  void clearCategoryName() => clearField(1);
+  void clearCount() => clearField(1);
 
   @$pb.TagNumber(2)
-  $core.List<PrecompiledObject> get precompiledObjects => $_getList(1);
-}
+  $fixnum.Int64 get sum => $_getI64(1);
+  @$pb.TagNumber(2)
+  set sum($fixnum.Int64 v) { $_setInt64(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasSum() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearSum() => clearField(2);
 
-class Categories extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'Categories', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..e<Sdk>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdk', $pb.PbFieldType.OE, defaultOrMaker: Sdk.SDK_UNSPECIFIED, valueOf: Sdk.valueOf, enumValues: Sdk.values)
+  @$pb.TagNumber(3)
+  $fixnum.Int64 get min => $_getI64(2);
+  @$pb.TagNumber(3)
+  set min($fixnum.Int64 v) { $_setInt64(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasMin() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearMin() => clearField(3);
+
+  @$pb.TagNumber(4)
+  $fixnum.Int64 get max => $_getI64(3);
+  @$pb.TagNumber(4)
+  set max($fixnum.Int64 v) { $_setInt64(3, v); }
+  @$pb.TagNumber(4)
+  $core.bool hasMax() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearMax() => clearField(4);
+}
+
+class DistributionMetric extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'DistributionMetric', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOM<MetricKey>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'key', subBuilder: MetricKey.create)
+    ..aOM<DistributionValue>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'attempted', subBuilder: DistributionValue.create)
+    ..aOM<DistributionValue>(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'committed', subBuilder: DistributionValue.create)
+    ..hasRequiredFields = false
+  ;
+
+  DistributionMetric._() : super();
+  factory DistributionMetric({
+    MetricKey? key,
+    DistributionValue? attempted,
+    DistributionValue? committed,
+  }) {
+    final _result = create();
+    if (key != null) {
+      _result.key = key;
+    }
+    if (attempted != null) {
+      _result.attempted = attempted;
+    }
+    if (committed != null) {
+      _result.committed = committed;
+    }
+    return _result;
+  }
+  factory DistributionMetric.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory DistributionMetric.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  DistributionMetric clone() => DistributionMetric()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  DistributionMetric copyWith(void Function(DistributionMetric) updates) => super.copyWith((message) => updates(message as DistributionMetric)) as DistributionMetric; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static DistributionMetric create() => DistributionMetric._();
+  DistributionMetric createEmptyInstance() => create();
+  static $pb.PbList<DistributionMetric> createRepeated() => $pb.PbList<DistributionMetric>();
+  @$core.pragma('dart2js:noInline')
+  static DistributionMetric getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<DistributionMetric>(create);
+  static DistributionMetric? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  MetricKey get key => $_getN(0);
+  @$pb.TagNumber(1)
+  set key(MetricKey v) { setField(1, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasKey() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearKey() => clearField(1);
+  @$pb.TagNumber(1)
+  MetricKey ensureKey() => $_ensure(0);
+
+  @$pb.TagNumber(2)
+  DistributionValue get attempted => $_getN(1);
+  @$pb.TagNumber(2)
+  set attempted(DistributionValue v) { setField(2, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasAttempted() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearAttempted() => clearField(2);
+  @$pb.TagNumber(2)
+  DistributionValue ensureAttempted() => $_ensure(1);
+
+  @$pb.TagNumber(3)
+  DistributionValue get committed => $_getN(2);
+  @$pb.TagNumber(3)
+  set committed(DistributionValue v) { setField(3, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasCommitted() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearCommitted() => clearField(3);
+  @$pb.TagNumber(3)
+  DistributionValue ensureCommitted() => $_ensure(2);
+}
+
+class GaugeValue extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GaugeValue', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aInt64(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'value')
+    ..aInt64(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'timestamp')
+    ..hasRequiredFields = false
+  ;
+
+  GaugeValue._() : super();
+  factory GaugeValue({
+    $fixnum.Int64? value,
+    $fixnum.Int64? timestamp,
+  }) {
+    final _result = create();
+    if (value != null) {
+      _result.value = value;
+    }
+    if (timestamp != null) {
+      _result.timestamp = timestamp;
+    }
+    return _result;
+  }
+  factory GaugeValue.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GaugeValue.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GaugeValue clone() => GaugeValue()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GaugeValue copyWith(void Function(GaugeValue) updates) => super.copyWith((message) => updates(message as GaugeValue)) as GaugeValue; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GaugeValue create() => GaugeValue._();
+  GaugeValue createEmptyInstance() => create();
+  static $pb.PbList<GaugeValue> createRepeated() => $pb.PbList<GaugeValue>();
+  @$core.pragma('dart2js:noInline')
+  static GaugeValue getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GaugeValue>(create);
+  static GaugeValue? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $fixnum.Int64 get value => $_getI64(0);
+  @$pb.TagNumber(1)
+  set value($fixnum.Int64 v) { $_setInt64(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasValue() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearValue() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $fixnum.Int64 get timestamp => $_getI64(1);
+  @$pb.TagNumber(2)
+  set timestamp($fixnum.Int64 v) { $_setInt64(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasTimestamp() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearTimestamp() => clearField(2);
+}
+
+class GaugeMetric extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GaugeMetric', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOM<MetricKey>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'key', subBuilder: MetricKey.create)
+    ..aOM<GaugeValue>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'attempted', subBuilder: GaugeValue.create)
+    ..aOM<GaugeValue>(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'committed', subBuilder: GaugeValue.create)
+    ..hasRequiredFields = false
+  ;
+
+  GaugeMetric._() : super();
+  factory GaugeMetric({
+    MetricKey? key,
+    GaugeValue? attempted,
+    GaugeValue? committed,
+  }) {
+    final _result = create();
+    if (key != null) {
+      _result.key = key;
+    }
+    if (attempted != null) {
+      _result.attempted = attempted;
+    }
+    if (committed != null) {
+      _result.committed = committed;
+    }
+    return _result;
+  }
+  factory GaugeMetric.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GaugeMetric.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GaugeMetric clone() => GaugeMetric()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GaugeMetric copyWith(void Function(GaugeMetric) updates) => super.copyWith((message) => updates(message as GaugeMetric)) as GaugeMetric; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GaugeMetric create() => GaugeMetric._();
+  GaugeMetric createEmptyInstance() => create();
+  static $pb.PbList<GaugeMetric> createRepeated() => $pb.PbList<GaugeMetric>();
+  @$core.pragma('dart2js:noInline')
+  static GaugeMetric getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GaugeMetric>(create);
+  static GaugeMetric? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  MetricKey get key => $_getN(0);
+  @$pb.TagNumber(1)
+  set key(MetricKey v) { setField(1, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasKey() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearKey() => clearField(1);
+  @$pb.TagNumber(1)
+  MetricKey ensureKey() => $_ensure(0);
+
+  @$pb.TagNumber(2)
+  GaugeValue get attempted => $_getN(1);
+  @$pb.TagNumber(2)
+  set attempted(GaugeValue v) { setField(2, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasAttempted() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearAttempted() => clearField(2);
+  @$pb.TagNumber(2)
+  GaugeValue ensureAttempted() => $_ensure(1);
+
+  @$pb.TagNumber(3)
+  GaugeValue get committed => $_getN(2);
+  @$pb.TagNumber(3)
+  set committed(GaugeValue v) { setField(3, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasCommitted() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearCommitted() => clearField(3);
+  @$pb.TagNumber(3)
+  GaugeValue ensureCommitted() => $_ensure(2);
+}
+
+class GetRunMetricsResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetRunMetricsResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..pc<CounterMetric>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'counters', $pb.PbFieldType.PM, subBuilder: CounterMetric.create)
+    ..pc<DistributionMetric>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'distributions', $pb.PbFieldType.PM, subBuilder: DistributionMetric.create)
+    ..pc<GaugeMetric>(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'gauges', $pb.PbFieldType.PM, subBuilder: GaugeMetric.create)
+    ..hasRequiredFields = false
+  ;
+
+  GetRunMetricsResponse._() : super();
+  factory GetRunMetricsResponse({
+    $core.Iterable<CounterMetric>? counters,
+    $core.Iterable<DistributionMetric>? distributions,
+    $core.Iterable<GaugeMetric>? gauges,
+  }) {
+    final _result = create();
+    if (counters != null) {
+      _result.counters.addAll(counters);
+    }
+    if (distributions != null) {
+      _result.distributions.addAll(distributions);
+    }
+    if (gauges != null) {
+      _result.gauges.addAll(gauges);
+    }
+    return _result;
+  }
+  factory GetRunMetricsResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetRunMetricsResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetRunMetricsResponse clone() => GetRunMetricsResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetRunMetricsResponse copyWith(void Function(GetRunMetricsResponse) updates) => super.copyWith((message) => updates(message as GetRunMetricsResponse)) as GetRunMetricsResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetRunMetricsResponse create() => GetRunMetricsResponse._();
+  GetRunMetricsResponse createEmptyInstance() => create();
+  static $pb.PbList<GetRunMetricsResponse> createRepeated() => $pb.PbList<GetRunMetricsResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetRunMetricsResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetRunMetricsResponse>(create);
+  static GetRunMetricsResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.List<CounterMetric> get counters => $_getList(0);
+
+  @$pb.TagNumber(2)
+  $core.List<DistributionMetric> get distributions => $_getList(1);
+
+  @$pb.TagNumber(3)
+  $core.List<GaugeMetric> get gauges => $_getList(2);
+}
+
+class CancelRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'CancelRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineUuid')
+    ..hasRequiredFields = false
+  ;
+
+  CancelRequest._() : super();
+  factory CancelRequest({
+    $core.String? pipelineUuid,
+  }) {
+    final _result = create();
+    if (pipelineUuid != null) {
+      _result.pipelineUuid = pipelineUuid;
+    }
+    return _result;
+  }
+  factory CancelRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory CancelRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  CancelRequest clone() => CancelRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  CancelRequest copyWith(void Function(CancelRequest) updates) => super.copyWith((message) => updates(message as CancelRequest)) as CancelRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static CancelRequest create() => CancelRequest._();
+  CancelRequest createEmptyInstance() => create();
+  static $pb.PbList<CancelRequest> createRepeated() => $pb.PbList<CancelRequest>();
+  @$core.pragma('dart2js:noInline')
+  static CancelRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<CancelRequest>(create);
+  static CancelRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get pipelineUuid => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set pipelineUuid($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasPipelineUuid() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearPipelineUuid() => clearField(1);
+}
+
+class CancelResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'CancelResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..hasRequiredFields = false
+  ;
+
+  CancelResponse._() : super();
+  factory CancelResponse() => create();
+  factory CancelResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory CancelResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  CancelResponse clone() => CancelResponse()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  CancelResponse copyWith(void Function(CancelResponse) updates) => super.copyWith((message) => updates(message as CancelResponse)) as CancelResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static CancelResponse create() => CancelResponse._();
+  CancelResponse createEmptyInstance() => create();
+  static $pb.PbList<CancelResponse> createRepeated() => $pb.PbList<CancelResponse>();
+  @$core.pragma('dart2js:noInline')
+  static CancelResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<CancelResponse>(create);
+  static CancelResponse? _defaultInstance;
+}
+
+class PrecompiledObject extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'PrecompiledObject', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'cloudPath')
+    ..aOS(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'name')
+    ..aOS(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'description')
+    ..e<PrecompiledObjectType>(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'type', $pb.PbFieldType.OE, defaultOrMaker: PrecompiledObjectType.PRECOMPILED_OBJECT_TYPE_UNSPECIFIED, valueOf: PrecompiledObjectType.valueOf, enumValues: PrecompiledObjectType.values)
+    ..aOS(5, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineOptions')
+    ..aOS(6, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'link')
+    ..aOB(7, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'multifile')
+    ..a<$core.int>(8, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'contextLine', $pb.PbFieldType.O3)
+    ..aOB(9, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'defaultExample')
+    ..e<Sdk>(10, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdk', $pb.PbFieldType.OE, defaultOrMaker: Sdk.SDK_UNSPECIFIED, valueOf: Sdk.valueOf, enumValues: Sdk.values)
+    ..e<Complexity>(11, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'complexity', $pb.PbFieldType.OE, defaultOrMaker: Complexity.COMPLEXITY_UNSPECIFIED, valueOf: Complexity.valueOf, enumValues: Complexity.values)
+    ..pPS(12, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'tags')
+    ..pc<Dataset>(13, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'datasets', $pb.PbFieldType.PM, subBuilder: Dataset.create)
+    ..aOS(14, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'urlVcs')
+    ..aOS(15, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'urlNotebook')
+    ..aOB(16, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'alwaysRun')
+    ..aOB(17, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'neverRun')
+    ..hasRequiredFields = false
+  ;
+
+  PrecompiledObject._() : super();
+  factory PrecompiledObject({
+    $core.String? cloudPath,
+    $core.String? name,
+    $core.String? description,
+    PrecompiledObjectType? type,
+    $core.String? pipelineOptions,
+    $core.String? link,
+    $core.bool? multifile,
+    $core.int? contextLine,
+    $core.bool? defaultExample,
+    Sdk? sdk,
+    Complexity? complexity,
+    $core.Iterable<$core.String>? tags,
+    $core.Iterable<Dataset>? datasets,
+    $core.String? urlVcs,
+    $core.String? urlNotebook,
+    $core.bool? alwaysRun,
+    $core.bool? neverRun,
+  }) {
+    final _result = create();
+    if (cloudPath != null) {
+      _result.cloudPath = cloudPath;
+    }
+    if (name != null) {
+      _result.name = name;
+    }
+    if (description != null) {
+      _result.description = description;
+    }
+    if (type != null) {
+      _result.type = type;
+    }
+    if (pipelineOptions != null) {
+      _result.pipelineOptions = pipelineOptions;
+    }
+    if (link != null) {
+      _result.link = link;
+    }
+    if (multifile != null) {
+      _result.multifile = multifile;
+    }
+    if (contextLine != null) {
+      _result.contextLine = contextLine;
+    }
+    if (defaultExample != null) {
+      _result.defaultExample = defaultExample;
+    }
+    if (sdk != null) {
+      _result.sdk = sdk;
+    }
+    if (complexity != null) {
+      _result.complexity = complexity;
+    }
+    if (tags != null) {
+      _result.tags.addAll(tags);
+    }
+    if (datasets != null) {
+      _result.datasets.addAll(datasets);
+    }
+    if (urlVcs != null) {
+      _result.urlVcs = urlVcs;
+    }
+    if (urlNotebook != null) {
+      _result.urlNotebook = urlNotebook;
+    }
+    if (alwaysRun != null) {
+      _result.alwaysRun = alwaysRun;
+    }
+    if (neverRun != null) {
+      _result.neverRun = neverRun;
+    }
+    return _result;
+  }
+  factory PrecompiledObject.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory PrecompiledObject.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  PrecompiledObject clone() => PrecompiledObject()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  PrecompiledObject copyWith(void Function(PrecompiledObject) updates) => super.copyWith((message) => updates(message as PrecompiledObject)) as PrecompiledObject; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static PrecompiledObject create() => PrecompiledObject._();
+  PrecompiledObject createEmptyInstance() => create();
+  static $pb.PbList<PrecompiledObject> createRepeated() => $pb.PbList<PrecompiledObject>();
+  @$core.pragma('dart2js:noInline')
+  static PrecompiledObject getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<PrecompiledObject>(create);
+  static PrecompiledObject? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get cloudPath => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set cloudPath($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasCloudPath() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearCloudPath() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get name => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set name($core.String v) { $_setString(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasName() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearName() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get description => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set description($core.String v) { $_setString(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasDescription() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearDescription() => clearField(3);
+
+  @$pb.TagNumber(4)
+  PrecompiledObjectType get type => $_getN(3);
+  @$pb.TagNumber(4)
+  set type(PrecompiledObjectType v) { setField(4, v); }
+  @$pb.TagNumber(4)
+  $core.bool hasType() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearType() => clearField(4);
+
+  @$pb.TagNumber(5)
+  $core.String get pipelineOptions => $_getSZ(4);
+  @$pb.TagNumber(5)
+  set pipelineOptions($core.String v) { $_setString(4, v); }
+  @$pb.TagNumber(5)
+  $core.bool hasPipelineOptions() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearPipelineOptions() => clearField(5);
+
+  @$pb.TagNumber(6)
+  $core.String get link => $_getSZ(5);
+  @$pb.TagNumber(6)
+  set link($core.String v) { $_setString(5, v); }
+  @$pb.TagNumber(6)
+  $core.bool hasLink() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearLink() => clearField(6);
+
+  @$pb.TagNumber(7)
+  $core.bool get multifile => $_getBF(6);
+  @$pb.TagNumber(7)
+  set multifile($core.bool v) { $_setBool(6, v); }
+  @$pb.TagNumber(7)
+  $core.bool hasMultifile() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearMultifile() => clearField(7);
+
+  @$pb.TagNumber(8)
+  $core.int get contextLine => $_getIZ(7);
+  @$pb.TagNumber(8)
+  set contextLine($core.int v) { $_setSignedInt32(7, v); }
+  @$pb.TagNumber(8)
+  $core.bool hasContextLine() => $_has(7);
+  @$pb.TagNumber(8)
+  void clearContextLine() => clearField(8);
+
+  @$pb.TagNumber(9)
+  $core.bool get defaultExample => $_getBF(8);
+  @$pb.TagNumber(9)
+  set defaultExample($core.bool v) { $_setBool(8, v); }
+  @$pb.TagNumber(9)
+  $core.bool hasDefaultExample() => $_has(8);
+  @$pb.TagNumber(9)
+  void clearDefaultExample() => clearField(9);
+
+  @$pb.TagNumber(10)
+  Sdk get sdk => $_getN(9);
+  @$pb.TagNumber(10)
+  set sdk(Sdk v) { setField(10, v); }
+  @$pb.TagNumber(10)
+  $core.bool hasSdk() => $_has(9);
+  @$pb.TagNumber(10)
+  void clearSdk() => clearField(10);
+
+  @$pb.TagNumber(11)
+  Complexity get complexity => $_getN(10);
+  @$pb.TagNumber(11)
+  set complexity(Complexity v) { setField(11, v); }
+  @$pb.TagNumber(11)
+  $core.bool hasComplexity() => $_has(10);
+  @$pb.TagNumber(11)
+  void clearComplexity() => clearField(11);
+
+  @$pb.TagNumber(12)
+  $core.List<$core.String> get tags => $_getList(11);
+
+  @$pb.TagNumber(13)
+  $core.List<Dataset> get datasets => $_getList(12);
+
+  @$pb.TagNumber(14)
+  $core.String get urlVcs => $_getSZ(13);
+  @$pb.TagNumber(14)
+  set urlVcs($core.String v) { $_setString(13, v); }
+  @$pb.TagNumber(14)
+  $core.bool hasUrlVcs() => $_has(13);
+  @$pb.TagNumber(14)
+  void clearUrlVcs() => clearField(14);
+
+  @$pb.TagNumber(15)
+  $core.String get urlNotebook => $_getSZ(14);
+  @$pb.TagNumber(15)
+  set urlNotebook($core.String v) { $_setString(14, v); }
+  @$pb.TagNumber(15)
+  $core.bool hasUrlNotebook() => $_has(14);
+  @$pb.TagNumber(15)
+  void clearUrlNotebook() => clearField(15);
+
+  @$pb.TagNumber(16)
+  $core.bool get alwaysRun => $_getBF(15);
+  @$pb.TagNumber(16)
+  set alwaysRun($core.bool v) { $_setBool(15, v); }
+  @$pb.TagNumber(16)
+  $core.bool hasAlwaysRun() => $_has(15);
+  @$pb.TagNumber(16)
+  void clearAlwaysRun() => clearField(16);
+
+  @$pb.TagNumber(17)
+  $core.bool get neverRun => $_getBF(16);
+  @$pb.TagNumber(17)
+  set neverRun($core.bool v) { $_setBool(16, v); }
+  @$pb.TagNumber(17)
+  $core.bool hasNeverRun() => $_has(16);
+  @$pb.TagNumber(17)
+  void clearNeverRun() => clearField(17);
+}
+
+class Categories_Category extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'Categories.Category', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'categoryName')
+    ..pc<PrecompiledObject>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'precompiledObjects', $pb.PbFieldType.PM, subBuilder: PrecompiledObject.create)
+    ..hasRequiredFields = false
+  ;
+
+  Categories_Category._() : super();
+  factory Categories_Category({
+    $core.String? categoryName,
+    $core.Iterable<PrecompiledObject>? precompiledObjects,
+  }) {
+    final _result = create();
+    if (categoryName != null) {
+      _result.categoryName = categoryName;
+    }
+    if (precompiledObjects != null) {
+      _result.precompiledObjects.addAll(precompiledObjects);
+    }
+    return _result;
+  }
+  factory Categories_Category.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory Categories_Category.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  Categories_Category clone() => Categories_Category()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  Categories_Category copyWith(void Function(Categories_Category) updates) => super.copyWith((message) => updates(message as Categories_Category)) as Categories_Category; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static Categories_Category create() => Categories_Category._();
+  Categories_Category createEmptyInstance() => create();
+  static $pb.PbList<Categories_Category> createRepeated() => $pb.PbList<Categories_Category>();
+  @$core.pragma('dart2js:noInline')
+  static Categories_Category getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<Categories_Category>(create);
+  static Categories_Category? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get categoryName => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set categoryName($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasCategoryName() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearCategoryName() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.List<PrecompiledObject> get precompiledObjects => $_getList(1);
+}
+
+class Categories extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'Categories', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..e<Sdk>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdk', $pb.PbFieldType.OE, defaultOrMaker: Sdk.SDK_UNSPECIFIED, valueOf: Sdk.valueOf, enumValues: Sdk.values)
     ..pc<Categories_Category>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'categories', $pb.PbFieldType.PM, subBuilder: Categories_Category.create)
     ..hasRequiredFields = false
   ;
 
-  Categories._() : super();
-  factory Categories({
+  Categories._() : super();
+  factory Categories({
+    Sdk? sdk,
+    $core.Iterable<Categories_Category>? categories,
+  }) {
+    final _result = create();
+    if (sdk != null) {
+      _result.sdk = sdk;
+    }
+    if (categories != null) {
+      _result.categories.addAll(categories);
+    }
+    return _result;
+  }
+  factory Categories.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory Categories.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  Categories clone() => Categories()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  Categories copyWith(void Function(Categories) updates) => super.copyWith((message) => updates(message as Categories)) as Categories; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static Categories create() => Categories._();
+  Categories createEmptyInstance() => create();
+  static $pb.PbList<Categories> createRepeated() => $pb.PbList<Categories>();
+  @$core.pragma('dart2js:noInline')
+  static Categories getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<Categories>(create);
+  static Categories? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  Sdk get sdk => $_getN(0);
+  @$pb.TagNumber(1)
+  set sdk(Sdk v) { setField(1, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasSdk() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearSdk() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.List<Categories_Category> get categories => $_getList(1);
+}
+
+class GetPrecompiledObjectsRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectsRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..e<Sdk>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdk', $pb.PbFieldType.OE, defaultOrMaker: Sdk.SDK_UNSPECIFIED, valueOf: Sdk.valueOf, enumValues: Sdk.values)
+    ..aOS(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'category')
+    ..hasRequiredFields = false
+  ;
+
+  GetPrecompiledObjectsRequest._() : super();
+  factory GetPrecompiledObjectsRequest({
+    Sdk? sdk,
+    $core.String? category,
+  }) {
+    final _result = create();
+    if (sdk != null) {
+      _result.sdk = sdk;
+    }
+    if (category != null) {
+      _result.category = category;
+    }
+    return _result;
+  }
+  factory GetPrecompiledObjectsRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetPrecompiledObjectsRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetPrecompiledObjectsRequest clone() => GetPrecompiledObjectsRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetPrecompiledObjectsRequest copyWith(void Function(GetPrecompiledObjectsRequest) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectsRequest)) as GetPrecompiledObjectsRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetPrecompiledObjectsRequest create() => GetPrecompiledObjectsRequest._();
+  GetPrecompiledObjectsRequest createEmptyInstance() => create();
+  static $pb.PbList<GetPrecompiledObjectsRequest> createRepeated() => $pb.PbList<GetPrecompiledObjectsRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetPrecompiledObjectsRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectsRequest>(create);
+  static GetPrecompiledObjectsRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  Sdk get sdk => $_getN(0);
+  @$pb.TagNumber(1)
+  set sdk(Sdk v) { setField(1, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasSdk() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearSdk() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get category => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set category($core.String v) { $_setString(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasCategory() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearCategory() => clearField(2);
+}
+
+class GetPrecompiledObjectRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'cloudPath')
+    ..hasRequiredFields = false
+  ;
+
+  GetPrecompiledObjectRequest._() : super();
+  factory GetPrecompiledObjectRequest({
+    $core.String? cloudPath,
+  }) {
+    final _result = create();
+    if (cloudPath != null) {
+      _result.cloudPath = cloudPath;
+    }
+    return _result;
+  }
+  factory GetPrecompiledObjectRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetPrecompiledObjectRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetPrecompiledObjectRequest clone() => GetPrecompiledObjectRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetPrecompiledObjectRequest copyWith(void Function(GetPrecompiledObjectRequest) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectRequest)) as GetPrecompiledObjectRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetPrecompiledObjectRequest create() => GetPrecompiledObjectRequest._();
+  GetPrecompiledObjectRequest createEmptyInstance() => create();
+  static $pb.PbList<GetPrecompiledObjectRequest> createRepeated() => $pb.PbList<GetPrecompiledObjectRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetPrecompiledObjectRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectRequest>(create);
+  static GetPrecompiledObjectRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get cloudPath => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set cloudPath($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasCloudPath() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearCloudPath() => clearField(1);
+}
+
+class GetPrecompiledObjectCodeRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectCodeRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'cloudPath')
+    ..hasRequiredFields = false
+  ;
+
+  GetPrecompiledObjectCodeRequest._() : super();
+  factory GetPrecompiledObjectCodeRequest({
+    $core.String? cloudPath,
+  }) {
+    final _result = create();
+    if (cloudPath != null) {
+      _result.cloudPath = cloudPath;
+    }
+    return _result;
+  }
+  factory GetPrecompiledObjectCodeRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetPrecompiledObjectCodeRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetPrecompiledObjectCodeRequest clone() => GetPrecompiledObjectCodeRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetPrecompiledObjectCodeRequest copyWith(void Function(GetPrecompiledObjectCodeRequest) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectCodeRequest)) as GetPrecompiledObjectCodeRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetPrecompiledObjectCodeRequest create() => GetPrecompiledObjectCodeRequest._();
+  GetPrecompiledObjectCodeRequest createEmptyInstance() => create();
+  static $pb.PbList<GetPrecompiledObjectCodeRequest> createRepeated() => $pb.PbList<GetPrecompiledObjectCodeRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetPrecompiledObjectCodeRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectCodeRequest>(create);
+  static GetPrecompiledObjectCodeRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get cloudPath => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set cloudPath($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasCloudPath() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearCloudPath() => clearField(1);
+}
+
+class GetPrecompiledObjectOutputRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectOutputRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'cloudPath')
+    ..hasRequiredFields = false
+  ;
+
+  GetPrecompiledObjectOutputRequest._() : super();
+  factory GetPrecompiledObjectOutputRequest({
+    $core.String? cloudPath,
+  }) {
+    final _result = create();
+    if (cloudPath != null) {
+      _result.cloudPath = cloudPath;
+    }
+    return _result;
+  }
+  factory GetPrecompiledObjectOutputRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetPrecompiledObjectOutputRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetPrecompiledObjectOutputRequest clone() => GetPrecompiledObjectOutputRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetPrecompiledObjectOutputRequest copyWith(void Function(GetPrecompiledObjectOutputRequest) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectOutputRequest)) as GetPrecompiledObjectOutputRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetPrecompiledObjectOutputRequest create() => GetPrecompiledObjectOutputRequest._();
+  GetPrecompiledObjectOutputRequest createEmptyInstance() => create();
+  static $pb.PbList<GetPrecompiledObjectOutputRequest> createRepeated() => $pb.PbList<GetPrecompiledObjectOutputRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetPrecompiledObjectOutputRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectOutputRequest>(create);
+  static GetPrecompiledObjectOutputRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get cloudPath => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set cloudPath($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasCloudPath() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearCloudPath() => clearField(1);
+}
+
+class GetPrecompiledObjectLogsRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectLogsRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'cloudPath')
+    ..hasRequiredFields = false
+  ;
+
+  GetPrecompiledObjectLogsRequest._() : super();
+  factory GetPrecompiledObjectLogsRequest({
+    $core.String? cloudPath,
+  }) {
+    final _result = create();
+    if (cloudPath != null) {
+      _result.cloudPath = cloudPath;
+    }
+    return _result;
+  }
+  factory GetPrecompiledObjectLogsRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetPrecompiledObjectLogsRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetPrecompiledObjectLogsRequest clone() => GetPrecompiledObjectLogsRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetPrecompiledObjectLogsRequest copyWith(void Function(GetPrecompiledObjectLogsRequest) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectLogsRequest)) as GetPrecompiledObjectLogsRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetPrecompiledObjectLogsRequest create() => GetPrecompiledObjectLogsRequest._();
+  GetPrecompiledObjectLogsRequest createEmptyInstance() => create();
+  static $pb.PbList<GetPrecompiledObjectLogsRequest> createRepeated() => $pb.PbList<GetPrecompiledObjectLogsRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetPrecompiledObjectLogsRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectLogsRequest>(create);
+  static GetPrecompiledObjectLogsRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get cloudPath => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set cloudPath($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasCloudPath() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearCloudPath() => clearField(1);
+}
+
+class GetPrecompiledObjectGraphRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectGraphRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'cloudPath')
+    ..hasRequiredFields = false
+  ;
+
+  GetPrecompiledObjectGraphRequest._() : super();
+  factory GetPrecompiledObjectGraphRequest({
+    $core.String? cloudPath,
+  }) {
+    final _result = create();
+    if (cloudPath != null) {
+      _result.cloudPath = cloudPath;
+    }
+    return _result;
+  }
+  factory GetPrecompiledObjectGraphRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetPrecompiledObjectGraphRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetPrecompiledObjectGraphRequest clone() => GetPrecompiledObjectGraphRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetPrecompiledObjectGraphRequest copyWith(void Function(GetPrecompiledObjectGraphRequest) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectGraphRequest)) as GetPrecompiledObjectGraphRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetPrecompiledObjectGraphRequest create() => GetPrecompiledObjectGraphRequest._();
+  GetPrecompiledObjectGraphRequest createEmptyInstance() => create();
+  static $pb.PbList<GetPrecompiledObjectGraphRequest> createRepeated() => $pb.PbList<GetPrecompiledObjectGraphRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetPrecompiledObjectGraphRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectGraphRequest>(create);
+  static GetPrecompiledObjectGraphRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get cloudPath => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set cloudPath($core.String v) { $_setString(0, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasCloudPath() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearCloudPath() => clearField(1);
+}
+
+class GetDefaultPrecompiledObjectRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetDefaultPrecompiledObjectRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..e<Sdk>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdk', $pb.PbFieldType.OE, defaultOrMaker: Sdk.SDK_UNSPECIFIED, valueOf: Sdk.valueOf, enumValues: Sdk.values)
+    ..hasRequiredFields = false
+  ;
+
+  GetDefaultPrecompiledObjectRequest._() : super();
+  factory GetDefaultPrecompiledObjectRequest({
     Sdk? sdk,
-    $core.Iterable<Categories_Category>? categories,
   }) {
     final _result = create();
     if (sdk != null) {
       _result.sdk = sdk;
     }
-    if (categories != null) {
-      _result.categories.addAll(categories);
+    return _result;
+  }
+  factory GetDefaultPrecompiledObjectRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetDefaultPrecompiledObjectRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
+  'Will be removed in next major version')
+  GetDefaultPrecompiledObjectRequest clone() => GetDefaultPrecompiledObjectRequest()..mergeFromMessage(this);
+  @$core.Deprecated(
+  'Using this can add significant overhead to your binary. '
+  'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
+  'Will be removed in next major version')
+  GetDefaultPrecompiledObjectRequest copyWith(void Function(GetDefaultPrecompiledObjectRequest) updates) => super.copyWith((message) => updates(message as GetDefaultPrecompiledObjectRequest)) as GetDefaultPrecompiledObjectRequest; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
+  @$core.pragma('dart2js:noInline')
+  static GetDefaultPrecompiledObjectRequest create() => GetDefaultPrecompiledObjectRequest._();
+  GetDefaultPrecompiledObjectRequest createEmptyInstance() => create();
+  static $pb.PbList<GetDefaultPrecompiledObjectRequest> createRepeated() => $pb.PbList<GetDefaultPrecompiledObjectRequest>();
+  @$core.pragma('dart2js:noInline')
+  static GetDefaultPrecompiledObjectRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetDefaultPrecompiledObjectRequest>(create);
+  static GetDefaultPrecompiledObjectRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  Sdk get sdk => $_getN(0);
+  @$pb.TagNumber(1)
+  set sdk(Sdk v) { setField(1, v); }
+  @$pb.TagNumber(1)
+  $core.bool hasSdk() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearSdk() => clearField(1);
+}
+
+class GetPrecompiledObjectsResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectsResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..pc<Categories>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdkCategories', $pb.PbFieldType.PM, subBuilder: Categories.create)
+    ..hasRequiredFields = false
+  ;
+
+  GetPrecompiledObjectsResponse._() : super();
+  factory GetPrecompiledObjectsResponse({
+    $core.Iterable<Categories>? sdkCategories,
+  }) {
+    final _result = create();
+    if (sdkCategories != null) {
+      _result.sdkCategories.addAll(sdkCategories);
     }
     return _result;
   }
-  factory Categories.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory Categories.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetPrecompiledObjectsResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetPrecompiledObjectsResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  Categories clone() => Categories()..mergeFromMessage(this);
+  GetPrecompiledObjectsResponse clone() => GetPrecompiledObjectsResponse()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  Categories copyWith(void Function(Categories) updates) => super.copyWith((message) => updates(message as Categories)) as Categories; // ignore: deprecated_member_use
+  GetPrecompiledObjectsResponse copyWith(void Function(GetPrecompiledObjectsResponse) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectsResponse)) as GetPrecompiledObjectsResponse; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static Categories create() => Categories._();
-  Categories createEmptyInstance() => create();
-  static $pb.PbList<Categories> createRepeated() => $pb.PbList<Categories>();
+  static GetPrecompiledObjectsResponse create() => GetPrecompiledObjectsResponse._();
+  GetPrecompiledObjectsResponse createEmptyInstance() => create();
+  static $pb.PbList<GetPrecompiledObjectsResponse> createRepeated() => $pb.PbList<GetPrecompiledObjectsResponse>();
   @$core.pragma('dart2js:noInline')
-  static Categories getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<Categories>(create);
-  static Categories? _defaultInstance;
+  static GetPrecompiledObjectsResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectsResponse>(create);
+  static GetPrecompiledObjectsResponse? _defaultInstance;
 
   @$pb.TagNumber(1)
-  Sdk get sdk => $_getN(0);
-  @$pb.TagNumber(1)
-  set sdk(Sdk v) { setField(1, v); }
-  @$pb.TagNumber(1)
-  $core.bool hasSdk() => $_has(0);
-  @$pb.TagNumber(1)
-  void clearSdk() => clearField(1);
-
-  @$pb.TagNumber(2)
-  $core.List<Categories_Category> get categories => $_getList(1);
+  $core.List<Categories> get sdkCategories => $_getList(0);
 }
 
-class GetPrecompiledObjectsRequest extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectsRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..e<Sdk>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdk', $pb.PbFieldType.OE, defaultOrMaker: Sdk.SDK_UNSPECIFIED, valueOf: Sdk.valueOf, enumValues: Sdk.values)
-    ..aOS(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'category')
+class GetPrecompiledObjectResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOM<PrecompiledObject>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'precompiledObject', subBuilder: PrecompiledObject.create)
     ..hasRequiredFields = false
   ;
 
-  GetPrecompiledObjectsRequest._() : super();
-  factory GetPrecompiledObjectsRequest({
-    Sdk? sdk,
-    $core.String? category,
+  GetPrecompiledObjectResponse._() : super();
+  factory GetPrecompiledObjectResponse({
+    PrecompiledObject? precompiledObject,
   }) {
     final _result = create();
-    if (sdk != null) {
-      _result.sdk = sdk;
-    }
-    if (category != null) {
-      _result.category = category;
+    if (precompiledObject != null) {
+      _result.precompiledObject = precompiledObject;
     }
     return _result;
   }
-  factory GetPrecompiledObjectsRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetPrecompiledObjectsRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetPrecompiledObjectResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetPrecompiledObjectResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectsRequest clone() => GetPrecompiledObjectsRequest()..mergeFromMessage(this);
+  GetPrecompiledObjectResponse clone() => GetPrecompiledObjectResponse()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectsRequest copyWith(void Function(GetPrecompiledObjectsRequest) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectsRequest)) as GetPrecompiledObjectsRequest; // ignore: deprecated_member_use
+  GetPrecompiledObjectResponse copyWith(void Function(GetPrecompiledObjectResponse) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectResponse)) as GetPrecompiledObjectResponse; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectsRequest create() => GetPrecompiledObjectsRequest._();
-  GetPrecompiledObjectsRequest createEmptyInstance() => create();
-  static $pb.PbList<GetPrecompiledObjectsRequest> createRepeated() => $pb.PbList<GetPrecompiledObjectsRequest>();
+  static GetPrecompiledObjectResponse create() => GetPrecompiledObjectResponse._();
+  GetPrecompiledObjectResponse createEmptyInstance() => create();
+  static $pb.PbList<GetPrecompiledObjectResponse> createRepeated() => $pb.PbList<GetPrecompiledObjectResponse>();
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectsRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectsRequest>(create);
-  static GetPrecompiledObjectsRequest? _defaultInstance;
+  static GetPrecompiledObjectResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectResponse>(create);
+  static GetPrecompiledObjectResponse? _defaultInstance;
 
   @$pb.TagNumber(1)
-  Sdk get sdk => $_getN(0);
+  PrecompiledObject get precompiledObject => $_getN(0);
   @$pb.TagNumber(1)
-  set sdk(Sdk v) { setField(1, v); }
+  set precompiledObject(PrecompiledObject v) { setField(1, v); }
   @$pb.TagNumber(1)
-  $core.bool hasSdk() => $_has(0);
+  $core.bool hasPrecompiledObject() => $_has(0);
   @$pb.TagNumber(1)
-  void clearSdk() => clearField(1);
-
-  @$pb.TagNumber(2)
-  $core.String get category => $_getSZ(1);
-  @$pb.TagNumber(2)
-  set category($core.String v) { $_setString(1, v); }
-  @$pb.TagNumber(2)
-  $core.bool hasCategory() => $_has(1);
-  @$pb.TagNumber(2)
-  void clearCategory() => clearField(2);
+  void clearPrecompiledObject() => clearField(1);
+  @$pb.TagNumber(1)
+  PrecompiledObject ensurePrecompiledObject() => $_ensure(0);
 }
 
-class GetPrecompiledObjectRequest extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'cloudPath')
+class GetPrecompiledObjectCodeResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectCodeResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'code')
+    ..pc<SnippetFile>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'files', $pb.PbFieldType.PM, subBuilder: SnippetFile.create)
     ..hasRequiredFields = false
   ;
 
-  GetPrecompiledObjectRequest._() : super();
-  factory GetPrecompiledObjectRequest({
-    $core.String? cloudPath,
+  GetPrecompiledObjectCodeResponse._() : super();
+  factory GetPrecompiledObjectCodeResponse({
+    $core.String? code,
+    $core.Iterable<SnippetFile>? files,
   }) {
     final _result = create();
-    if (cloudPath != null) {
-      _result.cloudPath = cloudPath;
+    if (code != null) {
+      _result.code = code;
+    }
+    if (files != null) {
+      _result.files.addAll(files);
     }
     return _result;
   }
-  factory GetPrecompiledObjectRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetPrecompiledObjectRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetPrecompiledObjectCodeResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetPrecompiledObjectCodeResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectRequest clone() => GetPrecompiledObjectRequest()..mergeFromMessage(this);
+  GetPrecompiledObjectCodeResponse clone() => GetPrecompiledObjectCodeResponse()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectRequest copyWith(void Function(GetPrecompiledObjectRequest) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectRequest)) as GetPrecompiledObjectRequest; // ignore: deprecated_member_use
+  GetPrecompiledObjectCodeResponse copyWith(void Function(GetPrecompiledObjectCodeResponse) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectCodeResponse)) as GetPrecompiledObjectCodeResponse; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectRequest create() => GetPrecompiledObjectRequest._();
-  GetPrecompiledObjectRequest createEmptyInstance() => create();
-  static $pb.PbList<GetPrecompiledObjectRequest> createRepeated() => $pb.PbList<GetPrecompiledObjectRequest>();
+  static GetPrecompiledObjectCodeResponse create() => GetPrecompiledObjectCodeResponse._();
+  GetPrecompiledObjectCodeResponse createEmptyInstance() => create();
+  static $pb.PbList<GetPrecompiledObjectCodeResponse> createRepeated() => $pb.PbList<GetPrecompiledObjectCodeResponse>();
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectRequest>(create);
-  static GetPrecompiledObjectRequest? _defaultInstance;
+  static GetPrecompiledObjectCodeResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectCodeResponse>(create);
+  static GetPrecompiledObjectCodeResponse? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get cloudPath => $_getSZ(0);
+  $core.String get code => $_getSZ(0);
   @$pb.TagNumber(1)
-  set cloudPath($core.String v) { $_setString(0, v); }
+  set code($core.String v) { $_setString(0, v); }
   @$pb.TagNumber(1)
-  $core.bool hasCloudPath() => $_has(0);
+  $core.bool hasCode() => $_has(0);
   @$pb.TagNumber(1)
-  void clearCloudPath() => clearField(1);
+  void clearCode() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.List<SnippetFile> get files => $_getList(1);
 }
 
-class GetPrecompiledObjectCodeRequest extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectCodeRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'cloudPath')
+class GetPrecompiledObjectOutputResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectOutputResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'output')
     ..hasRequiredFields = false
   ;
 
-  GetPrecompiledObjectCodeRequest._() : super();
-  factory GetPrecompiledObjectCodeRequest({
-    $core.String? cloudPath,
+  GetPrecompiledObjectOutputResponse._() : super();
+  factory GetPrecompiledObjectOutputResponse({
+    $core.String? output,
   }) {
     final _result = create();
-    if (cloudPath != null) {
-      _result.cloudPath = cloudPath;
+    if (output != null) {
+      _result.output = output;
     }
     return _result;
   }
-  factory GetPrecompiledObjectCodeRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetPrecompiledObjectCodeRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetPrecompiledObjectOutputResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetPrecompiledObjectOutputResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectCodeRequest clone() => GetPrecompiledObjectCodeRequest()..mergeFromMessage(this);
+  GetPrecompiledObjectOutputResponse clone() => GetPrecompiledObjectOutputResponse()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectCodeRequest copyWith(void Function(GetPrecompiledObjectCodeRequest) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectCodeRequest)) as GetPrecompiledObjectCodeRequest; // ignore: deprecated_member_use
+  GetPrecompiledObjectOutputResponse copyWith(void Function(GetPrecompiledObjectOutputResponse) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectOutputResponse)) as GetPrecompiledObjectOutputResponse; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectCodeRequest create() => GetPrecompiledObjectCodeRequest._();
-  GetPrecompiledObjectCodeRequest createEmptyInstance() => create();
-  static $pb.PbList<GetPrecompiledObjectCodeRequest> createRepeated() => $pb.PbList<GetPrecompiledObjectCodeRequest>();
+  static GetPrecompiledObjectOutputResponse create() => GetPrecompiledObjectOutputResponse._();
+  GetPrecompiledObjectOutputResponse createEmptyInstance() => create();
+  static $pb.PbList<GetPrecompiledObjectOutputResponse> createRepeated() => $pb.PbList<GetPrecompiledObjectOutputResponse>();
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectCodeRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectCodeRequest>(create);
-  static GetPrecompiledObjectCodeRequest? _defaultInstance;
+  static GetPrecompiledObjectOutputResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectOutputResponse>(create);
+  static GetPrecompiledObjectOutputResponse? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get cloudPath => $_getSZ(0);
+  $core.String get output => $_getSZ(0);
   @$pb.TagNumber(1)
-  set cloudPath($core.String v) { $_setString(0, v); }
+  set output($core.String v) { $_setString(0, v); }
   @$pb.TagNumber(1)
-  $core.bool hasCloudPath() => $_has(0);
+  $core.bool hasOutput() => $_has(0);
   @$pb.TagNumber(1)
-  void clearCloudPath() => clearField(1);
+  void clearOutput() => clearField(1);
 }
 
-class GetPrecompiledObjectOutputRequest extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectOutputRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'cloudPath')
+class GetPrecompiledObjectLogsResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectLogsResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'output')
     ..hasRequiredFields = false
   ;
 
-  GetPrecompiledObjectOutputRequest._() : super();
-  factory GetPrecompiledObjectOutputRequest({
-    $core.String? cloudPath,
+  GetPrecompiledObjectLogsResponse._() : super();
+  factory GetPrecompiledObjectLogsResponse({
+    $core.String? output,
   }) {
     final _result = create();
-    if (cloudPath != null) {
-      _result.cloudPath = cloudPath;
+    if (output != null) {
+      _result.output = output;
     }
     return _result;
   }
-  factory GetPrecompiledObjectOutputRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetPrecompiledObjectOutputRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetPrecompiledObjectLogsResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetPrecompiledObjectLogsResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectOutputRequest clone() => GetPrecompiledObjectOutputRequest()..mergeFromMessage(this);
+  GetPrecompiledObjectLogsResponse clone() => GetPrecompiledObjectLogsResponse()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectOutputRequest copyWith(void Function(GetPrecompiledObjectOutputRequest) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectOutputRequest)) as GetPrecompiledObjectOutputRequest; // ignore: deprecated_member_use
+  GetPrecompiledObjectLogsResponse copyWith(void Function(GetPrecompiledObjectLogsResponse) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectLogsResponse)) as GetPrecompiledObjectLogsResponse; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectOutputRequest create() => GetPrecompiledObjectOutputRequest._();
-  GetPrecompiledObjectOutputRequest createEmptyInstance() => create();
-  static $pb.PbList<GetPrecompiledObjectOutputRequest> createRepeated() => $pb.PbList<GetPrecompiledObjectOutputRequest>();
+  static GetPrecompiledObjectLogsResponse create() => GetPrecompiledObjectLogsResponse._();
+  GetPrecompiledObjectLogsResponse createEmptyInstance() => create();
+  static $pb.PbList<GetPrecompiledObjectLogsResponse> createRepeated() => $pb.PbList<GetPrecompiledObjectLogsResponse>();
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectOutputRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectOutputRequest>(create);
-  static GetPrecompiledObjectOutputRequest? _defaultInstance;
+  static GetPrecompiledObjectLogsResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectLogsResponse>(create);
+  static GetPrecompiledObjectLogsResponse? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get cloudPath => $_getSZ(0);
+  $core.String get output => $_getSZ(0);
   @$pb.TagNumber(1)
-  set cloudPath($core.String v) { $_setString(0, v); }
+  set output($core.String v) { $_setString(0, v); }
   @$pb.TagNumber(1)
-  $core.bool hasCloudPath() => $_has(0);
+  $core.bool hasOutput() => $_has(0);
   @$pb.TagNumber(1)
-  void clearCloudPath() => clearField(1);
+  void clearOutput() => clearField(1);
 }
 
-class GetPrecompiledObjectLogsRequest extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectLogsRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'cloudPath')
+class GetPrecompiledObjectGraphResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectGraphResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'graph')
     ..hasRequiredFields = false
   ;
 
-  GetPrecompiledObjectLogsRequest._() : super();
-  factory GetPrecompiledObjectLogsRequest({
-    $core.String? cloudPath,
+  GetPrecompiledObjectGraphResponse._() : super();
+  factory GetPrecompiledObjectGraphResponse({
+    $core.String? graph,
   }) {
     final _result = create();
-    if (cloudPath != null) {
-      _result.cloudPath = cloudPath;
+    if (graph != null) {
+      _result.graph = graph;
     }
     return _result;
   }
-  factory GetPrecompiledObjectLogsRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetPrecompiledObjectLogsRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetPrecompiledObjectGraphResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetPrecompiledObjectGraphResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectLogsRequest clone() => GetPrecompiledObjectLogsRequest()..mergeFromMessage(this);
+  GetPrecompiledObjectGraphResponse clone() => GetPrecompiledObjectGraphResponse()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectLogsRequest copyWith(void Function(GetPrecompiledObjectLogsRequest) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectLogsRequest)) as GetPrecompiledObjectLogsRequest; // ignore: deprecated_member_use
-  $pb.BuilderInfo get info_ => _i;
-  @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectLogsRequest create() => GetPrecompiledObjectLogsRequest._();
-  GetPrecompiledObjectLogsRequest createEmptyInstance() => create();
-  static $pb.PbList<GetPrecompiledObjectLogsRequest> createRepeated() => $pb.PbList<GetPrecompiledObjectLogsRequest>();
+  GetPrecompiledObjectGraphResponse copyWith(void Function(GetPrecompiledObjectGraphResponse) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectGraphResponse)) as GetPrecompiledObjectGraphResponse; // ignore: deprecated_member_use
+  $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectLogsRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectLogsRequest>(create);
-  static GetPrecompiledObjectLogsRequest? _defaultInstance;
+  static GetPrecompiledObjectGraphResponse create() => GetPrecompiledObjectGraphResponse._();
+  GetPrecompiledObjectGraphResponse createEmptyInstance() => create();
+  static $pb.PbList<GetPrecompiledObjectGraphResponse> createRepeated() => $pb.PbList<GetPrecompiledObjectGraphResponse>();
+  @$core.pragma('dart2js:noInline')
+  static GetPrecompiledObjectGraphResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectGraphResponse>(create);
+  static GetPrecompiledObjectGraphResponse? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get cloudPath => $_getSZ(0);
+  $core.String get graph => $_getSZ(0);
   @$pb.TagNumber(1)
-  set cloudPath($core.String v) { $_setString(0, v); }
+  set graph($core.String v) { $_setString(0, v); }
   @$pb.TagNumber(1)
-  $core.bool hasCloudPath() => $_has(0);
+  $core.bool hasGraph() => $_has(0);
   @$pb.TagNumber(1)
-  void clearCloudPath() => clearField(1);
+  void clearGraph() => clearField(1);
 }
 
-class GetPrecompiledObjectGraphRequest extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectGraphRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'cloudPath')
+class GetDefaultPrecompiledObjectResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetDefaultPrecompiledObjectResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOM<PrecompiledObject>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'precompiledObject', subBuilder: PrecompiledObject.create)
     ..hasRequiredFields = false
   ;
 
-  GetPrecompiledObjectGraphRequest._() : super();
-  factory GetPrecompiledObjectGraphRequest({
-    $core.String? cloudPath,
+  GetDefaultPrecompiledObjectResponse._() : super();
+  factory GetDefaultPrecompiledObjectResponse({
+    PrecompiledObject? precompiledObject,
   }) {
     final _result = create();
-    if (cloudPath != null) {
-      _result.cloudPath = cloudPath;
+    if (precompiledObject != null) {
+      _result.precompiledObject = precompiledObject;
     }
     return _result;
   }
-  factory GetPrecompiledObjectGraphRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetPrecompiledObjectGraphRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetDefaultPrecompiledObjectResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetDefaultPrecompiledObjectResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectGraphRequest clone() => GetPrecompiledObjectGraphRequest()..mergeFromMessage(this);
+  GetDefaultPrecompiledObjectResponse clone() => GetDefaultPrecompiledObjectResponse()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectGraphRequest copyWith(void Function(GetPrecompiledObjectGraphRequest) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectGraphRequest)) as GetPrecompiledObjectGraphRequest; // ignore: deprecated_member_use
+  GetDefaultPrecompiledObjectResponse copyWith(void Function(GetDefaultPrecompiledObjectResponse) updates) => super.copyWith((message) => updates(message as GetDefaultPrecompiledObjectResponse)) as GetDefaultPrecompiledObjectResponse; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectGraphRequest create() => GetPrecompiledObjectGraphRequest._();
-  GetPrecompiledObjectGraphRequest createEmptyInstance() => create();
-  static $pb.PbList<GetPrecompiledObjectGraphRequest> createRepeated() => $pb.PbList<GetPrecompiledObjectGraphRequest>();
+  static GetDefaultPrecompiledObjectResponse create() => GetDefaultPrecompiledObjectResponse._();
+  GetDefaultPrecompiledObjectResponse createEmptyInstance() => create();
+  static $pb.PbList<GetDefaultPrecompiledObjectResponse> createRepeated() => $pb.PbList<GetDefaultPrecompiledObjectResponse>();
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectGraphRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectGraphRequest>(create);
-  static GetPrecompiledObjectGraphRequest? _defaultInstance;
+  static GetDefaultPrecompiledObjectResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetDefaultPrecompiledObjectResponse>(create);
+  static GetDefaultPrecompiledObjectResponse? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get cloudPath => $_getSZ(0);
+  PrecompiledObject get precompiledObject => $_getN(0);
   @$pb.TagNumber(1)
-  set cloudPath($core.String v) { $_setString(0, v); }
+  set precompiledObject(PrecompiledObject v) { setField(1, v); }
   @$pb.TagNumber(1)
-  $core.bool hasCloudPath() => $_has(0);
+  $core.bool hasPrecompiledObject() => $_has(0);
   @$pb.TagNumber(1)
-  void clearCloudPath() => clearField(1);
+  void clearPrecompiledObject() => clearField(1);
+  @$pb.TagNumber(1)
+  PrecompiledObject ensurePrecompiledObject() => $_ensure(0);
 }
 
-class GetDefaultPrecompiledObjectRequest extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetDefaultPrecompiledObjectRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..e<Sdk>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdk', $pb.PbFieldType.OE, defaultOrMaker: Sdk.SDK_UNSPECIFIED, valueOf: Sdk.valueOf, enumValues: Sdk.values)
+class SnippetFile extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'SnippetFile', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'name')
+    ..aOS(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'content')
+    ..aOB(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'isMain')
     ..hasRequiredFields = false
   ;
 
-  GetDefaultPrecompiledObjectRequest._() : super();
-  factory GetDefaultPrecompiledObjectRequest({
-    Sdk? sdk,
+  SnippetFile._() : super();
+  factory SnippetFile({
+    $core.String? name,
+    $core.String? content,
+    $core.bool? isMain,
   }) {
     final _result = create();
-    if (sdk != null) {
-      _result.sdk = sdk;
+    if (name != null) {
+      _result.name = name;
+    }
+    if (content != null) {
+      _result.content = content;
+    }
+    if (isMain != null) {
+      _result.isMain = isMain;
     }
     return _result;
   }
-  factory GetDefaultPrecompiledObjectRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetDefaultPrecompiledObjectRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory SnippetFile.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory SnippetFile.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetDefaultPrecompiledObjectRequest clone() => GetDefaultPrecompiledObjectRequest()..mergeFromMessage(this);
+  SnippetFile clone() => SnippetFile()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetDefaultPrecompiledObjectRequest copyWith(void Function(GetDefaultPrecompiledObjectRequest) updates) => super.copyWith((message) => updates(message as GetDefaultPrecompiledObjectRequest)) as GetDefaultPrecompiledObjectRequest; // ignore: deprecated_member_use
+  SnippetFile copyWith(void Function(SnippetFile) updates) => super.copyWith((message) => updates(message as SnippetFile)) as SnippetFile; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetDefaultPrecompiledObjectRequest create() => GetDefaultPrecompiledObjectRequest._();
-  GetDefaultPrecompiledObjectRequest createEmptyInstance() => create();
-  static $pb.PbList<GetDefaultPrecompiledObjectRequest> createRepeated() => $pb.PbList<GetDefaultPrecompiledObjectRequest>();
+  static SnippetFile create() => SnippetFile._();
+  SnippetFile createEmptyInstance() => create();
+  static $pb.PbList<SnippetFile> createRepeated() => $pb.PbList<SnippetFile>();
   @$core.pragma('dart2js:noInline')
-  static GetDefaultPrecompiledObjectRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetDefaultPrecompiledObjectRequest>(create);
-  static GetDefaultPrecompiledObjectRequest? _defaultInstance;
+  static SnippetFile getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<SnippetFile>(create);
+  static SnippetFile? _defaultInstance;
 
   @$pb.TagNumber(1)
-  Sdk get sdk => $_getN(0);
+  $core.String get name => $_getSZ(0);
   @$pb.TagNumber(1)
-  set sdk(Sdk v) { setField(1, v); }
+  set name($core.String v) { $_setString(0, v); }
   @$pb.TagNumber(1)
-  $core.bool hasSdk() => $_has(0);
+  $core.bool hasName() => $_has(0);
   @$pb.TagNumber(1)
-  void clearSdk() => clearField(1);
+  void clearName() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get content => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set content($core.String v) { $_setString(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasContent() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearContent() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.bool get isMain => $_getBF(2);
+  @$pb.TagNumber(3)
+  set isMain($core.bool v) { $_setBool(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasIsMain() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearIsMain() => clearField(3);
 }
 
-class GetPrecompiledObjectsResponse extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectsResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..pc<Categories>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdkCategories', $pb.PbFieldType.PM, subBuilder: Categories.create)
+class SaveSnippetRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'SaveSnippetRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..pc<SnippetFile>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'files', $pb.PbFieldType.PM, subBuilder: SnippetFile.create)
+    ..e<Sdk>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdk', $pb.PbFieldType.OE, defaultOrMaker: Sdk.SDK_UNSPECIFIED, valueOf: Sdk.valueOf, enumValues: Sdk.values)
+    ..aOS(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineOptions')
+    ..e<Complexity>(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'complexity', $pb.PbFieldType.OE, defaultOrMaker: Complexity.COMPLEXITY_UNSPECIFIED, valueOf: Complexity.valueOf, enumValues: Complexity.values)
+    ..aOS(5, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'persistenceKey')
+    ..aOS(6, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'parentId')
+    ..aOS(7, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'authorToken')
     ..hasRequiredFields = false
   ;
 
-  GetPrecompiledObjectsResponse._() : super();
-  factory GetPrecompiledObjectsResponse({
-    $core.Iterable<Categories>? sdkCategories,
+  SaveSnippetRequest._() : super();
+  factory SaveSnippetRequest({
+    $core.Iterable<SnippetFile>? files,
+    Sdk? sdk,
+    $core.String? pipelineOptions,
+    Complexity? complexity,
+    $core.String? persistenceKey,
+    $core.String? parentId,
+    $core.String? authorToken,
   }) {
     final _result = create();
-    if (sdkCategories != null) {
-      _result.sdkCategories.addAll(sdkCategories);
+    if (files != null) {
+      _result.files.addAll(files);
+    }
+    if (sdk != null) {
+      _result.sdk = sdk;
+    }
+    if (pipelineOptions != null) {
+      _result.pipelineOptions = pipelineOptions;
+    }
+    if (complexity != null) {
+      _result.complexity = complexity;
+    }
+    if (persistenceKey != null) {
+      _result.persistenceKey = persistenceKey;
+    }
+    if (parentId != null) {
+      _result.parentId = parentId;
+    }
+    if (authorToken != null) {
+      _result.authorToken = authorToken;
     }
     return _result;
   }
-  factory GetPrecompiledObjectsResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetPrecompiledObjectsResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory SaveSnippetRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory SaveSnippetRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectsResponse clone() => GetPrecompiledObjectsResponse()..mergeFromMessage(this);
+  SaveSnippetRequest clone() => SaveSnippetRequest()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectsResponse copyWith(void Function(GetPrecompiledObjectsResponse) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectsResponse)) as GetPrecompiledObjectsResponse; // ignore: deprecated_member_use
+  SaveSnippetRequest copyWith(void Function(SaveSnippetRequest) updates) => super.copyWith((message) => updates(message as SaveSnippetRequest)) as SaveSnippetRequest; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectsResponse create() => GetPrecompiledObjectsResponse._();
-  GetPrecompiledObjectsResponse createEmptyInstance() => create();
-  static $pb.PbList<GetPrecompiledObjectsResponse> createRepeated() => $pb.PbList<GetPrecompiledObjectsResponse>();
+  static SaveSnippetRequest create() => SaveSnippetRequest._();
+  SaveSnippetRequest createEmptyInstance() => create();
+  static $pb.PbList<SaveSnippetRequest> createRepeated() => $pb.PbList<SaveSnippetRequest>();
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectsResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectsResponse>(create);
-  static GetPrecompiledObjectsResponse? _defaultInstance;
+  static SaveSnippetRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<SaveSnippetRequest>(create);
+  static SaveSnippetRequest? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.List<Categories> get sdkCategories => $_getList(0);
+  $core.List<SnippetFile> get files => $_getList(0);
+
+  @$pb.TagNumber(2)
+  Sdk get sdk => $_getN(1);
+  @$pb.TagNumber(2)
+  set sdk(Sdk v) { setField(2, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasSdk() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearSdk() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get pipelineOptions => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set pipelineOptions($core.String v) { $_setString(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasPipelineOptions() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearPipelineOptions() => clearField(3);
+
+  @$pb.TagNumber(4)
+  Complexity get complexity => $_getN(3);
+  @$pb.TagNumber(4)
+  set complexity(Complexity v) { setField(4, v); }
+  @$pb.TagNumber(4)
+  $core.bool hasComplexity() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearComplexity() => clearField(4);
+
+  @$pb.TagNumber(5)
+  $core.String get persistenceKey => $_getSZ(4);
+  @$pb.TagNumber(5)
+  set persistenceKey($core.String v) { $_setString(4, v); }
+  @$pb.TagNumber(5)
+  $core.bool hasPersistenceKey() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearPersistenceKey() => clearField(5);
+
+  @$pb.TagNumber(6)
+  $core.String get parentId => $_getSZ(5);
+  @$pb.TagNumber(6)
+  set parentId($core.String v) { $_setString(5, v); }
+  @$pb.TagNumber(6)
+  $core.bool hasParentId() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearParentId() => clearField(6);
+
+  @$pb.TagNumber(7)
+  $core.String get authorToken => $_getSZ(6);
+  @$pb.TagNumber(7)
+  set authorToken($core.String v) { $_setString(6, v); }
+  @$pb.TagNumber(7)
+  $core.bool hasAuthorToken() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearAuthorToken() => clearField(7);
 }
 
-class GetPrecompiledObjectResponse extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOM<PrecompiledObject>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'precompiledObject', subBuilder: PrecompiledObject.create)
+class SaveSnippetResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'SaveSnippetResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'id')
     ..hasRequiredFields = false
   ;
 
-  GetPrecompiledObjectResponse._() : super();
-  factory GetPrecompiledObjectResponse({
-    PrecompiledObject? precompiledObject,
+  SaveSnippetResponse._() : super();
+  factory SaveSnippetResponse({
+    $core.String? id,
   }) {
     final _result = create();
-    if (precompiledObject != null) {
-      _result.precompiledObject = precompiledObject;
+    if (id != null) {
+      _result.id = id;
     }
     return _result;
   }
-  factory GetPrecompiledObjectResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetPrecompiledObjectResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory SaveSnippetResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory SaveSnippetResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectResponse clone() => GetPrecompiledObjectResponse()..mergeFromMessage(this);
+  SaveSnippetResponse clone() => SaveSnippetResponse()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectResponse copyWith(void Function(GetPrecompiledObjectResponse) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectResponse)) as GetPrecompiledObjectResponse; // ignore: deprecated_member_use
+  SaveSnippetResponse copyWith(void Function(SaveSnippetResponse) updates) => super.copyWith((message) => updates(message as SaveSnippetResponse)) as SaveSnippetResponse; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectResponse create() => GetPrecompiledObjectResponse._();
-  GetPrecompiledObjectResponse createEmptyInstance() => create();
-  static $pb.PbList<GetPrecompiledObjectResponse> createRepeated() => $pb.PbList<GetPrecompiledObjectResponse>();
+  static SaveSnippetResponse create() => SaveSnippetResponse._();
+  SaveSnippetResponse createEmptyInstance() => create();
+  static $pb.PbList<SaveSnippetResponse> createRepeated() => $pb.PbList<SaveSnippetResponse>();
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectResponse>(create);
-  static GetPrecompiledObjectResponse? _defaultInstance;
+  static SaveSnippetResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<SaveSnippetResponse>(create);
+  static SaveSnippetResponse? _defaultInstance;
 
   @$pb.TagNumber(1)
-  PrecompiledObject get precompiledObject => $_getN(0);
-  @$pb.TagNumber(1)
-  set precompiledObject(PrecompiledObject v) { setField(1, v); }
+  $core.String get id => $_getSZ(0);
   @$pb.TagNumber(1)
-  $core.bool hasPrecompiledObject() => $_has(0);
+  set id($core.String v) { $_setString(0, v); }
   @$pb.TagNumber(1)
-  void clearPrecompiledObject() => clearField(1);
+  $core.bool hasId() => $_has(0);
   @$pb.TagNumber(1)
-  PrecompiledObject ensurePrecompiledObject() => $_ensure(0);
+  void clearId() => clearField(1);
 }
 
-class GetPrecompiledObjectCodeResponse extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectCodeResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'code')
-    ..pc<SnippetFile>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'files', $pb.PbFieldType.PM, subBuilder: SnippetFile.create)
+class GetSnippetRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetSnippetRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'id')
     ..hasRequiredFields = false
   ;
 
-  GetPrecompiledObjectCodeResponse._() : super();
-  factory GetPrecompiledObjectCodeResponse({
-    $core.String? code,
-    $core.Iterable<SnippetFile>? files,
+  GetSnippetRequest._() : super();
+  factory GetSnippetRequest({
+    $core.String? id,
   }) {
     final _result = create();
-    if (code != null) {
-      _result.code = code;
-    }
-    if (files != null) {
-      _result.files.addAll(files);
+    if (id != null) {
+      _result.id = id;
     }
     return _result;
   }
-  factory GetPrecompiledObjectCodeResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetPrecompiledObjectCodeResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetSnippetRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetSnippetRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectCodeResponse clone() => GetPrecompiledObjectCodeResponse()..mergeFromMessage(this);
+  GetSnippetRequest clone() => GetSnippetRequest()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectCodeResponse copyWith(void Function(GetPrecompiledObjectCodeResponse) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectCodeResponse)) as GetPrecompiledObjectCodeResponse; // ignore: deprecated_member_use
+  GetSnippetRequest copyWith(void Function(GetSnippetRequest) updates) => super.copyWith((message) => updates(message as GetSnippetRequest)) as GetSnippetRequest; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectCodeResponse create() => GetPrecompiledObjectCodeResponse._();
-  GetPrecompiledObjectCodeResponse createEmptyInstance() => create();
-  static $pb.PbList<GetPrecompiledObjectCodeResponse> createRepeated() => $pb.PbList<GetPrecompiledObjectCodeResponse>();
+  static GetSnippetRequest create() => GetSnippetRequest._();
+  GetSnippetRequest createEmptyInstance() => create();
+  static $pb.PbList<GetSnippetRequest> createRepeated() => $pb.PbList<GetSnippetRequest>();
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectCodeResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectCodeResponse>(create);
-  static GetPrecompiledObjectCodeResponse? _defaultInstance;
+  static GetSnippetRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetSnippetRequest>(create);
+  static GetSnippetRequest? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get code => $_getSZ(0);
+  $core.String get id => $_getSZ(0);
   @$pb.TagNumber(1)
-  set code($core.String v) { $_setString(0, v); }
+  set id($core.String v) { $_setString(0, v); }
   @$pb.TagNumber(1)
-  $core.bool hasCode() => $_has(0);
+  $core.bool hasId() => $_has(0);
   @$pb.TagNumber(1)
-  void clearCode() => clearField(1);
-
-  @$pb.TagNumber(2)
-  $core.List<SnippetFile> get files => $_getList(1);
+  void clearId() => clearField(1);
 }
 
-class GetPrecompiledObjectOutputResponse extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectOutputResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'output')
+class GetSnippetResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetSnippetResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..pc<SnippetFile>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'files', $pb.PbFieldType.PM, subBuilder: SnippetFile.create)
+    ..e<Sdk>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdk', $pb.PbFieldType.OE, defaultOrMaker: Sdk.SDK_UNSPECIFIED, valueOf: Sdk.valueOf, enumValues: Sdk.values)
+    ..aOS(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineOptions')
+    ..e<Complexity>(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'complexity', $pb.PbFieldType.OE, defaultOrMaker: Complexity.COMPLEXITY_UNSPECIFIED, valueOf: Complexity.valueOf, enumValues: Complexity.values)
+    ..aOS(5, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'parentId')
+    ..a<$core.int>(6, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'revision', $pb.PbFieldType.O3)
     ..hasRequiredFields = false
   ;
 
-  GetPrecompiledObjectOutputResponse._() : super();
-  factory GetPrecompiledObjectOutputResponse({
-    $core.String? output,
+  GetSnippetResponse._() : super();
+  factory GetSnippetResponse({
+    $core.Iterable<SnippetFile>? files,
+    Sdk? sdk,
+    $core.String? pipelineOptions,
+    Complexity? complexity,
+    $core.String? parentId,
+    $core.int? revision,
   }) {
     final _result = create();
-    if (output != null) {
-      _result.output = output;
+    if (files != null) {
+      _result.files.addAll(files);
+    }
+    if (sdk != null) {
+      _result.sdk = sdk;
+    }
+    if (pipelineOptions != null) {
+      _result.pipelineOptions = pipelineOptions;
+    }
+    if (complexity != null) {
+      _result.complexity = complexity;
+    }
+    if (parentId != null) {
+      _result.parentId = parentId;
+    }
+    if (revision != null) {
+      _result.revision = revision;
     }
     return _result;
   }
-  factory GetPrecompiledObjectOutputResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetPrecompiledObjectOutputResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetSnippetResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetSnippetResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectOutputResponse clone() => GetPrecompiledObjectOutputResponse()..mergeFromMessage(this);
+  GetSnippetResponse clone() => GetSnippetResponse()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectOutputResponse copyWith(void Function(GetPrecompiledObjectOutputResponse) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectOutputResponse)) as GetPrecompiledObjectOutputResponse; // ignore: deprecated_member_use
+  GetSnippetResponse copyWith(void Function(GetSnippetResponse) updates) => super.copyWith((message) => updates(message as GetSnippetResponse)) as GetSnippetResponse; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectOutputResponse create() => GetPrecompiledObjectOutputResponse._();
-  GetPrecompiledObjectOutputResponse createEmptyInstance() => create();
-  static $pb.PbList<GetPrecompiledObjectOutputResponse> createRepeated() => $pb.PbList<GetPrecompiledObjectOutputResponse>();
+  static GetSnippetResponse create() => GetSnippetResponse._();
+  GetSnippetResponse createEmptyInstance() => create();
+  static $pb.PbList<GetSnippetResponse> createRepeated() => $pb.PbList<GetSnippetResponse>();
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectOutputResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectOutputResponse>(create);
-  static GetPrecompiledObjectOutputResponse? _defaultInstance;
+  static GetSnippetResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetSnippetResponse>(create);
+  static GetSnippetResponse? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get output => $_getSZ(0);
-  @$pb.TagNumber(1)
-  set output($core.String v) { $_setString(0, v); }
-  @$pb.TagNumber(1)
-  $core.bool hasOutput() => $_has(0);
-  @$pb.TagNumber(1)
-  void clearOutput() => clearField(1);
+  $core.List<SnippetFile> get files => $_getList(0);
+
+  @$pb.TagNumber(2)
+  Sdk get sdk => $_getN(1);
+  @$pb.TagNumber(2)
+  set sdk(Sdk v) { setField(2, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasSdk() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearSdk() => clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get pipelineOptions => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set pipelineOptions($core.String v) { $_setString(2, v); }
+  @$pb.TagNumber(3)
+  $core.bool hasPipelineOptions() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearPipelineOptions() => clearField(3);
+
+  @$pb.TagNumber(4)
+  Complexity get complexity => $_getN(3);
+  @$pb.TagNumber(4)
+  set complexity(Complexity v) { setField(4, v); }
+  @$pb.TagNumber(4)
+  $core.bool hasComplexity() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearComplexity() => clearField(4);
+
+  @$pb.TagNumber(5)
+  $core.String get parentId => $_getSZ(4);
+  @$pb.TagNumber(5)
+  set parentId($core.String v) { $_setString(4, v); }
+  @$pb.TagNumber(5)
+  $core.bool hasParentId() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearParentId() => clearField(5);
+
+  @$pb.TagNumber(6)
+  $core.int get revision => $_getIZ(5);
+  @$pb.TagNumber(6)
+  set revision($core.int v) { $_setSignedInt32(5, v); }
+  @$pb.TagNumber(6)
+  $core.bool hasRevision() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearRevision() => clearField(6);
 }
 
-class GetPrecompiledObjectLogsResponse extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectLogsResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'output')
+class ForkSnippetRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'ForkSnippetRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'id')
+    ..aOS(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'authorToken')
     ..hasRequiredFields = false
   ;
 
-  GetPrecompiledObjectLogsResponse._() : super();
-  factory GetPrecompiledObjectLogsResponse({
-    $core.String? output,
+  ForkSnippetRequest._() : super();
+  factory ForkSnippetRequest({
+    $core.String? id,
+    $core.String? authorToken,
   }) {
     final _result = create();
-    if (output != null) {
-      _result.output = output;
+    if (id != null) {
+      _result.id = id;
+    }
+    if (authorToken != null) {
+      _result.authorToken = authorToken;
     }
     return _result;
   }
-  factory GetPrecompiledObjectLogsResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetPrecompiledObjectLogsResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory ForkSnippetRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory ForkSnippetRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectLogsResponse clone() => GetPrecompiledObjectLogsResponse()..mergeFromMessage(this);
+  ForkSnippetRequest clone() => ForkSnippetRequest()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectLogsResponse copyWith(void Function(GetPrecompiledObjectLogsResponse) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectLogsResponse)) as GetPrecompiledObjectLogsResponse; // ignore: deprecated_member_use
+  ForkSnippetRequest copyWith(void Function(ForkSnippetRequest) updates) => super.copyWith((message) => updates(message as ForkSnippetRequest)) as ForkSnippetRequest; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectLogsResponse create() => GetPrecompiledObjectLogsResponse._();
-  GetPrecompiledObjectLogsResponse createEmptyInstance() => create();
-  static $pb.PbList<GetPrecompiledObjectLogsResponse> createRepeated() => $pb.PbList<GetPrecompiledObjectLogsResponse>();
+  static ForkSnippetRequest create() => ForkSnippetRequest._();
+  ForkSnippetRequest createEmptyInstance() => create();
+  static $pb.PbList<ForkSnippetRequest> createRepeated() => $pb.PbList<ForkSnippetRequest>();
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectLogsResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectLogsResponse>(create);
-  static GetPrecompiledObjectLogsResponse? _defaultInstance;
+  static ForkSnippetRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ForkSnippetRequest>(create);
+  static ForkSnippetRequest? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get output => $_getSZ(0);
+  $core.String get id => $_getSZ(0);
   @$pb.TagNumber(1)
-  set output($core.String v) { $_setString(0, v); }
+  set id($core.String v) { $_setString(0, v); }
   @$pb.TagNumber(1)
-  $core.bool hasOutput() => $_has(0);
+  $core.bool hasId() => $_has(0);
   @$pb.TagNumber(1)
-  void clearOutput() => clearField(1);
+  void clearId() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get authorToken => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set authorToken($core.String v) { $_setString(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasAuthorToken() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearAuthorToken() => clearField(2);
 }
 
-class GetPrecompiledObjectGraphResponse extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetPrecompiledObjectGraphResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'graph')
+class ForkSnippetResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'ForkSnippetResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'id')
     ..hasRequiredFields = false
   ;
 
-  GetPrecompiledObjectGraphResponse._() : super();
-  factory GetPrecompiledObjectGraphResponse({
-    $core.String? graph,
+  ForkSnippetResponse._() : super();
+  factory ForkSnippetResponse({
+    $core.String? id,
   }) {
     final _result = create();
-    if (graph != null) {
-      _result.graph = graph;
+    if (id != null) {
+      _result.id = id;
     }
     return _result;
   }
-  factory GetPrecompiledObjectGraphResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetPrecompiledObjectGraphResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory ForkSnippetResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory ForkSnippetResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectGraphResponse clone() => GetPrecompiledObjectGraphResponse()..mergeFromMessage(this);
+  ForkSnippetResponse clone() => ForkSnippetResponse()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetPrecompiledObjectGraphResponse copyWith(void Function(GetPrecompiledObjectGraphResponse) updates) => super.copyWith((message) => updates(message as GetPrecompiledObjectGraphResponse)) as GetPrecompiledObjectGraphResponse; // ignore: deprecated_member_use
+  ForkSnippetResponse copyWith(void Function(ForkSnippetResponse) updates) => super.copyWith((message) => updates(message as ForkSnippetResponse)) as ForkSnippetResponse; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectGraphResponse create() => GetPrecompiledObjectGraphResponse._();
-  GetPrecompiledObjectGraphResponse createEmptyInstance() => create();
-  static $pb.PbList<GetPrecompiledObjectGraphResponse> createRepeated() => $pb.PbList<GetPrecompiledObjectGraphResponse>();
+  static ForkSnippetResponse create() => ForkSnippetResponse._();
+  ForkSnippetResponse createEmptyInstance() => create();
+  static $pb.PbList<ForkSnippetResponse> createRepeated() => $pb.PbList<ForkSnippetResponse>();
   @$core.pragma('dart2js:noInline')
-  static GetPrecompiledObjectGraphResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetPrecompiledObjectGraphResponse>(create);
-  static GetPrecompiledObjectGraphResponse? _defaultInstance;
+  static ForkSnippetResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ForkSnippetResponse>(create);
+  static ForkSnippetResponse? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get graph => $_getSZ(0);
+  $core.String get id => $_getSZ(0);
   @$pb.TagNumber(1)
-  set graph($core.String v) { $_setString(0, v); }
+  set id($core.String v) { $_setString(0, v); }
   @$pb.TagNumber(1)
-  $core.bool hasGraph() => $_has(0);
+  $core.bool hasId() => $_has(0);
   @$pb.TagNumber(1)
-  void clearGraph() => clearField(1);
+  void clearId() => clearField(1);
 }
 
-class GetDefaultPrecompiledObjectResponse extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetDefaultPrecompiledObjectResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOM<PrecompiledObject>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'precompiledObject', subBuilder: PrecompiledObject.create)
+class ListSnippetRevisionsRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'ListSnippetRevisionsRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'id')
     ..hasRequiredFields = false
   ;
 
-  GetDefaultPrecompiledObjectResponse._() : super();
-  factory GetDefaultPrecompiledObjectResponse({
-    PrecompiledObject? precompiledObject,
+  ListSnippetRevisionsRequest._() : super();
+  factory ListSnippetRevisionsRequest({
+    $core.String? id,
   }) {
     final _result = create();
-    if (precompiledObject != null) {
-      _result.precompiledObject = precompiledObject;
+    if (id != null) {
+      _result.id = id;
     }
     return _result;
   }
-  factory GetDefaultPrecompiledObjectResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetDefaultPrecompiledObjectResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory ListSnippetRevisionsRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory ListSnippetRevisionsRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetDefaultPrecompiledObjectResponse clone() => GetDefaultPrecompiledObjectResponse()..mergeFromMessage(this);
+  ListSnippetRevisionsRequest clone() => ListSnippetRevisionsRequest()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetDefaultPrecompiledObjectResponse copyWith(void Function(GetDefaultPrecompiledObjectResponse) updates) => super.copyWith((message) => updates(message as GetDefaultPrecompiledObjectResponse)) as GetDefaultPrecompiledObjectResponse; // ignore: deprecated_member_use
+  ListSnippetRevisionsRequest copyWith(void Function(ListSnippetRevisionsRequest) updates) => super.copyWith((message) => updates(message as ListSnippetRevisionsRequest)) as ListSnippetRevisionsRequest; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetDefaultPrecompiledObjectResponse create() => GetDefaultPrecompiledObjectResponse._();
-  GetDefaultPrecompiledObjectResponse createEmptyInstance() => create();
-  static $pb.PbList<GetDefaultPrecompiledObjectResponse> createRepeated() => $pb.PbList<GetDefaultPrecompiledObjectResponse>();
+  static ListSnippetRevisionsRequest create() => ListSnippetRevisionsRequest._();
+  ListSnippetRevisionsRequest createEmptyInstance() => create();
+  static $pb.PbList<ListSnippetRevisionsRequest> createRepeated() => $pb.PbList<ListSnippetRevisionsRequest>();
   @$core.pragma('dart2js:noInline')
-  static GetDefaultPrecompiledObjectResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetDefaultPrecompiledObjectResponse>(create);
-  static GetDefaultPrecompiledObjectResponse? _defaultInstance;
+  static ListSnippetRevisionsRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ListSnippetRevisionsRequest>(create);
+  static ListSnippetRevisionsRequest? _defaultInstance;
 
   @$pb.TagNumber(1)
-  PrecompiledObject get precompiledObject => $_getN(0);
-  @$pb.TagNumber(1)
-  set precompiledObject(PrecompiledObject v) { setField(1, v); }
+  $core.String get id => $_getSZ(0);
   @$pb.TagNumber(1)
-  $core.bool hasPrecompiledObject() => $_has(0);
+  set id($core.String v) { $_setString(0, v); }
   @$pb.TagNumber(1)
-  void clearPrecompiledObject() => clearField(1);
+  $core.bool hasId() => $_has(0);
   @$pb.TagNumber(1)
-  PrecompiledObject ensurePrecompiledObject() => $_ensure(0);
+  void clearId() => clearField(1);
 }
 
-class SnippetFile extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'SnippetFile', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'name')
-    ..aOS(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'content')
-    ..aOB(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'isMain')
+class SnippetRevision extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'SnippetRevision', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'id')
+    ..aOS(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'parentId')
+    ..a<$core.int>(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'revision', $pb.PbFieldType.O3)
+    ..aInt64(5, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'created')
+    ..aOS(6, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'authorHash')
     ..hasRequiredFields = false
   ;
 
-  SnippetFile._() : super();
-  factory SnippetFile({
-    $core.String? name,
-    $core.String? content,
-    $core.bool? isMain,
+  SnippetRevision._() : super();
+  factory SnippetRevision({
+    $core.String? id,
+    $core.String? parentId,
+    $core.int? revision,
+    $fixnum.Int64? created,
+    $core.String? authorHash,
   }) {
     final _result = create();
-    if (name != null) {
-      _result.name = name;
+    if (id != null) {
+      _result.id = id;
     }
-    if (content != null) {
-      _result.content = content;
+    if (parentId != null) {
+      _result.parentId = parentId;
     }
-    if (isMain != null) {
-      _result.isMain = isMain;
+    if (revision != null) {
+      _result.revision = revision;
+    }
+    if (created != null) {
+      _result.created = created;
+    }
+    if (authorHash != null) {
+      _result.authorHash = authorHash;
     }
     return _result;
   }
-  factory SnippetFile.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory SnippetFile.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory SnippetRevision.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory SnippetRevision.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  SnippetFile clone() => SnippetFile()..mergeFromMessage(this);
+  SnippetRevision clone() => SnippetRevision()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  SnippetFile copyWith(void Function(SnippetFile) updates) => super.copyWith((message) => updates(message as SnippetFile)) as SnippetFile; // ignore: deprecated_member_use
+  SnippetRevision copyWith(void Function(SnippetRevision) updates) => super.copyWith((message) => updates(message as SnippetRevision)) as SnippetRevision; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static SnippetFile create() => SnippetFile._();
-  SnippetFile createEmptyInstance() => create();
-  static $pb.PbList<SnippetFile> createRepeated() => $pb.PbList<SnippetFile>();
+  static SnippetRevision create() => SnippetRevision._();
+  SnippetRevision createEmptyInstance() => create();
+  static $pb.PbList<SnippetRevision> createRepeated() => $pb.PbList<SnippetRevision>();
   @$core.pragma('dart2js:noInline')
-  static SnippetFile getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<SnippetFile>(create);
-  static SnippetFile? _defaultInstance;
+  static SnippetRevision getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<SnippetRevision>(create);
+  static SnippetRevision? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get name => $_getSZ(0);
+  $core.String get id => $_getSZ(0);
   @$pb.TagNumber(1)
-  set name($core.String v) { $_setString(0, v); }
+  set id($core.String v) { $_setString(0, v); }
   @$pb.TagNumber(1)
-  $core.bool hasName() => $_has(0);
+  $core.bool hasId() => $_has(0);
   @$pb.TagNumber(1)
-  void clearName() => clearField(1);
+  void clearId() => clearField(1);
 
   @$pb.TagNumber(2)
-  $core.String get content => $_getSZ(1);
+  $core.String get parentId => $_getSZ(1);
   @$pb.TagNumber(2)
-  set content($core.String v) { $_setString(1, v); }
+  set parentId($core.String v) { $_setString(1, v); }
   @$pb.TagNumber(2)
-  $core.bool hasContent() => $_has(1);
+  $core.bool hasParentId() => $_has(1);
   @$pb.TagNumber(2)
-  void clearContent() => clearField(2);
+  void clearParentId() => clearField(2);
 
   @$pb.TagNumber(3)
-  $core.bool get isMain => $_getBF(2);
+  $core.int get revision => $_getIZ(2);
   @$pb.TagNumber(3)
-  set isMain($core.bool v) { $_setBool(2, v); }
+  set revision($core.int v) { $_setSignedInt32(2, v); }
   @$pb.TagNumber(3)
-  $core.bool hasIsMain() => $_has(2);
+  $core.bool hasRevision() => $_has(2);
   @$pb.TagNumber(3)
-  void clearIsMain() => clearField(3);
+  void clearRevision() => clearField(3);
+
+  @$pb.TagNumber(5)
+  $fixnum.Int64 get created => $_getI64(3);
+  @$pb.TagNumber(5)
+  set created($fixnum.Int64 v) { $_setInt64(3, v); }
+  @$pb.TagNumber(5)
+  $core.bool hasCreated() => $_has(3);
+  @$pb.TagNumber(5)
+  void clearCreated() => clearField(5);
+
+  @$pb.TagNumber(6)
+  $core.String get authorHash => $_getSZ(4);
+  @$pb.TagNumber(6)
+  set authorHash($core.String v) { $_setString(4, v); }
+  @$pb.TagNumber(6)
+  $core.bool hasAuthorHash() => $_has(4);
+  @$pb.TagNumber(6)
+  void clearAuthorHash() => clearField(6);
 }
 
-class SaveSnippetRequest extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'SaveSnippetRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..pc<SnippetFile>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'files', $pb.PbFieldType.PM, subBuilder: SnippetFile.create)
-    ..e<Sdk>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdk', $pb.PbFieldType.OE, defaultOrMaker: Sdk.SDK_UNSPECIFIED, valueOf: Sdk.valueOf, enumValues: Sdk.values)
-    ..aOS(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineOptions')
-    ..e<Complexity>(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'complexity', $pb.PbFieldType.OE, defaultOrMaker: Complexity.COMPLEXITY_UNSPECIFIED, valueOf: Complexity.valueOf, enumValues: Complexity.values)
-    ..aOS(5, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'persistenceKey')
+class ListSnippetRevisionsResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'ListSnippetRevisionsResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..pc<SnippetRevision>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'revisions', $pb.PbFieldType.PM, subBuilder: SnippetRevision.create)
+    ..pc<SnippetRevision>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'descendants', $pb.PbFieldType.PM, subBuilder: SnippetRevision.create)
     ..hasRequiredFields = false
   ;
 
-  SaveSnippetRequest._() : super();
-  factory SaveSnippetRequest({
-    $core.Iterable<SnippetFile>? files,
-    Sdk? sdk,
-    $core.String? pipelineOptions,
-    Complexity? complexity,
-    $core.String? persistenceKey,
+  ListSnippetRevisionsResponse._() : super();
+  factory ListSnippetRevisionsResponse({
+    $core.Iterable<SnippetRevision>? revisions,
+    $core.Iterable<SnippetRevision>? descendants,
   }) {
     final _result = create();
-    if (files != null) {
-      _result.files.addAll(files);
-    }
-    if (sdk != null) {
-      _result.sdk = sdk;
-    }
-    if (pipelineOptions != null) {
-      _result.pipelineOptions = pipelineOptions;
-    }
-    if (complexity != null) {
-      _result.complexity = complexity;
+    if (revisions != null) {
+      _result.revisions.addAll(revisions);
     }
-    if (persistenceKey != null) {
-      _result.persistenceKey = persistenceKey;
+    if (descendants != null) {
+      _result.descendants.addAll(descendants);
     }
     return _result;
   }
-  factory SaveSnippetRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory SaveSnippetRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory ListSnippetRevisionsResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory ListSnippetRevisionsResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  SaveSnippetRequest clone() => SaveSnippetRequest()..mergeFromMessage(this);
+  ListSnippetRevisionsResponse clone() => ListSnippetRevisionsResponse()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  SaveSnippetRequest copyWith(void Function(SaveSnippetRequest) updates) => super.copyWith((message) => updates(message as SaveSnippetRequest)) as SaveSnippetRequest; // ignore: deprecated_member_use
+  ListSnippetRevisionsResponse copyWith(void Function(ListSnippetRevisionsResponse) updates) => super.copyWith((message) => updates(message as ListSnippetRevisionsResponse)) as ListSnippetRevisionsResponse; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static SaveSnippetRequest create() => SaveSnippetRequest._();
-  SaveSnippetRequest createEmptyInstance() => create();
-  static $pb.PbList<SaveSnippetRequest> createRepeated() => $pb.PbList<SaveSnippetRequest>();
+  static ListSnippetRevisionsResponse create() => ListSnippetRevisionsResponse._();
+  ListSnippetRevisionsResponse createEmptyInstance() => create();
+  static $pb.PbList<ListSnippetRevisionsResponse> createRepeated() => $pb.PbList<ListSnippetRevisionsResponse>();
   @$core.pragma('dart2js:noInline')
-  static SaveSnippetRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<SaveSnippetRequest>(create);
-  static SaveSnippetRequest? _defaultInstance;
+  static ListSnippetRevisionsResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<ListSnippetRevisionsResponse>(create);
+  static ListSnippetRevisionsResponse? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.List<SnippetFile> get files => $_getList(0);
+  $core.List<SnippetRevision> get revisions => $_getList(0);
 
   @$pb.TagNumber(2)
-  Sdk get sdk => $_getN(1);
-  @$pb.TagNumber(2)
-  set sdk(Sdk v) { setField(2, v); }
-  @$pb.TagNumber(2)
-  $core.bool hasSdk() => $_has(1);
-  @$pb.TagNumber(2)
-  void clearSdk() => clearField(2);
-
-  @$pb.TagNumber(3)
-  $core.String get pipelineOptions => $_getSZ(2);
-  @$pb.TagNumber(3)
-  set pipelineOptions($core.String v) { $_setString(2, v); }
-  @$pb.TagNumber(3)
-  $core.bool hasPipelineOptions() => $_has(2);
-  @$pb.TagNumber(3)
-  void clearPipelineOptions() => clearField(3);
-
-  @$pb.TagNumber(4)
-  Complexity get complexity => $_getN(3);
-  @$pb.TagNumber(4)
-  set complexity(Complexity v) { setField(4, v); }
-  @$pb.TagNumber(4)
-  $core.bool hasComplexity() => $_has(3);
-  @$pb.TagNumber(4)
-  void clearComplexity() => clearField(4);
-
-  @$pb.TagNumber(5)
-  $core.String get persistenceKey => $_getSZ(4);
-  @$pb.TagNumber(5)
-  set persistenceKey($core.String v) { $_setString(4, v); }
-  @$pb.TagNumber(5)
-  $core.bool hasPersistenceKey() => $_has(4);
-  @$pb.TagNumber(5)
-  void clearPersistenceKey() => clearField(5);
+  $core.List<SnippetRevision> get descendants => $_getList(1);
 }
 
-class SaveSnippetResponse extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'SaveSnippetResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'id')
+class GetSnippetDiffRequest extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetSnippetDiffRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'fromId')
+    ..aOS(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'toId')
     ..hasRequiredFields = false
   ;
 
-  SaveSnippetResponse._() : super();
-  factory SaveSnippetResponse({
-    $core.String? id,
+  GetSnippetDiffRequest._() : super();
+  factory GetSnippetDiffRequest({
+    $core.String? fromId,
+    $core.String? toId,
   }) {
     final _result = create();
-    if (id != null) {
-      _result.id = id;
+    if (fromId != null) {
+      _result.fromId = fromId;
+    }
+    if (toId != null) {
+      _result.toId = toId;
     }
     return _result;
   }
-  factory SaveSnippetResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory SaveSnippetResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetSnippetDiffRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetSnippetDiffRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  SaveSnippetResponse clone() => SaveSnippetResponse()..mergeFromMessage(this);
+  GetSnippetDiffRequest clone() => GetSnippetDiffRequest()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  SaveSnippetResponse copyWith(void Function(SaveSnippetResponse) updates) => super.copyWith((message) => updates(message as SaveSnippetResponse)) as SaveSnippetResponse; // ignore: deprecated_member_use
+  GetSnippetDiffRequest copyWith(void Function(GetSnippetDiffRequest) updates) => super.copyWith((message) => updates(message as GetSnippetDiffRequest)) as GetSnippetDiffRequest; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static SaveSnippetResponse create() => SaveSnippetResponse._();
-  SaveSnippetResponse createEmptyInstance() => create();
-  static $pb.PbList<SaveSnippetResponse> createRepeated() => $pb.PbList<SaveSnippetResponse>();
+  static GetSnippetDiffRequest create() => GetSnippetDiffRequest._();
+  GetSnippetDiffRequest createEmptyInstance() => create();
+  static $pb.PbList<GetSnippetDiffRequest> createRepeated() => $pb.PbList<GetSnippetDiffRequest>();
   @$core.pragma('dart2js:noInline')
-  static SaveSnippetResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<SaveSnippetResponse>(create);
-  static SaveSnippetResponse? _defaultInstance;
+  static GetSnippetDiffRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetSnippetDiffRequest>(create);
+  static GetSnippetDiffRequest? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get id => $_getSZ(0);
+  $core.String get fromId => $_getSZ(0);
   @$pb.TagNumber(1)
-  set id($core.String v) { $_setString(0, v); }
+  set fromId($core.String v) { $_setString(0, v); }
   @$pb.TagNumber(1)
-  $core.bool hasId() => $_has(0);
+  $core.bool hasFromId() => $_has(0);
   @$pb.TagNumber(1)
-  void clearId() => clearField(1);
+  void clearFromId() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get toId => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set toId($core.String v) { $_setString(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasToId() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearToId() => clearField(2);
 }
 
-class GetSnippetRequest extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetSnippetRequest', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'id')
+class SnippetFileDiff extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'SnippetFileDiff', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..aOS(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'name')
+    ..aOS(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'diff')
     ..hasRequiredFields = false
   ;
 
-  GetSnippetRequest._() : super();
-  factory GetSnippetRequest({
-    $core.String? id,
+  SnippetFileDiff._() : super();
+  factory SnippetFileDiff({
+    $core.String? name,
+    $core.String? diff,
   }) {
     final _result = create();
-    if (id != null) {
-      _result.id = id;
+    if (name != null) {
+      _result.name = name;
+    }
+    if (diff != null) {
+      _result.diff = diff;
     }
     return _result;
   }
-  factory GetSnippetRequest.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetSnippetRequest.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory SnippetFileDiff.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory SnippetFileDiff.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetSnippetRequest clone() => GetSnippetRequest()..mergeFromMessage(this);
+  SnippetFileDiff clone() => SnippetFileDiff()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetSnippetRequest copyWith(void Function(GetSnippetRequest) updates) => super.copyWith((message) => updates(message as GetSnippetRequest)) as GetSnippetRequest; // ignore: deprecated_member_use
+  SnippetFileDiff copyWith(void Function(SnippetFileDiff) updates) => super.copyWith((message) => updates(message as SnippetFileDiff)) as SnippetFileDiff; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetSnippetRequest create() => GetSnippetRequest._();
-  GetSnippetRequest createEmptyInstance() => create();
-  static $pb.PbList<GetSnippetRequest> createRepeated() => $pb.PbList<GetSnippetRequest>();
+  static SnippetFileDiff create() => SnippetFileDiff._();
+  SnippetFileDiff createEmptyInstance() => create();
+  static $pb.PbList<SnippetFileDiff> createRepeated() => $pb.PbList<SnippetFileDiff>();
   @$core.pragma('dart2js:noInline')
-  static GetSnippetRequest getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetSnippetRequest>(create);
-  static GetSnippetRequest? _defaultInstance;
+  static SnippetFileDiff getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<SnippetFileDiff>(create);
+  static SnippetFileDiff? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get id => $_getSZ(0);
+  $core.String get name => $_getSZ(0);
   @$pb.TagNumber(1)
-  set id($core.String v) { $_setString(0, v); }
+  set name($core.String v) { $_setString(0, v); }
   @$pb.TagNumber(1)
-  $core.bool hasId() => $_has(0);
+  $core.bool hasName() => $_has(0);
   @$pb.TagNumber(1)
-  void clearId() => clearField(1);
+  void clearName() => clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get diff => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set diff($core.String v) { $_setString(1, v); }
+  @$pb.TagNumber(2)
+  $core.bool hasDiff() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearDiff() => clearField(2);
 }
 
-class GetSnippetResponse extends $pb.GeneratedMessage {
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetSnippetResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
-    ..pc<SnippetFile>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'files', $pb.PbFieldType.PM, subBuilder: SnippetFile.create)
-    ..e<Sdk>(2, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'sdk', $pb.PbFieldType.OE, defaultOrMaker: Sdk.SDK_UNSPECIFIED, valueOf: Sdk.valueOf, enumValues: Sdk.values)
-    ..aOS(3, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'pipelineOptions')
-    ..e<Complexity>(4, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'complexity', $pb.PbFieldType.OE, defaultOrMaker: Complexity.COMPLEXITY_UNSPECIFIED, valueOf: Complexity.valueOf, enumValues: Complexity.values)
+class GetSnippetDiffResponse extends $pb.GeneratedMessage {
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'GetSnippetDiffResponse', package: const $pb.PackageName(const $core.bool.fromEnvironment('protobuf.omit_message_names') ? '' : 'api.v1'), createEmptyInstance: create)
+    ..pc<SnippetFileDiff>(1, const $core.bool.fromEnvironment('protobuf.omit_field_names') ? '' : 'files', $pb.PbFieldType.PM, subBuilder: SnippetFileDiff.create)
     ..hasRequiredFields = false
   ;
 
-  GetSnippetResponse._() : super();
-  factory GetSnippetResponse({
-    $core.Iterable<SnippetFile>? files,
-    Sdk? sdk,
-    $core.String? pipelineOptions,
-    Complexity? complexity,
+  GetSnippetDiffResponse._() : super();
+  factory GetSnippetDiffResponse({
+    $core.Iterable<SnippetFileDiff>? files,
   }) {
     final _result = create();
     if (files != null) {
       _result.files.addAll(files);
     }
-    if (sdk != null) {
-      _result.sdk = sdk;
-    }
-    if (pipelineOptions != null) {
-      _result.pipelineOptions = pipelineOptions;
-    }
-    if (complexity != null) {
-      _result.complexity = complexity;
-    }
     return _result;
   }
-  factory GetSnippetResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
-  factory GetSnippetResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
+  factory GetSnippetDiffResponse.fromBuffer($core.List<$core.int> i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromBuffer(i, r);
+  factory GetSnippetDiffResponse.fromJson($core.String i, [$pb.ExtensionRegistry r = $pb.ExtensionRegistry.EMPTY]) => create()..mergeFromJson(i, r);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.deepCopy] instead. '
   'Will be removed in next major version')
-  GetSnippetResponse clone() => GetSnippetResponse()..mergeFromMessage(this);
+  GetSnippetDiffResponse clone() => GetSnippetDiffResponse()..mergeFromMessage(this);
   @$core.Deprecated(
   'Using this can add significant overhead to your binary. '
   'Use [GeneratedMessageGenericExtensions.rebuild] instead. '
   'Will be removed in next major version')
-  GetSnippetResponse copyWith(void Function(GetSnippetResponse) updates) => super.copyWith((message) => updates(message as GetSnippetResponse)) as GetSnippetResponse; // ignore: deprecated_member_use
+  GetSnippetDiffResponse copyWith(void Function(GetSnippetDiffResponse) updates) => super.copyWith((message) => updates(message as GetSnippetDiffResponse)) as GetSnippetDiffResponse; // ignore: deprecated_member_use
   $pb.BuilderInfo get info_ => _i;
   @$core.pragma('dart2js:noInline')
-  static GetSnippetResponse create() => GetSnippetResponse._();
-  GetSnippetResponse createEmptyInstance() => create();
-  static $pb.PbList<GetSnippetResponse> createRepeated() => $pb.PbList<GetSnippetResponse>();
+  static GetSnippetDiffResponse create() => GetSnippetDiffResponse._();
+  GetSnippetDiffResponse createEmptyInstance() => create();
+  static $pb.PbList<GetSnippetDiffResponse> createRepeated() => $pb.PbList<GetSnippetDiffResponse>();
   @$core.pragma('dart2js:noInline')
-  static GetSnippetResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetSnippetResponse>(create);
-  static GetSnippetResponse? _defaultInstance;
+  static GetSnippetDiffResponse getDefault() => _defaultInstance ??= $pb.GeneratedMessage.$_defaultFor<GetSnippetDiffResponse>(create);
+  static GetSnippetDiffResponse? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.List<SnippetFile> get files => $_getList(0);
-
-  @$pb.TagNumber(2)
-  Sdk get sdk => $_getN(1);
-  @$pb.TagNumber(2)
-  set sdk(Sdk v) { setField(2, v); }
-  @$pb.TagNumber(2)
-  $core.bool hasSdk() => $_has(1);
-  @$pb.TagNumber(2)
-  void clearSdk() => clearField(2);
-
-  @$pb.TagNumber(3)
-  $core.String get pipelineOptions => $_getSZ(2);
-  @$pb.TagNumber(3)
-  set pipelineOptions($core.String v) { $_setString(2, v); }
-  @$pb.TagNumber(3)
-  $core.bool hasPipelineOptions() => $_has(2);
-  @$pb.TagNumber(3)
-  void clearPipelineOptions() => clearField(3);
-
-  @$pb.TagNumber(4)
-  Complexity get complexity => $_getN(3);
-  @$pb.TagNumber(4)
-  set complexity(Complexity v) { setField(4, v); }
-  @$pb.TagNumber(4)
-  $core.bool hasComplexity() => $_has(3);
-  @$pb.TagNumber(4)
-  void clearComplexity() => clearField(4);
+  $core.List<SnippetFileDiff> get files => $_getList(0);
 }
 
 class GetMetadataRequest extends $pb.GeneratedMessage {
@@ -15,6 +15,7 @@ class Sdk extends $pb.ProtobufEnum {
   static const Sdk SDK_GO = Sdk._(2, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'SDK_GO');
   static const Sdk SDK_PYTHON = Sdk._(3, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'SDK_PYTHON');
   static const Sdk SDK_SCIO = Sdk._(4, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'SDK_SCIO');
+  static const Sdk SDK_TYPESCRIPT = Sdk._(5, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'SDK_TYPESCRIPT');
 
   static const $core.List<Sdk> values = <Sdk> [
     SDK_UNSPECIFIED,
@@ -22,6 +23,7 @@ class Sdk extends $pb.ProtobufEnum {
     SDK_GO,
     SDK_PYTHON,
     SDK_SCIO,
+    SDK_TYPESCRIPT,
   ];
 
   static final $core.Map<$core.int, Sdk> _byValue = $pb.ProtobufEnum.initByValue(values);
@@ -44,6 +46,7 @@ class Status extends $pb.ProtobufEnum {
   static const Status STATUS_ERROR = Status._(10, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'STATUS_ERROR');
   static const Status STATUS_RUN_TIMEOUT = Status._(11, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'STATUS_RUN_TIMEOUT');
   static const Status STATUS_CANCELED = Status._(12, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'STATUS_CANCELED');
+  static const Status STATUS_QUEUED = Status._(13, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'STATUS_QUEUED');
 
   static const $core.List<Status> values = <Status> [
     STATUS_UNSPECIFIED,
@@ -59,6 +62,7 @@ class Status extends $pb.ProtobufEnum {
     STATUS_ERROR,
     STATUS_RUN_TIMEOUT,
     STATUS_CANCELED,
+    STATUS_QUEUED,
   ];
 
   static final $core.Map<$core.int, Status> _byValue = $pb.ProtobufEnum.initByValue(values);
@@ -108,10 +112,16 @@ class Complexity extends $pb.ProtobufEnum {
 class EmulatorType extends $pb.ProtobufEnum {
   static const EmulatorType EMULATOR_TYPE_UNSPECIFIED = EmulatorType._(0, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'EMULATOR_TYPE_UNSPECIFIED');
   static const EmulatorType EMULATOR_TYPE_KAFKA = EmulatorType._(1, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'EMULATOR_TYPE_KAFKA');
+  static const EmulatorType EMULATOR_TYPE_PUBSUB = EmulatorType._(2, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'EMULATOR_TYPE_PUBSUB');
+  static const EmulatorType EMULATOR_TYPE_GCS = EmulatorType._(3, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'EMULATOR_TYPE_GCS');
+  static const EmulatorType EMULATOR_TYPE_JDBC = EmulatorType._(4, const $core.bool.fromEnvironment('protobuf.omit_enum_names') ? '' : 'EMULATOR_TYPE_JDBC');
 
   static const $core.List<EmulatorType> values = <EmulatorType> [
     EMULATOR_TYPE_UNSPECIFIED,
     EMULATOR_TYPE_KAFKA,
+    EMULATOR_TYPE_PUBSUB,
+    EMULATOR_TYPE_GCS,
+    EMULATOR_TYPE_JDBC,
   ];
 
   static final $core.Map<$core.int, EmulatorType> _byValue = $pb.ProtobufEnum.initByValue(values);
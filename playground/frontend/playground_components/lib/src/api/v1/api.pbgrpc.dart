@@ -38,12 +38,36 @@ class PlaygroundServiceClient extends $grpc.Client {
           ($0.GetLogsRequest value) => value.writeToBuffer(),
           ($core.List<$core.int> value) =>
               $0.GetLogsResponse.fromBuffer(value));
+  static final _$streamRunOutput =
+      $grpc.ClientMethod<$0.StreamRunOutputRequest, $0.StreamRunOutputResponse>(
+          '/api.v1.PlaygroundService/StreamRunOutput',
+          ($0.StreamRunOutputRequest value) => value.writeToBuffer(),
+          ($core.List<$core.int> value) =>
+              $0.StreamRunOutputResponse.fromBuffer(value));
+  static final _$streamLogs =
+      $grpc.ClientMethod<$0.StreamLogsRequest, $0.StreamLogsResponse>(
+          '/api.v1.PlaygroundService/StreamLogs',
+          ($0.StreamLogsRequest value) => value.writeToBuffer(),
+          ($core.List<$core.int> value) =>
+              $0.StreamLogsResponse.fromBuffer(value));
+  static final _$streamStatus =
+      $grpc.ClientMethod<$0.StreamStatusRequest, $0.StreamStatusResponse>(
+          '/api.v1.PlaygroundService/StreamStatus',
+          ($0.StreamStatusRequest value) => value.writeToBuffer(),
+          ($core.List<$core.int> value) =>
+              $0.StreamStatusResponse.fromBuffer(value));
   static final _$getGraph =
       $grpc.ClientMethod<$0.GetGraphRequest, $0.GetGraphResponse>(
           '/api.v1.PlaygroundService/GetGraph',
           ($0.GetGraphRequest value) => value.writeToBuffer(),
           ($core.List<$core.int> value) =>
               $0.GetGraphResponse.fromBuffer(value));
+  static final _$getRunMetrics =
+      $grpc.ClientMethod<$0.GetRunMetricsRequest, $0.GetRunMetricsResponse>(
+          '/api.v1.PlaygroundService/GetRunMetrics',
+          ($0.GetRunMetricsRequest value) => value.writeToBuffer(),
+          ($core.List<$core.int> value) =>
+              $0.GetRunMetricsResponse.fromBuffer(value));
   static final _$getRunError =
       $grpc.ClientMethod<$0.GetRunErrorRequest, $0.GetRunErrorResponse>(
           '/api.v1.PlaygroundService/GetRunError',
@@ -132,6 +156,24 @@ class PlaygroundServiceClient extends $grpc.Client {
           ($0.GetSnippetRequest value) => value.writeToBuffer(),
           ($core.List<$core.int> value) =>
               $0.GetSnippetResponse.fromBuffer(value));
+  static final _$forkSnippet =
+      $grpc.ClientMethod<$0.ForkSnippetRequest, $0.ForkSnippetResponse>(
+          '/api.v1.PlaygroundService/ForkSnippet',
+          ($0.ForkSnippetRequest value) => value.writeToBuffer(),
+          ($core.List<$core.int> value) =>
+              $0.ForkSnippetResponse.fromBuffer(value));
+  static final _$listSnippetRevisions =
+      $grpc.ClientMethod<$0.ListSnippetRevisionsRequest, $0.ListSnippetRevisionsResponse>(
+          '/api.v1.PlaygroundService/ListSnippetRevisions',
+          ($0.ListSnippetRevisionsRequest value) => value.writeToBuffer(),
+          ($core.List<$core.int> value) =>
+              $0.ListSnippetRevisionsResponse.fromBuffer(value));
+  static final _$getSnippetDiff =
+      $grpc.ClientMethod<$0.GetSnippetDiffRequest, $0.GetSnippetDiffResponse>(
+          '/api.v1.PlaygroundService/GetSnippetDiff',
+          ($0.GetSnippetDiffRequest value) => value.writeToBuffer(),
+          ($core.List<$core.int> value) =>
+              $0.GetSnippetDiffResponse.fromBuffer(value));
   static final _$getMetadata =
       $grpc.ClientMethod<$0.GetMetadataRequest, $0.GetMetadataResponse>(
           '/api.v1.PlaygroundService/GetMetadata',
@@ -166,11 +208,41 @@ class PlaygroundServiceClient extends $grpc.Client {
     return $createUnaryCall(_$getLogs, request, options: options);
   }
 
+  $grpc.ResponseStream<$0.StreamRunOutputResponse> streamRunOutput(
+      $0.StreamRunOutputRequest request,
+      {$grpc.CallOptions? options}) {
+    return $createStreamingCall(
+        _$streamRunOutput, $async.Stream.fromIterable([request]),
+        options: options);
+  }
+
+  $grpc.ResponseStream<$0.StreamLogsResponse> streamLogs(
+      $0.StreamLogsRequest request,
+      {$grpc.CallOptions? options}) {
+    return $createStreamingCall(
+        _$streamLogs, $async.Stream.fromIterable([request]),
+        options: options);
+  }
+
+  $grpc.ResponseStream<$0.StreamStatusResponse> streamStatus(
+      $0.StreamStatusRequest request,
+      {$grpc.CallOptions? options}) {
+    return $createStreamingCall(
+        _$streamStatus, $async.Stream.fromIterable([request]),
+        options: options);
+  }
+
   $grpc.ResponseFuture<$0.GetGraphResponse> getGraph($0.GetGraphRequest request,
       {$grpc.CallOptions? options}) {
     return $createUnaryCall(_$getGraph, request, options: options);
   }
 
+  $grpc.ResponseFuture<$0.GetRunMetricsResponse> getRunMetrics(
+      $0.GetRunMetricsRequest request,
+      {$grpc.CallOptions? options}) {
+    return $createUnaryCall(_$getRunMetrics, request, options: options);
+  }
+
   $grpc.ResponseFuture<$0.GetRunErrorResponse> getRunError(
       $0.GetRunErrorRequest request,
       {$grpc.CallOptions? options}) {
@@ -259,6 +331,24 @@ class PlaygroundServiceClient extends $grpc.Client {
     return $createUnaryCall(_$getSnippet, request, options: options);
   }
 
+  $grpc.ResponseFuture<$0.ForkSnippetResponse> forkSnippet(
+      $0.ForkSnippetRequest request,
+      {$grpc.CallOptions? options}) {
+    return $createUnaryCall(_$forkSnippet, request, options: options);
+  }
+
+  $grpc.ResponseFuture<$0.ListSnippetRevisionsResponse> listSnippetRevisions(
+      $0.ListSnippetRevisionsRequest request,
+      {$grpc.CallOptions? options}) {
+    return $createUnaryCall(_$listSnippetRevisions, request, options: options);
+  }
+
+  $grpc.ResponseFuture<$0.GetSnippetDiffResponse> getSnippetDiff(
+      $0.GetSnippetDiffRequest request,
+      {$grpc.CallOptions? options}) {
+    return $createUnaryCall(_$getSnippetDiff, request, options: options);
+  }
+
   $grpc.ResponseFuture<$0.GetMetadataResponse> getMetadata(
       $0.GetMetadataRequest request,
       {$grpc.CallOptions? options}) {
@@ -302,6 +392,33 @@ abstract class PlaygroundServiceBase extends $grpc.Service {
         false,
         ($core.List<$core.int> value) => $0.GetLogsRequest.fromBuffer(value),
         ($0.GetLogsResponse value) => value.writeToBuffer()));
+    $addMethod(
+        $grpc.ServiceMethod<$0.StreamRunOutputRequest, $0.StreamRunOutputResponse>(
+            'StreamRunOutput',
+            streamRunOutput_Pre,
+            false,
+            true,
+            ($core.List<$core.int> value) =>
+                $0.StreamRunOutputRequest.fromBuffer(value),
+            ($0.StreamRunOutputResponse value) => value.writeToBuffer()));
+    $addMethod(
+        $grpc.ServiceMethod<$0.StreamLogsRequest, $0.StreamLogsResponse>(
+            'StreamLogs',
+            streamLogs_Pre,
+            false,
+            true,
+            ($core.List<$core.int> value) =>
+                $0.StreamLogsRequest.fromBuffer(value),
+            ($0.StreamLogsResponse value) => value.writeToBuffer()));
+    $addMethod(
+        $grpc.ServiceMethod<$0.StreamStatusRequest, $0.StreamStatusResponse>(
+            'StreamStatus',
+            streamStatus_Pre,
+            false,
+            true,
+            ($core.List<$core.int> value) =>
+                $0.StreamStatusRequest.fromBuffer(value),
+            ($0.StreamStatusResponse value) => value.writeToBuffer()));
     $addMethod($grpc.ServiceMethod<$0.GetGraphRequest, $0.GetGraphResponse>(
         'GetGraph',
         getGraph_Pre,
@@ -309,6 +426,15 @@ abstract class PlaygroundServiceBase extends $grpc.Service {
         false,
         ($core.List<$core.int> value) => $0.GetGraphRequest.fromBuffer(value),
         ($0.GetGraphResponse value) => value.writeToBuffer()));
+    $addMethod(
+        $grpc.ServiceMethod<$0.GetRunMetricsRequest, $0.GetRunMetricsResponse>(
+            'GetRunMetrics',
+            getRunMetrics_Pre,
+            false,
+            false,
+            ($core.List<$core.int> value) =>
+                $0.GetRunMetricsRequest.fromBuffer(value),
+            ($0.GetRunMetricsResponse value) => value.writeToBuffer()));
     $addMethod(
         $grpc.ServiceMethod<$0.GetRunErrorRequest, $0.GetRunErrorResponse>(
             'GetRunError',
@@ -433,6 +559,33 @@ abstract class PlaygroundServiceBase extends $grpc.Service {
         false,
         ($core.List<$core.int> value) => $0.GetSnippetRequest.fromBuffer(value),
         ($0.GetSnippetResponse value) => value.writeToBuffer()));
+    $addMethod(
+        $grpc.ServiceMethod<$0.ForkSnippetRequest, $0.ForkSnippetResponse>(
+            'ForkSnippet',
+            forkSnippet_Pre,
+            false,
+            false,
+            ($core.List<$core.int> value) =>
+                $0.ForkSnippetRequest.fromBuffer(value),
+            ($0.ForkSnippetResponse value) => value.writeToBuffer()));
+    $addMethod(
+        $grpc.ServiceMethod<$0.ListSnippetRevisionsRequest, $0.ListSnippetRevisionsResponse>(
+            'ListSnippetRevisions',
+            listSnippetRevisions_Pre,
+            false,
+            false,
+            ($core.List<$core.int> value) =>
+                $0.ListSnippetRevisionsRequest.fromBuffer(value),
+            ($0.ListSnippetRevisionsResponse value) => value.writeToBuffer()));
+    $addMethod(
+        $grpc.ServiceMethod<$0.GetSnippetDiffRequest, $0.GetSnippetDiffResponse>(
+            'GetSnippetDiff',
+            getSnippetDiff_Pre,
+            false,
+            false,
+            ($core.List<$core.int> value) =>
+                $0.GetSnippetDiffRequest.fromBuffer(value),
+            ($0.GetSnippetDiffResponse value) => value.writeToBuffer()));
     $addMethod(
         $grpc.ServiceMethod<$0.GetMetadataRequest, $0.GetMetadataResponse>(
             'GetMetadata',
@@ -465,11 +618,31 @@ abstract class PlaygroundServiceBase extends $grpc.Service {
     return getLogs(call, await request);
   }
 
+  $async.Stream<$0.StreamRunOutputResponse> streamRunOutput_Pre($grpc.ServiceCall call,
+      $async.Future<$0.StreamRunOutputRequest> request) async* {
+    yield* streamRunOutput(call, await request);
+  }
+
+  $async.Stream<$0.StreamLogsResponse> streamLogs_Pre($grpc.ServiceCall call,
+      $async.Future<$0.StreamLogsRequest> request) async* {
+    yield* streamLogs(call, await request);
+  }
+
+  $async.Stream<$0.StreamStatusResponse> streamStatus_Pre($grpc.ServiceCall call,
+      $async.Future<$0.StreamStatusRequest> request) async* {
+    yield* streamStatus(call, await request);
+  }
+
   $async.Future<$0.GetGraphResponse> getGraph_Pre(
       $grpc.ServiceCall call, $async.Future<$0.GetGraphRequest> request) async {
     return getGraph(call, await request);
   }
 
+  $async.Future<$0.GetRunMetricsResponse> getRunMetrics_Pre($grpc.ServiceCall call,
+      $async.Future<$0.GetRunMetricsRequest> request) async {
+    return getRunMetrics(call, await request);
+  }
+
   $async.Future<$0.GetRunErrorResponse> getRunError_Pre($grpc.ServiceCall call,
       $async.Future<$0.GetRunErrorRequest> request) async {
     return getRunError(call, await request);
@@ -550,6 +723,21 @@ abstract class PlaygroundServiceBase extends $grpc.Service {
     return getSnippet(call, await request);
   }
 
+  $async.Future<$0.ForkSnippetResponse> forkSnippet_Pre($grpc.ServiceCall call,
+      $async.Future<$0.ForkSnippetRequest> request) async {
+    return forkSnippet(call, await request);
+  }
+
+  $async.Future<$0.ListSnippetRevisionsResponse> listSnippetRevisions_Pre($grpc.ServiceCall call,
+      $async.Future<$0.ListSnippetRevisionsRequest> request) async {
+    return listSnippetRevisions(call, await request);
+  }
+
+  $async.Future<$0.GetSnippetDiffResponse> getSnippetDiff_Pre($grpc.ServiceCall call,
+      $async.Future<$0.GetSnippetDiffRequest> request) async {
+    return getSnippetDiff(call, await request);
+  }
+
   $async.Future<$0.GetMetadataResponse> getMetadata_Pre($grpc.ServiceCall call,
       $async.Future<$0.GetMetadataRequest> request) async {
     return getMetadata(call, await request);
@@ -563,8 +751,16 @@ abstract class PlaygroundServiceBase extends $grpc.Service {
       $grpc.ServiceCall call, $0.GetRunOutputRequest request);
   $async.Future<$0.GetLogsResponse> getLogs(
       $grpc.ServiceCall call, $0.GetLogsRequest request);
+  $async.Stream<$0.StreamRunOutputResponse> streamRunOutput(
+      $grpc.ServiceCall call, $0.StreamRunOutputRequest request);
+  $async.Stream<$0.StreamLogsResponse> streamLogs(
+      $grpc.ServiceCall call, $0.StreamLogsRequest request);
+  $async.Stream<$0.StreamStatusResponse> streamStatus(
+      $grpc.ServiceCall call, $0.StreamStatusRequest request);
   $async.Future<$0.GetGraphResponse> getGraph(
       $grpc.ServiceCall call, $0.GetGraphRequest request);
+  $async.Future<$0.GetRunMetricsResponse> getRunMetrics(
+      $grpc.ServiceCall call, $0.GetRunMetricsRequest request);
   $async.Future<$0.GetRunErrorResponse> getRunError(
       $grpc.ServiceCall call, $0.GetRunErrorRequest request);
   $async.Future<$0.GetValidationOutputResponse> getValidationOutput(
@@ -595,6 +791,12 @@ abstract class PlaygroundServiceBase extends $grpc.Service {
       $grpc.ServiceCall call, $0.SaveSnippetRequest request);
   $async.Future<$0.GetSnippetResponse> getSnippet(
       $grpc.ServiceCall call, $0.GetSnippetRequest request);
+  $async.Future<$0.ForkSnippetResponse> forkSnippet(
+      $grpc.ServiceCall call, $0.ForkSnippetRequest request);
+  $async.Future<$0.ListSnippetRevisionsResponse> listSnippetRevisions(
+      $grpc.ServiceCall call, $0.ListSnippetRevisionsRequest request);
+  $async.Future<$0.GetSnippetDiffResponse> getSnippetDiff(
+      $grpc.ServiceCall call, $0.GetSnippetDiffRequest request);
   $async.Future<$0.GetMetadataResponse> getMetadata(
       $grpc.ServiceCall call, $0.GetMetadataRequest request);
 }
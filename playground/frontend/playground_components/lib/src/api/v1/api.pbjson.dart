@@ -17,11 +17,12 @@ const Sdk$json = const {
     const {'1': 'SDK_GO', '2': 2},
     const {'1': 'SDK_PYTHON', '2': 3},
     const {'1': 'SDK_SCIO', '2': 4},
+    const {'1': 'SDK_TYPESCRIPT', '2': 5},
   ],
 };
 
 /// Descriptor for `Sdk`. Decode as a `google.protobuf.EnumDescriptorProto`.
-final $typed_data.Uint8List sdkDescriptor = $convert.base64Decode('CgNTZGsSEwoPU0RLX1VOU1BFQ0lGSUVEEAASDAoIU0RLX0pBVkEQARIKCgZTREtfR08QAhIOCgpTREtfUFlUSE9OEAMSDAoIU0RLX1NDSU8QBA==');
+final $typed_data.Uint8List sdkDescriptor = $convert.base64Decode('CgNTZGsSEwoPU0RLX1VOU1BFQ0lGSUVEEAASDAoIU0RLX0pBVkEQARIKCgZTREtfR08QAhIOCgpTREtfUFlUSE9OEAMSDAoIU0RLX1NDSU8QBBISCg5TREtfVFlQRVNDUklQVBAF');
 @$core.Deprecated('Use statusDescriptor instead')
 const Status$json = const {
   '1': 'Status',
@@ -39,11 +40,12 @@ const Status$json = const {
     const {'1': 'STATUS_ERROR', '2': 10},
     const {'1': 'STATUS_RUN_TIMEOUT', '2': 11},
     const {'1': 'STATUS_CANCELED', '2': 12},
+    const {'1': 'STATUS_QUEUED', '2': 13},
   ],
 };
 
 /// Descriptor for `Status`. Decode as a `google.protobuf.EnumDescriptorProto`.
-final $typed_data.Uint8List statusDescriptor = $convert.base64Decode('CgZTdGF0dXMSFgoSU1RBVFVTX1VOU1BFQ0lGSUVEEAASFQoRU1RBVFVTX1ZBTElEQVRJTkcQARIbChdTVEFUVVNfVkFMSURBVElPTl9FUlJPUhACEhQKEFNUQVRVU19QUkVQQVJJTkcQAxIcChhTVEFUVVNfUFJFUEFSQVRJT05fRVJST1IQBBIUChBTVEFUVVNfQ09NUElMSU5HEAUSGAoUU1RBVFVTX0NPTVBJTEVfRVJST1IQBhIUChBTVEFUVVNfRVhFQ1VUSU5HEAcSEwoPU1RBVFVTX0ZJTklTSEVEEAgSFAoQU1RBVFVTX1JVTl9FUlJPUhAJEhAKDFNUQVRVU19FUlJPUhAKEhYKElNUQVRVU19SVU5fVElNRU9VVBALEhMKD1NUQVRVU19DQU5DRUxFRBAM');
+final $typed_data.Uint8List statusDescriptor = $convert.base64Decode('CgZTdGF0dXMSFgoSU1RBVFVTX1VOU1BFQ0lGSUVEEAASFQoRU1RBVFVTX1ZBTElEQVRJTkcQARIbChdTVEFUVVNfVkFMSURBVElPTl9FUlJPUhACEhQKEFNUQVRVU19QUkVQQVJJTkcQAxIcChhTVEFUVVNfUFJFUEFSQVRJT05fRVJST1IQBBIUChBTVEFUVVNfQ09NUElMSU5HEAUSGAoUU1RBVFVTX0NPTVBJTEVfRVJST1IQBhIUChBTVEFUVVNfRVhFQ1VUSU5HEAcSEwoPU1RBVFVTX0ZJTklTSEVEEAgSFAoQU1RBVFVTX1JVTl9FUlJPUhAJEhAKDFNUQVRVU19FUlJPUhAKEhYKElNUQVRVU19SVU5fVElNRU9VVBALEhMKD1NUQVRVU19DQU5DRUxFRBAMEhEKDVNUQVRVU19RVUVVRUQQDQ==');
 @$core.Deprecated('Use precompiledObjectTypeDescriptor instead')
 const PrecompiledObjectType$json = const {
   '1': 'PrecompiledObjectType',
@@ -76,11 +78,14 @@ const EmulatorType$json = const {
   '2': const [
     const {'1': 'EMULATOR_TYPE_UNSPECIFIED', '2': 0},
     const {'1': 'EMULATOR_TYPE_KAFKA', '2': 1},
+    const {'1': 'EMULATOR_TYPE_PUBSUB', '2': 2},
+    const {'1': 'EMULATOR_TYPE_GCS', '2': 3},
+    const {'1': 'EMULATOR_TYPE_JDBC', '2': 4},
   ],
 };
 
 /// Descriptor for `EmulatorType`. Decode as a `google.protobuf.EnumDescriptorProto`.
-final $typed_data.Uint8List emulatorTypeDescriptor = $convert.base64Decode('CgxFbXVsYXRvclR5cGUSHQoZRU1VTEFUT1JfVFlQRV9VTlNQRUNJRklFRBAAEhcKE0VNVUxBVE9SX1RZUEVfS0FGS0EQAQ==');
+final $typed_data.Uint8List emulatorTypeDescriptor = $convert.base64Decode('CgxFbXVsYXRvclR5cGUSHQoZRU1VTEFUT1JfVFlQRV9VTlNQRUNJRklFRBAAEhcKE0VNVUxBVE9SX1RZUEVfS0FGS0EQARIYChRFTVVMQVRPUl9UWVBFX1BVQlNVQhACEhUKEUVNVUxBVE9SX1RZUEVfR0NTEAMSFgoSRU1VTEFUT1JfVFlQRV9KREJDEAQ=');
 @$core.Deprecated('Use datasetDescriptor instead')
 const Dataset$json = const {
   '1': 'Dataset',
@@ -268,6 +273,66 @@ const GetLogsResponse$json = const {
 
 /// Descriptor for `GetLogsResponse`. Decode as a `google.protobuf.DescriptorProto`.
 final $typed_data.Uint8List getLogsResponseDescriptor = $convert.base64Decode('Cg9HZXRMb2dzUmVzcG9uc2USFgoGb3V0cHV0GAEgASgJUgZvdXRwdXQ=');
+@$core.Deprecated('Use streamRunOutputRequestDescriptor instead')
+const StreamRunOutputRequest$json = const {
+  '1': 'StreamRunOutputRequest',
+  '2': const [
+    const {'1': 'pipeline_uuid', '3': 1, '4': 1, '5': 9, '10': 'pipelineUuid'},
+  ],
+};
+
+/// Descriptor for `StreamRunOutputRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List streamRunOutputRequestDescriptor = $convert.base64Decode('ChZTdHJlYW1SdW5PdXRwdXRSZXF1ZXN0EiMKDXBpcGVsaW5lX3V1aWQYASABKAlSDHBpcGVsaW5lVXVpZA==');
+@$core.Deprecated('Use streamRunOutputResponseDescriptor instead')
+const StreamRunOutputResponse$json = const {
+  '1': 'StreamRunOutputResponse',
+  '2': const [
+    const {'1': 'output', '3': 1, '4': 1, '5': 9, '10': 'output'},
+  ],
+};
+
+/// Descriptor for `StreamRunOutputResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List streamRunOutputResponseDescriptor = $convert.base64Decode('ChdTdHJlYW1SdW5PdXRwdXRSZXNwb25zZRIWCgZvdXRwdXQYASABKAlSBm91dHB1dA==');
+@$core.Deprecated('Use streamLogsRequestDescriptor instead')
+const StreamLogsRequest$json = const {
+  '1': 'StreamLogsRequest',
+  '2': const [
+    const {'1': 'pipeline_uuid', '3': 1, '4': 1, '5': 9, '10': 'pipelineUuid'},
+  ],
+};
+
+/// Descriptor for `StreamLogsRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List streamLogsRequestDescriptor = $convert.base64Decode('ChFTdHJlYW1Mb2dzUmVxdWVzdBIjCg1waXBlbGluZV91dWlkGAEgASgJUgxwaXBlbGluZVV1aWQ=');
+@$core.Deprecated('Use streamLogsResponseDescriptor instead')
+const StreamLogsResponse$json = const {
+  '1': 'StreamLogsResponse',
+  '2': const [
+    const {'1': 'output', '3': 1, '4': 1, '5': 9, '10': 'output'},
+  ],
+};
+
+/// Descriptor for `StreamLogsResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List streamLogsResponseDescriptor = $convert.base64Decode('ChJTdHJlYW1Mb2dzUmVzcG9uc2USFgoGb3V0cHV0GAEgASgJUgZvdXRwdXQ=');
+@$core.Deprecated('Use streamStatusRequestDescriptor instead')
+const StreamStatusRequest$json = const {
+  '1': 'StreamStatusRequest',
+  '2': const [
+    const {'1': 'pipeline_uuid', '3': 1, '4': 1, '5': 9, '10': 'pipelineUuid'},
+  ],
+};
+
+/// Descriptor for `StreamStatusRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List streamStatusRequestDescriptor = $convert.base64Decode('ChNTdHJlYW1TdGF0dXNSZXF1ZXN0EiMKDXBpcGVsaW5lX3V1aWQYASABKAlSDHBpcGVsaW5lVXVpZA==');
+@$core.Deprecated('Use streamStatusResponseDescriptor instead')
+const StreamStatusResponse$json = const {
+  '1': 'StreamStatusResponse',
+  '2': const [
+    const {'1': 'status', '3': 1, '4': 1, '5': 14, '6': '.api.v1.Status', '10': 'status'},
+  ],
+};
+
+/// Descriptor for `StreamStatusResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List streamStatusResponseDescriptor = $convert.base64Decode('ChRTdHJlYW1TdGF0dXNSZXNwb25zZRImCgZzdGF0dXMYASABKA4yDi5hcGkudjEuU3RhdHVzUgZzdGF0dXM=');
 @$core.Deprecated('Use getGraphRequestDescriptor instead')
 const GetGraphRequest$json = const {
   '1': 'GetGraphRequest',
@@ -288,6 +353,100 @@ const GetGraphResponse$json = const {
 
 /// Descriptor for `GetGraphResponse`. Decode as a `google.protobuf.DescriptorProto`.
 final $typed_data.Uint8List getGraphResponseDescriptor = $convert.base64Decode('ChBHZXRHcmFwaFJlc3BvbnNlEhQKBWdyYXBoGAEgASgJUgVncmFwaA==');
+@$core.Deprecated('Use getRunMetricsRequestDescriptor instead')
+const GetRunMetricsRequest$json = const {
+  '1': 'GetRunMetricsRequest',
+  '2': const [
+    const {'1': 'pipeline_uuid', '3': 1, '4': 1, '5': 9, '10': 'pipelineUuid'},
+  ],
+};
+
+/// Descriptor for `GetRunMetricsRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getRunMetricsRequestDescriptor = $convert.base64Decode('ChRHZXRSdW5NZXRyaWNzUmVxdWVzdBIjCg1waXBlbGluZV91dWlkGAEgASgJUgxwaXBlbGluZVV1aWQ=');
+@$core.Deprecated('Use metricKeyDescriptor instead')
+const MetricKey$json = const {
+  '1': 'MetricKey',
+  '2': const [
+    const {'1': 'step', '3': 1, '4': 1, '5': 9, '10': 'step'},
+    const {'1': 'namespace', '3': 2, '4': 1, '5': 9, '10': 'namespace'},
+    const {'1': 'name', '3': 3, '4': 1, '5': 9, '10': 'name'},
+  ],
+};
+
+/// Descriptor for `MetricKey`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List metricKeyDescriptor = $convert.base64Decode('CglNZXRyaWNLZXkSEgoEc3RlcBgBIAEoCVIEc3RlcBIcCgluYW1lc3BhY2UYAiABKAlSCW5hbWVzcGFjZRISCgRuYW1lGAMgASgJUgRuYW1l');
+@$core.Deprecated('Use counterMetricDescriptor instead')
+const CounterMetric$json = const {
+  '1': 'CounterMetric',
+  '2': const [
+    const {'1': 'key', '3': 1, '4': 1, '5': 11, '6': '.api.v1.MetricKey', '10': 'key'},
+    const {'1': 'attempted', '3': 2, '4': 1, '5': 3, '10': 'attempted'},
+    const {'1': 'committed', '3': 3, '4': 1, '5': 3, '10': 'committed'},
+  ],
+};
+
+/// Descriptor for `CounterMetric`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List counterMetricDescriptor = $convert.base64Decode('Cg1Db3VudGVyTWV0cmljEiMKA2tleRgBIAEoCzIRLmFwaS52MS5NZXRyaWNLZXlSA2tleRIcCglhdHRlbXB0ZWQYAiABKANSCWF0dGVtcHRlZBIcCgljb21taXR0ZWQYAyABKANSCWNvbW1pdHRlZA==');
+@$core.Deprecated('Use distributionValueDescriptor instead')
+const DistributionValue$json = const {
+  '1': 'DistributionValue',
+  '2': const [
+    const {'1': 'count', '3': 1, '4': 1, '5': 3, '10': 'count'},
+    const {'1': 'sum', '3': 2, '4': 1, '5': 3, '10': 'sum'},
+    const {'1': 'min', '3': 3, '4': 1, '5': 3, '10': 'min'},
+    const {'1': 'max', '3': 4, '4': 1, '5': 3, '10': 'max'},
+  ],
+};
+
+/// Descriptor for `DistributionValue`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List distributionValueDescriptor = $convert.base64Decode('ChFEaXN0cmlidXRpb25WYWx1ZRIUCgVjb3VudBgBIAEoA1IFY291bnQSEAoDc3VtGAIgASgDUgNzdW0SEAoDbWluGAMgASgDUgNtaW4SEAoDbWF4GAQgASgDUgNtYXg=');
+@$core.Deprecated('Use distributionMetricDescriptor instead')
+const DistributionMetric$json = const {
+  '1': 'DistributionMetric',
+  '2': const [
+    const {'1': 'key', '3': 1, '4': 1, '5': 11, '6': '.api.v1.MetricKey', '10': 'key'},
+    const {'1': 'attempted', '3': 2, '4': 1, '5': 11, '6': '.api.v1.DistributionValue', '10': 'attempted'},
+    const {'1': 'committed', '3': 3, '4': 1, '5': 11, '6': '.api.v1.DistributionValue', '10': 'committed'},
+  ],
+};
+
+/// Descriptor for `DistributionMetric`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List distributionMetricDescriptor = $convert.base64Decode('ChJEaXN0cmlidXRpb25NZXRyaWMSIwoDa2V5GAEgASgLMhEuYXBpLnYxLk1ldHJpY0tleVIDa2V5EjcKCWF0dGVtcHRlZBgCIAEoCzIZLmFwaS52MS5EaXN0cmlidXRpb25WYWx1ZVIJYXR0ZW1wdGVkEjcKCWNvbW1pdHRlZBgDIAEoCzIZLmFwaS52MS5EaXN0cmlidXRpb25WYWx1ZVIJY29tbWl0dGVk');
+@$core.Deprecated('Use gaugeValueDescriptor instead')
+const GaugeValue$json = const {
+  '1': 'GaugeValue',
+  '2': const [
+    const {'1': 'value', '3': 1, '4': 1, '5': 3, '10': 'value'},
+    const {'1': 'timestamp', '3': 2, '4': 1, '5': 3, '10': 'timestamp'},
+  ],
+};
+
+/// Descriptor for `GaugeValue`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List gaugeValueDescriptor = $convert.base64Decode('CgpHYXVnZVZhbHVlEhQKBXZhbHVlGAEgASgDUgV2YWx1ZRIcCgl0aW1lc3RhbXAYAiABKANSCXRpbWVzdGFtcA==');
+@$core.Deprecated('Use gaugeMetricDescriptor instead')
+const GaugeMetric$json = const {
+  '1': 'GaugeMetric',
+  '2': const [
+    const {'1': 'key', '3': 1, '4': 1, '5': 11, '6': '.api.v1.MetricKey', '10': 'key'},
+    const {'1': 'attempted', '3': 2, '4': 1, '5': 11, '6': '.api.v1.GaugeValue', '10': 'attempted'},
+    const {'1': 'committed', '3': 3, '4': 1, '5': 11, '6': '.api.v1.GaugeValue', '10': 'committed'},
+  ],
+};
+
+/// Descriptor for `GaugeMetric`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List gaugeMetricDescriptor = $convert.base64Decode('CgtHYXVnZU1ldHJpYxIjCgNrZXkYASABKAsyES5hcGkudjEuTWV0cmljS2V5UgNrZXkSMAoJYXR0ZW1wdGVkGAIgASgLMhIuYXBpLnYxLkdhdWdlVmFsdWVSCWF0dGVtcHRlZBIwCgljb21taXR0ZWQYAyABKAsyEi5hcGkudjEuR2F1Z2VWYWx1ZVIJY29tbWl0dGVk');
+@$core.Deprecated('Use getRunMetricsResponseDescriptor instead')
+const GetRunMetricsResponse$json = const {
+  '1': 'GetRunMetricsResponse',
+  '2': const [
+    const {'1': 'counters', '3': 1, '4': 3, '5': 11, '6': '.api.v1.CounterMetric', '10': 'counters'},
+    const {'1': 'distributions', '3': 2, '4': 3, '5': 11, '6': '.api.v1.DistributionMetric', '10': 'distributions'},
+    const {'1': 'gauges', '3': 3, '4': 3, '5': 11, '6': '.api.v1.GaugeMetric', '10': 'gauges'},
+  ],
+};
+
+/// Descriptor for `GetRunMetricsResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getRunMetricsResponseDescriptor = $convert.base64Decode('ChVHZXRSdW5NZXRyaWNzUmVzcG9uc2USMQoIY291bnRlcnMYASADKAsyFS5hcGkudjEuQ291bnRlck1ldHJpY1IIY291bnRlcnMSQAoNZGlzdHJpYnV0aW9ucxgCIAMoCzIaLmFwaS52MS5EaXN0cmlidXRpb25NZXRyaWNSDWRpc3RyaWJ1dGlvbnMSKwoGZ2F1Z2VzGAMgAygLMhMuYXBpLnYxLkdhdWdlTWV0cmljUgZnYXVnZXM=');
 @$core.Deprecated('Use cancelRequestDescriptor instead')
 const CancelRequest$json = const {
   '1': 'CancelRequest',
@@ -515,11 +674,13 @@ const SaveSnippetRequest$json = const {
     const {'1': 'pipeline_options', '3': 3, '4': 1, '5': 9, '10': 'pipelineOptions'},
     const {'1': 'complexity', '3': 4, '4': 1, '5': 14, '6': '.api.v1.Complexity', '10': 'complexity'},
     const {'1': 'persistence_key', '3': 5, '4': 1, '5': 9, '10': 'persistenceKey'},
+    const {'1': 'parent_id', '3': 6, '4': 1, '5': 9, '10': 'parentId'},
+    const {'1': 'author_token', '3': 7, '4': 1, '5': 9, '10': 'authorToken'},
   ],
 };
 
 /// Descriptor for `SaveSnippetRequest`. Decode as a `google.protobuf.DescriptorProto`.
-final $typed_data.Uint8List saveSnippetRequestDescriptor = $convert.base64Decode('ChJTYXZlU25pcHBldFJlcXVlc3QSKQoFZmlsZXMYASADKAsyEy5hcGkudjEuU25pcHBldEZpbGVSBWZpbGVzEh0KA3NkaxgCIAEoDjILLmFwaS52MS5TZGtSA3NkaxIpChBwaXBlbGluZV9vcHRpb25zGAMgASgJUg9waXBlbGluZU9wdGlvbnMSMgoKY29tcGxleGl0eRgEIAEoDjISLmFwaS52MS5Db21wbGV4aXR5Ugpjb21wbGV4aXR5EicKD3BlcnNpc3RlbmNlX2tleRgFIAEoCVIOcGVyc2lzdGVuY2VLZXk=');
+final $typed_data.Uint8List saveSnippetRequestDescriptor = $convert.base64Decode('ChJTYXZlU25pcHBldFJlcXVlc3QSKQoFZmlsZXMYASADKAsyEy5hcGkudjEuU25pcHBldEZpbGVSBWZpbGVzEh0KA3NkaxgCIAEoDjILLmFwaS52MS5TZGtSA3NkaxIpChBwaXBlbGluZV9vcHRpb25zGAMgASgJUg9waXBlbGluZU9wdGlvbnMSMgoKY29tcGxleGl0eRgEIAEoDjISLmFwaS52MS5Db21wbGV4aXR5Ugpjb21wbGV4aXR5EicKD3BlcnNpc3RlbmNlX2tleRgFIAEoCVIOcGVyc2lzdGVuY2VLZXkSGwoJcGFyZW50X2lkGAYgASgJUghwYXJlbnRJZBIhCgxhdXRob3JfdG9rZW4YByABKAlSC2F1dGhvclRva2Vu');
 @$core.Deprecated('Use saveSnippetResponseDescriptor instead')
 const SaveSnippetResponse$json = const {
   '1': 'SaveSnippetResponse',
@@ -548,11 +709,101 @@ const GetSnippetResponse$json = const {
     const {'1': 'sdk', '3': 2, '4': 1, '5': 14, '6': '.api.v1.Sdk', '10': 'sdk'},
     const {'1': 'pipeline_options', '3': 3, '4': 1, '5': 9, '10': 'pipelineOptions'},
     const {'1': 'complexity', '3': 4, '4': 1, '5': 14, '6': '.api.v1.Complexity', '10': 'complexity'},
+    const {'1': 'parent_id', '3': 5, '4': 1, '5': 9, '10': 'parentId'},
+    const {'1': 'revision', '3': 6, '4': 1, '5': 5, '10': 'revision'},
   ],
 };
 
 /// Descriptor for `GetSnippetResponse`. Decode as a `google.protobuf.DescriptorProto`.
-final $typed_data.Uint8List getSnippetResponseDescriptor = $convert.base64Decode('ChJHZXRTbmlwcGV0UmVzcG9uc2USKQoFZmlsZXMYASADKAsyEy5hcGkudjEuU25pcHBldEZpbGVSBWZpbGVzEh0KA3NkaxgCIAEoDjILLmFwaS52MS5TZGtSA3NkaxIpChBwaXBlbGluZV9vcHRpb25zGAMgASgJUg9waXBlbGluZU9wdGlvbnMSMgoKY29tcGxleGl0eRgEIAEoDjISLmFwaS52MS5Db21wbGV4aXR5Ugpjb21wbGV4aXR5');
+final $typed_data.Uint8List getSnippetResponseDescriptor = $convert.base64Decode('ChJHZXRTbmlwcGV0UmVzcG9uc2USKQoFZmlsZXMYASADKAsyEy5hcGkudjEuU25pcHBldEZpbGVSBWZpbGVzEh0KA3NkaxgCIAEoDjILLmFwaS52MS5TZGtSA3NkaxIpChBwaXBlbGluZV9vcHRpb25zGAMgASgJUg9waXBlbGluZU9wdGlvbnMSMgoKY29tcGxleGl0eRgEIAEoDjISLmFwaS52MS5Db21wbGV4aXR5Ugpjb21wbGV4aXR5EhsKCXBhcmVudF9pZBgFIAEoCVIIcGFyZW50SWQSGgoIcmV2aXNpb24YBiABKAVSCHJldmlzaW9u');
+@$core.Deprecated('Use forkSnippetRequestDescriptor instead')
+const ForkSnippetRequest$json = const {
+  '1': 'ForkSnippetRequest',
+  '2': const [
+    const {'1': 'id', '3': 1, '4': 1, '5': 9, '10': 'id'},
+    const {'1': 'author_token', '3': 2, '4': 1, '5': 9, '10': 'authorToken'},
+  ],
+};
+
+/// Descriptor for `ForkSnippetRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List forkSnippetRequestDescriptor = $convert.base64Decode('ChJGb3JrU25pcHBldFJlcXVlc3QSDgoCaWQYASABKAlSAmlkEiEKDGF1dGhvcl90b2tlbhgCIAEoCVILYXV0aG9yVG9rZW4=');
+@$core.Deprecated('Use forkSnippetResponseDescriptor instead')
+const ForkSnippetResponse$json = const {
+  '1': 'ForkSnippetResponse',
+  '2': const [
+    const {'1': 'id', '3': 1, '4': 1, '5': 9, '10': 'id'},
+  ],
+};
+
+/// Descriptor for `ForkSnippetResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List forkSnippetResponseDescriptor = $convert.base64Decode('ChNGb3JrU25pcHBldFJlc3BvbnNlEg4KAmlkGAEgASgJUgJpZA==');
+@$core.Deprecated('Use listSnippetRevisionsRequestDescriptor instead')
+const ListSnippetRevisionsRequest$json = const {
+  '1': 'ListSnippetRevisionsRequest',
+  '2': const [
+    const {'1': 'id', '3': 1, '4': 1, '5': 9, '10': 'id'},
+  ],
+};
+
+/// Descriptor for `ListSnippetRevisionsRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List listSnippetRevisionsRequestDescriptor = $convert.base64Decode('ChtMaXN0U25pcHBldFJldmlzaW9uc1JlcXVlc3QSDgoCaWQYASABKAlSAmlk');
+@$core.Deprecated('Use snippetRevisionDescriptor instead')
+const SnippetRevision$json = const {
+  '1': 'SnippetRevision',
+  '2': const [
+    const {'1': 'id', '3': 1, '4': 1, '5': 9, '10': 'id'},
+    const {'1': 'parent_id', '3': 2, '4': 1, '5': 9, '10': 'parentId'},
+    const {'1': 'revision', '3': 3, '4': 1, '5': 5, '10': 'revision'},
+    const {'1': 'created', '3': 5, '4': 1, '5': 3, '10': 'created'},
+    const {'1': 'author_hash', '3': 6, '4': 1, '5': 9, '10': 'authorHash'},
+  ],
+};
+
+/// Descriptor for `SnippetRevision`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List snippetRevisionDescriptor = $convert.base64Decode('Cg9TbmlwcGV0UmV2aXNpb24SDgoCaWQYASABKAlSAmlkEhsKCXBhcmVudF9pZBgCIAEoCVIIcGFyZW50SWQSGgoIcmV2aXNpb24YAyABKAVSCHJldmlzaW9uEhgKB2NyZWF0ZWQYBSABKANSB2NyZWF0ZWQSHwoLYXV0aG9yX2hhc2gYBiABKAlSCmF1dGhvckhhc2hKBAgEEAVSDGF1dGhvcl90b2tlbg==');
+@$core.Deprecated('Use listSnippetRevisionsResponseDescriptor instead')
+const ListSnippetRevisionsResponse$json = const {
+  '1': 'ListSnippetRevisionsResponse',
+  '2': const [
+    const {'1': 'revisions', '3': 1, '4': 3, '5': 11, '6': '.api.v1.SnippetRevision', '10': 'revisions'},
+    const {'1': 'descendants', '3': 2, '4': 3, '5': 11, '6': '.api.v1.SnippetRevision', '10': 'descendants'},
+  ],
+};
+
+/// Descriptor for `ListSnippetRevisionsResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List listSnippetRevisionsResponseDescriptor = $convert.base64Decode('ChxMaXN0U25pcHBldFJldmlzaW9uc1Jlc3BvbnNlEjUKCXJldmlzaW9ucxgBIAMoCzIXLmFwaS52MS5TbmlwcGV0UmV2aXNpb25SCXJldmlzaW9ucxI5CgtkZXNjZW5kYW50cxgCIAMoCzIXLmFwaS52MS5TbmlwcGV0UmV2aXNpb25SC2Rlc2NlbmRhbnRz');
+@$core.Deprecated('Use getSnippetDiffRequestDescriptor instead')
+const GetSnippetDiffRequest$json = const {
+  '1': 'GetSnippetDiffRequest',
+  '2': const [
+    const {'1': 'from_id', '3': 1, '4': 1, '5': 9, '10': 'fromId'},
+    const {'1': 'to_id', '3': 2, '4': 1, '5': 9, '10': 'toId'},
+  ],
+};
+
+/// Descriptor for `GetSnippetDiffRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getSnippetDiffRequestDescriptor = $convert.base64Decode('ChVHZXRTbmlwcGV0RGlmZlJlcXVlc3QSFwoHZnJvbV9pZBgBIAEoCVIGZnJvbUlkEhMKBXRvX2lkGAIgASgJUgR0b0lk');
+@$core.Deprecated('Use snippetFileDiffDescriptor instead')
+const SnippetFileDiff$json = const {
+  '1': 'SnippetFileDiff',
+  '2': const [
+    const {'1': 'name', '3': 1, '4': 1, '5': 9, '10': 'name'},
+    const {'1': 'diff', '3': 2, '4': 1, '5': 9, '10': 'diff'},
+  ],
+};
+
+/// Descriptor for `SnippetFileDiff`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List snippetFileDiffDescriptor = $convert.base64Decode('Cg9TbmlwcGV0RmlsZURpZmYSEgoEbmFtZRgBIAEoCVIEbmFtZRISCgRkaWZmGAIgASgJUgRkaWZm');
+@$core.Deprecated('Use getSnippetDiffResponseDescriptor instead')
+const GetSnippetDiffResponse$json = const {
+  '1': 'GetSnippetDiffResponse',
+  '2': const [
+    const {'1': 'files', '3': 1, '4': 3, '5': 11, '6': '.api.v1.SnippetFileDiff', '10': 'files'},
+  ],
+};
+
+/// Descriptor for `GetSnippetDiffResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getSnippetDiffResponseDescriptor = $convert.base64Decode('ChZHZXRTbmlwcGV0RGlmZlJlc3BvbnNlEi0KBWZpbGVzGAEgAygLMhcuYXBpLnYxLlNuaXBwZXRGaWxlRGlmZlIFZmlsZXM=');
 @$core.Deprecated('Use getMetadataRequestDescriptor instead')
 const GetMetadataRequest$json = const {
   '1': 'GetMetadataRequest',
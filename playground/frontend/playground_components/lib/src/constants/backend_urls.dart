@@ -28,6 +28,7 @@ const backendUrlOverrides = <String, String>{
   // 'java': 'https://java.play-dev.beam.apache.org',
   // 'python': 'https://python.play-dev.beam.apache.org',
   // 'scio': 'https://scio.play-dev.beam.apache.org',
+  // 'typescript': 'https://typescript.play-dev.beam.apache.org',
 };
 
 /// The URL templates that will not be probed
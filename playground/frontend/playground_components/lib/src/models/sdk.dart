@@ -23,6 +23,7 @@ import 'package:highlight/languages/go.dart' as mode_go;
 import 'package:highlight/languages/java.dart' as mode_java;
 import 'package:highlight/languages/python.dart' as mode_python;
 import 'package:highlight/languages/scala.dart' as mode_scala;
+import 'package:highlight/languages/typescript.dart' as mode_typescript;
 import 'package:json_annotation/json_annotation.dart';
 
 part 'sdk.g.dart';
@@ -45,12 +46,14 @@ class Sdk with EquatableMixin {
   static const go = Sdk(id: 'go', title: 'Go');
   static const python = Sdk(id: 'python', title: 'Python');
   static const scio = Sdk(id: 'scio', title: 'SCIO');
+  static const typescript = Sdk(id: 'typescript', title: 'TypeScript');
 
   static const known = [
     java,
     go,
     python,
     scio,
+    typescript,
   ];
 
   @override
@@ -70,6 +73,8 @@ class Sdk with EquatableMixin {
         return '.py';
       case 'scio':
         return '.scala';
+      case 'typescript':
+        return '.ts';
     }
     throw Exception('Unknown SDK: $id');
   }
@@ -97,6 +102,10 @@ class Sdk with EquatableMixin {
       return scio;
     }
 
+    if (path.startsWith('SDK_TYPESCRIPT')) {
+      return typescript;
+    }
+
     return null;
   }
 
@@ -113,6 +122,7 @@ class Sdk with EquatableMixin {
     Sdk.go.id: mode_go.go,
     Sdk.python.id: mode_python.python,
     Sdk.scio.id: mode_scala.scala,
+    Sdk.typescript.id: mode_typescript.typescript,
   };
 
   Mode? get highlightMode => _idToHighlightMode[id];
@@ -253,6 +253,7 @@ class GrpcCodeClient implements CodeClient {
     switch (status) {
       case grpc.Status.STATUS_UNSPECIFIED:
         return RunCodeStatus.unspecified;
+      case grpc.Status.STATUS_QUEUED:
       case grpc.Status.STATUS_VALIDATING:
       case grpc.Status.STATUS_PREPARING:
         return RunCodeStatus.preparation;
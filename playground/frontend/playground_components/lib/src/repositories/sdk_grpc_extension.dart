@@ -25,6 +25,7 @@ extension SdkExtension on Sdk {
     Sdk.go.id: g.Sdk.SDK_GO,
     Sdk.python.id: g.Sdk.SDK_PYTHON,
     Sdk.scio.id: g.Sdk.SDK_SCIO,
+    Sdk.typescript.id: g.Sdk.SDK_TYPESCRIPT,
   };
 
   g.Sdk get grpc =>
@@ -42,6 +43,8 @@ extension GrpcSdkExtension on g.Sdk {
         return Sdk.python;
       case g.Sdk.SDK_SCIO:
         return Sdk.scio;
+      case g.Sdk.SDK_TYPESCRIPT:
+        return Sdk.typescript;
     }
 
     return Sdk(id: '$value', title: name);
@@ -13,7 +13,7 @@ _sym_db = _symbol_database.Default()
 
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x10\x61pi/v1/api.proto\x12\x06\x61pi.v1\"\xca\x01\n\x07\x44\x61taset\x12(\n\x04type\x18\x01 \x01(\x0e\x32\x14.api.v1.EmulatorTypeR\x04type\x12\x36\n\x07options\x18\x02 \x03(\x0b\x32\x1c.api.v1.Dataset.OptionsEntryR\x07options\x12!\n\x0c\x64\x61taset_path\x18\x03 \x01(\tR\x0b\x64\x61tasetPath\x1a:\n\x0cOptionsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x02\x38\x01\"\xc6\x01\n\x0eRunCodeRequest\x12\x12\n\x04\x63ode\x18\x01 \x01(\tR\x04\x63ode\x12\x1d\n\x03sdk\x18\x02 \x01(\x0e\x32\x0b.api.v1.SdkR\x03sdk\x12)\n\x10pipeline_options\x18\x03 \x01(\tR\x0fpipelineOptions\x12+\n\x08\x64\x61tasets\x18\x04 \x03(\x0b\x32\x0f.api.v1.DatasetR\x08\x64\x61tasets\x12)\n\x05\x66iles\x18\x05 \x03(\x0b\x32\x13.api.v1.SnippetFileR\x05\x66iles\"6\n\x0fRunCodeResponse\x12#\n\rpipeline_uuid\x18\x01 \x01(\tR\x0cpipelineUuid\"9\n\x12\x43heckStatusRequest\x12#\n\rpipeline_uuid\x18\x01 \x01(\tR\x0cpipelineUuid\"=\n\x13\x43heckStatusResponse\x12&\n\x06status\x18\x01 \x01(\x0e\x32\x0e.api.v1.StatusR\x06status\"A\n\x1aGetValidationOutputRequest\x12#\n\rpipeline_uuid\x18\x01 \x01(\tR\x0cpipelineUuid\"5\n\x1bGetValidationOutputResponse\x12\x16\n\x06output\x18\x01 \x01(\tR\x06output\"B\n\x1bGetPreparationOutputRequest\x12#\n\rpipeline_uuid\x18\x01 \x01(\tR\x0cpipelineUuid\"6\n\x1cGetPreparationOutputResponse\x12\x16\n\x06output\x18\x01 \x01(\tR\x06output\">\n\x17GetCompileOutputRequest\x12#\n\rpipeline_uuid\x18\x01 \x01(\tR\x0cpipelineUuid\"2\n\x18GetCompileOutputResponse\x12\x16\n\x06output\x18\x01 \x01(\tR\x06output\":\n\x13GetRunOutputRequest\x12#\n\rpipeline_uuid\x18\x01 \x01(\tR\x0cpipelineUuid\".\n\x14GetRunOutputResponse\x12\x16\n\x06output\x18\x01 \x01(\tR\x06output\"9\n\x12GetRunErrorRequest\x12#\n\rpipeline_uuid\x18\x01 \x01(\tR\x0cpipelineUuid\"-\n\x13GetRunErrorResponse\x12\x16\n\x06output\x18\x01 \x01(\tR\x06output\"5\n\x0eGetLogsRequest\x12#\n\rpipeline_uuid\x18\x01 \x01(\tR\x0cpipelineUuid\")\n\x0fGetLogsResponse\x12\x16\n\x06output\x18\x01 \x01(\tR\x06output\"6\n\x0fGetGraphRequest\x12#\n\rpipeline_uuid\x18\x01 \x01(\tR\x0cpipelineUuid\"(\n\x10GetGraphResponse\x12\x14\n\x05graph\x18\x01 \x01(\tR\x05graph\"4\n\rCancelRequest\x12#\n\rpipeline_uuid\x18\x01 \x01(\tR\x0cpipelineUuid\"\x10\n\x0e\x43\x61ncelResponse\"\xd0\x04\n\x11PrecompiledObject\x12\x1d\n\ncloud_path\x18\x01 \x01(\tR\tcloudPath\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12 \n\x0b\x64\x65scription\x18\x03 \x01(\tR\x0b\x64\x65scription\x12\x31\n\x04type\x18\x04 \x01(\x0e\x32\x1d.api.v1.PrecompiledObjectTypeR\x04type\x12)\n\x10pipeline_options\x18\x05 \x01(\tR\x0fpipelineOptions\x12\x12\n\x04link\x18\x06 \x01(\tR\x04link\x12\x1c\n\tmultifile\x18\x07 \x01(\x08R\tmultifile\x12!\n\x0c\x63ontext_line\x18\x08 \x01(\x05R\x0b\x63ontextLine\x12\'\n\x0f\x64\x65\x66\x61ult_example\x18\t \x01(\x08R\x0e\x64\x65\x66\x61ultExample\x12\x1d\n\x03sdk\x18\n \x01(\x0e\x32\x0b.api.v1.SdkR\x03sdk\x12\x32\n\ncomplexity\x18\x0b \x01(\x0e\x32\x12.api.v1.ComplexityR\ncomplexity\x12\x12\n\x04tags\x18\x0c \x03(\tR\x04tags\x12+\n\x08\x64\x61tasets\x18\r \x03(\x0b\x32\x0f.api.v1.DatasetR\x08\x64\x61tasets\x12\x17\n\x07url_vcs\x18\x0e \x01(\tR\x06urlVcs\x12!\n\x0curl_notebook\x18\x0f \x01(\tR\x0burlNotebook\x12\x1d\n\nalways_run\x18\x10 \x01(\x08R\talwaysRun\x12\x1b\n\tnever_run\x18\x11 \x01(\x08R\x08neverRun\"\xe5\x01\n\nCategories\x12\x1d\n\x03sdk\x18\x01 \x01(\x0e\x32\x0b.api.v1.SdkR\x03sdk\x12;\n\ncategories\x18\x02 \x03(\x0b\x32\x1b.api.v1.Categories.CategoryR\ncategories\x1a{\n\x08\x43\x61tegory\x12#\n\rcategory_name\x18\x01 \x01(\tR\x0c\x63\x61tegoryName\x12J\n\x13precompiled_objects\x18\x02 \x03(\x0b\x32\x19.api.v1.PrecompiledObjectR\x12precompiledObjects\"Y\n\x1cGetPrecompiledObjectsRequest\x12\x1d\n\x03sdk\x18\x01 \x01(\x0e\x32\x0b.api.v1.SdkR\x03sdk\x12\x1a\n\x08\x63\x61tegory\x18\x02 \x01(\tR\x08\x63\x61tegory\"<\n\x1bGetPrecompiledObjectRequest\x12\x1d\n\ncloud_path\x18\x01 \x01(\tR\tcloudPath\"@\n\x1fGetPrecompiledObjectCodeRequest\x12\x1d\n\ncloud_path\x18\x01 \x01(\tR\tcloudPath\"B\n!GetPrecompiledObjectOutputRequest\x12\x1d\n\ncloud_path\x18\x01 \x01(\tR\tcloudPath\"@\n\x1fGetPrecompiledObjectLogsRequest\x12\x1d\n\ncloud_path\x18\x01 \x01(\tR\tcloudPath\"A\n GetPrecompiledObjectGraphRequest\x12\x1d\n\ncloud_path\x18\x01 \x01(\tR\tcloudPath\"C\n\"GetDefaultPrecompiledObjectRequest\x12\x1d\n\x03sdk\x18\x01 \x01(\x0e\x32\x0b.api.v1.SdkR\x03sdk\"Z\n\x1dGetPrecompiledObjectsResponse\x12\x39\n\x0esdk_categories\x18\x01 \x03(\x0b\x32\x12.api.v1.CategoriesR\rsdkCategories\"h\n\x1cGetPrecompiledObjectResponse\x12H\n\x12precompiled_object\x18\x01 \x01(\x0b\x32\x19.api.v1.PrecompiledObjectR\x11precompiledObject\"a\n GetPrecompiledObjectCodeResponse\x12\x12\n\x04\x63ode\x18\x01 \x01(\tR\x04\x63ode\x12)\n\x05\x66iles\x18\x02 \x03(\x0b\x32\x13.api.v1.SnippetFileR\x05\x66iles\"<\n\"GetPrecompiledObjectOutputResponse\x12\x16\n\x06output\x18\x01 \x01(\tR\x06output\":\n GetPrecompiledObjectLogsResponse\x12\x16\n\x06output\x18\x01 \x01(\tR\x06output\"9\n!GetPrecompiledObjectGraphResponse\x12\x14\n\x05graph\x18\x01 \x01(\tR\x05graph\"o\n#GetDefaultPrecompiledObjectResponse\x12H\n\x12precompiled_object\x18\x01 \x01(\x0b\x32\x19.api.v1.PrecompiledObjectR\x11precompiledObject\"T\n\x0bSnippetFile\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n\x07\x63ontent\x18\x02 \x01(\tR\x07\x63ontent\x12\x17\n\x07is_main\x18\x03 \x01(\x08R\x06isMain\"\xe6\x01\n\x12SaveSnippetRequest\x12)\n\x05\x66iles\x18\x01 \x03(\x0b\x32\x13.api.v1.SnippetFileR\x05\x66iles\x12\x1d\n\x03sdk\x18\x02 \x01(\x0e\x32\x0b.api.v1.SdkR\x03sdk\x12)\n\x10pipeline_options\x18\x03 \x01(\tR\x0fpipelineOptions\x12\x32\n\ncomplexity\x18\x04 \x01(\x0e\x32\x12.api.v1.ComplexityR\ncomplexity\x12\'\n\x0fpersistence_key\x18\x05 \x01(\tR\x0epersistenceKey\"%\n\x13SaveSnippetResponse\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\"#\n\x11GetSnippetRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\"\xbd\x01\n\x12GetSnippetResponse\x12)\n\x05\x66iles\x18\x01 \x03(\x0b\x32\x13.api.v1.SnippetFileR\x05\x66iles\x12\x1d\n\x03sdk\x18\x02 \x01(\x0e\x32\x0b.api.v1.SdkR\x03sdk\x12)\n\x10pipeline_options\x18\x03 \x01(\tR\x0fpipelineOptions\x12\x32\n\ncomplexity\x18\x04 \x01(\x0e\x32\x12.api.v1.ComplexityR\ncomplexity\"\x14\n\x12GetMetadataRequest\"\xe5\x01\n\x13GetMetadataResponse\x12\x1d\n\nrunner_sdk\x18\x01 \x01(\tR\trunnerSdk\x12*\n\x11\x62uild_commit_hash\x18\x02 \x01(\tR\x0f\x62uildCommitHash\x12Y\n*build_commit_timestamp_seconds_since_epoch\x18\x03 \x01(\x03R%buildCommitTimestampSecondsSinceEpoch\x12(\n\x10\x62\x65\x61m_sdk_version\x18\x04 \x01(\tR\x0e\x62\x65\x61mSdkVersion*R\n\x03Sdk\x12\x13\n\x0fSDK_UNSPECIFIED\x10\x00\x12\x0c\n\x08SDK_JAVA\x10\x01\x12\n\n\x06SDK_GO\x10\x02\x12\x0e\n\nSDK_PYTHON\x10\x03\x12\x0c\n\x08SDK_SCIO\x10\x04*\xb8\x02\n\x06Status\x12\x16\n\x12STATUS_UNSPECIFIED\x10\x00\x12\x15\n\x11STATUS_VALIDATING\x10\x01\x12\x1b\n\x17STATUS_VALIDATION_ERROR\x10\x02\x12\x14\n\x10STATUS_PREPARING\x10\x03\x12\x1c\n\x18STATUS_PREPARATION_ERROR\x10\x04\x12\x14\n\x10STATUS_COMPILING\x10\x05\x12\x18\n\x14STATUS_COMPILE_ERROR\x10\x06\x12\x14\n\x10STATUS_EXECUTING\x10\x07\x12\x13\n\x0fSTATUS_FINISHED\x10\x08\x12\x14\n\x10STATUS_RUN_ERROR\x10\t\x12\x10\n\x0cSTATUS_ERROR\x10\n\x12\x16\n\x12STATUS_RUN_TIMEOUT\x10\x0b\x12\x13\n\x0fSTATUS_CANCELED\x10\x0c*\xae\x01\n\x15PrecompiledObjectType\x12\'\n#PRECOMPILED_OBJECT_TYPE_UNSPECIFIED\x10\x00\x12#\n\x1fPRECOMPILED_OBJECT_TYPE_EXAMPLE\x10\x01\x12 \n\x1cPRECOMPILED_OBJECT_TYPE_KATA\x10\x02\x12%\n!PRECOMPILED_OBJECT_TYPE_UNIT_TEST\x10\x03*n\n\nComplexity\x12\x1a\n\x16\x43OMPLEXITY_UNSPECIFIED\x10\x00\x12\x14\n\x10\x43OMPLEXITY_BASIC\x10\x01\x12\x15\n\x11\x43OMPLEXITY_MEDIUM\x10\x02\x12\x17\n\x13\x43OMPLEXITY_ADVANCED\x10\x03*F\n\x0c\x45mulatorType\x12\x1d\n\x19\x45MULATOR_TYPE_UNSPECIFIED\x10\x00\x12\x17\n\x13\x45MULATOR_TYPE_KAFKA\x10\x01\x32\xd3\r\n\x11PlaygroundService\x12:\n\x07RunCode\x12\x16.api.v1.RunCodeRequest\x1a\x17.api.v1.RunCodeResponse\x12\x46\n\x0b\x43heckStatus\x12\x1a.api.v1.CheckStatusRequest\x1a\x1b.api.v1.CheckStatusResponse\x12I\n\x0cGetRunOutput\x12\x1b.api.v1.GetRunOutputRequest\x1a\x1c.api.v1.GetRunOutputResponse\x12:\n\x07GetLogs\x12\x16.api.v1.GetLogsRequest\x1a\x17.api.v1.GetLogsResponse\x12=\n\x08GetGraph\x12\x17.api.v1.GetGraphRequest\x1a\x18.api.v1.GetGraphResponse\x12\x46\n\x0bGetRunError\x12\x1a.api.v1.GetRunErrorRequest\x1a\x1b.api.v1.GetRunErrorResponse\x12^\n\x13GetValidationOutput\x12\".api.v1.GetValidationOutputRequest\x1a#.api.v1.GetValidationOutputResponse\x12\x61\n\x14GetPreparationOutput\x12#.api.v1.GetPreparationOutputRequest\x1a$.api.v1.GetPreparationOutputResponse\x12U\n\x10GetCompileOutput\x12\x1f.api.v1.GetCompileOutputRequest\x1a .api.v1.GetCompileOutputResponse\x12\x37\n\x06\x43\x61ncel\x12\x15.api.v1.CancelRequest\x1a\x16.api.v1.CancelResponse\x12\x64\n\x15GetPrecompiledObjects\x12$.api.v1.GetPrecompiledObjectsRequest\x1a%.api.v1.GetPrecompiledObjectsResponse\x12\x61\n\x14GetPrecompiledObject\x12#.api.v1.GetPrecompiledObjectRequest\x1a$.api.v1.GetPrecompiledObjectResponse\x12m\n\x18GetPrecompiledObjectCode\x12\'.api.v1.GetPrecompiledObjectCodeRequest\x1a(.api.v1.GetPrecompiledObjectCodeResponse\x12s\n\x1aGetPrecompiledObjectOutput\x12).api.v1.GetPrecompiledObjectOutputRequest\x1a*.api.v1.GetPrecompiledObjectOutputResponse\x12m\n\x18GetPrecompiledObjectLogs\x12\'.api.v1.GetPrecompiledObjectLogsRequest\x1a(.api.v1.GetPrecompiledObjectLogsResponse\x12p\n\x19GetPrecompiledObjectGraph\x12(.api.v1.GetPrecompiledObjectGraphRequest\x1a).api.v1.GetPrecompiledObjectGraphResponse\x12v\n\x1bGetDefaultPrecompiledObject\x12*.api.v1.GetDefaultPrecompiledObjectRequest\x1a+.api.v1.GetDefaultPrecompiledObjectResponse\x12\x46\n\x0bSaveSnippet\x12\x1a.api.v1.SaveSnippetRequest\x1a\x1b.api.v1.SaveSnippetResponse\x12\x43\n\nGetSnippet\x12\x19.api.v1.GetSnippetRequest\x1a\x1a.api.v1.GetSnippetResponse\x12\x46\n\x0bGetMetadata\x12\x1a.api.v1.GetMetadataRequest\x1a\x1b.api.v1.GetMetadataResponseB8Z6beam.apache.org/playground/backend/internal;playgroundb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x10\x61pi/v1/api.proto\x12\x06\x61pi.v1\"\xca\x01\n\x07\x44\x61taset\x12(\n\x04type\x18\x01 \x01(\x0e\x32\x14.api.v1.EmulatorTypeR\x04type\x12\x36\n\x07options\x18\x02 \x03(\x0b\x32\x1c.api.v1.Dataset.OptionsEntryR\x07options\x12!\n\x0c\x64\x61taset_path\x18\x03 \x01(\tR\x0b\x64\x61tasetPath\x1a:\n\x0cOptionsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x02\x38\x01\"\xc6\x01\n\x0eRunCodeRequest\x12\x12\n\x04\x63ode\x18\x01 \x01(\tR\x04\x63ode\x12\x1d\n\x03sdk\x18\x02 \x01(\x0e\x32\x0b.api.v1.SdkR\x03sdk\x12)\n\x10pipeline_options\x18\x03 \x01(\tR\x0fpipelineOptions\x12+\n\x08\x64\x61tasets\x18\x04 \x03(\x0b\x32\x0f.api.v1.DatasetR\x08\x64\x61tasets\x12)\n\x05\x66iles\x18\x05 \x03(\x0b\x32\x13.api.v1.SnippetFileR\x05\x66iles\"6\n\x0fRunCodeResponse\x12#\n\rpipeline_uuid\x18\x01 \x01(\tR\x0cpipelineUuid\"9\n\x12\x43heckStatusRequest\x12#\n\rpipeline_uuid\x18\x01 \x01(\tR\x0cpipelineUuid\"=\n\x13\x43heckStatusResponse\x12&\n\x06status\x18\x01 \x01(\x0e\x32\x0e.api.v1.StatusR\x06status\"A\n\x1aGetValidationOutputRequest\x12#\n\rpipeline_uuid\x18\x01 \x01(\tR\x0cpipelineUuid\"5\n\x1bGetValidationOutputResponse\x12\x16\n\x06output\x18\x01 \x01(\tR\x06output\"B\n\x1bGetPreparationOutputRequest\x12#\n\rpipeline_uuid\x18\x01 \x01(\tR\x0cpipelineUuid\"6\n\x1cGetPreparationOutputResponse\x12\x16\n\x06output\x18\x01 \x01(\tR\x06output\">\n\x17GetCompileOutputRequest\x12#\n\rpipeline_uuid\x18\x01 \x01(\tR\x0cpipelineUuid\"2\n\x18GetCompileOutputResponse\x12\x16\n\x06output\x18\x01 \x01(\tR\x06output\":\n\x13GetRunOutputRequest\x12#\n\rpipeline_uuid\x18\x01 \x01(\tR\x0cpipelineUuid\".\n\x14GetRunOutputResponse\x12\x16\n\x06output\x18\x01 \x01(\tR\x06output\"9\n\x12GetRunErrorRequest\x12#\n\rpipeline_uuid\x18\x01 \x01(\tR\x0cpipelineUuid\"-\n\x13GetRunErrorResponse\x12\x16\n\x06output\x18\x01 \x01(\tR\x06output\"5\n\x0eGetLogsRequest\x12#\n\rpipeline_uuid\x18\x01 \x01(\tR\x0cpipelineUuid\")\n\x0fGetLogsResponse\x12\x16\n\x06output\x18\x01 \x01(\tR\x06output\"=\n\x16StreamRunOutputRequest\x12#\n\rpipeline_uuid\x18\x01 \x01(\tR\x0cpipelineUuid\"1\n\x17StreamRunOutputResponse\x12\x16\n\x06output\x18\x01 \x01(\tR\x06output\"8\n\x11StreamLogsRequest\x12#\n\rpipeline_uuid\x18\x01 \x01(\tR\x0cpipelineUuid\",\n\x12StreamLogsResponse\x12\x16\n\x06output\x18\x01 \x01(\tR\x06output\":\n\x13StreamStatusRequest\x12#\n\rpipeline_uuid\x18\x01 \x01(\tR\x0cpipelineUuid\">\n\x14StreamStatusResponse\x12&\n\x06status\x18\x01 \x01(\x0e\x32\x0e.api.v1.StatusR\x06status\"6\n\x0fGetGraphRequest\x12#\n\rpipeline_uuid\x18\x01 \x01(\tR\x0cpipelineUuid\"(\n\x10GetGraphResponse\x12\x14\n\x05graph\x18\x01 \x01(\tR\x05graph\";\n\x14GetRunMetricsRequest\x12#\n\rpipeline_uuid\x18\x01 \x01(\tR\x0cpipelineUuid\"Q\n\tMetricKey\x12\x12\n\x04step\x18\x01 \x01(\tR\x04step\x12\x1c\n\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x12\n\x04name\x18\x03 \x01(\tR\x04name\"p\n\rCounterMetric\x12#\n\x03key\x18\x01 \x01(\x0b\x32\x11.api.v1.MetricKeyR\x03key\x12\x1c\n\tattempted\x18\x02 \x01(\x03R\tattempted\x12\x1c\n\tcommitted\x18\x03 \x01(\x03R\tcommitted\"_\n\x11\x44istributionValue\x12\x14\n\x05\x63ount\x18\x01 \x01(\x03R\x05\x63ount\x12\x10\n\x03sum\x18\x02 \x01(\x03R\x03sum\x12\x10\n\x03min\x18\x03 \x01(\x03R\x03min\x12\x10\n\x03max\x18\x04 \x01(\x03R\x03max\"\xab\x01\n\x12\x44istributionMetric\x12#\n\x03key\x18\x01 \x01(\x0b\x32\x11.api.v1.MetricKeyR\x03key\x12\x37\n\tattempted\x18\x02 \x01(\x0b\x32\x19.api.v1.DistributionValueR\tattempted\x12\x37\n\tcommitted\x18\x03 \x01(\x0b\x32\x19.api.v1.DistributionValueR\tcommitted\"@\n\nGaugeValue\x12\x14\n\x05value\x18\x01 \x01(\x03R\x05value\x12\x1c\n\ttimestamp\x18\x02 \x01(\x03R\ttimestamp\"\x96\x01\n\x0bGaugeMetric\x12#\n\x03key\x18\x01 \x01(\x0b\x32\x11.api.v1.MetricKeyR\x03key\x12\x30\n\tattempted\x18\x02 \x01(\x0b\x32\x12.api.v1.GaugeValueR\tattempted\x12\x30\n\tcommitted\x18\x03 \x01(\x0b\x32\x12.api.v1.GaugeValueR\tcommitted\"\xb9\x01\n\x15GetRunMetricsResponse\x12\x31\n\x08\x63ounters\x18\x01 \x03(\x0b\x32\x15.api.v1.CounterMetricR\x08\x63ounters\x12@\n\rdistributions\x18\x02 \x03(\x0b\x32\x1a.api.v1.DistributionMetricR\rdistributions\x12+\n\x06gauges\x18\x03 \x03(\x0b\x32\x13.api.v1.GaugeMetricR\x06gauges\"4\n\rCancelRequest\x12#\n\rpipeline_uuid\x18\x01 \x01(\tR\x0cpipelineUuid\"\x10\n\x0e\x43\x61ncelResponse\"\xd0\x04\n\x11PrecompiledObject\x12\x1d\n\ncloud_path\x18\x01 \x01(\tR\tcloudPath\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12 \n\x0b\x64\x65scription\x18\x03 \x01(\tR\x0b\x64\x65scription\x12\x31\n\x04type\x18\x04 \x01(\x0e\x32\x1d.api.v1.PrecompiledObjectTypeR\x04type\x12)\n\x10pipeline_options\x18\x05 \x01(\tR\x0fpipelineOptions\x12\x12\n\x04link\x18\x06 \x01(\tR\x04link\x12\x1c\n\tmultifile\x18\x07 \x01(\x08R\tmultifile\x12!\n\x0c\x63ontext_line\x18\x08 \x01(\x05R\x0b\x63ontextLine\x12\'\n\x0f\x64\x65\x66\x61ult_example\x18\t \x01(\x08R\x0e\x64\x65\x66\x61ultExample\x12\x1d\n\x03sdk\x18\n \x01(\x0e\x32\x0b.api.v1.SdkR\x03sdk\x12\x32\n\ncomplexity\x18\x0b \x01(\x0e\x32\x12.api.v1.ComplexityR\ncomplexity\x12\x12\n\x04tags\x18\x0c \x03(\tR\x04tags\x12+\n\x08\x64\x61tasets\x18\r \x03(\x0b\x32\x0f.api.v1.DatasetR\x08\x64\x61tasets\x12\x17\n\x07url_vcs\x18\x0e \x01(\tR\x06urlVcs\x12!\n\x0curl_notebook\x18\x0f \x01(\tR\x0burlNotebook\x12\x1d\n\nalways_run\x18\x10 \x01(\x08R\talwaysRun\x12\x1b\n\tnever_run\x18\x11 \x01(\x08R\x08neverRun\"\xe5\x01\n\nCategories\x12\x1d\n\x03sdk\x18\x01 \x01(\x0e\x32\x0b.api.v1.SdkR\x03sdk\x12;\n\ncategories\x18\x02 \x03(\x0b\x32\x1b.api.v1.Categories.CategoryR\ncategories\x1a{\n\x08\x43\x61tegory\x12#\n\rcategory_name\x18\x01 \x01(\tR\x0c\x63\x61tegoryName\x12J\n\x13precompiled_objects\x18\x02 \x03(\x0b\x32\x19.api.v1.PrecompiledObjectR\x12precompiledObjects\"Y\n\x1cGetPrecompiledObjectsRequest\x12\x1d\n\x03sdk\x18\x01 \x01(\x0e\x32\x0b.api.v1.SdkR\x03sdk\x12\x1a\n\x08\x63\x61tegory\x18\x02 \x01(\tR\x08\x63\x61tegory\"<\n\x1bGetPrecompiledObjectRequest\x12\x1d\n\ncloud_path\x18\x01 \x01(\tR\tcloudPath\"@\n\x1fGetPrecompiledObjectCodeRequest\x12\x1d\n\ncloud_path\x18\x01 \x01(\tR\tcloudPath\"B\n!GetPrecompiledObjectOutputRequest\x12\x1d\n\ncloud_path\x18\x01 \x01(\tR\tcloudPath\"@\n\x1fGetPrecompiledObjectLogsRequest\x12\x1d\n\ncloud_path\x18\x01 \x01(\tR\tcloudPath\"A\n GetPrecompiledObjectGraphRequest\x12\x1d\n\ncloud_path\x18\x01 \x01(\tR\tcloudPath\"C\n\"GetDefaultPrecompiledObjectRequest\x12\x1d\n\x03sdk\x18\x01 \x01(\x0e\x32\x0b.api.v1.SdkR\x03sdk\"Z\n\x1dGetPrecompiledObjectsResponse\x12\x39\n\x0esdk_categories\x18\x01 \x03(\x0b\x32\x12.api.v1.CategoriesR\rsdkCategories\"h\n\x1cGetPrecompiledObjectResponse\x12H\n\x12precompiled_object\x18\x01 \x01(\x0b\x32\x19.api.v1.PrecompiledObjectR\x11precompiledObject\"a\n GetPrecompiledObjectCodeResponse\x12\x12\n\x04\x63ode\x18\x01 \x01(\tR\x04\x63ode\x12)\n\x05\x66iles\x18\x02 \x03(\x0b\x32\x13.api.v1.SnippetFileR\x05\x66iles\"<\n\"GetPrecompiledObjectOutputResponse\x12\x16\n\x06output\x18\x01 \x01(\tR\x06output\":\n GetPrecompiledObjectLogsResponse\x12\x16\n\x06output\x18\x01 \x01(\tR\x06output\"9\n!GetPrecompiledObjectGraphResponse\x12\x14\n\x05graph\x18\x01 \x01(\tR\x05graph\"o\n#GetDefaultPrecompiledObjectResponse\x12H\n\x12precompiled_object\x18\x01 \x01(\x0b\x32\x19.api.v1.PrecompiledObjectR\x11precompiledObject\"T\n\x0bSnippetFile\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n\x07\x63ontent\x18\x02 \x01(\tR\x07\x63ontent\x12\x17\n\x07is_main\x18\x03 \x01(\x08R\x06isMain\"\xa6\x02\n\x12SaveSnippetRequest\x12)\n\x05\x66iles\x18\x01 \x03(\x0b\x32\x13.api.v1.SnippetFileR\x05\x66iles\x12\x1d\n\x03sdk\x18\x02 \x01(\x0e\x32\x0b.api.v1.SdkR\x03sdk\x12)\n\x10pipeline_options\x18\x03 \x01(\tR\x0fpipelineOptions\x12\x32\n\ncomplexity\x18\x04 \x01(\x0e\x32\x12.api.v1.ComplexityR\ncomplexity\x12\'\n\x0fpersistence_key\x18\x05 \x01(\tR\x0epersistenceKey\x12\x1b\n\tparent_id\x18\x06 \x01(\tR\x08parentId\x12!\n\x0c\x61uthor_token\x18\x07 \x01(\tR\x0b\x61uthorToken\"%\n\x13SaveSnippetResponse\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\"#\n\x11GetSnippetRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\"\xf6\x01\n\x12GetSnippetResponse\x12)\n\x05\x66iles\x18\x01 \x03(\x0b\x32\x13.api.v1.SnippetFileR\x05\x66iles\x12\x1d\n\x03sdk\x18\x02 \x01(\x0e\x32\x0b.api.v1.SdkR\x03sdk\x12)\n\x10pipeline_options\x18\x03 \x01(\tR\x0fpipelineOptions\x12\x32\n\ncomplexity\x18\x04 \x01(\x0e\x32\x12.api.v1.ComplexityR\ncomplexity\x12\x1b\n\tparent_id\x18\x05 \x01(\tR\x08parentId\x12\x1a\n\x08revision\x18\x06 \x01(\x05R\x08revision\"G\n\x12\x46orkSnippetRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12!\n\x0c\x61uthor_token\x18\x02 \x01(\tR\x0b\x61uthorToken\"%\n\x13\x46orkSnippetResponse\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\"-\n\x1bListSnippetRevisionsRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\"\xa9\x01\n\x0fSnippetRevision\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n\tparent_id\x18\x02 \x01(\tR\x08parentId\x12\x1a\n\x08revision\x18\x03 \x01(\x05R\x08revision\x12\x18\n\x07\x63reated\x18\x05 \x01(\x03R\x07\x63reated\x12\x1f\n\x0b\x61uthor_hash\x18\x06 \x01(\tR\nauthorHashJ\x04\x08\x04\x10\x05R\x0c\x61uthor_token\"\x90\x01\n\x1cListSnippetRevisionsResponse\x12\x35\n\trevisions\x18\x01 \x03(\x0b\x32\x17.api.v1.SnippetRevisionR\trevisions\x12\x39\n\x0b\x64\x65scendants\x18\x02 \x03(\x0b\x32\x17.api.v1.SnippetRevisionR\x0b\x64\x65scendants\"E\n\x15GetSnippetDiffRequest\x12\x17\n\x07\x66rom_id\x18\x01 \x01(\tR\x06\x66romId\x12\x13\n\x05to_id\x18\x02 \x01(\tR\x04toId\"9\n\x0fSnippetFileDiff\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n\x04\x64iff\x18\x02 \x01(\tR\x04\x64iff\"G\n\x16GetSnippetDiffResponse\x12-\n\x05\x66iles\x18\x01 \x03(\x0b\x32\x17.api.v1.SnippetFileDiffR\x05\x66iles\"\x14\n\x12GetMetadataRequest\"\xe5\x01\n\x13GetMetadataResponse\x12\x1d\n\nrunner_sdk\x18\x01 \x01(\tR\trunnerSdk\x12*\n\x11\x62uild_commit_hash\x18\x02 \x01(\tR\x0f\x62uildCommitHash\x12Y\n*build_commit_timestamp_seconds_since_epoch\x18\x03 \x01(\x03R%buildCommitTimestampSecondsSinceEpoch\x12(\n\x10\x62\x65\x61m_sdk_version\x18\x04 \x01(\tR\x0e\x62\x65\x61mSdkVersion*f\n\x03Sdk\x12\x13\n\x0fSDK_UNSPECIFIED\x10\x00\x12\x0c\n\x08SDK_JAVA\x10\x01\x12\n\n\x06SDK_GO\x10\x02\x12\x0e\n\nSDK_PYTHON\x10\x03\x12\x0c\n\x08SDK_SCIO\x10\x04\x12\x12\n\x0eSDK_TYPESCRIPT\x10\x05*\xcb\x02\n\x06Status\x12\x16\n\x12STATUS_UNSPECIFIED\x10\x00\x12\x15\n\x11STATUS_VALIDATING\x10\x01\x12\x1b\n\x17STATUS_VALIDATION_ERROR\x10\x02\x12\x14\n\x10STATUS_PREPARING\x10\x03\x12\x1c\n\x18STATUS_PREPARATION_ERROR\x10\x04\x12\x14\n\x10STATUS_COMPILING\x10\x05\x12\x18\n\x14STATUS_COMPILE_ERROR\x10\x06\x12\x14\n\x10STATUS_EXECUTING\x10\x07\x12\x13\n\x0fSTATUS_FINISHED\x10\x08\x12\x14\n\x10STATUS_RUN_ERROR\x10\t\x12\x10\n\x0cSTATUS_ERROR\x10\n\x12\x16\n\x12STATUS_RUN_TIMEOUT\x10\x0b\x12\x13\n\x0fSTATUS_CANCELED\x10\x0c\x12\x11\n\rSTATUS_QUEUED\x10\r*\xae\x01\n\x15PrecompiledObjectType\x12\'\n#PRECOMPILED_OBJECT_TYPE_UNSPECIFIED\x10\x00\x12#\n\x1fPRECOMPILED_OBJECT_TYPE_EXAMPLE\x10\x01\x12 \n\x1cPRECOMPILED_OBJECT_TYPE_KATA\x10\x02\x12%\n!PRECOMPILED_OBJECT_TYPE_UNIT_TEST\x10\x03*n\n\nComplexity\x12\x1a\n\x16\x43OMPLEXITY_UNSPECIFIED\x10\x00\x12\x14\n\x10\x43OMPLEXITY_BASIC\x10\x01\x12\x15\n\x11\x43OMPLEXITY_MEDIUM\x10\x02\x12\x17\n\x13\x43OMPLEXITY_ADVANCED\x10\x03*\x8f\x01\n\x0c\x45mulatorType\x12\x1d\n\x19\x45MULATOR_TYPE_UNSPECIFIED\x10\x00\x12\x17\n\x13\x45MULATOR_TYPE_KAFKA\x10\x01\x12\x18\n\x14\x45MULATOR_TYPE_PUBSUB\x10\x02\x12\x15\n\x11\x45MULATOR_TYPE_GCS\x10\x03\x12\x16\n\x12\x45MULATOR_TYPE_JDBC\x10\x04\x32\x87\x12\n\x11PlaygroundService\x12:\n\x07RunCode\x12\x16.api.v1.RunCodeRequest\x1a\x17.api.v1.RunCodeResponse\x12\x46\n\x0b\x43heckStatus\x12\x1a.api.v1.CheckStatusRequest\x1a\x1b.api.v1.CheckStatusResponse\x12I\n\x0cGetRunOutput\x12\x1b.api.v1.GetRunOutputRequest\x1a\x1c.api.v1.GetRunOutputResponse\x12:\n\x07GetLogs\x12\x16.api.v1.GetLogsRequest\x1a\x17.api.v1.GetLogsResponse\x12T\n\x0fStreamRunOutput\x12\x1e.api.v1.StreamRunOutputRequest\x1a\x1f.api.v1.StreamRunOutputResponse0\x01\x12\x45\n\nStreamLogs\x12\x19.api.v1.StreamLogsRequest\x1a\x1a.api.v1.StreamLogsResponse0\x01\x12K\n\x0cStreamStatus\x12\x1b.api.v1.StreamStatusRequest\x1a\x1c.api.v1.StreamStatusResponse0\x01\x12=\n\x08GetGraph\x12\x17.api.v1.GetGraphRequest\x1a\x18.api.v1.GetGraphResponse\x12L\n\rGetRunMetrics\x12\x1c.api.v1.GetRunMetricsRequest\x1a\x1d.api.v1.GetRunMetricsResponse\x12\x46\n\x0bGetRunError\x12\x1a.api.v1.GetRunErrorRequest\x1a\x1b.api.v1.GetRunErrorResponse\x12^\n\x13GetValidationOutput\x12\".api.v1.GetValidationOutputRequest\x1a#.api.v1.GetValidationOutputResponse\x12\x61\n\x14GetPreparationOutput\x12#.api.v1.GetPreparationOutputRequest\x1a$.api.v1.GetPreparationOutputResponse\x12U\n\x10GetCompileOutput\x12\x1f.api.v1.GetCompileOutputRequest\x1a .api.v1.GetCompileOutputResponse\x12\x37\n\x06\x43\x61ncel\x12\x15.api.v1.CancelRequest\x1a\x16.api.v1.CancelResponse\x12\x64\n\x15GetPrecompiledObjects\x12$.api.v1.GetPrecompiledObjectsRequest\x1a%.api.v1.GetPrecompiledObjectsResponse\x12\x61\n\x14GetPrecompiledObject\x12#.api.v1.GetPrecompiledObjectRequest\x1a$.api.v1.GetPrecompiledObjectResponse\x12m\n\x18GetPrecompiledObjectCode\x12\'.api.v1.GetPrecompiledObjectCodeRequest\x1a(.api.v1.GetPrecompiledObjectCodeResponse\x12s\n\x1aGetPrecompiledObjectOutput\x12).api.v1.GetPrecompiledObjectOutputRequest\x1a*.api.v1.GetPrecompiledObjectOutputResponse\x12m\n\x18GetPrecompiledObjectLogs\x12\'.api.v1.GetPrecompiledObjectLogsRequest\x1a(.api.v1.GetPrecompiledObjectLogsResponse\x12p\n\x19GetPrecompiledObjectGraph\x12(.api.v1.GetPrecompiledObjectGraphRequest\x1a).api.v1.GetPrecompiledObjectGraphResponse\x12v\n\x1bGetDefaultPrecompiledObject\x12*.api.v1.GetDefaultPrecompiledObjectRequest\x1a+.api.v1.GetDefaultPrecompiledObjectResponse\x12\x46\n\x0bSaveSnippet\x12\x1a.api.v1.SaveSnippetRequest\x1a\x1b.api.v1.SaveSnippetResponse\x12\x43\n\nGetSnippet\x12\x19.api.v1.GetSnippetRequest\x1a\x1a.api.v1.GetSnippetResponse\x12\x46\n\x0b\x46orkSnippet\x12\x1a.api.v1.ForkSnippetRequest\x1a\x1b.api.v1.ForkSnippetResponse\x12\x61\n\x14ListSnippetRevisions\x12#.api.v1.ListSnippetRevisionsRequest\x1a$.api.v1.ListSnippetRevisionsResponse\x12O\n\x0eGetSnippetDiff\x12\x1d.api.v1.GetSnippetDiffRequest\x1a\x1e.api.v1.GetSnippetDiffResponse\x12\x46\n\x0bGetMetadata\x12\x1a.api.v1.GetMetadataRequest\x1a\x1b.api.v1.GetMetadataResponseB8Z6beam.apache.org/playground/backend/internal;playgroundb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -24,16 +24,16 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   DESCRIPTOR._serialized_options = b'Z6beam.apache.org/playground/backend/internal;playground'
   _DATASET_OPTIONSENTRY._options = None
   _DATASET_OPTIONSENTRY._serialized_options = b'8\001'
-  _globals['_SDK']._serialized_start=4204
-  _globals['_SDK']._serialized_end=4286
-  _globals['_STATUS']._serialized_start=4289
-  _globals['_STATUS']._serialized_end=4601
-  _globals['_PRECOMPILEDOBJECTTYPE']._serialized_start=4604
-  _globals['_PRECOMPILEDOBJECTTYPE']._serialized_end=4778
-  _globals['_COMPLEXITY']._serialized_start=4780
-  _globals['_COMPLEXITY']._serialized_end=4890
-  _globals['_EMULATORTYPE']._serialized_start=4892
-  _globals['_EMULATORTYPE']._serialized_end=4962
+  _globals['_SDK']._serialized_start=6284
+  _globals['_SDK']._serialized_end=6386
+  _globals['_STATUS']._serialized_start=6389
+  _globals['_STATUS']._serialized_end=6720
+  _globals['_PRECOMPILEDOBJECTTYPE']._serialized_start=6723
+  _globals['_PRECOMPILEDOBJECTTYPE']._serialized_end=6897
+  _globals['_COMPLEXITY']._serialized_start=6899
+  _globals['_COMPLEXITY']._serialized_end=7009
+  _globals['_EMULATORTYPE']._serialized_start=7012
+  _globals['_EMULATORTYPE']._serialized_end=7155
   _globals['_DATASET']._serialized_start=29
   _globals['_DATASET']._serialized_end=231
   _globals['_DATASET_OPTIONSENTRY']._serialized_start=173
@@ -70,62 +70,106 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   _globals['_GETLOGSREQUEST']._serialized_end=1241
   _globals['_GETLOGSRESPONSE']._serialized_start=1243
   _globals['_GETLOGSRESPONSE']._serialized_end=1284
-  _globals['_GETGRAPHREQUEST']._serialized_start=1286
-  _globals['_GETGRAPHREQUEST']._serialized_end=1340
-  _globals['_GETGRAPHRESPONSE']._serialized_start=1342
-  _globals['_GETGRAPHRESPONSE']._serialized_end=1382
-  _globals['_CANCELREQUEST']._serialized_start=1384
-  _globals['_CANCELREQUEST']._serialized_end=1436
-  _globals['_CANCELRESPONSE']._serialized_start=1438
-  _globals['_CANCELRESPONSE']._serialized_end=1454
-  _globals['_PRECOMPILEDOBJECT']._serialized_start=1457
-  _globals['_PRECOMPILEDOBJECT']._serialized_end=2049
-  _globals['_CATEGORIES']._serialized_start=2052
-  _globals['_CATEGORIES']._serialized_end=2281
-  _globals['_CATEGORIES_CATEGORY']._serialized_start=2158
-  _globals['_CATEGORIES_CATEGORY']._serialized_end=2281
-  _globals['_GETPRECOMPILEDOBJECTSREQUEST']._serialized_start=2283
-  _globals['_GETPRECOMPILEDOBJECTSREQUEST']._serialized_end=2372
-  _globals['_GETPRECOMPILEDOBJECTREQUEST']._serialized_start=2374
-  _globals['_GETPRECOMPILEDOBJECTREQUEST']._serialized_end=2434
-  _globals['_GETPRECOMPILEDOBJECTCODEREQUEST']._serialized_start=2436
-  _globals['_GETPRECOMPILEDOBJECTCODEREQUEST']._serialized_end=2500
-  _globals['_GETPRECOMPILEDOBJECTOUTPUTREQUEST']._serialized_start=2502
-  _globals['_GETPRECOMPILEDOBJECTOUTPUTREQUEST']._serialized_end=2568
-  _globals['_GETPRECOMPILEDOBJECTLOGSREQUEST']._serialized_start=2570
-  _globals['_GETPRECOMPILEDOBJECTLOGSREQUEST']._serialized_end=2634
-  _globals['_GETPRECOMPILEDOBJECTGRAPHREQUEST']._serialized_start=2636
-  _globals['_GETPRECOMPILEDOBJECTGRAPHREQUEST']._serialized_end=2701
-  _globals['_GETDEFAULTPRECOMPILEDOBJECTREQUEST']._serialized_start=2703
-  _globals['_GETDEFAULTPRECOMPILEDOBJECTREQUEST']._serialized_end=2770
-  _globals['_GETPRECOMPILEDOBJECTSRESPONSE']._serialized_start=2772
-  _globals['_GETPRECOMPILEDOBJECTSRESPONSE']._serialized_end=2862
-  _globals['_GETPRECOMPILEDOBJECTRESPONSE']._serialized_start=2864
-  _globals['_GETPRECOMPILEDOBJECTRESPONSE']._serialized_end=2968
-  _globals['_GETPRECOMPILEDOBJECTCODERESPONSE']._serialized_start=2970
-  _globals['_GETPRECOMPILEDOBJECTCODERESPONSE']._serialized_end=3067
-  _globals['_GETPRECOMPILEDOBJECTOUTPUTRESPONSE']._serialized_start=3069
-  _globals['_GETPRECOMPILEDOBJECTOUTPUTRESPONSE']._serialized_end=3129
-  _globals['_GETPRECOMPILEDOBJECTLOGSRESPONSE']._serialized_start=3131
-  _globals['_GETPRECOMPILEDOBJECTLOGSRESPONSE']._serialized_end=3189
-  _globals['_GETPRECOMPILEDOBJECTGRAPHRESPONSE']._serialized_start=3191
-  _globals['_GETPRECOMPILEDOBJECTGRAPHRESPONSE']._serialized_end=3248
-  _globals['_GETDEFAULTPRECOMPILEDOBJECTRESPONSE']._serialized_start=3250
-  _globals['_GETDEFAULTPRECOMPILEDOBJECTRESPONSE']._serialized_end=3361
-  _globals['_SNIPPETFILE']._serialized_start=3363
-  _globals['_SNIPPETFILE']._serialized_end=3447
-  _globals['_SAVESNIPPETREQUEST']._serialized_start=3450
-  _globals['_SAVESNIPPETREQUEST']._serialized_end=3680
-  _globals['_SAVESNIPPETRESPONSE']._serialized_start=3682
-  _globals['_SAVESNIPPETRESPONSE']._serialized_end=3719
-  _globals['_GETSNIPPETREQUEST']._serialized_start=3721
-  _globals['_GETSNIPPETREQUEST']._serialized_end=3756
-  _globals['_GETSNIPPETRESPONSE']._serialized_start=3759
-  _globals['_GETSNIPPETRESPONSE']._serialized_end=3948
-  _globals['_GETMETADATAREQUEST']._serialized_start=3950
-  _globals['_GETMETADATAREQUEST']._serialized_end=3970
-  _globals['_GETMETADATARESPONSE']._serialized_start=3973
-  _globals['_GETMETADATARESPONSE']._serialized_end=4202
-  _globals['_PLAYGROUNDSERVICE']._serialized_start=4965
-  _globals['_PLAYGROUNDSERVICE']._serialized_end=6712
+  _globals['_STREAMRUNOUTPUTREQUEST']._serialized_start=1286
+  _globals['_STREAMRUNOUTPUTREQUEST']._serialized_end=1347
+  _globals['_STREAMRUNOUTPUTRESPONSE']._serialized_start=1349
+  _globals['_STREAMRUNOUTPUTRESPONSE']._serialized_end=1398
+  _globals['_STREAMLOGSREQUEST']._serialized_start=1400
+  _globals['_STREAMLOGSREQUEST']._serialized_end=1456
+  _globals['_STREAMLOGSRESPONSE']._serialized_start=1458
+  _globals['_STREAMLOGSRESPONSE']._serialized_end=1502
+  _globals['_STREAMSTATUSREQUEST']._serialized_start=1504
+  _globals['_STREAMSTATUSREQUEST']._serialized_end=1562
+  _globals['_STREAMSTATUSRESPONSE']._serialized_start=1564
+  _globals['_STREAMSTATUSRESPONSE']._serialized_end=1626
+  _globals['_GETGRAPHREQUEST']._serialized_start=1628
+  _globals['_GETGRAPHREQUEST']._serialized_end=1682
+  _globals['_GETGRAPHRESPONSE']._serialized_start=1684
+  _globals['_GETGRAPHRESPONSE']._serialized_end=1724
+  _globals['_GETRUNMETRICSREQUEST']._serialized_start=1726
+  _globals['_GETRUNMETRICSREQUEST']._serialized_end=1785
+  _globals['_METRICKEY']._serialized_start=1787
+  _globals['_METRICKEY']._serialized_end=1868
+  _globals['_COUNTERMETRIC']._serialized_start=1870
+  _globals['_COUNTERMETRIC']._serialized_end=1982
+  _globals['_DISTRIBUTIONVALUE']._serialized_start=1984
+  _globals['_DISTRIBUTIONVALUE']._serialized_end=2079
+  _globals['_DISTRIBUTIONMETRIC']._serialized_start=2082
+  _globals['_DISTRIBUTIONMETRIC']._serialized_end=2253
+  _globals['_GAUGEVALUE']._serialized_start=2255
+  _globals['_GAUGEVALUE']._serialized_end=2319
+  _globals['_GAUGEMETRIC']._serialized_start=2322
+  _globals['_GAUGEMETRIC']._serialized_end=2472
+  _globals['_GETRUNMETRICSRESPONSE']._serialized_start=2475
+  _globals['_GETRUNMETRICSRESPONSE']._serialized_end=2660
+  _globals['_CANCELREQUEST']._serialized_start=2662
+  _globals['_CANCELREQUEST']._serialized_end=2714
+  _globals['_CANCELRESPONSE']._serialized_start=2716
+  _globals['_CANCELRESPONSE']._serialized_end=2732
+  _globals['_PRECOMPILEDOBJECT']._serialized_start=2735
+  _globals['_PRECOMPILEDOBJECT']._serialized_end=3327
+  _globals['_CATEGORIES']._serialized_start=3330
+  _globals['_CATEGORIES']._serialized_end=3559
+  _globals['_CATEGORIES_CATEGORY']._serialized_start=3436
+  _globals['_CATEGORIES_CATEGORY']._serialized_end=3559
+  _globals['_GETPRECOMPILEDOBJECTSREQUEST']._serialized_start=3561
+  _globals['_GETPRECOMPILEDOBJECTSREQUEST']._serialized_end=3650
+  _globals['_GETPRECOMPILEDOBJECTREQUEST']._serialized_start=3652
+  _globals['_GETPRECOMPILEDOBJECTREQUEST']._serialized_end=3712
+  _globals['_GETPRECOMPILEDOBJECTCODEREQUEST']._serialized_start=3714
+  _globals['_GETPRECOMPILEDOBJECTCODEREQUEST']._serialized_end=3778
+  _globals['_GETPRECOMPILEDOBJECTOUTPUTREQUEST']._serialized_start=3780
+  _globals['_GETPRECOMPILEDOBJECTOUTPUTREQUEST']._serialized_end=3846
+  _globals['_GETPRECOMPILEDOBJECTLOGSREQUEST']._serialized_start=3848
+  _globals['_GETPRECOMPILEDOBJECTLOGSREQUEST']._serialized_end=3912
+  _globals['_GETPRECOMPILEDOBJECTGRAPHREQUEST']._serialized_start=3914
+  _globals['_GETPRECOMPILEDOBJECTGRAPHREQUEST']._serialized_end=3979
+  _globals['_GETDEFAULTPRECOMPILEDOBJECTREQUEST']._serialized_start=3981
+  _globals['_GETDEFAULTPRECOMPILEDOBJECTREQUEST']._serialized_end=4048
+  _globals['_GETPRECOMPILEDOBJECTSRESPONSE']._serialized_start=4050
+  _globals['_GETPRECOMPILEDOBJECTSRESPONSE']._serialized_end=4140
+  _globals['_GETPRECOMPILEDOBJECTRESPONSE']._serialized_start=4142
+  _globals['_GETPRECOMPILEDOBJECTRESPONSE']._serialized_end=4246
+  _globals['_GETPRECOMPILEDOBJECTCODERESPONSE']._serialized_start=4248
+  _globals['_GETPRECOMPILEDOBJECTCODERESPONSE']._serialized_end=4345
+  _globals['_GETPRECOMPILEDOBJECTOUTPUTRESPONSE']._serialized_start=4347
+  _globals['_GETPRECOMPILEDOBJECTOUTPUTRESPONSE']._serialized_end=4407
+  _globals['_GETPRECOMPILEDOBJECTLOGSRESPONSE']._serialized_start=4409
+  _globals['_GETPRECOMPILEDOBJECTLOGSRESPONSE']._serialized_end=4467
+  _globals['_GETPRECOMPILEDOBJECTGRAPHRESPONSE']._serialized_start=4469
+  _globals['_GETPRECOMPILEDOBJECTGRAPHRESPONSE']._serialized_end=4526
+  _globals['_GETDEFAULTPRECOMPILEDOBJECTRESPONSE']._serialized_start=4528
+  _globals['_GETDEFAULTPRECOMPILEDOBJECTRESPONSE']._serialized_end=4639
+  _globals['_SNIPPETFILE']._serialized_start=4641
+  _globals['_SNIPPETFILE']._serialized_end=4725
+  _globals['_SAVESNIPPETREQUEST']._serialized_start=4728
+  _globals['_SAVESNIPPETREQUEST']._serialized_end=5022
+  _globals['_SAVESNIPPETRESPONSE']._serialized_start=5024
+  _globals['_SAVESNIPPETRESPONSE']._serialized_end=5061
+  _globals['_GETSNIPPETREQUEST']._serialized_start=5063
+  _globals['_GETSNIPPETREQUEST']._serialized_end=5098
+  _globals['_GETSNIPPETRESPONSE']._serialized_start=5101
+  _globals['_GETSNIPPETRESPONSE']._serialized_end=5347
+  _globals['_FORKSNIPPETREQUEST']._serialized_start=5349
+  _globals['_FORKSNIPPETREQUEST']._serialized_end=5420
+  _globals['_FORKSNIPPETRESPONSE']._serialized_start=5422
+  _globals['_FORKSNIPPETRESPONSE']._serialized_end=5459
+  _globals['_LISTSNIPPETREVISIONSREQUEST']._serialized_start=5461
+  _globals['_LISTSNIPPETREVISIONSREQUEST']._serialized_end=5506
+  _globals['_SNIPPETREVISION']._serialized_start=5509
+  _globals['_SNIPPETREVISION']._serialized_end=5678
+  _globals['_LISTSNIPPETREVISIONSRESPONSE']._serialized_start=5681
+  _globals['_LISTSNIPPETREVISIONSRESPONSE']._serialized_end=5825
+  _globals['_GETSNIPPETDIFFREQUEST']._serialized_start=5827
+  _globals['_GETSNIPPETDIFFREQUEST']._serialized_end=5896
+  _globals['_SNIPPETFILEDIFF']._serialized_start=5898
+  _globals['_SNIPPETFILEDIFF']._serialized_end=5955
+  _globals['_GETSNIPPETDIFFRESPONSE']._serialized_start=5957
+  _globals['_GETSNIPPETDIFFRESPONSE']._serialized_end=6028
+  _globals['_GETMETADATAREQUEST']._serialized_start=6030
+  _globals['_GETMETADATAREQUEST']._serialized_end=6050
+  _globals['_GETMETADATARESPONSE']._serialized_start=6053
+  _globals['_GETMETADATARESPONSE']._serialized_end=6282
+  _globals['_PLAYGROUNDSERVICE']._serialized_start=7158
+  _globals['_PLAYGROUNDSERVICE']._serialized_end=9469
 # @@protoc_insertion_point(module_scope)
@@ -13,6 +13,7 @@ class Sdk(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
     SDK_GO: _ClassVar[Sdk]
     SDK_PYTHON: _ClassVar[Sdk]
     SDK_SCIO: _ClassVar[Sdk]
+    SDK_TYPESCRIPT: _ClassVar[Sdk]
 
 class Status(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
     __slots__ = []
@@ -29,6 +30,7 @@ class Status(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
     STATUS_ERROR: _ClassVar[Status]
     STATUS_RUN_TIMEOUT: _ClassVar[Status]
     STATUS_CANCELED: _ClassVar[Status]
+    STATUS_QUEUED: _ClassVar[Status]
 
 class PrecompiledObjectType(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
     __slots__ = []
@@ -48,11 +50,15 @@ class EmulatorType(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
     __slots__ = []
     EMULATOR_TYPE_UNSPECIFIED: _ClassVar[EmulatorType]
     EMULATOR_TYPE_KAFKA: _ClassVar[EmulatorType]
+    EMULATOR_TYPE_PUBSUB: _ClassVar[EmulatorType]
+    EMULATOR_TYPE_GCS: _ClassVar[EmulatorType]
+    EMULATOR_TYPE_JDBC: _ClassVar[EmulatorType]
 SDK_UNSPECIFIED: Sdk
 SDK_JAVA: Sdk
 SDK_GO: Sdk
 SDK_PYTHON: Sdk
 SDK_SCIO: Sdk
+SDK_TYPESCRIPT: Sdk
 STATUS_UNSPECIFIED: Status
 STATUS_VALIDATING: Status
 STATUS_VALIDATION_ERROR: Status
@@ -66,6 +72,7 @@ STATUS_RUN_ERROR: Status
 STATUS_ERROR: Status
 STATUS_RUN_TIMEOUT: Status
 STATUS_CANCELED: Status
+STATUS_QUEUED: Status
 PRECOMPILED_OBJECT_TYPE_UNSPECIFIED: PrecompiledObjectType
 PRECOMPILED_OBJECT_TYPE_EXAMPLE: PrecompiledObjectType
 PRECOMPILED_OBJECT_TYPE_KATA: PrecompiledObjectType
@@ -76,6 +83,9 @@ COMPLEXITY_MEDIUM: Complexity
 COMPLEXITY_ADVANCED: Complexity
 EMULATOR_TYPE_UNSPECIFIED: EmulatorType
 EMULATOR_TYPE_KAFKA: EmulatorType
+EMULATOR_TYPE_PUBSUB: EmulatorType
+EMULATOR_TYPE_GCS: EmulatorType
+EMULATOR_TYPE_JDBC: EmulatorType
 
 class Dataset(_message.Message):
     __slots__ = ["type", "options", "dataset_path"]
@@ -198,6 +208,42 @@ class GetLogsResponse(_message.Message):
     output: str
     def __init__(self, output: _Optional[str] = ...) -> None: ...
 
+class StreamRunOutputRequest(_message.Message):
+    __slots__ = ["pipeline_uuid"]
+    PIPELINE_UUID_FIELD_NUMBER: _ClassVar[int]
+    pipeline_uuid: str
+    def __init__(self, pipeline_uuid: _Optional[str] = ...) -> None: ...
+
+class StreamRunOutputResponse(_message.Message):
+    __slots__ = ["output"]
+    OUTPUT_FIELD_NUMBER: _ClassVar[int]
+    output: str
+    def __init__(self, output: _Optional[str] = ...) -> None: ...
+
+class StreamLogsRequest(_message.Message):
+    __slots__ = ["pipeline_uuid"]
+    PIPELINE_UUID_FIELD_NUMBER: _ClassVar[int]
+    pipeline_uuid: str
+    def __init__(self, pipeline_uuid: _Optional[str] = ...) -> None: ...
+
+class StreamLogsResponse(_message.Message):
+    __slots__ = ["output"]
+    OUTPUT_FIELD_NUMBER: _ClassVar[int]
+    output: str
+    def __init__(self, output: _Optional[str] = ...) -> None: ...
+
+class StreamStatusRequest(_message.Message):
+    __slots__ = ["pipeline_uuid"]
+    PIPELINE_UUID_FIELD_NUMBER: _ClassVar[int]
+    pipeline_uuid: str
+    def __init__(self, pipeline_uuid: _Optional[str] = ...) -> None: ...
+
+class StreamStatusResponse(_message.Message):
+    __slots__ = ["status"]
+    STATUS_FIELD_NUMBER: _ClassVar[int]
+    status: Status
+    def __init__(self, status: _Optional[_Union[Status, str]] = ...) -> None: ...
+
 class GetGraphRequest(_message.Message):
     __slots__ = ["pipeline_uuid"]
     PIPELINE_UUID_FIELD_NUMBER: _ClassVar[int]
@@ -210,6 +256,82 @@ class GetGraphResponse(_message.Message):
     graph: str
     def __init__(self, graph: _Optional[str] = ...) -> None: ...
 
+class GetRunMetricsRequest(_message.Message):
+    __slots__ = ["pipeline_uuid"]
+    PIPELINE_UUID_FIELD_NUMBER: _ClassVar[int]
+    pipeline_uuid: str
+    def __init__(self, pipeline_uuid: _Optional[str] = ...) -> None: ...
+
+class MetricKey(_message.Message):
+    __slots__ = ["step", "namespace", "name"]
+    STEP_FIELD_NUMBER: _ClassVar[int]
+    NAMESPACE_FIELD_NUMBER: _ClassVar[int]
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    step: str
+    namespace: str
+    name: str
+    def __init__(self, step: _Optional[str] = ..., namespace: _Optional[str] = ..., name: _Optional[str] = ...) -> None: ...
+
+class CounterMetric(_message.Message):
+    __slots__ = ["key", "attempted", "committed"]
+    KEY_FIELD_NUMBER: _ClassVar[int]
+    ATTEMPTED_FIELD_NUMBER: _ClassVar[int]
+    COMMITTED_FIELD_NUMBER: _ClassVar[int]
+    key: MetricKey
+    attempted: int
+    committed: int
+    def __init__(self, key: _Optional[_Union[MetricKey, _Mapping]] = ..., attempted: _Optional[int] = ..., committed: _Optional[int] = ...) -> None: ...
+
+class DistributionValue(_message.Message):
+    __slots__ = ["count", "sum", "min", "max"]
+    COUNT_FIELD_NUMBER: _ClassVar[int]
+    SUM_FIELD_NUMBER: _ClassVar[int]
+    MIN_FIELD_NUMBER: _ClassVar[int]
+    MAX_FIELD_NUMBER: _ClassVar[int]
+    count: int
+    sum: int
+    min: int
+    max: int
+    def __init__(self, count: _Optional[int] = ..., sum: _Optional[int] = ..., min: _Optional[int] = ..., max: _Optional[int] = ...) -> None: ...
+
+class DistributionMetric(_message.Message):
+    __slots__ = ["key", "attempted", "committed"]
+    KEY_FIELD_NUMBER: _ClassVar[int]
+    ATTEMPTED_FIELD_NUMBER: _ClassVar[int]
+    COMMITTED_FIELD_NUMBER: _ClassVar[int]
+    key: MetricKey
+    attempted: DistributionValue
+    committed: DistributionValue
+    def __init__(self, key: _Optional[_Union[MetricKey, _Mapping]] = ..., attempted: _Optional[_Union[DistributionValue, _Mapping]] = ..., committed: _Optional[_Union[DistributionValue, _Mapping]] = ...) -> None: ...
+
+class GaugeValue(_message.Message):
+    __slots__ = ["value", "timestamp"]
+    VALUE_FIELD_NUMBER: _ClassVar[int]
+    TIMESTAMP_FIELD_NUMBER: _ClassVar[int]
+    value: int
+    timestamp: int
+    def __init__(self, value: _Optional[int] = ..., timestamp: _Optional[int] = ...) -> None: ...
+
+class GaugeMetric(_message.Message):
+    __slots__ = ["key", "attempted", "committed"]
+    KEY_FIELD_NUMBER: _ClassVar[int]
+    ATTEMPTED_FIELD_NUMBER: _ClassVar[int]
+    COMMITTED_FIELD_NUMBER: _ClassVar[int]
+    key: MetricKey
+    attempted: GaugeValue
+    committed: GaugeValue
+    def __init__(self, key: _Optional[_Union[MetricKey, _Mapping]] = ..., attempted: _Optional[_Union[GaugeValue, _Mapping]] = ..., committed: _Optional[_Union[GaugeValue, _Mapping]] = ...) -> None: ...
+
+class GetRunMetricsResponse(_message.Message):
+    __slots__ = ["counters", "distributions", "gauges"]
+    COUNTERS_FIELD_NUMBER: _ClassVar[int]
+    DISTRIBUTIONS_FIELD_NUMBER: _ClassVar[int]
+    GAUGES_FIELD_NUMBER: _ClassVar[int]
+    counters: _containers.RepeatedCompositeFieldContainer[CounterMetric]
+    distributions: _containers.RepeatedCompositeFieldContainer[DistributionMetric]
+    gauges: _containers.RepeatedCompositeFieldContainer[GaugeMetric]
+    def __init__(self, counters: _Optional[_Iterable[_Union[CounterMetric, _Mapping]]] = ..., distributions: _Optional[_Iterable[_Union[DistributionMetric, _Mapping]]] = ..., gauges: _Optional[_Iterable[_Union[GaugeMetric, _Mapping]]] = ...) -> None: ...
+
 class CancelRequest(_message.Message):
     __slots__ = ["pipeline_uuid"]
     PIPELINE_UUID_FIELD_NUMBER: _ClassVar[int]
@@ -372,18 +494,22 @@ class SnippetFile(_message.Message):
     def __init__(self, name: _Optional[str] = ..., content: _Optional[str] = ..., is_main: bool = ...) -> None: ...
 
 class SaveSnippetRequest(_message.Message):
-    __slots__ = ["files", "sdk", "pipeline_options", "complexity", "persistence_key"]
+    __slots__ = ["files", "sdk", "pipeline_options", "complexity", "persistence_key", "parent_id", "author_token"]
     FILES_FIELD_NUMBER: _ClassVar[int]
     SDK_FIELD_NUMBER: _ClassVar[int]
     PIPELINE_OPTIONS_FIELD_NUMBER: _ClassVar[int]
     COMPLEXITY_FIELD_NUMBER: _ClassVar[int]
     PERSISTENCE_KEY_FIELD_NUMBER: _ClassVar[int]
+    PARENT_ID_FIELD_NUMBER: _ClassVar[int]
+    AUTHOR_TOKEN_FIELD_NUMBER: _ClassVar[int]
     files: _containers.RepeatedCompositeFieldContainer[SnippetFile]
     sdk: Sdk
     pipeline_options: str
     complexity: Complexity
     persistence_key: str
-    def __init__(self, files: _Optional[_Iterable[_Union[SnippetFile, _Mapping]]] = ..., sdk: _Optional[_Union[Sdk, str]] = ..., pipeline_options: _Optional[str] = ..., complexity: _Optional[_Union[Complexity, str]] = ..., persistence_key: _Optional[str] = ...) -> None: ...
+    parent_id: str
+    author_token: str
+    def __init__(self, files: _Optional[_Iterable[_Union[SnippetFile, _Mapping]]] = ..., sdk: _Optional[_Union[Sdk, str]] = ..., pipeline_options: _Optional[str] = ..., complexity: _Optional[_Union[Complexity, str]] = ..., persistence_key: _Optional[str] = ..., parent_id: _Optional[str] = ..., author_token: _Optional[str] = ...) -> None: ...
 
 class SaveSnippetResponse(_message.Message):
     __slots__ = ["id"]
@@ -398,16 +524,84 @@ class GetSnippetRequest(_message.Message):
     def __init__(self, id: _Optional[str] = ...) -> None: ...
 
 class GetSnippetResponse(_message.Message):
-    __slots__ = ["files", "sdk", "pipeline_options", "complexity"]
+    __slots__ = ["files", "sdk", "pipeline_options", "complexity", "parent_id", "revision"]
     FILES_FIELD_NUMBER: _ClassVar[int]
     SDK_FIELD_NUMBER: _ClassVar[int]
     PIPELINE_OPTIONS_FIELD_NUMBER: _ClassVar[int]
     COMPLEXITY_FIELD_NUMBER: _ClassVar[int]
+    PARENT_ID_FIELD_NUMBER: _ClassVar[int]
+    REVISION_FIELD_NUMBER: _ClassVar[int]
     files: _containers.RepeatedCompositeFieldContainer[SnippetFile]
     sdk: Sdk
     pipeline_options: str
     complexity: Complexity
-    def __init__(self, files: _Optional[_Iterable[_Union[SnippetFile, _Mapping]]] = ..., sdk: _Optional[_Union[Sdk, str]] = ..., pipeline_options: _Optional[str] = ..., complexity: _Optional[_Union[Complexity, str]] = ...) -> None: ...
+    parent_id: str
+    revision: int
+    def __init__(self, files: _Optional[_Iterable[_Union[SnippetFile, _Mapping]]] = ..., sdk: _Optional[_Union[Sdk, str]] = ..., pipeline_options: _Optional[str] = ..., complexity: _Optional[_Union[Complexity, str]] = ..., parent_id: _Optional[str] = ..., revision: _Optional[int] = ...) -> None: ...
+
+class ForkSnippetRequest(_message.Message):
+    __slots__ = ["id", "author_token"]
+    ID_FIELD_NUMBER: _ClassVar[int]
+    AUTHOR_TOKEN_FIELD_NUMBER: _ClassVar[int]
+    id: str
+    author_token: str
+    def __init__(self, id: _Optional[str] = ..., author_token: _Optional[str] = ...) -> None: ...
+
+class ForkSnippetResponse(_message.Message):
+    __slots__ = ["id"]
+    ID_FIELD_NUMBER: _ClassVar[int]
+    id: str
+    def __init__(self, id: _Optional[str] = ...) -> None: ...
+
+class ListSnippetRevisionsRequest(_message.Message):
+    __slots__ = ["id"]
+    ID_FIELD_NUMBER: _ClassVar[int]
+    id: str
+    def __init__(self, id: _Optional[str] = ...) -> None: ...
+
+class SnippetRevision(_message.Message):
+    __slots__ = ["id", "parent_id", "revision", "created", "author_hash"]
+    ID_FIELD_NUMBER: _ClassVar[int]
+    PARENT_ID_FIELD_NUMBER: _ClassVar[int]
+    REVISION_FIELD_NUMBER: _ClassVar[int]
+    CREATED_FIELD_NUMBER: _ClassVar[int]
+    AUTHOR_HASH_FIELD_NUMBER: _ClassVar[int]
+    id: str
+    parent_id: str
+    revision: int
+    created: int
+    author_hash: str
+    def __init__(self, id: _Optional[str] = ..., parent_id: _Optional[str] = ..., revision: _Optional[int] = ..., created: _Optional[int] = ..., author_hash: _Optional[str] = ...) -> None: ...
+
+class ListSnippetRevisionsResponse(_message.Message):
+    __slots__ = ["revisions", "descendants"]
+    REVISIONS_FIELD_NUMBER: _ClassVar[int]
+    DESCENDANTS_FIELD_NUMBER: _ClassVar[int]
+    revisions: _containers.RepeatedCompositeFieldContainer[SnippetRevision]
+    descendants: _containers.RepeatedCompositeFieldContainer[SnippetRevision]
+    def __init__(self, revisions: _Optional[_Iterable[_Union[SnippetRevision, _Mapping]]] = ..., descendants: _Optional[_Iterable[_Union[SnippetRevision, _Mapping]]] = ...) -> None: ...
+
+class GetSnippetDiffRequest(_message.Message):
+    __slots__ = ["from_id", "to_id"]
+    FROM_ID_FIELD_NUMBER: _ClassVar[int]
+    TO_ID_FIELD_NUMBER: _ClassVar[int]
+    from_id: str
+    to_id: str
+    def __init__(self, from_id: _Optional[str] = ..., to_id: _Optional[str] = ...) -> None: ...
+
+class SnippetFileDiff(_message.Message):
+    __slots__ = ["name", "diff"]
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    DIFF_FIELD_NUMBER: _ClassVar[int]
+    name: str
+    diff: str
+    def __init__(self, name: _Optional[str] = ..., diff: _Optional[str] = ...) -> None: ...
+
+class GetSnippetDiffResponse(_message.Message):
+    __slots__ = ["files"]
+    FILES_FIELD_NUMBER: _ClassVar[int]
+    files: _containers.RepeatedCompositeFieldContainer[SnippetFileDiff]
+    def __init__(self, files: _Optional[_Iterable[_Union[SnippetFileDiff, _Mapping]]] = ...) -> None: ...
 
 class GetMetadataRequest(_message.Message):
     __slots__ = []
@@ -34,11 +34,31 @@ class PlaygroundServiceStub(object):
                 request_serializer=api_dot_v1_dot_api__pb2.GetLogsRequest.SerializeToString,
                 response_deserializer=api_dot_v1_dot_api__pb2.GetLogsResponse.FromString,
                 )
+        self.StreamRunOutput = channel.unary_stream(
+                '/api.v1.PlaygroundService/StreamRunOutput',
+                request_serializer=api_dot_v1_dot_api__pb2.StreamRunOutputRequest.SerializeToString,
+                response_deserializer=api_dot_v1_dot_api__pb2.StreamRunOutputResponse.FromString,
+                )
+        self.StreamLogs = channel.unary_stream(
+                '/api.v1.PlaygroundService/StreamLogs',
+                request_serializer=api_dot_v1_dot_api__pb2.StreamLogsRequest.SerializeToString,
+                response_deserializer=api_dot_v1_dot_api__pb2.StreamLogsResponse.FromString,
+                )
+        self.StreamStatus = channel.unary_stream(
+                '/api.v1.PlaygroundService/StreamStatus',
+                request_serializer=api_dot_v1_dot_api__pb2.StreamStatusRequest.SerializeToString,
+                response_deserializer=api_dot_v1_dot_api__pb2.StreamStatusResponse.FromString,
+                )
         self.GetGraph = channel.unary_unary(
                 '/api.v1.PlaygroundService/GetGraph',
                 request_serializer=api_dot_v1_dot_api__pb2.GetGraphRequest.SerializeToString,
                 response_deserializer=api_dot_v1_dot_api__pb2.GetGraphResponse.FromString,
                 )
+        self.GetRunMetrics = channel.unary_unary(
+                '/api.v1.PlaygroundService/GetRunMetrics',
+                request_serializer=api_dot_v1_dot_api__pb2.GetRunMetricsRequest.SerializeToString,
+                response_deserializer=api_dot_v1_dot_api__pb2.GetRunMetricsResponse.FromString,
+                )
         self.GetRunError = channel.unary_unary(
                 '/api.v1.PlaygroundService/GetRunError',
                 request_serializer=api_dot_v1_dot_api__pb2.GetRunErrorRequest.SerializeToString,
@@ -109,6 +129,21 @@ class PlaygroundServiceStub(object):
                 request_serializer=api_dot_v1_dot_api__pb2.GetSnippetRequest.SerializeToString,
                 response_deserializer=api_dot_v1_dot_api__pb2.GetSnippetResponse.FromString,
                 )
+        self.ForkSnippet = channel.unary_unary(
+                '/api.v1.PlaygroundService/ForkSnippet',
+                request_serializer=api_dot_v1_dot_api__pb2.ForkSnippetRequest.SerializeToString,
+                response_deserializer=api_dot_v1_dot_api__pb2.ForkSnippetResponse.FromString,
+                )
+        self.ListSnippetRevisions = channel.unary_unary(
+                '/api.v1.PlaygroundService/ListSnippetRevisions',
+                request_serializer=api_dot_v1_dot_api__pb2.ListSnippetRevisionsRequest.SerializeToString,
+                response_deserializer=api_dot_v1_dot_api__pb2.ListSnippetRevisionsResponse.FromString,
+                )
+        self.GetSnippetDiff = channel.unary_unary(
+                '/api.v1.PlaygroundService/GetSnippetDiff',
+                request_serializer=api_dot_v1_dot_api__pb2.GetSnippetDiffRequest.SerializeToString,
+                response_deserializer=api_dot_v1_dot_api__pb2.GetSnippetDiffResponse.FromString,
+                )
         self.GetMetadata = channel.unary_unary(
                 '/api.v1.PlaygroundService/GetMetadata',
                 request_serializer=api_dot_v1_dot_api__pb2.GetMetadataRequest.SerializeToString,
@@ -147,6 +182,27 @@ class PlaygroundServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def StreamRunOutput(self, request, context):
+        """Stream the output of pipeline execution until the pipeline is finished.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def StreamLogs(self, request, context):
+        """Stream the logs of pipeline execution until the pipeline is finished.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def StreamStatus(self, request, context):
+        """Stream the status changes of pipeline execution until the pipeline is finished.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def GetGraph(self, request, context):
         """Get the string representation of the pipeline execution graph in DOT format.
         """
@@ -154,6 +210,13 @@ class PlaygroundServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def GetRunMetrics(self, request, context):
+        """Get the final metrics of pipeline execution reported by the runner.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def GetRunError(self, request, context):
         """Get the error of pipeline execution.
         """
@@ -252,6 +315,27 @@ class PlaygroundServiceServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def ForkSnippet(self, request, context):
+        """Fork the snippet as its next revision.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListSnippetRevisions(self, request, context):
+        """List the revisions of the snippet and the revisions derived from it.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def GetSnippetDiff(self, request, context):
+        """Get the diff between two revisions of the snippet.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def GetMetadata(self, request, context):
         """Get the runner metadata.
         """
@@ -282,11 +366,31 @@ def add_PlaygroundServiceServicer_to_server(servicer, server):
                     request_deserializer=api_dot_v1_dot_api__pb2.GetLogsRequest.FromString,
                     response_serializer=api_dot_v1_dot_api__pb2.GetLogsResponse.SerializeToString,
             ),
+            'StreamRunOutput': grpc.unary_stream_rpc_method_handler(
+                    servicer.StreamRunOutput,
+                    request_deserializer=api_dot_v1_dot_api__pb2.StreamRunOutputRequest.FromString,
+                    response_serializer=api_dot_v1_dot_api__pb2.StreamRunOutputResponse.SerializeToString,
+            ),
+            'StreamLogs': grpc.unary_stream_rpc_method_handler(
+                    servicer.StreamLogs,
+                    request_deserializer=api_dot_v1_dot_api__pb2.StreamLogsRequest.FromString,
+                    response_serializer=api_dot_v1_dot_api__pb2.StreamLogsResponse.SerializeToString,
+            ),
+            'StreamStatus': grpc.unary_stream_rpc_method_handler(
+                    servicer.StreamStatus,
+                    request_deserializer=api_dot_v1_dot_api__pb2.StreamStatusRequest.FromString,
+                    response_serializer=api_dot_v1_dot_api__pb2.StreamStatusResponse.SerializeToString,
+            ),
             'GetGraph': grpc.unary_unary_rpc_method_handler(
                     servicer.GetGraph,
                     request_deserializer=api_dot_v1_dot_api__pb2.GetGraphRequest.FromString,
                     response_serializer=api_dot_v1_dot_api__pb2.GetGraphResponse.SerializeToString,
             ),
+            'GetRunMetrics': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetRunMetrics,
+                    request_deserializer=api_dot_v1_dot_api__pb2.GetRunMetricsRequest.FromString,
+                    response_serializer=api_dot_v1_dot_api__pb2.GetRunMetricsResponse.SerializeToString,
+            ),
             'GetRunError': grpc.unary_unary_rpc_method_handler(
                     servicer.GetRunError,
                     request_deserializer=api_dot_v1_dot_api__pb2.GetRunErrorRequest.FromString,
@@ -357,6 +461,21 @@ def add_PlaygroundServiceServicer_to_server(servicer, server):
                     request_deserializer=api_dot_v1_dot_api__pb2.GetSnippetRequest.FromString,
                     response_serializer=api_dot_v1_dot_api__pb2.GetSnippetResponse.SerializeToString,
             ),
+            'ForkSnippet': grpc.unary_unary_rpc_method_handler(
+                    servicer.ForkSnippet,
+                    request_deserializer=api_dot_v1_dot_api__pb2.ForkSnippetRequest.FromString,
+                    response_serializer=api_dot_v1_dot_api__pb2.ForkSnippetResponse.SerializeToString,
+            ),
+            'ListSnippetRevisions': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListSnippetRevisions,
+                    request_deserializer=api_dot_v1_dot_api__pb2.ListSnippetRevisionsRequest.FromString,
+                    response_serializer=api_dot_v1_dot_api__pb2.ListSnippetRevisionsResponse.SerializeToString,
+            ),
+            'GetSnippetDiff': grpc.unary_unary_rpc_method_handler(
+                    servicer.GetSnippetDiff,
+                    request_deserializer=api_dot_v1_dot_api__pb2.GetSnippetDiffRequest.FromString,
+                    response_serializer=api_dot_v1_dot_api__pb2.GetSnippetDiffResponse.SerializeToString,
+            ),
             'GetMetadata': grpc.unary_unary_rpc_method_handler(
                     servicer.GetMetadata,
                     request_deserializer=api_dot_v1_dot_api__pb2.GetMetadataRequest.FromString,
@@ -439,6 +558,57 @@ class PlaygroundService(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def StreamRunOutput(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/api.v1.PlaygroundService/StreamRunOutput',
+            api_dot_v1_dot_api__pb2.StreamRunOutputRequest.SerializeToString,
+            api_dot_v1_dot_api__pb2.StreamRunOutputResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def StreamLogs(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/api.v1.PlaygroundService/StreamLogs',
+            api_dot_v1_dot_api__pb2.StreamLogsRequest.SerializeToString,
+            api_dot_v1_dot_api__pb2.StreamLogsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def StreamStatus(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(request, target, '/api.v1.PlaygroundService/StreamStatus',
+            api_dot_v1_dot_api__pb2.StreamStatusRequest.SerializeToString,
+            api_dot_v1_dot_api__pb2.StreamStatusResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def GetGraph(request,
             target,
@@ -456,6 +626,23 @@ class PlaygroundService(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def GetRunMetrics(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/api.v1.PlaygroundService/GetRunMetrics',
+            api_dot_v1_dot_api__pb2.GetRunMetricsRequest.SerializeToString,
+            api_dot_v1_dot_api__pb2.GetRunMetricsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def GetRunError(request,
             target,
@@ -694,6 +881,57 @@ class PlaygroundService(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def ForkSnippet(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/api.v1.PlaygroundService/ForkSnippet',
+            api_dot_v1_dot_api__pb2.ForkSnippetRequest.SerializeToString,
+            api_dot_v1_dot_api__pb2.ForkSnippetResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListSnippetRevisions(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/api.v1.PlaygroundService/ListSnippetRevisions',
+            api_dot_v1_dot_api__pb2.ListSnippetRevisionsRequest.SerializeToString,
+            api_dot_v1_dot_api__pb2.ListSnippetRevisionsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def GetSnippetDiff(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/api.v1.PlaygroundService/GetSnippetDiff',
+            api_dot_v1_dot_api__pb2.GetSnippetDiffRequest.SerializeToString,
+            api_dot_v1_dot_api__pb2.GetSnippetDiffResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def GetMetadata(request,
             target,
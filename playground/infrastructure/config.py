@@ -25,7 +25,7 @@ from typing import Literal
 from api.v1.api_pb2 import STATUS_VALIDATION_ERROR, STATUS_ERROR, \
     STATUS_PREPARATION_ERROR, STATUS_COMPILE_ERROR, \
     STATUS_RUN_TIMEOUT, STATUS_RUN_ERROR, SDK_JAVA, SDK_GO, SDK_PYTHON, \
-    SDK_SCIO, Sdk
+    SDK_SCIO, SDK_TYPESCRIPT, Sdk
 from constants import SERVER_ADDRESS_ENV_VAR_KEY, SDK_CONFIG_ENV_VAR_KEY
 
 
@@ -36,15 +36,18 @@ class Config:
     """
     SERVER_ADDRESS = os.getenv(SERVER_ADDRESS_ENV_VAR_KEY, "localhost:8080")
     EXTENSION_TO_SDK = {
-        "java": SDK_JAVA, "go": SDK_GO, "py": SDK_PYTHON, "scala": SDK_SCIO
+        "java": SDK_JAVA, "go": SDK_GO, "py": SDK_PYTHON, "scala": SDK_SCIO,
+        "ts": SDK_TYPESCRIPT
     }
     SUPPORTED_SDK = (
         Sdk.Name(SDK_JAVA),
         Sdk.Name(SDK_GO),
         Sdk.Name(SDK_PYTHON),
-        Sdk.Name(SDK_SCIO))
+        Sdk.Name(SDK_SCIO),
+        Sdk.Name(SDK_TYPESCRIPT))
     SDK_TO_EXTENSION = {
-        SDK_JAVA: "java", SDK_GO: "go", SDK_PYTHON: "py", SDK_SCIO: "scala"
+        SDK_JAVA: "java", SDK_GO: "go", SDK_PYTHON: "py", SDK_SCIO: "scala",
+        SDK_TYPESCRIPT: "ts"
     }
     ERROR_STATUSES = [
         STATUS_VALIDATION_ERROR,
@@ -31,6 +31,7 @@ from api.v1.api_pb2 import (
     SDK_UNSPECIFIED,
     STATUS_UNSPECIFIED,
     Sdk,
+    STATUS_QUEUED,
     STATUS_VALIDATING,
     STATUS_PREPARING,
     STATUS_COMPILING,
@@ -269,7 +270,7 @@ async def update_example_status(example: Example, client: GRPCClient):
 
     Use client to send requests to the backend:
     1. Start code processing.
-    2. Ping the backend while status is STATUS_VALIDATING/
+    2. Ping the backend while status is STATUS_QUEUED/STATUS_VALIDATING/
       STATUS_PREPARING/STATUS_COMPILING/STATUS_EXECUTING
     Update example.status with resulting status.
 
@@ -304,6 +305,7 @@ async def update_example_status(example: Example, client: GRPCClient):
     example.pipeline_id = pipeline_id
     status = await client.check_status(pipeline_id)
     while status in [
+        STATUS_QUEUED,
         STATUS_VALIDATING,
         STATUS_PREPARING,
         STATUS_COMPILING,
@@ -54,6 +54,9 @@ class Topic(BaseModel):
 
 class EmulatorType(str, Enum):
     KAFKA = "kafka"
+    PUBSUB = "pubsub"
+    GCS = "gcs"
+    JDBC = "jdbc"
 
 
 class Emulator(BaseModel):
@@ -169,6 +172,7 @@ class SdkEnum(IntEnum):
     GO = api_pb2.SDK_GO
     PYTHON = api_pb2.SDK_PYTHON
     SCIO = api_pb2.SDK_SCIO
+    TYPESCRIPT = api_pb2.SDK_TYPESCRIPT
 
 
 def StringToSdkEnum(s: str) -> SdkEnum:
@@ -69,6 +69,7 @@ require (
 require (
 	github.com/fsouza/fake-gcs-server v1.45.1
 	golang.org/x/exp v0.0.0-20230321023759-10a507213a29
+	golang.org/x/tools v0.7.0
 )
 
 require (
@@ -155,7 +156,6 @@ require (
 	go.opencensus.io v0.24.0 // indirect
 	golang.org/x/crypto v0.7.0 // indirect
 	golang.org/x/mod v0.9.0 // indirect
-	golang.org/x/tools v0.7.0 // indirect
 	golang.org/x/xerrors v0.0.0-20220907171357-04be3eba64a2 // indirect
 	google.golang.org/appengine v1.6.7 // indirect
 	gopkg.in/linkedin/goavro.v1 v1.0.5 // indirect
@@ -0,0 +1,354 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/apache/beam/sdks/v2/go/container/tools"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/metrics"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/runtime"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/runtime/metricsx"
+	jobpb "github.com/apache/beam/sdks/v2/go/pkg/beam/model/jobmanagement_v1"
+	pipepb "github.com/apache/beam/sdks/v2/go/pkg/beam/model/pipeline_v1"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/runners/universal/runnerlib"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	jobCmd = &cobra.Command{
+		Use:   "job",
+		Short: "Job management commands",
+	}
+
+	jobSubmitCmd = &cobra.Command{
+		Use:   "submit <pipeline>",
+		Short: "Submit a pipeline proto as a job",
+		Long: `Submit a pipeline proto as a job, and stream its messages until it terminates.
+
+The pipeline is read from a binary encoded Pipeline proto, or a text encoded
+one if the file has a .textproto, .pbtxt or .txt extension. If a worker binary
+is given, it is staged as the default Go environment's artifact.`,
+		RunE: jobSubmitFn,
+		Args: cobra.ExactArgs(1),
+	}
+
+	jobListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List jobs",
+		RunE:  jobListFn,
+		Args:  cobra.NoArgs,
+	}
+
+	jobStatusCmd = &cobra.Command{
+		Use:   "status <job id>",
+		Short: "Print the state of a job",
+		RunE:  jobStatusFn,
+		Args:  cobra.ExactArgs(1),
+	}
+
+	jobCancelCmd = &cobra.Command{
+		Use:   "cancel <job id>",
+		Short: "Cancel a job",
+		RunE:  jobCancelFn,
+		Args:  cobra.ExactArgs(1),
+	}
+
+	jobLogsCmd = &cobra.Command{
+		Use:   "logs <job id>",
+		Short: "Stream the messages and state changes of a job until it terminates",
+		RunE:  jobLogsFn,
+		Args:  cobra.ExactArgs(1),
+	}
+
+	jobMetricsCmd = &cobra.Command{
+		Use:   "metrics <job id>",
+		Short: "Print the user metrics of a job",
+		RunE:  jobMetricsFn,
+		Args:  cobra.ExactArgs(1),
+	}
+
+	jobName        string
+	jobWorker      string
+	jobOptions     []string
+	jobExperiments []string
+	jobDetach      bool
+)
+
+func init() {
+	RootCmd.AddCommand(jobCmd)
+	jobCmd.AddCommand(jobSubmitCmd, jobListCmd, jobStatusCmd, jobCancelCmd, jobLogsCmd, jobMetricsCmd)
+
+	jobSubmitCmd.Flags().StringVar(&jobName, "name", "", "Job name. Defaults to the pipeline file name.")
+	jobSubmitCmd.Flags().StringVar(&jobWorker, "worker", "", "Go worker binary to stage for the pipeline (optional).")
+	jobSubmitCmd.Flags().StringArrayVar(&jobOptions, "option", nil, "Pipeline option as key=value. May be repeated.")
+	jobSubmitCmd.Flags().StringSliceVar(&jobExperiments, "experiments", nil, "Comma separated experiments to enable.")
+	jobSubmitCmd.Flags().BoolVar(&jobDetach, "detach", false, "Print the job ID and exit without waiting for the job to terminate. "+
+		"Some job services, such as Prism, only make progress while the job's messages are streamed.")
+}
+
+func jobClient() (context.Context, jobpb.JobServiceClient, func() error, error) {
+	ctx, cc, err := dial()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return ctx, jobpb.NewJobServiceClient(cc), cc.Close, nil
+}
+
+func jobSubmitFn(cmd *cobra.Command, args []string) error {
+	p, err := readPipeline(args[0])
+	if err != nil {
+		return err
+	}
+	name := jobName
+	if name == "" {
+		name = strings.TrimSuffix(filepath.Base(args[0]), filepath.Ext(args[0]))
+	}
+	opts := map[string]string{}
+	for _, o := range jobOptions {
+		k, v, ok := strings.Cut(o, "=")
+		if !ok {
+			return fmt.Errorf("invalid option %q, want key=value", o)
+		}
+		opts[k] = v
+	}
+	options, err := tools.OptionsToProto(runtime.RawOptionsWrapper{
+		Options:     runtime.RawOptions{Options: opts},
+		AppName:     name,
+		Experiments: append(jobExperiments, "beam_fn_api"),
+	})
+	if err != nil {
+		return err
+	}
+	if jobWorker != "" {
+		if err := runnerlib.UpdateGoEnvironmentWorker(jobWorker, p); err != nil {
+			return err
+		}
+	}
+
+	ctx, client, closeFn, err := jobClient()
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	prep, err := client.Prepare(ctx, &jobpb.PrepareJobRequest{
+		Pipeline:        p,
+		PipelineOptions: options,
+		JobName:         name,
+	})
+	if err != nil {
+		return err
+	}
+	token := prep.GetStagingSessionToken()
+	if jobWorker != "" {
+		token, err = runnerlib.Stage(ctx, prep.GetPreparationId(), prep.GetArtifactStagingEndpoint().GetUrl(), jobWorker, token)
+		if err != nil {
+			return err
+		}
+	}
+	jobID, err := runnerlib.Submit(ctx, client, prep.GetPreparationId(), token)
+	if err != nil {
+		return err
+	}
+	cmd.Println(jobID)
+
+	if jobDetach {
+		return nil
+	}
+	return streamMessages(ctx, cmd, client, jobID)
+}
+
+// readPipeline reads a binary or text encoded pipeline proto from the file.
+func readPipeline(filename string) (*pipepb.Pipeline, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var p pipepb.Pipeline
+	switch filepath.Ext(filename) {
+	case ".textproto", ".pbtxt", ".txt":
+		err = prototext.Unmarshal(data, &p)
+	default:
+		err = proto.Unmarshal(data, &p)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid pipeline in %v: %v", filename, err)
+	}
+	return &p, nil
+}
+
+func jobListFn(cmd *cobra.Command, args []string) error {
+	ctx, client, closeFn, err := jobClient()
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	resp, err := client.GetJobs(ctx, &jobpb.GetJobsRequest{})
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "JOB ID\tNAME\tSTATE")
+	for _, j := range resp.GetJobInfo() {
+		fmt.Fprintf(w, "%v\t%v\t%v\n", j.GetJobId(), j.GetJobName(), j.GetState())
+	}
+	return w.Flush()
+}
+
+func jobStatusFn(cmd *cobra.Command, args []string) error {
+	ctx, client, closeFn, err := jobClient()
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	resp, err := client.GetState(ctx, &jobpb.GetJobStateRequest{JobId: args[0]})
+	if err != nil {
+		return err
+	}
+	cmd.Println(resp.GetState())
+	return nil
+}
+
+func jobCancelFn(cmd *cobra.Command, args []string) error {
+	ctx, client, closeFn, err := jobClient()
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	resp, err := client.Cancel(ctx, &jobpb.CancelJobRequest{JobId: args[0]})
+	if err != nil {
+		return err
+	}
+	cmd.Println(resp.GetState())
+	return nil
+}
+
+func jobLogsFn(cmd *cobra.Command, args []string) error {
+	ctx, client, closeFn, err := jobClient()
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	return streamMessages(ctx, cmd, client, args[0])
+}
+
+// streamMessages prints the messages and state changes of the job until it
+// reaches a terminal state. It returns an error if the job failed.
+func streamMessages(ctx context.Context, cmd *cobra.Command, client jobpb.JobServiceClient, jobID string) error {
+	stream, err := client.GetMessageStream(ctx, &jobpb.JobMessagesRequest{JobId: jobID})
+	if err != nil {
+		return err
+	}
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch {
+		case msg.GetStateResponse() != nil:
+			state := msg.GetStateResponse().GetState()
+			cmd.Printf("State: %v\n", state)
+			switch state {
+			case jobpb.JobState_DONE, jobpb.JobState_CANCELLED, jobpb.JobState_DRAINED, jobpb.JobState_UPDATED:
+				return nil
+			case jobpb.JobState_FAILED:
+				return fmt.Errorf("job %v failed", jobID)
+			}
+		case msg.GetMessageResponse() != nil:
+			m := msg.GetMessageResponse()
+			cmd.Printf("%v %v: %v\n", m.GetTime(), m.GetImportance(), m.GetMessageText())
+		}
+	}
+}
+
+func jobMetricsFn(cmd *cobra.Command, args []string) error {
+	ctx, client, closeFn, err := jobClient()
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	resp, err := client.GetJobMetrics(ctx, &jobpb.GetJobMetricsRequest{JobId: args[0]})
+	if err != nil {
+		return err
+	}
+	// The pipeline is only used to name PCollection metrics, so it's fine
+	// if the job service doesn't provide it.
+	var p *pipepb.Pipeline
+	if pr, err := client.GetPipeline(ctx, &jobpb.GetJobPipelineRequest{JobId: args[0]}); err == nil {
+		p = pr.GetPipeline()
+	}
+	res := metricsx.FromMonitoringInfos(p, resp.GetMetrics().GetAttempted(), resp.GetMetrics().GetCommitted())
+	return printMetrics(cmd.OutOrStdout(), res)
+}
+
+// printMetrics writes the user metrics of the results as a table, sorted
+// by step, namespace and name.
+func printMetrics(out io.Writer, res *metrics.Results) error {
+	all := res.AllMetrics()
+	var rows [][]string
+	row := func(k metrics.StepKey, typ string, attempted, committed any) {
+		rows = append(rows, []string{k.Step, k.Namespace, k.Name, typ, fmt.Sprint(attempted), fmt.Sprint(committed)})
+	}
+	for _, r := range all.Counters() {
+		row(r.Key, "counter", r.Attempted, r.Committed)
+	}
+	for _, r := range all.Distributions() {
+		row(r.Key, "distribution", distributionString(r.Attempted), distributionString(r.Committed))
+	}
+	for _, r := range all.Gauges() {
+		row(r.Key, "gauge", r.Attempted.Value, r.Committed.Value)
+	}
+	for _, r := range all.Histograms() {
+		row(r.Key, "histogram", r.Attempted, r.Committed)
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		for c := 0; c < 4; c++ {
+			if rows[i][c] != rows[j][c] {
+				return rows[i][c] < rows[j][c]
+			}
+		}
+		return false
+	})
+
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "STEP\tNAMESPACE\tNAME\tTYPE\tATTEMPTED\tCOMMITTED")
+	for _, r := range rows {
+		fmt.Fprintln(w, strings.Join(r, "\t"))
+	}
+	return w.Flush()
+}
+
+func distributionString(v metrics.DistributionValue) string {
+	return fmt.Sprintf("count: %d sum: %d min: %d max: %d", v.Count, v.Sum, v.Min, v.Max)
+}
@@ -0,0 +1,284 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/runtime/metricsx"
+	jobpb "github.com/apache/beam/sdks/v2/go/pkg/beam/model/jobmanagement_v1"
+	pipepb "github.com/apache/beam/sdks/v2/go/pkg/beam/model/pipeline_v1"
+	"google.golang.org/grpc"
+)
+
+// fakeJobService is a minimal job service that tracks a single job.
+type fakeJobService struct {
+	jobpb.UnimplementedJobServiceServer
+
+	mu       sync.Mutex
+	name     string
+	state    jobpb.JobState_Enum
+	canceled []string
+}
+
+func (s *fakeJobService) Prepare(ctx context.Context, req *jobpb.PrepareJobRequest) (*jobpb.PrepareJobResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.name = req.GetJobName()
+	s.state = jobpb.JobState_STOPPED
+	return &jobpb.PrepareJobResponse{PreparationId: "prep"}, nil
+}
+
+func (s *fakeJobService) Run(ctx context.Context, req *jobpb.RunJobRequest) (*jobpb.RunJobResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if req.GetPreparationId() != "prep" {
+		return nil, fmt.Errorf("unknown preparation id: %v", req.GetPreparationId())
+	}
+	s.state = jobpb.JobState_RUNNING
+	return &jobpb.RunJobResponse{JobId: "job-1"}, nil
+}
+
+func (s *fakeJobService) GetJobs(ctx context.Context, req *jobpb.GetJobsRequest) (*jobpb.GetJobsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &jobpb.GetJobsResponse{
+		JobInfo: []*jobpb.JobInfo{{JobId: "job-1", JobName: s.name, State: s.state}},
+	}, nil
+}
+
+func (s *fakeJobService) GetState(ctx context.Context, req *jobpb.GetJobStateRequest) (*jobpb.JobStateEvent, error) {
+	if req.GetJobId() != "job-1" {
+		return nil, fmt.Errorf("unknown job id: %v", req.GetJobId())
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &jobpb.JobStateEvent{State: s.state}, nil
+}
+
+func (s *fakeJobService) Cancel(ctx context.Context, req *jobpb.CancelJobRequest) (*jobpb.CancelJobResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.canceled = append(s.canceled, req.GetJobId())
+	s.state = jobpb.JobState_CANCELLING
+	return &jobpb.CancelJobResponse{State: s.state}, nil
+}
+
+func (s *fakeJobService) GetMessageStream(req *jobpb.JobMessagesRequest, stream jobpb.JobService_GetMessageStreamServer) error {
+	stream.Send(&jobpb.JobMessagesResponse{
+		Response: &jobpb.JobMessagesResponse_MessageResponse{
+			MessageResponse: &jobpb.JobMessage{
+				MessageText: "running " + req.GetJobId(),
+				Importance:  jobpb.JobMessage_JOB_MESSAGE_BASIC,
+			},
+		},
+	})
+	s.mu.Lock()
+	final := jobpb.JobState_DONE
+	if s.name == "failing" {
+		final = jobpb.JobState_FAILED
+	}
+	s.state = final
+	s.mu.Unlock()
+	return stream.Send(&jobpb.JobMessagesResponse{
+		Response: &jobpb.JobMessagesResponse_StateResponse{
+			StateResponse: &jobpb.JobStateEvent{State: final},
+		},
+	})
+}
+
+func (s *fakeJobService) GetJobMetrics(ctx context.Context, req *jobpb.GetJobMetricsRequest) (*jobpb.GetJobMetricsResponse, error) {
+	payload, err := metricsx.Int64Counter(42)
+	if err != nil {
+		return nil, err
+	}
+	counter := &pipepb.MonitoringInfo{
+		Urn:  metricsx.UrnToString(metricsx.UrnUserSumInt64),
+		Type: metricsx.UrnToType(metricsx.UrnUserSumInt64),
+		Labels: map[string]string{
+			"PTRANSFORM": "main.countFn",
+			"NAMESPACE":  "main",
+			"NAME":       "elements",
+		},
+		Payload: payload,
+	}
+	return &jobpb.GetJobMetricsResponse{
+		Metrics: &jobpb.MetricResults{
+			Attempted: []*pipepb.MonitoringInfo{counter},
+			Committed: []*pipepb.MonitoringInfo{counter},
+		},
+	}, nil
+}
+
+// startFakeJobService serves a fakeJobService and points the beamctl
+// endpoint at it for the duration of the test.
+func startFakeJobService(t *testing.T) *fakeJobService {
+	t.Helper()
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	fake := &fakeJobService{}
+	g := grpc.NewServer()
+	jobpb.RegisterJobServiceServer(g, fake)
+	go g.Serve(lis)
+	t.Cleanup(g.Stop)
+
+	old := endpoint
+	endpoint = lis.Addr().String()
+	t.Cleanup(func() { endpoint = old })
+	return fake
+}
+
+// runJobCmd executes beamctl with the given arguments, returning its output.
+func runJobCmd(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	var out bytes.Buffer
+	RootCmd.SetOut(&out)
+	RootCmd.SetErr(&out)
+	RootCmd.SetArgs(append([]string{"job"}, args...))
+	defer RootCmd.SetArgs(nil)
+	err := RootCmd.Execute()
+	return out.String(), err
+}
+
+func writePipeline(t *testing.T, name string) string {
+	t.Helper()
+	filename := filepath.Join(t.TempDir(), name+".textproto")
+	if err := os.WriteFile(filename, []byte("requirements: \"beam:requirement:pardo:splittable_dofn:v1\"\n"), 0644); err != nil {
+		t.Fatalf("failed to write pipeline: %v", err)
+	}
+	return filename
+}
+
+func TestJobSubmit(t *testing.T) {
+	fake := startFakeJobService(t)
+
+	out, err := runJobCmd(t, "submit", writePipeline(t, "wordcount"))
+	if err != nil {
+		t.Fatalf("job submit failed: %v\n%v", err, out)
+	}
+	for _, want := range []string{"job-1", "running job-1", "State: DONE"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("job submit output = %q, want it to contain %q", out, want)
+		}
+	}
+	if got, want := fake.name, "wordcount"; got != want {
+		t.Errorf("job submit name = %v, want %v", got, want)
+	}
+
+	if out, err := runJobCmd(t, "submit", writePipeline(t, "failing")); err == nil {
+		t.Errorf("job submit of failing job succeeded, want error\n%v", out)
+	}
+}
+
+func TestJobSubmit_invalidPipeline(t *testing.T) {
+	startFakeJobService(t)
+
+	filename := filepath.Join(t.TempDir(), "bad.textproto")
+	if err := os.WriteFile(filename, []byte("not a pipeline"), 0644); err != nil {
+		t.Fatalf("failed to write pipeline: %v", err)
+	}
+	if out, err := runJobCmd(t, "submit", filename); err == nil {
+		t.Errorf("job submit of invalid pipeline succeeded, want error\n%v", out)
+	}
+}
+
+func TestJobListStatusCancel(t *testing.T) {
+	fake := startFakeJobService(t)
+	if out, err := runJobCmd(t, "submit", "--detach", writePipeline(t, "wordcount")); err != nil {
+		t.Fatalf("job submit failed: %v\n%v", err, out)
+	}
+	defer func() { jobDetach = false }()
+
+	out, err := runJobCmd(t, "list")
+	if err != nil {
+		t.Fatalf("job list failed: %v", err)
+	}
+	if !strings.Contains(out, "job-1") || !strings.Contains(out, "wordcount") || !strings.Contains(out, "RUNNING") {
+		t.Errorf("job list output = %q, want a RUNNING job-1 named wordcount", out)
+	}
+
+	out, err = runJobCmd(t, "status", "job-1")
+	if err != nil {
+		t.Fatalf("job status failed: %v", err)
+	}
+	if got, want := strings.TrimSpace(out), "RUNNING"; got != want {
+		t.Errorf("job status output = %q, want %q", got, want)
+	}
+	if _, err := runJobCmd(t, "status", "unknown"); err == nil {
+		t.Errorf("job status of unknown job succeeded, want error")
+	}
+
+	out, err = runJobCmd(t, "cancel", "job-1")
+	if err != nil {
+		t.Fatalf("job cancel failed: %v", err)
+	}
+	if got, want := strings.TrimSpace(out), "CANCELLING"; got != want {
+		t.Errorf("job cancel output = %q, want %q", got, want)
+	}
+	if got := fake.canceled; len(got) != 1 || got[0] != "job-1" {
+		t.Errorf("job cancel cancelled %v, want [job-1]", got)
+	}
+}
+
+func TestJobLogs(t *testing.T) {
+	startFakeJobService(t)
+
+	out, err := runJobCmd(t, "logs", "job-1")
+	if err != nil {
+		t.Fatalf("job logs failed: %v", err)
+	}
+	if !strings.Contains(out, "running job-1") || !strings.Contains(out, "State: DONE") {
+		t.Errorf("job logs output = %q, want the job's message and DONE state", out)
+	}
+}
+
+func TestJobMetrics(t *testing.T) {
+	startFakeJobService(t)
+
+	out, err := runJobCmd(t, "metrics", "job-1")
+	if err != nil {
+		t.Fatalf("job metrics failed: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("job metrics output = %q, want a header and one row", out)
+	}
+	if got, want := strings.Fields(lines[1]), []string{"main.countFn", "main", "elements", "counter", "42", "42"}; strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("job metrics row = %v, want %v", got, want)
+	}
+}
+
+func TestJobCommands_noEndpoint(t *testing.T) {
+	old := endpoint
+	endpoint = ""
+	defer func() { endpoint = old }()
+
+	for _, args := range [][]string{{"list"}, {"status", "job-1"}, {"cancel", "job-1"}, {"logs", "job-1"}, {"metrics", "job-1"}} {
+		if _, err := runJobCmd(t, args...); err == nil {
+			t.Errorf("job %v without an endpoint succeeded, want error", args)
+		}
+	}
+}
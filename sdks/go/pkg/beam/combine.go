@@ -57,13 +57,16 @@ func addCombinePerKeyCtx(err error, s Scope) error {
 func TryCombinePerKey(s Scope, combinefn any, col PCollection, opts ...Option) (PCollection, error) {
 	s = s.Scope(graph.CombinePerKeyScope)
 	ValidateKVType(col)
-	side, typedefs, err := validate(s, col, opts)
+	side, typedefs, handler, err := validate(s, col, opts)
 	if err != nil {
 		return PCollection{}, addCombinePerKeyCtx(err, s)
 	}
 	if len(side) > 0 {
 		return PCollection{}, addCombinePerKeyCtx(errors.New("combine does not support side inputs"), s)
 	}
+	if handler != nil {
+		return PCollection{}, addCombinePerKeyCtx(errors.New("combine does not support error handlers"), s)
+	}
 
 	col, err = TryGroupByKey(s, col)
 	if err != nil {
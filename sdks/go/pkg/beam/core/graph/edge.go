@@ -163,6 +163,7 @@ type MultiEdge struct {
 	External         *ExternalTransform      // Current External Transforms API
 	Payload          *Payload                // Legacy External Transforms API
 	WindowFn         *window.Fn              // WindowInto
+	Annotations      map[string][]byte       // ParDo
 
 	Input  []*Inbound
 	Output []*Outbound
@@ -74,6 +74,9 @@ func dumperExtractor(store *Store, p func(format string, args ...any)) {
 		GaugeInt64: func(l Labels, v int64, t time.Time) {
 			m[l] = &gauge{v: v, t: t}
 		},
+		HistogramInt64: func(l Labels, v HistogramValue) {
+			m[l] = &histogram{buckets: v.Buckets, counts: v.Counts}
+		},
 		MsecsInt64: func(labels string, e *[4]ExecutionState) {},
 	}
 	e.ExtractFrom(store)
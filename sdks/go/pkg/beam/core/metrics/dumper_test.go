@@ -34,12 +34,14 @@ func TestDumperExtractor(t *testing.T) {
 	store.storeMetric("pid", newName("ns", "counter"), &counter{value: 1})
 	store.storeMetric("pid", newName("ns", "distribution"), &distribution{count: 1, sum: 2, min: 3, max: 4})
 	store.storeMetric("pid", newName("ns", "gauge"), &gauge{v: 1, t: now})
+	store.storeMetric("pid", newName("ns", "histogram"), &histogram{buckets: LinearBuckets(0, 10, 1), counts: []int64{0, 1, 0}})
 
 	expected := []string{
 		"PTransformID: \"pid\"",
 		"	ns.counter - value: 1",
 		"	ns.distribution - count: 1 sum: 2 min: 3 max: 4",
 		"	ns.gauge - Gauge time: 2019-01-01 00:00:00 +0000 UTC value: 1",
+		"	ns.histogram - count: 1 p50: 5 p99: 9.9 buckets: {[0,10): 1}",
 	}
 
 	dumperExtractor(store, printer)
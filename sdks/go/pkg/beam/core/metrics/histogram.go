@@ -0,0 +1,348 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Buckets describes how a Histogram partitions observed values.
+//
+// Buckets are defined by a strictly increasing list of boundaries. A layout
+// with n+1 boundaries has n buckets, where bucket i holds values in the
+// half open range [bounds[i], bounds[i+1]). Values below the first boundary
+// are counted in an underflow bucket, and values at or above the last boundary
+// are counted in an overflow bucket.
+type Buckets struct {
+	bounds []int64
+}
+
+// LinearBuckets returns a layout of n buckets of equal width, the first of
+// which starts at start.
+func LinearBuckets(start, width int64, n int) Buckets {
+	if width <= 0 || n <= 0 {
+		panic(fmt.Sprintf("linear buckets require a positive width and count, got width %d and count %d", width, n))
+	}
+	bounds := make([]int64, 0, n+1)
+	for i := 0; i <= n; i++ {
+		bounds = append(bounds, start+int64(i)*width)
+	}
+	return Buckets{bounds: bounds}
+}
+
+// ExponentialBuckets returns a layout of n buckets where each boundary is
+// growth times the previous one, starting at start. Since values are integers,
+// boundaries that would round to the previous boundary are bumped by one, so
+// small starting values with low growth factors still yield n buckets.
+func ExponentialBuckets(start int64, growth float64, n int) Buckets {
+	if start <= 0 || growth <= 1 || n <= 0 {
+		panic(fmt.Sprintf("exponential buckets require a positive start, a growth factor above 1 and a positive count, got start %d, growth %v and count %d", start, growth, n))
+	}
+	bounds := make([]int64, 0, n+1)
+	bounds = append(bounds, start)
+	cur := float64(start)
+	for i := 1; i <= n; i++ {
+		cur *= growth
+		b := int64(math.Round(cur))
+		if prev := bounds[i-1]; b <= prev {
+			b = prev + 1
+		}
+		bounds = append(bounds, b)
+	}
+	return Buckets{bounds: bounds}
+}
+
+// NewBuckets returns a layout from explicit bucket boundaries, which must
+// be strictly increasing, and contain at least two values.
+func NewBuckets(bounds []int64) (Buckets, error) {
+	if len(bounds) < 2 {
+		return Buckets{}, fmt.Errorf("bucket layout requires at least 2 boundaries, got %v", bounds)
+	}
+	for i := 1; i < len(bounds); i++ {
+		if bounds[i] <= bounds[i-1] {
+			return Buckets{}, fmt.Errorf("bucket boundaries must be strictly increasing, got %v", bounds)
+		}
+	}
+	return Buckets{bounds: append([]int64(nil), bounds...)}, nil
+}
+
+// Bounds returns a copy of the bucket boundaries.
+func (b Buckets) Bounds() []int64 {
+	return append([]int64(nil), b.bounds...)
+}
+
+// NumBuckets returns the number of buckets in the layout, excluding
+// the underflow and overflow buckets.
+func (b Buckets) NumBuckets() int {
+	if len(b.bounds) == 0 {
+		return 0
+	}
+	return len(b.bounds) - 1
+}
+
+// Equal returns whether the two layouts have the same boundaries.
+func (b Buckets) Equal(o Buckets) bool {
+	if len(b.bounds) != len(o.bounds) {
+		return false
+	}
+	for i := range b.bounds {
+		if b.bounds[i] != o.bounds[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// index returns the index into a counts slice for the value, where
+// 0 is the underflow bucket, and NumBuckets()+1 is the overflow bucket.
+func (b Buckets) index(v int64) int {
+	return sort.Search(len(b.bounds), func(i int) bool { return b.bounds[i] > v })
+}
+
+// Histogram is a metric that records the distribution of values into
+// a fixed set of buckets, allowing percentiles to be estimated.
+type Histogram struct {
+	name    name
+	hash    nameHash
+	buckets Buckets
+}
+
+func (m *Histogram) String() string {
+	return fmt.Sprintf("Histogram metric %s", m.name)
+}
+
+// NewHistogram returns the Histogram with the given namespace, name and
+// bucket layout.
+func NewHistogram(ns, n string, buckets Buckets) *Histogram {
+	if buckets.NumBuckets() == 0 {
+		panic(fmt.Sprintf("histogram %s.%s requires a non-empty bucket layout", ns, n))
+	}
+	return &Histogram{
+		name:    newName(ns, n),
+		hash:    hashName(ns, n),
+		buckets: buckets,
+	}
+}
+
+// Update adds an observation to the histogram within the given PTransform context.
+func (m *Histogram) Update(ctx context.Context, v int64) {
+	cs := getCounterSet(ctx)
+	if cs == nil {
+		return
+	}
+	if h, ok := cs.histograms[m.hash]; ok {
+		h.update(v)
+		return
+	}
+	// We're the first to create this metric!
+	h := &histogram{
+		buckets: m.buckets,
+		counts:  make([]int64, m.buckets.NumBuckets()+2),
+		sum:     v,
+	}
+	h.counts[m.buckets.index(v)]++
+	cs.histograms[m.hash] = h
+	GetStore(ctx).storeMetric(cs.pid, m.name, h)
+}
+
+// histogram is a metric cell for histogram values.
+type histogram struct {
+	mu      sync.Mutex
+	buckets Buckets
+	counts  []int64
+	sum     int64
+}
+
+func (m *histogram) update(v int64) {
+	m.mu.Lock()
+	m.counts[m.buckets.index(v)]++
+	m.sum += v
+	m.mu.Unlock()
+}
+
+func (m *histogram) kind() kind {
+	return kindHistogram
+}
+
+func (m *histogram) String() string {
+	return m.get().String()
+}
+
+func (m *histogram) get() HistogramValue {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return HistogramValue{Buckets: m.buckets, Counts: append([]int64(nil), m.counts...), Sum: m.sum}
+}
+
+// HistogramValue is the value of a Histogram metric.
+//
+// Counts has NumBuckets()+2 entries, where the first entry is the underflow
+// bucket, and the last entry is the overflow bucket. Sum is the sum of all
+// observed values.
+type HistogramValue struct {
+	Buckets Buckets
+	Counts  []int64
+	Sum     int64
+}
+
+// Count returns the total number of observations in the histogram.
+func (v HistogramValue) Count() int64 {
+	var n int64
+	for _, c := range v.Counts {
+		n += c
+	}
+	return n
+}
+
+// Percentile estimates the value at the given percentile, in the range
+// (0, 100], by linearly interpolating within the bucket that contains it.
+// Observations in the underflow or overflow buckets are reported as the
+// lowest or highest boundary respectively.
+//
+// Returns NaN if the histogram is empty.
+func (v HistogramValue) Percentile(p float64) float64 {
+	total := v.Count()
+	if total == 0 || len(v.Counts) != v.Buckets.NumBuckets()+2 {
+		return math.NaN()
+	}
+	bounds := v.Buckets.bounds
+	rank := p / 100 * float64(total)
+	var cum float64
+	for i, c := range v.Counts {
+		if c == 0 {
+			continue
+		}
+		if cum+float64(c) < rank {
+			cum += float64(c)
+			continue
+		}
+		switch i {
+		case 0:
+			return float64(bounds[0])
+		case len(v.Counts) - 1:
+			return float64(bounds[len(bounds)-1])
+		}
+		lo, hi := float64(bounds[i-1]), float64(bounds[i])
+		return lo + (hi-lo)*(rank-cum)/float64(c)
+	}
+	return float64(bounds[len(bounds)-1])
+}
+
+// Merge combines the observations of two histograms with the same bucket
+// layout. An empty histogram merges with any layout.
+func (v HistogramValue) Merge(o HistogramValue) (HistogramValue, error) {
+	if len(v.Counts) == 0 {
+		return o, nil
+	}
+	if len(o.Counts) == 0 {
+		return v, nil
+	}
+	if !v.Buckets.Equal(o.Buckets) || len(v.Counts) != len(o.Counts) {
+		return HistogramValue{}, fmt.Errorf("can't merge histograms with different bucket layouts: %v and %v", v.Buckets.bounds, o.Buckets.bounds)
+	}
+	counts := make([]int64, len(v.Counts))
+	for i := range counts {
+		counts[i] = v.Counts[i] + o.Counts[i]
+	}
+	return HistogramValue{Buckets: v.Buckets, Counts: counts, Sum: v.Sum + o.Sum}, nil
+}
+
+// String renders the histogram's count, estimated median and 99th percentile,
+// and all non-empty buckets.
+func (v HistogramValue) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "count: %d p50: %v p99: %v buckets: {", v.Count(), v.Percentile(50), v.Percentile(99))
+	bounds := v.Buckets.bounds
+	first := true
+	for i, c := range v.Counts {
+		if c == 0 {
+			continue
+		}
+		if !first {
+			b.WriteString(", ")
+		}
+		first = false
+		switch i {
+		case 0:
+			fmt.Fprintf(&b, "(-inf,%d): %d", bounds[0], c)
+		case len(v.Counts) - 1:
+			fmt.Fprintf(&b, "[%d,+inf): %d", bounds[len(bounds)-1], c)
+		default:
+			fmt.Fprintf(&b, "[%d,%d): %d", bounds[i-1], bounds[i], c)
+		}
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+// HistogramResult is an attempted and a commited value of a histogram
+// metric plus key.
+type HistogramResult struct {
+	Attempted, Committed HistogramValue
+	Key                  StepKey
+}
+
+// Result returns committed metrics. Falls back to attempted metrics if committed
+// are not populated (e.g. due to not being supported on a given runner).
+func (r HistogramResult) Result() HistogramValue {
+	if len(r.Committed.Counts) != 0 {
+		return r.Committed
+	}
+	return r.Attempted
+}
+
+// Name returns the Name of this Histogram.
+func (r HistogramResult) Name() string {
+	return r.Key.Name
+}
+
+// Namespace returns the Namespace of this Histogram.
+func (r HistogramResult) Namespace() string {
+	return r.Key.Namespace
+}
+
+// Transform returns the Transform step for this HistogramResult.
+func (r HistogramResult) Transform() string { return r.Key.Step }
+
+// MergeHistograms combines histogram metrics that share a common key.
+func MergeHistograms(
+	attempted map[StepKey]HistogramValue,
+	committed map[StepKey]HistogramValue) []HistogramResult {
+	res := make([]HistogramResult, 0)
+	merged := map[StepKey]HistogramResult{}
+
+	for k, v := range attempted {
+		merged[k] = HistogramResult{Attempted: v, Key: k}
+	}
+	for k, v := range committed {
+		m, ok := merged[k]
+		if ok {
+			merged[k] = HistogramResult{Attempted: m.Attempted, Committed: v, Key: k}
+		} else {
+			merged[k] = HistogramResult{Committed: v, Key: k}
+		}
+	}
+
+	for _, v := range merged {
+		res = append(res, v)
+	}
+	return res
+}
@@ -0,0 +1,144 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBuckets(t *testing.T) {
+	tests := []struct {
+		name    string
+		buckets Buckets
+		want    []int64
+	}{
+		{name: "linear", buckets: LinearBuckets(0, 10, 3), want: []int64{0, 10, 20, 30}},
+		{name: "linearNegativeStart", buckets: LinearBuckets(-5, 5, 2), want: []int64{-5, 0, 5}},
+		{name: "exponential", buckets: ExponentialBuckets(1, 2, 4), want: []int64{1, 2, 4, 8, 16}},
+		{name: "exponentialSlowGrowth", buckets: ExponentialBuckets(1, 1.1, 3), want: []int64{1, 2, 3, 4}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if diff := cmp.Diff(test.want, test.buckets.Bounds()); diff != "" {
+				t.Errorf("Bounds() diff (-want,+got):\n%v", diff)
+			}
+			if got, want := test.buckets.NumBuckets(), len(test.want)-1; got != want {
+				t.Errorf("NumBuckets() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestNewBuckets_Invalid(t *testing.T) {
+	for _, bounds := range [][]int64{nil, {1}, {1, 1}, {3, 2, 1}} {
+		if _, err := NewBuckets(bounds); err == nil {
+			t.Errorf("NewBuckets(%v) succeeded, want error", bounds)
+		}
+	}
+}
+
+func TestHistogram_Update(t *testing.T) {
+	ctxA := ctxWith(bID, "A")
+	ctxB := ctxWith(bID, "B")
+
+	h := NewHistogram("ns", "latency", LinearBuckets(0, 10, 3))
+	for _, v := range []int64{-1, 0, 5, 10, 29, 30, 100} {
+		h.Update(ctxA, v)
+	}
+	h.Update(ctxB, 15)
+
+	if got, want := getCounterSet(ctxA).histograms[h.hash].get().Counts, []int64{1, 2, 1, 1, 2}; !cmp.Equal(got, want) {
+		t.Errorf("histogram A counts = %v, want %v", got, want)
+	}
+	if got, want := getCounterSet(ctxB).histograms[h.hash].get().Counts, []int64{0, 0, 1, 0, 0}; !cmp.Equal(got, want) {
+		t.Errorf("histogram B counts = %v, want %v", got, want)
+	}
+	if got, want := getCounterSet(ctxA).histograms[h.hash].get().Sum, int64(173); got != want {
+		t.Errorf("histogram A sum = %v, want %v", got, want)
+	}
+}
+
+func TestHistogramValue_Percentile(t *testing.T) {
+	v := HistogramValue{
+		Buckets: LinearBuckets(0, 10, 4),
+		Counts:  []int64{0, 5, 3, 1, 1, 0},
+	}
+	tests := []struct {
+		p    float64
+		want float64
+	}{
+		{p: 10, want: 2},
+		{p: 50, want: 10},
+		{p: 80, want: 20},
+		{p: 100, want: 40},
+	}
+	for _, test := range tests {
+		if got := v.Percentile(test.p); math.Abs(got-test.want) > 1e-9 {
+			t.Errorf("Percentile(%v) = %v, want %v", test.p, got, test.want)
+		}
+	}
+	if got := (HistogramValue{}).Percentile(50); !math.IsNaN(got) {
+		t.Errorf("empty Percentile(50) = %v, want NaN", got)
+	}
+	overflow := HistogramValue{Buckets: LinearBuckets(0, 10, 1), Counts: []int64{0, 0, 4}}
+	if got, want := overflow.Percentile(99), 10.0; got != want {
+		t.Errorf("overflow Percentile(99) = %v, want %v", got, want)
+	}
+}
+
+func TestHistogramValue_Merge(t *testing.T) {
+	a := HistogramValue{Buckets: LinearBuckets(0, 10, 2), Counts: []int64{1, 2, 3, 4}, Sum: 100}
+	b := HistogramValue{Buckets: LinearBuckets(0, 10, 2), Counts: []int64{4, 3, 2, 1}, Sum: 50}
+
+	got, err := a.Merge(b)
+	if err != nil {
+		t.Fatalf("Merge() failed: %v", err)
+	}
+	if want := []int64{5, 5, 5, 5}; !cmp.Equal(got.Counts, want) {
+		t.Errorf("Merge() counts = %v, want %v", got.Counts, want)
+	}
+	if got, want := got.Sum, int64(150); got != want {
+		t.Errorf("Merge() sum = %v, want %v", got, want)
+	}
+	if got, err := (HistogramValue{}).Merge(b); err != nil || !cmp.Equal(got.Counts, b.Counts) {
+		t.Errorf("empty Merge() = %v, %v, want %v", got, err, b)
+	}
+
+	c := HistogramValue{Buckets: LinearBuckets(0, 5, 2), Counts: []int64{0, 0, 0, 0}}
+	if _, err := a.Merge(c); err == nil {
+		t.Errorf("Merge() with different layouts succeeded, want error")
+	}
+}
+
+func TestMergeHistograms(t *testing.T) {
+	realKey := StepKey{Name: "real"}
+	v1 := HistogramValue{Buckets: LinearBuckets(0, 1, 1), Counts: []int64{0, 1, 0}}
+	v2 := HistogramValue{Buckets: LinearBuckets(0, 1, 1), Counts: []int64{1, 1, 1}}
+	attempted := map[StepKey]HistogramValue{realKey: v1}
+	committed := map[StepKey]HistogramValue{realKey: v2}
+	want := []HistogramResult{{Attempted: v1, Committed: v2, Key: realKey}}
+
+	got := MergeHistograms(attempted, committed)
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(Buckets{})); diff != "" {
+		t.Errorf("MergeHistograms() diff (-want,+got):\n%v", diff)
+	}
+	if got, want := got[0].Result().Count(), int64(3); got != want {
+		t.Errorf("Result().Count() = %v, want %v", got, want)
+	}
+}
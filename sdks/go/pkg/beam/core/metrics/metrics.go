@@ -101,6 +101,7 @@ func (ctx *beamCtx) Value(key any) any {
 					counters:      make(map[nameHash]*counter),
 					distributions: make(map[nameHash]*distribution),
 					gauges:        make(map[nameHash]*gauge),
+					histograms:    make(map[nameHash]*histogram),
 				}
 				ctx.store.css = append(ctx.store.css, cs)
 				ctx.cs = cs
@@ -214,6 +215,7 @@ const (
 	kindDistribution
 	kindGauge
 	kindDoFnMsec
+	kindHistogram
 )
 
 func (t kind) String() string {
@@ -226,6 +228,8 @@ func (t kind) String() string {
 		return "Gauge"
 	case kindDoFnMsec:
 		return "DoFnMsec"
+	case kindHistogram:
+		return "Histogram"
 	default:
 		panic(fmt.Sprintf("Unknown metric type value: %v", uint8(t)))
 	}
@@ -290,6 +294,16 @@ func hashString(hasher hash.Hash64, s string, b []byte) {
 	ioutilx.WriteUnsafe(hasher, b[:n])
 }
 
+// ThrottlingNamespace and ThrottlingMsecs name the standard counter of the
+// milliseconds a transform spent throttled by an external service, such as
+// by client-side rate limiting. Runners may use it to avoid scaling up
+// workers for steps that are limited by the service rather than by the
+// workers.
+const (
+	ThrottlingNamespace = "beam-throttling-metrics"
+	ThrottlingMsecs     = "throttling-msecs"
+)
+
 // Counter is a simple counter for incrementing and decrementing a value.
 type Counter struct {
 	name name
@@ -534,6 +548,7 @@ type Results struct {
 	gauges        []GaugeResult
 	msecs         []MsecResult
 	pCols         []PColResult
+	histograms    []HistogramResult
 }
 
 // NewResults creates a new Results.
@@ -543,7 +558,18 @@ func NewResults(
 	gauges []GaugeResult,
 	msecs []MsecResult,
 	pCols []PColResult) *Results {
-	return &Results{counters, distributions, gauges, msecs, pCols}
+	return &Results{counters, distributions, gauges, msecs, pCols, nil}
+}
+
+// NewResultsWithHistograms creates a new Results which also contains histograms.
+func NewResultsWithHistograms(
+	counters []CounterResult,
+	distributions []DistributionResult,
+	gauges []GaugeResult,
+	msecs []MsecResult,
+	pCols []PColResult,
+	histograms []HistogramResult) *Results {
+	return &Results{counters, distributions, gauges, msecs, pCols, histograms}
 }
 
 // AllMetrics returns all metrics from a Results instance.
@@ -569,6 +595,7 @@ func (mr Results) Query(f func(SingleResult) bool) QueryResults {
 	gauges := []GaugeResult{}
 	msecs := []MsecResult{}
 	pCols := []PColResult{}
+	histograms := []HistogramResult{}
 
 	for _, counter := range mr.counters {
 		if f(counter) {
@@ -595,7 +622,12 @@ func (mr Results) Query(f func(SingleResult) bool) QueryResults {
 			pCols = append(pCols, pCol)
 		}
 	}
-	return QueryResults{counters: counters, distributions: distributions, gauges: gauges, msecs: msecs, pCols: pCols}
+	for _, histogram := range mr.histograms {
+		if f(histogram) {
+			histograms = append(histograms, histogram)
+		}
+	}
+	return QueryResults{counters: counters, distributions: distributions, gauges: gauges, msecs: msecs, pCols: pCols, histograms: histograms}
 }
 
 // QueryResults is the result of a query. Allows accessing all of the
@@ -606,6 +638,7 @@ type QueryResults struct {
 	gauges        []GaugeResult
 	msecs         []MsecResult
 	pCols         []PColResult
+	histograms    []HistogramResult
 }
 
 // Counters returns a slice of counter metrics.
@@ -643,6 +676,13 @@ func (qr QueryResults) PCols() []PColResult {
 	return out
 }
 
+// Histograms returns a slice of histogram metrics.
+func (qr QueryResults) Histograms() []HistogramResult {
+	out := make([]HistogramResult, len(qr.histograms))
+	copy(out, qr.histograms)
+	return out
+}
+
 // CounterResult is an attempted and a commited value of a counter metric plus
 // key.
 type CounterResult struct {
@@ -935,6 +975,9 @@ func ResultsExtractor(ctx context.Context) Results {
 		GaugeInt64: func(l Labels, v int64, t time.Time) {
 			m[l] = &gauge{v: v, t: t}
 		},
+		HistogramInt64: func(l Labels, v HistogramValue) {
+			m[l] = &histogram{buckets: v.Buckets, counts: v.Counts, sum: v.Sum}
+		},
 		MsecsInt64: func(labels string, e *[4]ExecutionState) {
 			m[PTransformLabels(labels)] = &executionState{state: e}
 		},
@@ -961,7 +1004,7 @@ func ResultsExtractor(ctx context.Context) Results {
 		return false
 	})
 
-	r := Results{counters: []CounterResult{}, distributions: []DistributionResult{}, gauges: []GaugeResult{}, msecs: []MsecResult{}}
+	r := Results{counters: []CounterResult{}, distributions: []DistributionResult{}, gauges: []GaugeResult{}, msecs: []MsecResult{}, histograms: []HistogramResult{}}
 	for _, l := range ls {
 		key := StepKey{Step: l.transform, Name: l.name, Namespace: l.namespace}
 		switch opt := m[l]; opt.(type) {
@@ -983,6 +1026,12 @@ func ResultsExtractor(ctx context.Context) Results {
 			attempted[key] = GaugeValue{}
 			committed[key] = GaugeValue{opt.(*gauge).v, opt.(*gauge).t}
 			r.gauges = append(r.gauges, MergeGauges(attempted, committed)...)
+		case *histogram:
+			attempted := make(map[StepKey]HistogramValue)
+			committed := make(map[StepKey]HistogramValue)
+			attempted[key] = HistogramValue{}
+			committed[key] = opt.(*histogram).get()
+			r.histograms = append(r.histograms, MergeHistograms(attempted, committed)...)
 		case *executionState:
 			attempted := make(map[StepKey]MsecValue)
 			committed := make(map[StepKey]MsecValue)
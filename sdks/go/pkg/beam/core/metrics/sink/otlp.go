@@ -0,0 +1,268 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/internal/errors"
+)
+
+func init() {
+	Register("otlp", func(_ context.Context, endpoint string) (Sink, error) {
+		return NewOTLP(endpoint), nil
+	})
+}
+
+// OTLP pushes snapshots to an OpenTelemetry collector using the OTLP/HTTP
+// protocol with JSON encoding.
+//
+// Counters are exported as the beam.user.counter sum, gauges as the
+// beam.user.gauge gauge, distributions as the beam.user.distribution summary
+// with the min and max as the 0 and 1 quantiles, and histograms as the
+// beam.user.histogram histogram. All values are cumulative from the start
+// of the export. Beam histogram buckets include their lower boundary rather
+// than their upper one, which differs slightly from OTLP explicit bounds.
+type OTLP struct {
+	url    string
+	client *http.Client
+	start  time.Time
+}
+
+// NewOTLP returns an OTLP sink for the given collector endpoint, such as
+// "http://localhost:4318". The "/v1/metrics" path is appended if the endpoint
+// has no path.
+func NewOTLP(endpoint string) *OTLP {
+	url := strings.TrimSuffix(endpoint, "/")
+	if i := strings.Index(url, "://"); i < 0 || !strings.Contains(url[i+3:], "/") {
+		url += "/v1/metrics"
+	}
+	return &OTLP{url: url, client: &http.Client{Timeout: 30 * time.Second}, start: time.Now()}
+}
+
+// Export pushes the snapshot to the collector.
+func (o *OTLP) Export(ctx context.Context, snap Snapshot) error {
+	body, err := json.Marshal(o.request(snap))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, o.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("OTLP export to %v failed with status %v: %s", o.url, resp.Status, msg)
+	}
+	return nil
+}
+
+// Close is a no-op, since each export is a separate request.
+func (o *OTLP) Close() error {
+	return nil
+}
+
+// The types below mirror the JSON encoding of the OTLP metrics protos.
+// 64 bit integers are encoded as strings, per the proto3 JSON mapping.
+
+type otlpRequest struct {
+	ResourceMetrics []otlpResourceMetrics `json:"resourceMetrics"`
+}
+
+type otlpResourceMetrics struct {
+	Resource     otlpResource       `json:"resource"`
+	ScopeMetrics []otlpScopeMetrics `json:"scopeMetrics"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeMetrics struct {
+	Scope   otlpScope    `json:"scope"`
+	Metrics []otlpMetric `json:"metrics"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type otlpMetric struct {
+	Name      string         `json:"name"`
+	Sum       *otlpSum       `json:"sum,omitempty"`
+	Gauge     *otlpGauge     `json:"gauge,omitempty"`
+	Summary   *otlpSummary   `json:"summary,omitempty"`
+	Histogram *otlpHistogram `json:"histogram,omitempty"`
+}
+
+// otlpCumulative is AGGREGATION_TEMPORALITY_CUMULATIVE.
+const otlpCumulative = 2
+
+type otlpSum struct {
+	AggregationTemporality int                   `json:"aggregationTemporality"`
+	IsMonotonic            bool                  `json:"isMonotonic"`
+	DataPoints             []otlpNumberDataPoint `json:"dataPoints"`
+}
+
+type otlpGauge struct {
+	DataPoints []otlpNumberDataPoint `json:"dataPoints"`
+}
+
+type otlpNumberDataPoint struct {
+	Attributes        []otlpKeyValue `json:"attributes"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano,omitempty"`
+	TimeUnixNano      string         `json:"timeUnixNano"`
+	AsInt             string         `json:"asInt"`
+}
+
+type otlpSummary struct {
+	DataPoints []otlpSummaryDataPoint `json:"dataPoints"`
+}
+
+type otlpSummaryDataPoint struct {
+	Attributes        []otlpKeyValue `json:"attributes"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	TimeUnixNano      string         `json:"timeUnixNano"`
+	Count             string         `json:"count"`
+	Sum               float64        `json:"sum"`
+	QuantileValues    []otlpQuantile `json:"quantileValues"`
+}
+
+type otlpQuantile struct {
+	Quantile float64 `json:"quantile"`
+	Value    float64 `json:"value"`
+}
+
+type otlpHistogram struct {
+	AggregationTemporality int                      `json:"aggregationTemporality"`
+	DataPoints             []otlpHistogramDataPoint `json:"dataPoints"`
+}
+
+type otlpHistogramDataPoint struct {
+	Attributes        []otlpKeyValue `json:"attributes"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	TimeUnixNano      string         `json:"timeUnixNano"`
+	Count             string         `json:"count"`
+	Sum               float64        `json:"sum"`
+	BucketCounts      []string       `json:"bucketCounts"`
+	ExplicitBounds    []float64      `json:"explicitBounds"`
+}
+
+func nanos(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+func otlpAttributes(attrs [][2]string) []otlpKeyValue {
+	kvs := make([]otlpKeyValue, 0, len(attrs))
+	for _, a := range attrs {
+		kvs = append(kvs, otlpKeyValue{Key: a[0], Value: otlpAnyValue{StringValue: a[1]}})
+	}
+	return kvs
+}
+
+// request converts the snapshot into an OTLP export request.
+func (o *OTLP) request(snap Snapshot) otlpRequest {
+	start, now := nanos(o.start), nanos(snap.Time)
+	counter := &otlpSum{AggregationTemporality: otlpCumulative}
+	gauge := &otlpGauge{}
+	summary := &otlpSummary{}
+	histogram := &otlpHistogram{AggregationTemporality: otlpCumulative}
+
+	for _, pt := range attemptedPoints(snap) {
+		attrs := otlpAttributes(attributes(snap.JobID, pt.labels))
+		switch pt.kind {
+		case kindCounter:
+			counter.DataPoints = append(counter.DataPoints, otlpNumberDataPoint{
+				Attributes: attrs, StartTimeUnixNano: start, TimeUnixNano: now, AsInt: strconv.FormatInt(pt.value, 10),
+			})
+		case kindGauge:
+			gauge.DataPoints = append(gauge.DataPoints, otlpNumberDataPoint{
+				Attributes: attrs, TimeUnixNano: nanos(pt.time), AsInt: strconv.FormatInt(pt.value, 10),
+			})
+		case kindDistribution:
+			summary.DataPoints = append(summary.DataPoints, otlpSummaryDataPoint{
+				Attributes: attrs, StartTimeUnixNano: start, TimeUnixNano: now,
+				Count: strconv.FormatInt(pt.dist.Count, 10),
+				Sum:   float64(pt.dist.Sum),
+				QuantileValues: []otlpQuantile{
+					{Quantile: 0, Value: float64(pt.dist.Min)},
+					{Quantile: 1, Value: float64(pt.dist.Max)},
+				},
+			})
+		case kindHistogram:
+			dp := otlpHistogramDataPoint{
+				Attributes: attrs, StartTimeUnixNano: start, TimeUnixNano: now,
+				Count: strconv.FormatInt(pt.hist.Count(), 10),
+				Sum:   float64(pt.hist.Sum),
+			}
+			for _, b := range pt.hist.Buckets.Bounds() {
+				dp.ExplicitBounds = append(dp.ExplicitBounds, float64(b))
+			}
+			for _, c := range pt.hist.Counts {
+				dp.BucketCounts = append(dp.BucketCounts, strconv.FormatInt(c, 10))
+			}
+			histogram.DataPoints = append(histogram.DataPoints, dp)
+		}
+	}
+
+	var ms []otlpMetric
+	if len(counter.DataPoints) > 0 {
+		ms = append(ms, otlpMetric{Name: "beam.user.counter", Sum: counter})
+	}
+	if len(summary.DataPoints) > 0 {
+		ms = append(ms, otlpMetric{Name: "beam.user.distribution", Summary: summary})
+	}
+	if len(gauge.DataPoints) > 0 {
+		ms = append(ms, otlpMetric{Name: "beam.user.gauge", Gauge: gauge})
+	}
+	if len(histogram.DataPoints) > 0 {
+		ms = append(ms, otlpMetric{Name: "beam.user.histogram", Histogram: histogram})
+	}
+	return otlpRequest{
+		ResourceMetrics: []otlpResourceMetrics{{
+			Resource: otlpResource{Attributes: otlpAttributes([][2]string{
+				{"service.name", "apache-beam"},
+				{"beam.job_id", snap.JobID},
+			})},
+			ScopeMetrics: []otlpScopeMetrics{{
+				Scope:   otlpScope{Name: "github.com/apache/beam/sdks/v2/go/pkg/beam"},
+				Metrics: ms,
+			}},
+		}},
+	}
+}
@@ -0,0 +1,82 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOTLP(t *testing.T) {
+	var got otlpRequest
+	var path string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("decoding request: %v", err)
+		}
+	}))
+	defer srv.Close()
+
+	o := NewOTLP(srv.URL)
+	if err := o.Export(context.Background(), Snapshot{JobID: "job", Time: time.Unix(20, 0), Results: *testResults()}); err != nil {
+		t.Fatalf("Export() failed: %v", err)
+	}
+	if want := "/v1/metrics"; path != want {
+		t.Errorf("Export() posted to %v, want %v", path, want)
+	}
+	ms := got.ResourceMetrics[0].ScopeMetrics[0].Metrics
+	var names []string
+	for _, m := range ms {
+		names = append(names, m.Name)
+	}
+	if diff := cmp.Diff([]string{"beam.user.counter", "beam.user.distribution", "beam.user.gauge", "beam.user.histogram"}, names); diff != "" {
+		t.Fatalf("Export() metric names diff (-want,+got):\n%v", diff)
+	}
+	if got, want := ms[0].Sum.DataPoints[0].AsInt, "5"; got != want {
+		t.Errorf("counter value = %v, want %v", got, want)
+	}
+	if got, want := ms[0].Sum.DataPoints[0].TimeUnixNano, "20000000000"; got != want {
+		t.Errorf("counter time = %v, want %v", got, want)
+	}
+	hist := ms[3].Histogram.DataPoints[0]
+	if diff := cmp.Diff([]string{"1", "2", "3", "4"}, hist.BucketCounts); diff != "" {
+		t.Errorf("histogram bucket counts diff (-want,+got):\n%v", diff)
+	}
+	if diff := cmp.Diff([]float64{0, 10, 20}, hist.ExplicitBounds); diff != "" {
+		t.Errorf("histogram bounds diff (-want,+got):\n%v", diff)
+	}
+	if got, want := hist.Sum, 95.0; got != want {
+		t.Errorf("histogram sum = %v, want %v", got, want)
+	}
+}
+
+func TestOTLP_ErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "nope", http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	if err := NewOTLP(srv.URL+"/custom").Export(context.Background(), Snapshot{Results: *testResults()}); err == nil {
+		t.Errorf("Export() succeeded, want error")
+	}
+}
@@ -0,0 +1,191 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/log"
+)
+
+func init() {
+	Register("prometheus", newPrometheusSink)
+}
+
+// Prometheus retains the latest snapshot of each job, and renders them
+// in the Prometheus text exposition format.
+//
+// Counters are exported as beam_user_counter, gauges as beam_user_gauge,
+// distributions as the beam_user_distribution_{count,sum,min,max} family,
+// and histograms as the beam_user_histogram histogram. Histogram buckets
+// hold values strictly below their upper boundary, so the count of a "le"
+// bucket excludes observations equal to the boundary.
+type Prometheus struct {
+	mu    sync.Mutex
+	snaps map[string]Snapshot
+}
+
+// NewPrometheus returns a Prometheus sink that isn't bound to an address.
+// Use it as an http.Handler to serve it from an existing server.
+func NewPrometheus() *Prometheus {
+	return &Prometheus{snaps: map[string]Snapshot{}}
+}
+
+// Export retains the snapshot to be served on the next scrape.
+func (p *Prometheus) Export(_ context.Context, snap Snapshot) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.snaps[snap.JobID] = snap
+	return nil
+}
+
+// Close is a no-op. The last values of each job continue to be served
+// so they may be scraped after the job completes.
+func (p *Prometheus) Close() error {
+	return nil
+}
+
+// ServeHTTP renders the retained snapshots.
+func (p *Prometheus) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	p.WriteTo(w)
+}
+
+// WriteTo writes the retained snapshots in the text exposition format.
+func (p *Prometheus) WriteTo(w io.Writer) (int64, error) {
+	p.mu.Lock()
+	var jobs []string
+	for id := range p.snaps {
+		jobs = append(jobs, id)
+	}
+	sort.Strings(jobs)
+	families := map[string][]string{}
+	for _, id := range jobs {
+		snap := p.snaps[id]
+		for _, pt := range attemptedPoints(snap) {
+			addPromSamples(families, id, pt)
+		}
+	}
+	p.mu.Unlock()
+
+	var n int64
+	for _, f := range promFamilies {
+		samples := families[f.name]
+		if len(samples) == 0 {
+			continue
+		}
+		m, err := fmt.Fprintf(w, "# TYPE %s %s\n%s", f.name, f.typ, strings.Join(samples, ""))
+		n += int64(m)
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+var promFamilies = []struct{ name, typ string }{
+	{"beam_user_counter", "gauge"},
+	{"beam_user_distribution_count", "counter"},
+	{"beam_user_distribution_max", "gauge"},
+	{"beam_user_distribution_min", "gauge"},
+	{"beam_user_distribution_sum", "gauge"},
+	{"beam_user_gauge", "gauge"},
+	{"beam_user_histogram", "histogram"},
+}
+
+// addPromSamples renders the samples for the point into their families.
+// User counters may be decremented, so they are exported as Prometheus gauges.
+func addPromSamples(families map[string][]string, jobID string, pt point) {
+	attrs := attributes(jobID, pt.labels)
+	add := func(family, suffix string, v any, extra ...[2]string) {
+		families[family] = append(families[family], fmt.Sprintf("%s%s%s %v\n", family, suffix, promLabels(append(attrs, extra...)), v))
+	}
+	switch pt.kind {
+	case kindCounter:
+		add("beam_user_counter", "", pt.value)
+	case kindGauge:
+		add("beam_user_gauge", "", pt.value)
+	case kindDistribution:
+		add("beam_user_distribution_count", "", pt.dist.Count)
+		add("beam_user_distribution_sum", "", pt.dist.Sum)
+		add("beam_user_distribution_min", "", pt.dist.Min)
+		add("beam_user_distribution_max", "", pt.dist.Max)
+	case kindHistogram:
+		bounds := pt.hist.Buckets.Bounds()
+		var cum int64
+		for i, b := range bounds {
+			cum += pt.hist.Counts[i]
+			add("beam_user_histogram", "_bucket", cum, [2]string{"le", fmt.Sprint(b)})
+		}
+		total := pt.hist.Count()
+		add("beam_user_histogram", "_bucket", total, [2]string{"le", "+Inf"})
+		add("beam_user_histogram", "_sum", pt.hist.Sum)
+		add("beam_user_histogram", "_count", total)
+	}
+}
+
+func promLabels(attrs [][2]string) string {
+	var b strings.Builder
+	b.WriteString("{")
+	for i, a := range attrs {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		fmt.Fprintf(&b, "%s=\"%s\"", a[0], promEscaper.Replace(a[1]))
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+var promEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+var (
+	promMu      sync.Mutex
+	promServers = map[string]*Prometheus{}
+)
+
+// newPrometheusSink serves a Prometheus endpoint at /metrics on the target
+// address. Sinks for the same address share a single server for the lifetime
+// of the process, so multiple jobs may be exported from the same process.
+func newPrometheusSink(ctx context.Context, addr string) (Sink, error) {
+	promMu.Lock()
+	defer promMu.Unlock()
+	if p, ok := promServers[addr]; ok {
+		return p, nil
+	}
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	p := NewPrometheus()
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", p)
+	go func() {
+		if err := http.Serve(lis, mux); err != nil {
+			log.Warnf(ctx, "prometheus metrics endpoint on %v stopped: %v", lis.Addr(), err)
+		}
+	}()
+	log.Infof(ctx, "Serving Prometheus metrics at http://%v/metrics", lis.Addr())
+	promServers[addr] = p
+	return p, nil
+}
@@ -0,0 +1,63 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPrometheus(t *testing.T) {
+	p := NewPrometheus()
+	if err := p.Export(context.Background(), Snapshot{JobID: `job"1`, Results: *testResults()}); err != nil {
+		t.Fatalf("Export() failed: %v", err)
+	}
+	var b strings.Builder
+	if _, err := p.WriteTo(&b); err != nil {
+		t.Fatalf("WriteTo() failed: %v", err)
+	}
+	labels := func(n string) string {
+		return `job="job\"1",name="` + n + `",namespace="ns",ptransform="step"`
+	}
+	want := strings.Join([]string{
+		"# TYPE beam_user_counter gauge",
+		"beam_user_counter{" + labels("count") + "} 5",
+		"# TYPE beam_user_distribution_count counter",
+		"beam_user_distribution_count{" + labels("dist") + "} 2",
+		"# TYPE beam_user_distribution_max gauge",
+		"beam_user_distribution_max{" + labels("dist") + "} 7",
+		"# TYPE beam_user_distribution_min gauge",
+		"beam_user_distribution_min{" + labels("dist") + "} 3",
+		"# TYPE beam_user_distribution_sum gauge",
+		"beam_user_distribution_sum{" + labels("dist") + "} 10",
+		"# TYPE beam_user_gauge gauge",
+		"beam_user_gauge{" + labels("gauge") + "} 42",
+		"# TYPE beam_user_histogram histogram",
+		"beam_user_histogram_bucket{" + labels("hist") + `,le="0"} 1`,
+		"beam_user_histogram_bucket{" + labels("hist") + `,le="10"} 3`,
+		"beam_user_histogram_bucket{" + labels("hist") + `,le="20"} 6`,
+		"beam_user_histogram_bucket{" + labels("hist") + `,le="+Inf"} 10`,
+		"beam_user_histogram_sum{" + labels("hist") + "} 95",
+		"beam_user_histogram_count{" + labels("hist") + "} 10",
+		"",
+	}, "\n")
+	if diff := cmp.Diff(want, b.String()); diff != "" {
+		t.Errorf("WriteTo() diff (-want,+got):\n%v", diff)
+	}
+}
@@ -0,0 +1,250 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sink exports the metrics of a running job to external monitoring
+// systems.
+//
+// Sinks are configured with specs of the form "<kind>=<target>", for example
+// "prometheus=:9464" to serve a Prometheus exposition endpoint on port 9464,
+// or "otlp=http://localhost:4318" to push to an OpenTelemetry collector.
+// Additional kinds may be added with Register.
+//
+// While a job is running, Export periodically fetches the job's metrics and
+// publishes the attempted values to every sink. Metrics are labeled with the
+// job ID, and the PTransform, namespace and name of their metrics.Labels.
+package sink
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/metrics"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/internal/errors"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/log"
+)
+
+// Snapshot is the state of a job's metrics at a point in time.
+type Snapshot struct {
+	JobID   string
+	Time    time.Time
+	Results metrics.Results
+}
+
+// Sink publishes metric snapshots to an external system.
+type Sink interface {
+	// Export publishes the snapshot. Snapshots of a job are cumulative,
+	// so sinks only need to retain the latest one.
+	Export(ctx context.Context, snap Snapshot) error
+	// Close releases the resources held by the sink.
+	Close() error
+}
+
+// Factory constructs a Sink from the target portion of a sink spec.
+type Factory func(ctx context.Context, target string) (Sink, error)
+
+var (
+	mu        sync.Mutex
+	factories = map[string]Factory{}
+)
+
+// Register associates a sink kind with a Factory. Expected to be called in
+// an init function. Panics if the kind is already registered.
+func Register(kind string, f Factory) {
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := factories[kind]; ok {
+		panic(fmt.Sprintf("metrics sink %q already registered", kind))
+	}
+	factories[kind] = f
+}
+
+// New constructs a Sink from a "<kind>=<target>" spec.
+func New(ctx context.Context, spec string) (Sink, error) {
+	kind, target, ok := strings.Cut(spec, "=")
+	if !ok {
+		return nil, errors.Errorf("invalid metrics sink %q, want <kind>=<target>", spec)
+	}
+	mu.Lock()
+	f, ok := factories[kind]
+	mu.Unlock()
+	if !ok {
+		return nil, errors.Errorf("unknown metrics sink kind %q in %q, registered kinds: %v", kind, spec, kinds())
+	}
+	s, err := f(ctx, target)
+	if err != nil {
+		return nil, errors.WithContextf(err, "creating metrics sink %q", spec)
+	}
+	return s, nil
+}
+
+// NewAll constructs sinks for all the given specs. If any spec fails,
+// the sinks constructed so far are closed.
+func NewAll(ctx context.Context, specs []string) ([]Sink, error) {
+	var sinks []Sink
+	for _, spec := range specs {
+		s, err := New(ctx, spec)
+		if err != nil {
+			for _, s := range sinks {
+				s.Close()
+			}
+			return nil, err
+		}
+		sinks = append(sinks, s)
+	}
+	return sinks, nil
+}
+
+func kinds() []string {
+	var ks []string
+	for k := range factories {
+		ks = append(ks, k)
+	}
+	sort.Strings(ks)
+	return ks
+}
+
+// FetchFunc retrieves the current metrics of a job.
+type FetchFunc func(ctx context.Context) (*metrics.Results, error)
+
+// Export fetches the metrics of the job every interval, and publishes them
+// to all sinks. It blocks until the context is canceled, at which point it
+// performs one final export so the sinks see the latest values.
+//
+// Errors from fetching or exporting are logged, and don't stop the export.
+func Export(ctx context.Context, jobID string, interval time.Duration, fetch FetchFunc, sinks []Sink) {
+	if len(sinks) == 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			// Use a fresh context for the final export, since ours is done.
+			fctx, cancel := context.WithTimeout(context.Background(), interval)
+			exportOnce(fctx, jobID, fetch, sinks)
+			cancel()
+			return
+		case <-ticker.C:
+			exportOnce(ctx, jobID, fetch, sinks)
+		}
+	}
+}
+
+func exportOnce(ctx context.Context, jobID string, fetch FetchFunc, sinks []Sink) {
+	res, err := fetch(ctx)
+	if err != nil {
+		log.Warnf(ctx, "unable to fetch metrics for job %v: %v", jobID, err)
+		return
+	}
+	if res == nil {
+		return
+	}
+	snap := Snapshot{JobID: jobID, Time: time.Now(), Results: *res}
+	for _, s := range sinks {
+		if err := s.Export(ctx, snap); err != nil {
+			log.Warnf(ctx, "unable to export metrics for job %v: %v", jobID, err)
+		}
+	}
+}
+
+// point is a single user metric value, flattened for export.
+type point struct {
+	labels metrics.Labels
+	kind   string
+
+	value int64
+	dist  metrics.DistributionValue
+	hist  metrics.HistogramValue
+	time  time.Time
+}
+
+const (
+	kindCounter      = "counter"
+	kindDistribution = "distribution"
+	kindGauge        = "gauge"
+	kindHistogram    = "histogram"
+)
+
+// attemptedPoints flattens the user metrics of a snapshot, sorted by kind
+// and labels. Attempted values are used, falling back to committed values
+// for runners that don't report attempted metrics.
+func attemptedPoints(snap Snapshot) []point {
+	all := snap.Results.AllMetrics()
+	labels := func(k metrics.StepKey) metrics.Labels {
+		return metrics.UserLabels(k.Step, k.Namespace, k.Name)
+	}
+	var ps []point
+	for _, r := range all.Counters() {
+		v := r.Attempted
+		if v == 0 {
+			v = r.Committed
+		}
+		ps = append(ps, point{labels: labels(r.Key), kind: kindCounter, value: v})
+	}
+	for _, r := range all.Distributions() {
+		v := r.Attempted
+		if v == (metrics.DistributionValue{}) {
+			v = r.Committed
+		}
+		ps = append(ps, point{labels: labels(r.Key), kind: kindDistribution, dist: v})
+	}
+	for _, r := range all.Gauges() {
+		v := r.Attempted
+		if v == (metrics.GaugeValue{}) {
+			v = r.Committed
+		}
+		ps = append(ps, point{labels: labels(r.Key), kind: kindGauge, value: v.Value, time: v.Timestamp})
+	}
+	for _, r := range all.Histograms() {
+		v := r.Attempted
+		if len(v.Counts) == 0 {
+			v = r.Committed
+		}
+		if len(v.Counts) == 0 {
+			continue
+		}
+		ps = append(ps, point{labels: labels(r.Key), kind: kindHistogram, hist: v})
+	}
+	sort.SliceStable(ps, func(i, j int) bool {
+		a, b := ps[i], ps[j]
+		if a.kind != b.kind {
+			return a.kind < b.kind
+		}
+		if a.labels.Transform() != b.labels.Transform() {
+			return a.labels.Transform() < b.labels.Transform()
+		}
+		if a.labels.Namespace() != b.labels.Namespace() {
+			return a.labels.Namespace() < b.labels.Namespace()
+		}
+		return a.labels.Name() < b.labels.Name()
+	})
+	return ps
+}
+
+// attributes returns the export labels of a point, with lower case keys,
+// sorted by key.
+func attributes(jobID string, l metrics.Labels) [][2]string {
+	attrs := [][2]string{{"job", jobID}}
+	for k, v := range l.Map() {
+		attrs = append(attrs, [2]string{strings.ToLower(k), v})
+	}
+	sort.Slice(attrs, func(i, j int) bool { return attrs[i][0] < attrs[j][0] })
+	return attrs
+}
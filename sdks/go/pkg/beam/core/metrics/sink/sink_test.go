@@ -0,0 +1,101 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/metrics"
+)
+
+func testResults() *metrics.Results {
+	key := func(n string) metrics.StepKey {
+		return metrics.StepKey{Step: "step", Namespace: "ns", Name: n}
+	}
+	return metrics.NewResultsWithHistograms(
+		[]metrics.CounterResult{{Attempted: 5, Key: key("count")}},
+		[]metrics.DistributionResult{{Committed: metrics.DistributionValue{Count: 2, Sum: 10, Min: 3, Max: 7}, Key: key("dist")}},
+		[]metrics.GaugeResult{{Attempted: metrics.GaugeValue{Value: 42, Timestamp: time.Unix(10, 0)}, Key: key("gauge")}},
+		nil,
+		nil,
+		[]metrics.HistogramResult{{Attempted: metrics.HistogramValue{Buckets: metrics.LinearBuckets(0, 10, 2), Counts: []int64{1, 2, 3, 4}, Sum: 95}, Key: key("hist")}},
+	)
+}
+
+type recordingSink struct {
+	mu    sync.Mutex
+	snaps []Snapshot
+}
+
+func (s *recordingSink) Export(_ context.Context, snap Snapshot) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.snaps = append(s.snaps, snap)
+	return nil
+}
+
+func (s *recordingSink) Close() error { return nil }
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+	if _, err := New(ctx, "otlp=http://localhost:4318"); err != nil {
+		t.Errorf("New(otlp) failed: %v", err)
+	}
+	for _, spec := range []string{"otlp", "unknown=target", ""} {
+		if _, err := New(ctx, spec); err == nil {
+			t.Errorf("New(%q) succeeded, want error", spec)
+		}
+	}
+}
+
+func TestExport(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	rs := &recordingSink{}
+	fetches := 0
+	fetch := func(context.Context) (*metrics.Results, error) {
+		fetches++
+		if fetches == 2 {
+			cancel()
+		}
+		return testResults(), nil
+	}
+	Export(ctx, "job", time.Millisecond, fetch, []Sink{rs})
+
+	// The second fetch cancels the context, so there's one final fetch after that.
+	if got, want := len(rs.snaps), 3; got != want {
+		t.Fatalf("Export() exported %v snapshots, want %v", got, want)
+	}
+	if got, want := rs.snaps[0].JobID, "job"; got != want {
+		t.Errorf("Export() snapshot JobID = %v, want %v", got, want)
+	}
+}
+
+func TestAttemptedPoints(t *testing.T) {
+	ps := attemptedPoints(Snapshot{JobID: "job", Results: *testResults()})
+	if got, want := len(ps), 4; got != want {
+		t.Fatalf("attemptedPoints() returned %v points, want %v", got, want)
+	}
+	// Points are sorted by kind, and distributions fall back to committed values.
+	if got, want := ps[1].kind, kindDistribution; got != want {
+		t.Fatalf("attemptedPoints()[1].kind = %v, want %v", got, want)
+	}
+	if got, want := ps[1].dist.Count, int64(2); got != want {
+		t.Errorf("attemptedPoints() distribution count = %v, want %v", got, want)
+	}
+}
@@ -94,6 +94,8 @@ type Extractor struct {
 	DistributionInt64 func(labels Labels, count, sum, min, max int64)
 	// GaugeInt64 extracts data from Gauge Int64 counters.
 	GaugeInt64 func(labels Labels, v int64, t time.Time)
+	// HistogramInt64 extracts data from Histogram Int64 counters.
+	HistogramInt64 func(labels Labels, v HistogramValue)
 
 	// MsecsInt64 extracts data from StateRegistry of ExecutionState.
 	// Extraction of Msec counters is experimental and subject to change.
@@ -107,7 +109,7 @@ func (e Extractor) ExtractFrom(store *Store) error {
 	store.mu.RLock()
 	defer store.mu.RUnlock()
 
-	if e.SumInt64 == nil && e.DistributionInt64 == nil && e.GaugeInt64 == nil {
+	if e.SumInt64 == nil && e.DistributionInt64 == nil && e.GaugeInt64 == nil && e.HistogramInt64 == nil {
 		return fmt.Errorf("no Extractor fields were set")
 	}
 
@@ -128,6 +130,10 @@ func (e Extractor) ExtractFrom(store *Store) error {
 				v, t := um.(*gauge).get()
 				e.GaugeInt64(l, v, t)
 			}
+		case kindHistogram:
+			if e.HistogramInt64 != nil {
+				e.HistogramInt64(l, um.(*histogram).get())
+			}
 		}
 	}
 	if e.MsecsInt64 != nil {
@@ -155,6 +161,7 @@ type ptCounterSet struct {
 	counters      map[nameHash]*counter
 	distributions map[nameHash]*distribution
 	gauges        map[nameHash]*gauge
+	histograms    map[nameHash]*histogram
 }
 
 type bundleProcState int
@@ -148,7 +148,10 @@ func (n *DataSource) process(ctx context.Context, data func(bcr *byteCountReader
 			}
 			if len(e.Timers) > 0 {
 				r.Reset(e.Timers)
-				err = timer(&bcr, e.PtransformID, e.TimerFamilyID)
+				// Runners may send several timers at once.
+				for r.Len() > 0 && err == nil {
+					err = timer(&bcr, e.PtransformID, e.TimerFamilyID)
+				}
 			}
 
 			if err == errSplitSuccess {
@@ -0,0 +1,165 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exec
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/internal/errors"
+)
+
+// FailedElement is an element that a DoFn failed to process, as output by a
+// ParDo with an ErrorHandler.
+type FailedElement struct {
+	// Transform is the unique name of the failing transform.
+	Transform string
+	// Error is the error returned by the DoFn, or the value it panicked with.
+	Error string
+	// Element is the failing element, encoded with the coder of the main input.
+	Element []byte
+}
+
+// ErrorHandler routes the elements that a ParDo fails to process to its last
+// output, rather than failing the bundle. The bundle still fails if the
+// fraction of failed elements exceeds MaxFailureRate.
+type ErrorHandler struct {
+	Enc            ElementEncoder
+	MaxFailureRate float64
+
+	processed, failed int64
+	lastErr           error
+	// downstream is set when a node that the DoFn emits to fails, so that the
+	// failure isn't attributed to the element being processed.
+	downstream bool
+}
+
+// minCheckedElements is the number of elements a bundle must process before
+// its failure rate is checked on each failure, rather than only when the
+// bundle finishes, so that failures early in a bundle don't fail it.
+const minCheckedElements = 100
+
+// NewErrorHandler returns an ErrorHandler for the encoded maximum failure
+// rate of an error handler annotation.
+func NewErrorHandler(enc ElementEncoder, maxFailureRate []byte) (*ErrorHandler, error) {
+	rate, err := strconv.ParseFloat(string(maxFailureRate), 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid maximum failure rate %q", maxFailureRate)
+	}
+	return &ErrorHandler{Enc: enc, MaxFailureRate: rate}, nil
+}
+
+func (h *ErrorHandler) reset() {
+	h.processed = 0
+	h.failed = 0
+	h.lastErr = nil
+}
+
+// check returns an error if the failure rate of the bundle exceeds the
+// maximum.
+func (h *ErrorHandler) check() error {
+	if h.processed == 0 || float64(h.failed)/float64(h.processed) <= h.MaxFailureRate {
+		return nil
+	}
+	return errors.Wrapf(h.lastErr, "%v of %v elements failed, exceeding the maximum failure rate of %v", h.failed, h.processed, h.MaxFailureRate)
+}
+
+// mainOut returns the outputs of the DoFn, excluding the error output.
+func (n *ParDo) mainOut() []Node {
+	if n.ErrorHandler == nil {
+		return n.Out
+	}
+	out := make([]Node, len(n.Out)-1)
+	for i, o := range n.Out[:len(n.Out)-1] {
+		out[i] = &downstreamNode{Node: o, h: n.ErrorHandler}
+	}
+	return out
+}
+
+// downstreamNode wraps an output of a ParDo with an ErrorHandler, to record
+// failures of the nodes that the DoFn emits to.
+type downstreamNode struct {
+	Node
+	h *ErrorHandler
+}
+
+func (d *downstreamNode) ProcessElement(ctx context.Context, elm *FullValue, values ...ReStream) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			d.h.downstream = true
+			panic(r)
+		}
+	}()
+	if err := d.Node.ProcessElement(ctx, elm, values...); err != nil {
+		d.h.downstream = true
+		return err
+	}
+	return nil
+}
+
+// invokeAndHandle invokes the ProcessElement function, and outputs the
+// element to the error output if the function fails or panics. Failures of
+// downstream nodes that surface through emitters aren't handled, and fail
+// the bundle as they would without an ErrorHandler.
+func (n *ParDo) invokeAndHandle(mainIn *MainInput) (val *FullValue, err error) {
+	h := n.ErrorHandler
+	h.processed++
+	h.downstream = false
+	defer func() {
+		if r := recover(); r != nil {
+			if h.downstream {
+				panic(r)
+			}
+			val, err = nil, n.outputFailure(&mainIn.Key, errors.Errorf("panic: %v", r))
+		}
+	}()
+
+	elm := &mainIn.Key
+	val, err = n.invokeProcessFn(n.ctx, elm.Pane, elm.Windows, elm.Timestamp, mainIn)
+	if err != nil {
+		if h.downstream {
+			return nil, err
+		}
+		return nil, n.outputFailure(elm, err)
+	}
+	return val, nil
+}
+
+// outputFailure outputs a FailedElement for the element to the error output,
+// in the element's windows and with its timestamp.
+func (n *ParDo) outputFailure(elm *FullValue, cause error) error {
+	h := n.ErrorHandler
+	h.failed++
+	h.lastErr = cause
+	if h.MaxFailureRate == 0 || h.processed >= minCheckedElements {
+		if err := h.check(); err != nil {
+			return err
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := h.Enc.Encode(&FullValue{Elm: elm.Elm, Elm2: elm.Elm2}, &buf); err != nil {
+		return errors.Wrapf(err, "encoding failed element for %v", n.PID)
+	}
+	failed := FailedElement{
+		Transform: n.PID,
+		Error:     fmt.Sprint(cause),
+		Element:   buf.Bytes(),
+	}
+	return n.Out[len(n.Out)-1].ProcessElement(n.ctx, &FullValue{Elm: failed, Timestamp: elm.Timestamp, Windows: elm.Windows, Pane: elm.Pane})
+}
@@ -0,0 +1,181 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exec
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/graph"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/graph/coder"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/graph/window"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/typex"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/util/reflectx"
+)
+
+func failingFn(v int64) (int64, error) {
+	switch v {
+	case 0:
+		panic("zero")
+	case 1:
+		return 0, fmt.Errorf("one")
+	}
+	return v, nil
+}
+
+func runErrorHandlerPlan(t *testing.T, rate string, in ...any) (*CaptureNode, *CaptureNode, error) {
+	t.Helper()
+	fn, err := graph.NewDoFn(failingFn)
+	if err != nil {
+		t.Fatalf("invalid function: %v", err)
+	}
+	g := graph.New()
+	nN := g.NewNode(typex.New(reflectx.Int64), window.DefaultWindowingStrategy(), true)
+	edge, err := graph.NewParDo(g, g.Root(), fn, []*graph.Node{nN}, nil, nil)
+	if err != nil {
+		t.Fatalf("invalid pardo: %v", err)
+	}
+
+	enc := MakeElementEncoder(coder.NewVarInt())
+	h, err := NewErrorHandler(enc, []byte(rate))
+	if err != nil {
+		t.Fatalf("NewErrorHandler(%v) failed: %v", rate, err)
+	}
+	out := &CaptureNode{UID: 1}
+	failures := &CaptureNode{UID: 2}
+	pardo := &ParDo{UID: 3, PID: "failing", Fn: edge.DoFn, Inbound: edge.Input, Out: []Node{out, failures}, ErrorHandler: h}
+	n := &FixedRoot{UID: 4, Elements: makeInput(in...), Out: pardo}
+
+	p, err := NewPlan("a", []Unit{n, pardo, out, failures})
+	if err != nil {
+		t.Fatalf("failed to construct plan: %v", err)
+	}
+	err = p.Execute(context.Background(), "1", DataContext{})
+	p.Down(context.Background())
+	return out, failures, err
+}
+
+func TestParDo_ErrorHandler(t *testing.T) {
+	out, failures, err := runErrorHandlerPlan(t, "1", int64(0), int64(1), int64(2), int64(3))
+	if err != nil {
+		t.Fatalf("execute failed: %v", err)
+	}
+	if want := makeValues(int64(2), int64(3)); !equalList(out.Elements, want) {
+		t.Errorf("pardo(failingFn) = %v, want %v", extractValues(out.Elements...), extractValues(want...))
+	}
+
+	enc := MakeElementEncoder(coder.NewVarInt())
+	var want []FailedElement
+	for v, msg := range []string{"panic: zero", "one"} {
+		var buf bytes.Buffer
+		if err := enc.Encode(&FullValue{Elm: int64(v)}, &buf); err != nil {
+			t.Fatalf("Encode(%v) failed: %v", v, err)
+		}
+		want = append(want, FailedElement{Transform: "failing", Error: msg, Element: buf.Bytes()})
+	}
+	if len(failures.Elements) != len(want) {
+		t.Fatalf("failures = %v, want %v", extractValues(failures.Elements...), want)
+	}
+	for i, elm := range failures.Elements {
+		got := elm.Elm.(FailedElement)
+		if got.Transform != want[i].Transform || got.Error != want[i].Error || !bytes.Equal(got.Element, want[i].Element) {
+			t.Errorf("failure %v = %+v, want %+v", i, got, want[i])
+		}
+		if len(elm.Windows) != 1 || !elm.Windows[0].Equals(window.GlobalWindow{}) {
+			t.Errorf("failure %v windows = %v, want global window", i, elm.Windows)
+		}
+	}
+}
+
+func TestParDo_ErrorHandlerMaxFailureRate(t *testing.T) {
+	if _, _, err := runErrorHandlerPlan(t, "0.5", int64(1), int64(2)); err != nil {
+		t.Errorf("execute with 1 of 2 failing at rate 0.5 failed: %v", err)
+	}
+	_, _, err := runErrorHandlerPlan(t, "0.5", int64(0), int64(1), int64(2))
+	if err == nil || !strings.Contains(err.Error(), "2 of 3 elements failed") {
+		t.Errorf("execute with 2 of 3 failing at rate 0.5 = %v, want failure rate error", err)
+	}
+}
+
+func TestParDo_ErrorHandlerFailsEarly(t *testing.T) {
+	out, failures, err := runErrorHandlerPlan(t, "0", int64(2), int64(1), int64(3))
+	if err == nil || !strings.Contains(err.Error(), "1 of 2 elements failed") {
+		t.Errorf("execute with 1 failing at rate 0 = %v, want failure rate error", err)
+	}
+	if want := makeValues(int64(2)); !equalList(out.Elements, want) {
+		t.Errorf("pardo(failingFn) = %v, want %v", extractValues(out.Elements...), extractValues(want...))
+	}
+	if len(failures.Elements) != 0 {
+		t.Errorf("failures = %v, want none", extractValues(failures.Elements...))
+	}
+}
+
+func emitFn(v int64, emit func(int64)) {
+	emit(v)
+}
+
+func TestParDo_ErrorHandlerDownstreamFailure(t *testing.T) {
+	emit, err := graph.NewDoFn(emitFn)
+	if err != nil {
+		t.Fatalf("invalid function: %v", err)
+	}
+	failing, err := graph.NewDoFn(failingFn)
+	if err != nil {
+		t.Fatalf("invalid function: %v", err)
+	}
+	g := graph.New()
+	nN := g.NewNode(typex.New(reflectx.Int64), window.DefaultWindowingStrategy(), true)
+	emitEdge, err := graph.NewParDo(g, g.Root(), emit, []*graph.Node{nN}, nil, nil)
+	if err != nil {
+		t.Fatalf("invalid pardo: %v", err)
+	}
+	failingEdge, err := graph.NewParDo(g, g.Root(), failing, []*graph.Node{nN}, nil, nil)
+	if err != nil {
+		t.Fatalf("invalid pardo: %v", err)
+	}
+
+	h, err := NewErrorHandler(MakeElementEncoder(coder.NewVarInt()), []byte("1"))
+	if err != nil {
+		t.Fatalf("NewErrorHandler failed: %v", err)
+	}
+	out := &CaptureNode{UID: 1}
+	downstream := &ParDo{UID: 2, PID: "failing", Fn: failingEdge.DoFn, Inbound: failingEdge.Input, Out: []Node{out}}
+	failures := &CaptureNode{UID: 3}
+	pardo := &ParDo{UID: 4, PID: "emit", Fn: emitEdge.DoFn, Inbound: emitEdge.Input, Out: []Node{downstream, failures}, ErrorHandler: h}
+	n := &FixedRoot{UID: 5, Elements: makeInput(int64(2), int64(0)), Out: pardo}
+
+	p, err := NewPlan("a", []Unit{n, pardo, downstream, out, failures})
+	if err != nil {
+		t.Fatalf("failed to construct plan: %v", err)
+	}
+	err = p.Execute(context.Background(), "1", DataContext{})
+	p.Down(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "panic: zero") {
+		t.Errorf("execute with failing downstream DoFn = %v, want panic", err)
+	}
+	if len(failures.Elements) != 0 {
+		t.Errorf("failures = %v, want none", extractValues(failures.Elements...))
+	}
+}
+
+func TestNewErrorHandler_InvalidRate(t *testing.T) {
+	if _, err := NewErrorHandler(nil, []byte("high")); err == nil {
+		t.Errorf("NewErrorHandler(high) succeeded, want error")
+	}
+}
@@ -41,6 +41,9 @@ type ParDo struct {
 	UState  UserStateAdapter
 	Out     []Node
 
+	// ErrorHandler, if set, routes failing elements to the last output.
+	ErrorHandler *ErrorHandler
+
 	PID      string
 	emitters []ReusableEmitter
 	ctx      context.Context
@@ -103,7 +106,7 @@ func (n *ParDo) Up(ctx context.Context) error {
 		return n.fail(err)
 	}
 
-	emitters, err := makeEmitters(n.Fn.ProcessElementFn(), n.Out)
+	emitters, err := makeEmitters(n.Fn.ProcessElementFn(), n.mainOut())
 	if err != nil {
 		return n.fail(err)
 	}
@@ -130,6 +133,10 @@ func (n *ParDo) StartBundle(ctx context.Context, id string, data DataContext) er
 
 	n.states.Set(n.ctx, metrics.StartBundle)
 
+	if n.ErrorHandler != nil {
+		n.ErrorHandler.reset()
+	}
+
 	if err := MultiStartBundle(n.ctx, id, data, n.Out...); err != nil {
 		return n.fail(err)
 	}
@@ -187,7 +194,13 @@ func (n *ParDo) processMainInput(mainIn *MainInput) error {
 // each individual window by exploding the windows first.
 func (n *ParDo) processSingleWindow(mainIn *MainInput) (sdf.ProcessContinuation, error) {
 	elm := &mainIn.Key
-	val, err := n.invokeProcessFn(n.ctx, elm.Pane, elm.Windows, elm.Timestamp, mainIn)
+	var val *FullValue
+	var err error
+	if n.ErrorHandler != nil {
+		val, err = n.invokeAndHandle(mainIn)
+	} else {
+		val, err = n.invokeProcessFn(n.ctx, elm.Pane, elm.Windows, elm.Timestamp, mainIn)
+	}
 	if err != nil {
 		return nil, n.fail(err)
 	}
@@ -246,9 +259,22 @@ func (n *ParDo) FinishBundle(_ context.Context) error {
 
 	n.states.Set(n.ctx, metrics.FinishBundle)
 
+	if n.ErrorHandler != nil {
+		if err := n.ErrorHandler.check(); err != nil {
+			return n.fail(err)
+		}
+	}
+
 	if _, err := n.invokeDataFn(n.ctx, typex.NoFiringPane(), window.SingleGlobalWindow, mtime.ZeroTimestamp, n.Fn.FinishBundleFn(), nil); err != nil {
 		return n.fail(err)
 	}
+	// Timers must be complete before downstream data, so the runner has all of them
+	// once the bundle's outputs are done.
+	if ta, ok := n.Timer.(*userTimerAdapter); ok && n.timerManager != nil {
+		if err := ta.finishTimers(n.ctx, n.timerManager); err != nil {
+			return n.fail(err)
+		}
+	}
 	n.reader = nil
 	n.cache = nil
 	n.timerManager = nil
@@ -73,7 +73,13 @@ func NewPlan(id string, units []Unit) (*Plan, error) {
 			if onTimers == nil {
 				onTimers = map[string]*ParDo{}
 			}
-			onTimers[pd.PID] = pd
+			// Runners return timers with the ID of the transform that set them,
+			// rather than its unique name.
+			id := pd.PID
+			if ta, ok := pd.Timer.(*userTimerAdapter); ok {
+				id = ta.sID.PtransformID
+			}
+			onTimers[id] = pd
 		}
 		if p, ok := u.(needsBundleFinalization); ok {
 			p.AttachFinalizer(&bf)
@@ -32,21 +32,39 @@ type UserTimerAdapter interface {
 }
 
 type userTimerAdapter struct {
-	sID StreamID
-	ec  ElementEncoder
-	dc  ElementDecoder
-	wc  WindowDecoder
+	sID      StreamID
+	families []string
+	ec       ElementEncoder
+	dc       ElementDecoder
+	wc       WindowDecoder
 }
 
-// NewUserTimerAdapter returns a user timer adapter for the given StreamID and timer coder.
-func NewUserTimerAdapter(sID StreamID, c *coder.Coder, timerCoder *coder.Coder) UserTimerAdapter {
+// NewUserTimerAdapter returns a user timer adapter for the given StreamID, timer coder
+// and timer families.
+func NewUserTimerAdapter(sID StreamID, c *coder.Coder, timerCoder *coder.Coder, families []string) UserTimerAdapter {
 	if !coder.IsW(c) {
 		panic(fmt.Sprintf("expected WV coder for user timer %v: %v", sID, c))
 	}
 	ec := MakeElementEncoder(timerCoder)
 	dc := MakeElementDecoder(coder.SkipW(c).Components[0])
 	wc := MakeWindowDecoder(c.Window)
-	return &userTimerAdapter{sID: sID, ec: ec, wc: wc, dc: dc}
+	return &userTimerAdapter{sID: sID, families: families, ec: ec, wc: wc, dc: dc}
+}
+
+// finishTimers closes the timer stream of every timer family, including those
+// without timers set in the bundle, so the runner knows how many streams to
+// wait on before the bundle's timers are complete.
+func (u *userTimerAdapter) finishTimers(ctx context.Context, manager DataManager) error {
+	for _, family := range u.families {
+		w, err := manager.OpenTimerWrite(ctx, u.sID, family)
+		if err != nil {
+			return err
+		}
+		if err := w.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // NewTimerProvider creates and returns a timer provider to set/clear timers.
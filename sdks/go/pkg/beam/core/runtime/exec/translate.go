@@ -18,6 +18,7 @@ package exec
 import (
 	"fmt"
 	"math/rand"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -33,6 +34,7 @@ import (
 	fnpb "github.com/apache/beam/sdks/v2/go/pkg/beam/model/fnexecution_v1"
 	pipepb "github.com/apache/beam/sdks/v2/go/pkg/beam/model/pipeline_v1"
 	"github.com/golang/protobuf/proto"
+	"golang.org/x/exp/maps"
 )
 
 // TODO(lostluck): 2018/05/28 Extract these from the canonical enums in beam_runner_api.proto
@@ -597,7 +599,9 @@ func (b *builder) makeLink(from string, id linkID) (Node, error) {
 							return nil, err
 						}
 						timerCoder := coder.NewT(ec.Components[0], wc)
-						n.Timer = NewUserTimerAdapter(sID, coder.NewW(ec, wc), timerCoder)
+						families := maps.Keys(userTimers)
+						sort.Strings(families)
+						n.Timer = NewUserTimerAdapter(sID, coder.NewW(ec, wc), timerCoder, families)
 					}
 
 					for i := 1; i < len(input); i++ {
@@ -626,6 +630,17 @@ func (b *builder) makeLink(from string, id linkID) (Node, error) {
 						side := NewSideInputAdapter(sid, sideInputID, coder.NewW(ec, wc), mapper)
 						n.Side = append(n.Side, side)
 					}
+
+					if rate, ok := transform.GetAnnotations()[graphx.URNErrorHandlerAnnotation]; ok {
+						ec, _, err := b.makeCoderForPCollection(input[0])
+						if err != nil {
+							return nil, err
+						}
+						n.ErrorHandler, err = NewErrorHandler(MakeElementEncoder(ec), rate)
+						if err != nil {
+							return nil, errors.WithContextf(err, "creating error handler for %v", transform.GetUniqueName())
+						}
+					}
 					u = n
 					if urn == urnProcessSizedElementsAndRestrictions {
 						outputs := make([]string, len(transform.GetOutputs()))
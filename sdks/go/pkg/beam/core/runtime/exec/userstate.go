@@ -142,6 +142,9 @@ func (s *stateProvider) ReadBagState(userStateID string) ([]any, []state.Transac
 			}
 		}
 		s.initialBagByKey[userStateID] = initialValue
+		// Appends and clears are sent to the runner as they're made, so the
+		// bag just read already reflects any earlier transactions.
+		delete(s.transactionsByKey, userStateID)
 	}
 
 	transactions, ok := s.transactionsByKey[userStateID]
@@ -67,6 +67,8 @@ const (
 	URNWindowMappingFixed   = "beam:go:windowmapping:fixed:v1"
 	URNWindowMappingSliding = "beam:go:windowmapping:sliding:v1"
 
+	URNErrorHandlerAnnotation = "beam:go:annotation:error_handler:v1"
+
 	URNProgressReporting     = "beam:protocol:progress_reporting:v1"
 	URNMultiCore             = "beam:protocol:multi_core_bundle_processing:v1"
 	URNWorkerStatus          = "beam:protocol:worker_status:v1"
@@ -597,7 +599,7 @@ func (m *marshaller) addMultiEdge(edge NamedEdge) ([]string, error) {
 			payload.TimerFamilySpecs = timerSpecs
 		}
 		spec = &pipepb.FunctionSpec{Urn: URNParDo, Payload: protox.MustEncode(payload)}
-		annotations = edge.Edge.DoFn.Annotations()
+		annotations = mergeAnnotations(edge.Edge.DoFn.Annotations(), edge.Edge.Annotations)
 
 	case graph.Combine:
 		mustEncodeMultiEdge, err := mustEncodeMultiEdgeBase64(edge.Edge)
@@ -1433,6 +1435,22 @@ func mustEncodeMultiEdgeBase64(edge *graph.MultiEdge) (string, error) {
 	}), nil
 }
 
+// mergeAnnotations returns the union of the DoFn and edge annotations, without
+// modifying either.
+func mergeAnnotations(fn, edge map[string][]byte) map[string][]byte {
+	if len(edge) == 0 {
+		return fn
+	}
+	ret := make(map[string][]byte, len(fn)+len(edge))
+	for k, v := range fn {
+		ret[k] = v
+	}
+	for k, v := range edge {
+		ret[k] = v
+	}
+	return ret
+}
+
 func edgeID(edge *graph.MultiEdge) string {
 	return fmt.Sprintf("e%v", edge.ID())
 }
@@ -160,6 +160,22 @@ func monitoring(p *exec.Plan, store *metrics.Store, supportShortID bool) ([]*pip
 					})
 			}
 		},
+		HistogramInt64: func(l metrics.Labels, v metrics.HistogramValue) {
+			payload, err := metricsx.Int64Histogram(v)
+			if err != nil {
+				panic(err)
+			}
+			payloads[getShortID(l, metricsx.UrnUserHistogramInt64)] = payload
+			if !supportShortID {
+				monitoringInfo = append(monitoringInfo,
+					&pipepb.MonitoringInfo{
+						Urn:     metricsx.UrnToString(metricsx.UrnUserHistogramInt64),
+						Type:    metricsx.UrnToType(metricsx.UrnUserHistogramInt64),
+						Labels:  l.Map(),
+						Payload: payload,
+					})
+			}
+		},
 		MsecsInt64: func(l string, states *[4]metrics.ExecutionState) {
 			label := map[string]string{"PTRANSFORM": l}
 			for i, v := range states {
@@ -29,10 +29,10 @@ import (
 // FromMonitoringInfos extracts metrics from monitored states and
 // groups them into counters, distributions and gauges.
 func FromMonitoringInfos(p *pipepb.Pipeline, attempted []*pipepb.MonitoringInfo, committed []*pipepb.MonitoringInfo) *metrics.Results {
-	ac, ad, ag, am, ap := groupByType(p, attempted)
-	cc, cd, cg, cm, cp := groupByType(p, committed)
+	ac, ad, ag, am, ap, ah := groupByType(p, attempted)
+	cc, cd, cg, cm, cp, ch := groupByType(p, committed)
 
-	return metrics.NewResults(metrics.MergeCounters(ac, cc), metrics.MergeDistributions(ad, cd), metrics.MergeGauges(ag, cg), metrics.MergeMsecs(am, cm), metrics.MergePCols(ap, cp))
+	return metrics.NewResultsWithHistograms(metrics.MergeCounters(ac, cc), metrics.MergeDistributions(ad, cd), metrics.MergeGauges(ag, cg), metrics.MergeMsecs(am, cm), metrics.MergePCols(ap, cp), metrics.MergeHistograms(ah, ch))
 }
 
 func groupByType(p *pipepb.Pipeline, minfos []*pipepb.MonitoringInfo) (
@@ -40,12 +40,14 @@ func groupByType(p *pipepb.Pipeline, minfos []*pipepb.MonitoringInfo) (
 	map[metrics.StepKey]metrics.DistributionValue,
 	map[metrics.StepKey]metrics.GaugeValue,
 	map[metrics.StepKey]metrics.MsecValue,
-	map[metrics.StepKey]metrics.PColValue) {
+	map[metrics.StepKey]metrics.PColValue,
+	map[metrics.StepKey]metrics.HistogramValue) {
 	counters := make(map[metrics.StepKey]int64)
 	distributions := make(map[metrics.StepKey]metrics.DistributionValue)
 	gauges := make(map[metrics.StepKey]metrics.GaugeValue)
 	msecs := make(map[metrics.StepKey]metrics.MsecValue)
 	pcols := make(map[metrics.StepKey]metrics.PColValue)
+	histograms := make(map[metrics.StepKey]metrics.HistogramValue)
 
 	// extract pcol for a PTransform into a map from pipeline proto.
 	pcolToTransform := make(map[string]string)
@@ -92,6 +94,13 @@ func groupByType(p *pipepb.Pipeline, minfos []*pipepb.MonitoringInfo) (
 				continue
 			}
 			gauges[key] = value
+		case UrnToString(UrnUserHistogramInt64):
+			value, err := DecodeInt64Histogram(r)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			histograms[key] = value
 		case
 			UrnToString(UrnStartBundle),
 			UrnToString(UrnProcessBundle),
@@ -141,7 +150,7 @@ func groupByType(p *pipepb.Pipeline, minfos []*pipepb.MonitoringInfo) (
 	if len(errs) > 0 {
 		log.Printf("Warning: %v errors during metrics processing: %v\n", len(errs), errs)
 	}
-	return counters, distributions, gauges, msecs, pcols
+	return counters, distributions, gauges, msecs, pcols, histograms
 }
 
 func extractKey(mi *pipepb.MonitoringInfo, pcolToTransform map[string]string) (metrics.StepKey, error) {
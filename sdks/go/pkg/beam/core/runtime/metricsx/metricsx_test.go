@@ -345,3 +345,54 @@ func TestFromMonitoringInfos_Gauges(t *testing.T) {
 			got[0], want, d)
 	}
 }
+
+func TestFromMonitoringInfos_Histograms(t *testing.T) {
+	value := metrics.HistogramValue{
+		Buckets: metrics.ExponentialBuckets(1, 2, 3),
+		Counts:  []int64{1, 0, 4, 2, 7},
+		Sum:     61,
+	}
+
+	payload, err := Int64Histogram(value)
+	if err != nil {
+		t.Fatalf("Failed to encode Int64Histogram: %v", err)
+	}
+
+	labels := map[string]string{
+		"PTRANSFORM": "main.customDoFn",
+		"NAMESPACE":  "customDoFn",
+		"NAME":       "customHistogram",
+	}
+
+	mInfo := &pipepb.MonitoringInfo{
+		Urn:     UrnToString(UrnUserHistogramInt64),
+		Type:    UrnToType(UrnUserHistogramInt64),
+		Labels:  labels,
+		Payload: payload,
+	}
+
+	attempted := []*pipepb.MonitoringInfo{}
+	committed := []*pipepb.MonitoringInfo{mInfo}
+	p := &pipepb.Pipeline{}
+
+	got := FromMonitoringInfos(p, attempted, committed).AllMetrics().Histograms()
+	size := len(got)
+	if size != 1 {
+		t.Fatalf("Invalid array's size: got: %v, want: %v", size, 1)
+	}
+	wantKey := metrics.StepKey{
+		Step:      "main.customDoFn",
+		Name:      "customHistogram",
+		Namespace: "customDoFn",
+	}
+	if d := cmp.Diff(wantKey, got[0].Key); d != "" {
+		t.Fatalf("Invalid histogram key: diff(-want,+got):\n %v", d)
+	}
+	res := got[0].Result()
+	if !res.Buckets.Equal(value.Buckets) {
+		t.Errorf("Invalid histogram buckets: got: %v, want: %v", res.Buckets.Bounds(), value.Buckets.Bounds())
+	}
+	if d := cmp.Diff(value.Counts, res.Counts); d != "" {
+		t.Errorf("Invalid histogram counts: diff(-want,+got):\n %v", d)
+	}
+}
@@ -18,10 +18,12 @@ package metricsx
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/graph/coder"
 	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/graph/mtime"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/metrics"
 )
 
 // Urn is an enum type for representing urns of metrics and monitored states.
@@ -39,6 +41,7 @@ var sUrns = [...]string{
 	"beam:metric:user:top_n_double:v1",
 	"beam:metric:user:bottom_n_int64:v1",
 	"beam:metric:user:bottom_n_double:v1",
+	"beam:metric:user:histogram_int64:v1",
 
 	"beam:metric:element_count:v1",
 	"beam:metric:sampled_byte_size:v1",
@@ -67,6 +70,7 @@ const (
 	UrnUserTopNFloat64
 	UrnUserBottomNInt64
 	UrnUserBottomNFloat64
+	UrnUserHistogramInt64
 
 	UrnElementCount
 	UrnSampledByteSize
@@ -112,6 +116,10 @@ func UrnToType(u Urn) string {
 		return "beam:metrics:bottom_n_int64:v1"
 	case UrnUserBottomNFloat64:
 		return "beam:metrics:bottom_n_double:v1"
+	case UrnUserHistogramInt64:
+		// The model's histogram_int64 type has no portable encoding yet, so
+		// user histograms use their own type for the Int64Histogram payload.
+		return "beam:metrics:user_histogram_int64:v1"
 
 	case UrnProgressRemaining, UrnProgressCompleted:
 		return "beam:metrics:progress:v1"
@@ -170,6 +178,65 @@ func Int64Distribution(count, sum, min, max int64) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// Int64Histogram returns an encoded payload of the histogram of an
+// integer value. The payload is the number of bucket boundaries, followed
+// by the boundaries, the counts of the underflow bucket, each bucket, and
+// the overflow bucket, and finally the sum of the observed values, all as
+// varints.
+func Int64Histogram(v metrics.HistogramValue) ([]byte, error) {
+	var buf bytes.Buffer
+	bounds := v.Buckets.Bounds()
+	if len(v.Counts) != len(bounds)+1 {
+		return nil, fmt.Errorf("histogram has %d counts for %d bucket boundaries, want %d", len(v.Counts), len(bounds), len(bounds)+1)
+	}
+	if err := coder.EncodeVarInt(int64(len(bounds)), &buf); err != nil {
+		return nil, err
+	}
+	for _, b := range bounds {
+		if err := coder.EncodeVarInt(b, &buf); err != nil {
+			return nil, err
+		}
+	}
+	for _, c := range v.Counts {
+		if err := coder.EncodeVarInt(c, &buf); err != nil {
+			return nil, err
+		}
+	}
+	if err := coder.EncodeVarInt(v.Sum, &buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// DecodeInt64Histogram decodes a payload produced by Int64Histogram.
+func DecodeInt64Histogram(r io.Reader) (metrics.HistogramValue, error) {
+	n, err := coder.DecodeVarInt(r)
+	if err != nil {
+		return metrics.HistogramValue{}, err
+	}
+	bounds := make([]int64, n)
+	for i := range bounds {
+		if bounds[i], err = coder.DecodeVarInt(r); err != nil {
+			return metrics.HistogramValue{}, err
+		}
+	}
+	buckets, err := metrics.NewBuckets(bounds)
+	if err != nil {
+		return metrics.HistogramValue{}, err
+	}
+	counts := make([]int64, n+1)
+	for i := range counts {
+		if counts[i], err = coder.DecodeVarInt(r); err != nil {
+			return metrics.HistogramValue{}, err
+		}
+	}
+	sum, err := coder.DecodeVarInt(r)
+	if err != nil {
+		return metrics.HistogramValue{}, err
+	}
+	return metrics.HistogramValue{Buckets: buckets, Counts: counts, Sum: sum}, nil
+}
+
 // ExecutionMsecUrn returns the Urn for the bundle state
 func ExecutionMsecUrn(i int) Urn {
 	switch i {
@@ -0,0 +1,118 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package beam
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/graph"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/runtime/exec"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/runtime/graphx"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/typex"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/internal/errors"
+)
+
+func init() {
+	RegisterType(reflect.TypeOf((*FailedElement)(nil)).Elem())
+}
+
+// FailedElement is an element that a DoFn failed to process, with the name
+// of the transform, the error message and the element encoded with the coder
+// of the DoFn's main input.
+type FailedElement = exec.FailedElement
+
+// ErrorHandler collects the elements that DoFns fail to process, so they can
+// be set aside rather than failing the pipeline. It's passed to ParDos with
+// the WithErrorHandler option. When the DoFn returns an error or panics for
+// an element, the element is output as a FailedElement instead, in the same
+// windows and with the same timestamp. Any outputs emitted for the element
+// before it failed are kept. For example:
+//
+//	h := beam.NewErrorHandler(0.01)
+//	parsed := beam.ParDo(s, parseFn, lines, beam.WithErrorHandler(h))
+//	textio.Write(s, "gs://bucket/deadletter", beam.ParDo(s, formatFailureFn, h.Failures(s)))
+//
+// To avoid silently dropping most of the data, a bundle still fails if more
+// than the maximum failure rate of its elements fail. Failure rates are
+// tracked per bundle and per ParDo, and are checked as elements fail once a
+// bundle has processed 100 elements, so a failing bundle stops early. Only
+// failures of the DoFn itself are routed to the error handler; failures of
+// downstream transforms fail the bundle.
+//
+// Error handlers aren't supported for splittable DoFns or DoFns on grouped
+// inputs.
+type ErrorHandler struct {
+	maxFailureRate float64
+	failures       []PCollection
+}
+
+// NewErrorHandler returns an ErrorHandler that fails bundles if more than
+// the given fraction of their elements fail. A rate of 0 routes failing
+// elements to the error output, but fails the bundle on any failure, and a
+// rate of 1 never fails the bundle. It panics if the rate isn't within
+// [0, 1].
+func NewErrorHandler(maxFailureRate float64) *ErrorHandler {
+	if maxFailureRate < 0 || maxFailureRate > 1 {
+		panic(fmt.Sprintf("invalid maximum failure rate %v: must be within [0, 1]", maxFailureRate))
+	}
+	return &ErrorHandler{maxFailureRate: maxFailureRate}
+}
+
+// Failures returns the failed elements of all the ParDos using the handler,
+// as a PCollection<FailedElement>. The failures are flattened, so the main
+// inputs of the ParDos must have compatible windowing. It should be called
+// after the ParDos have been added to the pipeline.
+func (h *ErrorHandler) Failures(s Scope) PCollection {
+	if len(h.failures) == 0 {
+		return CreateList(s, []FailedElement{})
+	}
+	return Flatten(s, h.failures...)
+}
+
+// addOutput adds the error output to a ParDo edge, as its last output, and
+// annotates the transform with the maximum failure rate for the worker.
+func (h *ErrorHandler) addOutput(s Scope, edge *graph.MultiEdge) error {
+	if edge.DoFn.IsSplittable() {
+		return errors.New("error handlers are not supported for splittable DoFns")
+	}
+	in := edge.Input[0].From
+	if typex.IsCoGBK(in.Type()) {
+		return errors.New("error handlers are not supported for DoFns on grouped inputs")
+	}
+	t := typex.New(reflect.TypeOf((*FailedElement)(nil)).Elem())
+	out := s.real.NewNode(t, in.WindowingStrategy(), in.Bounded())
+	edge.Output = append(edge.Output, &graph.Outbound{To: out, Type: t})
+
+	if edge.Annotations == nil {
+		edge.Annotations = make(map[string][]byte)
+	}
+	edge.Annotations[graphx.URNErrorHandlerAnnotation] = []byte(strconv.FormatFloat(h.maxFailureRate, 'g', -1, 64))
+	return nil
+}
+
+// WithErrorHandler returns an option for ParDo that outputs the elements the
+// DoFn fails to process to the given handler.
+func WithErrorHandler(h *ErrorHandler) Option {
+	return errorHandlerOption{h: h}
+}
+
+type errorHandlerOption struct {
+	h *ErrorHandler
+}
+
+func (o errorHandlerOption) private() {}
@@ -0,0 +1,186 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package beam_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/runners/prism"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/testing/passert"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/testing/ptest"
+)
+
+func init() {
+	beam.RegisterFunction(failOdd)
+	beam.RegisterFunction(panicOnZero)
+	beam.RegisterFunction(failKey)
+	beam.RegisterFunction(formatFailure)
+	beam.RegisterFunction(formatKVFailure)
+}
+
+func failOdd(v int, emit func(int)) error {
+	if v%2 != 0 {
+		return fmt.Errorf("odd %v", v)
+	}
+	emit(v)
+	return nil
+}
+
+func panicOnZero(v int) int {
+	if v == 0 {
+		panic("zero")
+	}
+	return 10 / v
+}
+
+func failKey(k string, v int) (string, int, error) {
+	if k == "bad" {
+		return "", 0, fmt.Errorf("bad key")
+	}
+	return k, v, nil
+}
+
+// formatFailure formats a failure of a DoFn with an int input as
+// "element:error", checking the transform name.
+func formatFailure(f beam.FailedElement) (string, error) {
+	if f.Transform == "" {
+		return "", fmt.Errorf("missing transform in %+v", f)
+	}
+	v, err := beam.NewElementDecoder(reflect.TypeOf(0)).Decode(bytes.NewReader(f.Element))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%v:%v", v, f.Error), nil
+}
+
+func formatKVFailure(f beam.FailedElement) (string, error) {
+	r := bytes.NewReader(f.Element)
+	k, err := beam.NewElementDecoder(reflect.TypeOf("")).Decode(r)
+	if err != nil {
+		return "", err
+	}
+	v, err := beam.NewElementDecoder(reflect.TypeOf(0)).Decode(r)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%v,%v:%v", k, v, f.Error), nil
+}
+
+func TestErrorHandler(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	h := beam.NewErrorHandler(1)
+	evens := beam.ParDo(s, failOdd, beam.Create(s, 1, 2, 3, 4), beam.WithErrorHandler(h))
+	passert.Equals(s, evens, 2, 4)
+	passert.Equals(s, beam.ParDo(s, formatFailure, h.Failures(s)), "1:odd 1", "3:odd 3")
+
+	panics := beam.NewErrorHandler(1)
+	quotients := beam.ParDo(s, panicOnZero, beam.Create(s, 0, 1, 2), beam.WithErrorHandler(panics))
+	passert.Equals(s, quotients, 10, 5)
+	passert.Equals(s, beam.ParDo(s, formatFailure, panics.Failures(s)), "0:panic: zero")
+
+	kvs := beam.NewErrorHandler(1)
+	keyed := beam.ParDo(s, keyByLength, beam.Create(s, "a", "bb"))
+	input := beam.ParDo(s, func(k int, v string) (string, int) {
+		if k == 2 {
+			return "bad", k
+		}
+		return v, k
+	}, keyed)
+	beam.ParDo(s, failKey, input, beam.WithErrorHandler(kvs))
+	passert.Equals(s, beam.ParDo(s, formatKVFailure, kvs.Failures(s)), "bad,2:bad key")
+
+	// Handlers without failing ParDos have no failures.
+	passert.Empty(s, beam.NewErrorHandler(0).Failures(s))
+
+	ptest.RunAndValidate(t, p)
+}
+
+// TestErrorHandler_Portable runs on Prism, to cover the translation of error
+// handlers for portable runners.
+func TestErrorHandler_Portable(t *testing.T) {
+	beam.Init()
+	p, s := beam.NewPipelineWithRoot()
+	h := beam.NewErrorHandler(1)
+	evens := beam.ParDo(s, failOdd, beam.Create(s, 1, 2, 3, 4), beam.WithErrorHandler(h))
+	passert.Equals(s, evens, 2, 4)
+	passert.Equals(s, beam.ParDo(s, formatFailure, h.Failures(s)), "1:odd 1", "3:odd 3")
+
+	if _, err := prism.Execute(context.Background(), p); err != nil {
+		t.Errorf("ErrorHandler() failed: %v", err)
+	}
+}
+
+func TestErrorHandler_Multiple(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	h := beam.NewErrorHandler(1)
+	beam.ParDo(s, failOdd, beam.Create(s, 1, 2), beam.WithErrorHandler(h))
+	beam.ParDo(s, failOdd, beam.Create(s, 3, 4), beam.WithErrorHandler(h))
+	passert.Equals(s, beam.ParDo(s, formatFailure, h.Failures(s)), "1:odd 1", "3:odd 3")
+	ptest.RunAndValidate(t, p)
+}
+
+func TestErrorHandler_MaxFailureRate(t *testing.T) {
+	tests := []struct {
+		rate    float64
+		input   []int
+		wantErr bool
+	}{
+		{rate: 0.5, input: []int{1, 2, 4, 6}},
+		{rate: 0.5, input: []int{1, 3, 5, 6}, wantErr: true},
+		{rate: 0, input: []int{2, 4}},
+		{rate: 0, input: []int{1, 2, 4}, wantErr: true},
+	}
+	for _, test := range tests {
+		p, s := beam.NewPipelineWithRoot()
+		h := beam.NewErrorHandler(test.rate)
+		beam.ParDo(s, failOdd, beam.CreateList(s, test.input), beam.WithErrorHandler(h))
+		err := ptest.Run(p)
+		if got := err != nil; got != test.wantErr {
+			t.Errorf("ErrorHandler(%v) on %v failed: %v, want failure %v", test.rate, test.input, err, test.wantErr)
+		}
+		if err != nil && !strings.Contains(err.Error(), "maximum failure rate") {
+			t.Errorf("ErrorHandler(%v) on %v failed with %v, want maximum failure rate error", test.rate, test.input, err)
+		}
+	}
+}
+
+func TestNewErrorHandler_Invalid(t *testing.T) {
+	for _, rate := range []float64{-0.1, 1.5} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewErrorHandler(%v) didn't panic", rate)
+				}
+			}()
+			beam.NewErrorHandler(rate)
+		}()
+	}
+}
+
+func TestWithErrorHandler_Combine(t *testing.T) {
+	_, s := beam.NewPipelineWithRoot()
+	keyed := beam.ParDo(s, keyByLength, beam.Create(s, "a", "bb"))
+	_, err := beam.TryCombinePerKey(s, func(a, b string) string { return a + b }, keyed, beam.WithErrorHandler(beam.NewErrorHandler(1)))
+	if err == nil {
+		t.Errorf("TryCombinePerKey() with an error handler succeeded, want error")
+	}
+}
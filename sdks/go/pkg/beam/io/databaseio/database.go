@@ -120,22 +120,20 @@ func (f *queryFn) ProcessElement(ctx context.Context, _ []byte, emit func(beam.X
 	return nil
 }
 
-// Write writes the elements of the given PCollection<T> to database, if columns left empty all table columns are used to insert into, otherwise selected
-func Write(s beam.Scope, driver, dsn, table string, columns []string, col beam.PCollection) {
-	t := col.Type().Type()
-	s = s.Scope(driver + ".Write")
-	pre := beam.AddFixedKey(s, col)
-	post := beam.GroupByKey(s, pre)
-	beam.ParDo0(s, &writeFn{Driver: driver, Dsn: dsn, Table: table, Columns: columns, BatchSize: writeRowLimit, Type: beam.EncodedType{T: t}}, post)
+// Write writes the elements of the given PCollection<T> to database, if columns left empty all table columns are used to insert into, otherwise selected.
+// It returns a PCollection<int> with the number of rows written for each window, which signals completion of the write to beam.WaitOn.
+func Write(s beam.Scope, driver, dsn, table string, columns []string, col beam.PCollection) beam.PCollection {
+	return WriteWithBatchSize(s, writeRowLimit, driver, dsn, table, columns, col)
 }
 
 // WriteWithBatchSize writes the elements of the given PCollection<T> to database with custom batch size. Batch size control number of elements in the batch INSERT statement.
-func WriteWithBatchSize(s beam.Scope, batchSize int, driver, dsn, table string, columns []string, col beam.PCollection) {
+// It returns a PCollection<int> with the number of rows written for each window, like Write.
+func WriteWithBatchSize(s beam.Scope, batchSize int, driver, dsn, table string, columns []string, col beam.PCollection) beam.PCollection {
 	t := col.Type().Type()
 	s = s.Scope(driver + ".Write")
 	pre := beam.AddFixedKey(s, col)
 	post := beam.GroupByKey(s, pre)
-	beam.ParDo0(s, &writeFn{Driver: driver, Dsn: dsn, Table: table, Columns: columns, BatchSize: batchSize, Type: beam.EncodedType{T: t}}, post)
+	return beam.ParDo(s, &writeFn{Driver: driver, Dsn: dsn, Table: table, Columns: columns, BatchSize: batchSize, Type: beam.EncodedType{T: t}}, post)
 }
 
 type writeFn struct {
@@ -153,7 +151,7 @@ type writeFn struct {
 	Type beam.EncodedType `json:"type"`
 }
 
-func (f *writeFn) ProcessElement(ctx context.Context, _ int, iter func(*beam.X) bool) error {
+func (f *writeFn) ProcessElement(ctx context.Context, _ int, iter func(*beam.X) bool, emit func(int)) error {
 	//TODO move DB Open and Close to Setup and Teardown methods or StartBundle and FinishBundle
 	db, err := sql.Open(f.Driver, f.Dsn)
 	if err != nil {
@@ -217,5 +215,6 @@ func (f *writeFn) ProcessElement(ctx context.Context, _ int, iter func(*beam.X)
 	}
 
 	log.Infof(ctx, "written %v row(s) into %v", writer.totalCount, f.Table)
+	emit(writer.totalCount)
 	return nil
 }
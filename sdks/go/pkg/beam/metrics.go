@@ -92,3 +92,38 @@ func (c Gauge) Set(ctx context.Context, v int64) {
 func NewGauge(namespace, name string) Gauge {
 	return Gauge{metrics.NewGauge(namespace, name)}
 }
+
+// Histogram is a metric that records the distribution of reported values
+// into buckets, so that percentiles can be estimated.
+//
+// Histograms are safe to use in multiple bundles simultaneously, but
+// not generally threadsafe. Your DoFn needs to manage the thread
+// safety of Beam metrics for any additional concurrency it uses.
+type Histogram struct {
+	*metrics.Histogram
+}
+
+// Update adds an observation to this histogram. The context must be
+// provided by the framework, or the value will not be recorded.
+func (c Histogram) Update(ctx context.Context, v int64) {
+	c.Histogram.Update(ctx, v)
+}
+
+// NewHistogram returns the Histogram with the given namespace, name and
+// bucket layout. Bucket layouts may be produced with LinearBuckets or
+// ExponentialBuckets.
+func NewHistogram(namespace, name string, buckets metrics.Buckets) Histogram {
+	return Histogram{metrics.NewHistogram(namespace, name, buckets)}
+}
+
+// LinearBuckets returns a histogram bucket layout of n buckets of equal
+// width, the first of which starts at start.
+func LinearBuckets(start, width int64, n int) metrics.Buckets {
+	return metrics.LinearBuckets(start, width, n)
+}
+
+// ExponentialBuckets returns a histogram bucket layout of n buckets, where
+// each bucket boundary is growth times the previous one, starting at start.
+func ExponentialBuckets(start int64, growth float64, n int) metrics.Buckets {
+	return metrics.ExponentialBuckets(start, growth, n)
+}
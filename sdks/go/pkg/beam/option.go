@@ -49,9 +49,10 @@ type TypeDefinition struct {
 
 func (s TypeDefinition) private() {}
 
-func parseOpts(opts []Option) ([]SideInput, []TypeDefinition) {
+func parseOpts(opts []Option) ([]SideInput, []TypeDefinition, *ErrorHandler) {
 	var side []SideInput
 	var infer []TypeDefinition
+	var handler *ErrorHandler
 
 	for _, opt := range opts {
 		switch opt := opt.(type) {
@@ -59,9 +60,11 @@ func parseOpts(opts []Option) ([]SideInput, []TypeDefinition) {
 			side = append(side, opt)
 		case TypeDefinition:
 			infer = append(infer, opt)
+		case errorHandlerOption:
+			handler = opt.h
 		default:
 			panic(fmt.Sprintf("Unexpected opt: %v", opt))
 		}
 	}
-	return side, infer
+	return side, infer, handler
 }
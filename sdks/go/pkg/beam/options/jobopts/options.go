@@ -51,6 +51,12 @@ func init() {
 			"eg.'min_ram=12GB', 'beam:resources:accelerator:v1='runner_specific' "+
 			"In case of duplicate hint URNs, the last value specified will be used. "+
 			"See https://beam.apache.org/documentation/runtime/resource-hints/ for more information.")
+	flag.Var(&MetricsSinks,
+		"metrics_sink",
+		"Export metrics to an external system while the job is running, of the format '<kind>=<target>'. "+
+			"eg. 'prometheus=:9464' to serve a Prometheus endpoint, or 'otlp=http://localhost:4318' "+
+			"to push to an OpenTelemetry collector. May be specified multiple times. "+
+			"Ignored for async jobs, since metrics are only exported while waiting for the job to complete.")
 }
 
 var (
@@ -101,6 +107,12 @@ var (
 
 	// ResourceHints flag takes whole pipeline hints for resources.
 	ResourceHints stringSlice
+
+	// MetricsSinks are the metrics sinks to export to while the job is running.
+	MetricsSinks stringSlice
+
+	// MetricsExportInterval is how often metrics are exported to MetricsSinks.
+	MetricsExportInterval = flag.Duration("metrics_export_interval", 10*time.Second, "How often to export metrics to the configured metrics sinks.")
 )
 
 type missingFlagError error
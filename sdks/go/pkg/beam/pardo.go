@@ -37,7 +37,7 @@ func addParDoCtx(err error, s Scope) error {
 // for multiple reasons, notably that the dofn is not valid or cannot be bound
 // -- due to type mismatch, say -- to the incoming PCollections.
 func TryParDo(s Scope, dofn any, col PCollection, opts ...Option) ([]PCollection, error) {
-	side, typedefs, err := validate(s, col, opts)
+	side, typedefs, handler, err := validate(s, col, opts)
 	if err != nil {
 		return nil, addParDoCtx(err, s)
 	}
@@ -92,6 +92,11 @@ func TryParDo(s Scope, dofn any, col PCollection, opts ...Option) ([]PCollection
 	if err != nil {
 		return nil, addParDoCtx(err, s)
 	}
+	if handler != nil {
+		if err := handler.addOutput(s, edge); err != nil {
+			return nil, addParDoCtx(err, s)
+		}
+	}
 
 	pipelineState := fn.PipelineState()
 	if len(pipelineState) > 0 {
@@ -133,6 +138,10 @@ func TryParDo(s Scope, dofn any, col PCollection, opts ...Option) ([]PCollection
 		c.SetCoder(NewCoder(c.Type()))
 		ret = append(ret, c)
 	}
+	if handler != nil {
+		handler.failures = append(handler.failures, ret[len(ret)-1])
+		ret = ret[:len(ret)-1]
+	}
 	return ret, nil
 }
 
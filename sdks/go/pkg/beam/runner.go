@@ -30,6 +30,11 @@ var (
 	runners = make(map[string]func(ctx context.Context, p *Pipeline) (PipelineResult, error))
 )
 
+// DefaultRunner is the runner used by Run when no runner is named. The direct
+// runner delegates pipelines it can't execute to the Prism runner, if it's
+// registered by importing github.com/apache/beam/sdks/v2/go/pkg/beam/runners/prism.
+const DefaultRunner = "direct"
+
 // RegisterRunner associates the name with the supplied runner, making it available
 // to execute a pipeline via Run.
 func RegisterRunner(name string, fn func(ctx context.Context, p *Pipeline) (PipelineResult, error)) {
@@ -41,11 +46,20 @@ func RegisterRunner(name string, fn func(ctx context.Context, p *Pipeline) (Pipe
 
 // Run executes the pipeline using the selected registred runner. It is customary
 // to define a "runner" with no default as a flag to let users control runner
-// selection.
+// selection. If runner is empty, DefaultRunner is used.
 func Run(ctx context.Context, runner string, p *Pipeline) (PipelineResult, error) {
+	if runner == "" {
+		runner = DefaultRunner
+	}
 	fn, ok := runners[runner]
 	if !ok {
 		log.Exitf(ctx, "Runner %v not registered. Forgot to _ import it?", runner)
 	}
 	return fn(ctx, p)
 }
+
+// IsRunnerRegistered returns whether a runner has been registered under the name.
+func IsRunnerRegistered(name string) bool {
+	_, ok := runners[name]
+	return ok
+}
@@ -0,0 +1,105 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package direct
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sync"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/graph"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/graph/window"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/internal/errors"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/log"
+)
+
+// usePrism forces all pipelines to be delegated to the Prism runner.
+var usePrism = flag.Bool("direct_use_prism", false, "Delegate all pipelines run with the direct runner to the Prism runner.")
+
+// delegateRunner is the registered runner that pipelines using features the
+// direct runner doesn't support are delegated to.
+var delegateRunner = "prism"
+
+var deprecationOnce sync.Once
+
+// warnDeprecated logs, once per process, that the direct runner is
+// deprecated in favour of Prism.
+func warnDeprecated(ctx context.Context) {
+	deprecationOnce.Do(func() {
+		log.Warn(ctx, "The direct runner is deprecated and will be removed in a future release. "+
+			"Use the Prism runner instead by importing github.com/apache/beam/sdks/v2/go/pkg/beam/runners/prism "+
+			"and running with --runner=prism.")
+	})
+}
+
+// unsupportedFeatures returns a description of each use of a feature in the
+// edges that the direct runner can't execute faithfully. The direct runner
+// executes every transform in a single bundle with no notion of state, timers
+// or watermarks, and ignores windows when materializing side inputs.
+func unsupportedFeatures(edges []*graph.MultiEdge) []string {
+	var fs []string
+	for _, e := range edges {
+		if e.Op != graph.ParDo {
+			continue
+		}
+		fn := e.DoFn
+		if len(fn.PipelineState()) > 0 {
+			fs = append(fs, fmt.Sprintf("%v: user state", e.Name()))
+		}
+		if _, ok := fn.OnTimerFn(); ok {
+			fs = append(fs, fmt.Sprintf("%v: timers", e.Name()))
+		}
+		if fn.IsSplittable() {
+			if _, ok := fn.ProcessElementFn().ProcessContinuation(); ok {
+				fs = append(fs, fmt.Sprintf("%v: splittable DoFn with process continuations", e.Name()))
+			}
+		}
+		if len(e.Input) > 1 && e.Input[0].From.WindowingStrategy().Fn.Kind != window.GlobalWindows {
+			// Side inputs are only well defined here if everything is in the
+			// global window, since they are materialized without windows.
+			fs = append(fs, fmt.Sprintf("%v: side inputs on a %v windowed main input", e.Name(), e.Input[0].From.WindowingStrategy().Fn))
+		}
+	}
+	return fs
+}
+
+// delegate runs the pipeline on the delegate runner if it's forced by
+// flag or required by the features in use. It returns false if the direct
+// runner should execute the pipeline itself.
+func delegate(ctx context.Context, p *beam.Pipeline, edges []*graph.MultiEdge) (beam.PipelineResult, bool, error) {
+	fs := unsupportedFeatures(edges)
+	if !*usePrism && len(fs) == 0 {
+		return nil, false, nil
+	}
+	if !beam.IsRunnerRegistered(delegateRunner) {
+		if len(fs) == 0 {
+			return nil, true, errors.Errorf("--direct_use_prism set, but the %v runner isn't registered. "+
+				"Import github.com/apache/beam/sdks/v2/go/pkg/beam/runners/prism", delegateRunner)
+		}
+		return nil, true, errors.Errorf("pipeline uses features unsupported by the direct runner: %v. "+
+			"Run it with the Prism runner instead, by importing github.com/apache/beam/sdks/v2/go/pkg/beam/runners/prism "+
+			"and running with --runner=prism", fs)
+	}
+	if len(fs) > 0 {
+		log.Infof(ctx, "Pipeline uses features unsupported by the direct runner, delegating to the %v runner: %v", delegateRunner, fs)
+	} else {
+		log.Infof(ctx, "Delegating pipeline to the %v runner.", delegateRunner)
+	}
+	pr, err := beam.Run(ctx, delegateRunner, p)
+	return pr, true, err
+}
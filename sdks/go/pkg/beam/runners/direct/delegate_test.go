@@ -0,0 +1,114 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package direct
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/graph/window"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/state"
+)
+
+func init() {
+	beam.RegisterType(reflect.TypeOf((*statefulFn)(nil)))
+	beam.RegisterRunner("directtest-delegate", func(ctx context.Context, p *beam.Pipeline) (beam.PipelineResult, error) {
+		delegated++
+		return nil, nil
+	})
+}
+
+var delegated int
+
+type statefulFn struct {
+	Count state.Value[int64]
+}
+
+func (fn *statefulFn) ProcessElement(sp state.Provider, k string, v int64) int64 {
+	n, _, _ := fn.Count.Read(sp)
+	fn.Count.Write(sp, n+v)
+	return n + v
+}
+
+func statefulPipeline() *beam.Pipeline {
+	p, s := beam.NewPipelineWithRoot()
+	col := beam.ParDo(s, dofnKV, beam.Impulse(s))
+	beam.ParDo(s, &statefulFn{Count: state.MakeValueState[int64]("count")}, col)
+	return p
+}
+
+func sideInputPipeline(ws *window.Fn) *beam.Pipeline {
+	p, s := beam.NewPipelineWithRoot()
+	main := beam.Impulse(s)
+	side := beam.ParDo(s, dofn1, beam.Impulse(s))
+	if ws != nil {
+		main = beam.WindowInto(s, ws, main)
+	}
+	beam.ParDo(s, dofn2x1, main, beam.SideInput{Input: side})
+	return p
+}
+
+func TestUnsupportedFeatures(t *testing.T) {
+	tests := []struct {
+		name string
+		p    *beam.Pipeline
+		want string
+	}{
+		{name: "globalSideInput", p: sideInputPipeline(nil)},
+		{name: "windowedSideInput", p: sideInputPipeline(window.NewFixedWindows(time.Minute)), want: "side inputs"},
+		{name: "state", p: statefulPipeline(), want: "user state"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			edges, _, err := test.p.Build()
+			if err != nil {
+				t.Fatalf("Build() failed: %v", err)
+			}
+			got := unsupportedFeatures(edges)
+			if test.want == "" {
+				if len(got) != 0 {
+					t.Errorf("unsupportedFeatures() = %v, want none", got)
+				}
+				return
+			}
+			if len(got) != 1 || !strings.Contains(got[0], test.want) {
+				t.Errorf("unsupportedFeatures() = %v, want one containing %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestExecute_Delegation(t *testing.T) {
+	defer func(r string) { delegateRunner = r }(delegateRunner)
+
+	delegateRunner = "unregistered"
+	if _, err := executeWithT(context.Background(), t, statefulPipeline()); err == nil || !strings.Contains(err.Error(), "--runner=prism") {
+		t.Errorf("Execute() with unregistered delegate = %v, want error suggesting prism", err)
+	}
+
+	delegateRunner = "directtest-delegate"
+	delegated = 0
+	if _, err := executeWithT(context.Background(), t, statefulPipeline()); err != nil {
+		t.Fatalf("Execute() failed: %v", err)
+	}
+	if delegated != 1 {
+		t.Errorf("Execute() delegated %v times, want 1", delegated)
+	}
+}
@@ -15,6 +15,12 @@
 
 // Package direct contains the direct runner for running single-bundle
 // pipelines in the current process. Useful for testing.
+//
+// The direct runner is deprecated in favour of the Prism runner. Pipelines
+// that use features the direct runner can't execute faithfully, such as
+// state, timers or side inputs on non-global windows, are delegated to Prism
+// if it has been imported, and rejected otherwise. Use --direct_use_prism to
+// delegate every pipeline.
 package direct
 
 import (
@@ -25,6 +31,7 @@ import (
 	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/graph"
 	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/metrics"
 	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/runtime/exec"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/runtime/graphx"
 	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/typex"
 	"github.com/apache/beam/sdks/v2/go/pkg/beam/internal/errors"
 	"github.com/apache/beam/sdks/v2/go/pkg/beam/log"
@@ -40,6 +47,7 @@ func init() {
 // Execute runs the pipeline in-process.
 func Execute(ctx context.Context, p *beam.Pipeline) (beam.PipelineResult, error) {
 	log.Info(ctx, "Executing pipeline with the direct runner.")
+	warnDeprecated(ctx)
 
 	if !beam.Initialized() {
 		log.Warn(ctx, "Beam has not been initialized. Call beam.Init() before pipeline construction.")
@@ -61,6 +69,9 @@ func Execute(ctx context.Context, p *beam.Pipeline) (beam.PipelineResult, error)
 	if err != nil {
 		return nil, errors.Wrap(err, "invalid pipeline")
 	}
+	if pr, ok, err := delegate(ctx, p, edges); ok {
+		return pr, err
+	}
 	plan, err := Compile(edges)
 	if err != nil {
 		return nil, errors.Wrap(err, "translation failed")
@@ -258,6 +269,12 @@ func (b *builder) makeLink(id linkID) (exec.Node, error) {
 			Out:     out,
 			PID:     path.Base(edge.DoFn.Name()),
 		}
+		if rate, ok := edge.Annotations[graphx.URNErrorHandlerAnnotation]; ok {
+			pardo.ErrorHandler, err = exec.NewErrorHandler(exec.MakeElementEncoder(edge.Input[0].From.Coder), rate)
+			if err != nil {
+				return nil, err
+			}
+		}
 		u = pardo
 		if edge.DoFn.IsSplittable() {
 			u = &exec.SdfFallback{PDo: pardo}
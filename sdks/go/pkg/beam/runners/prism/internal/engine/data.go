@@ -15,10 +15,19 @@
 
 package engine
 
+import (
+	"bytes"
+	"sort"
+	"sync"
+)
+
 // TentativeData is where data for in progress bundles is put
 // until the bundle executes successfully.
 type TentativeData struct {
 	Raw map[string][][]byte
+
+	// Timers holds the encoded timers set by the bundle, keyed by timer family.
+	Timers map[string][][]byte
 }
 
 // WriteData adds data to a given global collectionID.
@@ -28,3 +37,81 @@ func (d *TentativeData) WriteData(colID string, data []byte) {
 	}
 	d.Raw[colID] = append(d.Raw[colID], data)
 }
+
+// WriteTimers adds encoded timers for the given timer family.
+func (d *TentativeData) WriteTimers(family string, data []byte) {
+	if d.Timers == nil {
+		d.Timers = map[string][][]byte{}
+	}
+	d.Timers[family] = append(d.Timers[family], data)
+}
+
+// StateCell identifies the user state for a single key and window
+// of a transform.
+type StateCell struct {
+	TransformID, StateID string
+	Window, Key          string // Encoded window and key.
+}
+
+// StateData holds the user state of a stage across bundles.
+//
+// Unlike bundle output, state is written immediately rather than
+// on bundle commit, since Prism doesn't retry failed bundles.
+//
+// Bag state is stored as a multimap entry with an empty map key.
+type StateData struct {
+	mu    sync.Mutex
+	cells map[StateCell]map[string][][]byte
+}
+
+// Get returns the values for the given map key of the state cell.
+func (s *StateData) Get(c StateCell, mapKey []byte) [][]byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cells[c][string(mapKey)]
+}
+
+// Append adds a value to the given map key of the state cell.
+func (s *StateData) Append(c StateCell, mapKey, data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cells == nil {
+		s.cells = map[StateCell]map[string][][]byte{}
+	}
+	entries, ok := s.cells[c]
+	if !ok {
+		entries = map[string][][]byte{}
+		s.cells[c] = entries
+	}
+	entries[string(mapKey)] = append(entries[string(mapKey)], data)
+}
+
+// Clear removes the values of the given map key of the state cell.
+func (s *StateData) Clear(c StateCell, mapKey []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entries := s.cells[c]
+	delete(entries, string(mapKey))
+	if len(entries) == 0 {
+		delete(s.cells, c)
+	}
+}
+
+// Keys returns the encoded map keys of the state cell, in byte order.
+func (s *StateData) Keys(c StateCell) [][]byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var keys [][]byte
+	for k := range s.cells[c] {
+		keys = append(keys, []byte(k))
+	}
+	sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i], keys[j]) < 0 })
+	return keys
+}
+
+// ClearAll removes every map key of the state cell.
+func (s *StateData) ClearAll(c StateCell) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.cells, c)
+}
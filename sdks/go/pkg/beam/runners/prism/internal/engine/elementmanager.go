@@ -23,8 +23,11 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sort"
 	"sync"
+	"time"
 
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/graph/coder"
 	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/graph/mtime"
 	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/graph/window"
 	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/runtime/exec"
@@ -50,6 +53,7 @@ type PColInfo struct {
 	WDec     exec.WindowDecoder
 	WEnc     exec.WindowEncoder
 	EDec     func(io.Reader) []byte
+	KeyDec   func(io.Reader) []byte // Decodes keys of KV elements, for stateful stages.
 }
 
 // ToData recodes the elements with their approprate windowed value header.
@@ -64,6 +68,78 @@ func (es elements) ToData(info PColInfo) [][]byte {
 	return ret
 }
 
+// timerKey identifies a timer. Setting a timer replaces any pending
+// timer with the same key.
+type timerKey struct {
+	family, tag string
+	window      typex.Window
+	key         string // Encoded user key.
+}
+
+// timer is a pending or firing user timer.
+type timer struct {
+	timerKey
+	firing, hold mtime.Time
+	pane         typex.PaneInfo
+	clear        bool
+}
+
+// decodeTimers extracts the timers from encoded timer data, exploding them out
+// to their windows.
+func decodeTimers(family string, data []byte, inputInfo PColInfo) ([]timer, error) {
+	var ts []timer
+	buf := bytes.NewBuffer(data)
+	for buf.Len() > 0 {
+		key := inputInfo.KeyDec(buf)
+		tag, err := coder.DecodeStringUTF8(buf)
+		if err != nil {
+			return nil, fmt.Errorf("decoding timer tag: %w", err)
+		}
+		ws, err := inputInfo.WDec.Decode(buf)
+		if err != nil {
+			return nil, fmt.Errorf("decoding timer windows: %w", err)
+		}
+		clear, err := coder.DecodeBool(buf)
+		if err != nil {
+			return nil, fmt.Errorf("decoding timer clear bit: %w", err)
+		}
+		var firing, hold mtime.Time
+		var pane typex.PaneInfo
+		if !clear {
+			if firing, err = coder.DecodeEventTime(buf); err != nil {
+				return nil, fmt.Errorf("decoding timer firing timestamp: %w", err)
+			}
+			if hold, err = coder.DecodeEventTime(buf); err != nil {
+				return nil, fmt.Errorf("decoding timer hold timestamp: %w", err)
+			}
+			if pane, err = coder.DecodePane(buf); err != nil {
+				return nil, fmt.Errorf("decoding timer pane: %w", err)
+			}
+		}
+		for _, w := range ws {
+			ts = append(ts, timer{
+				timerKey: timerKey{family: family, tag: tag, window: w, key: string(key)},
+				firing:   firing,
+				hold:     hold,
+				pane:     pane,
+				clear:    clear,
+			})
+		}
+	}
+	return ts, nil
+}
+
+// encode writes the timer in the standard timer encoding.
+func (t timer) encode(wEnc exec.WindowEncoder, w io.Writer) {
+	w.Write([]byte(t.key))
+	coder.EncodeStringUTF8(t.tag, w)
+	wEnc.Encode([]typex.Window{t.window}, w)
+	coder.EncodeBool(false, w)
+	coder.EncodeEventTime(t.firing, w)
+	coder.EncodeEventTime(t.hold, w)
+	coder.EncodePane(t.pane, w)
+}
+
 // elementHeap orders elements based on their timestamps
 // so we can always find the minimum timestamp of pending elements.
 type elementHeap []element
@@ -168,6 +244,19 @@ func (em *ElementManager) StageAggregates(ID string) {
 	em.stages[ID].aggregate = true
 }
 
+// StageStateful marks the given stage as using user state or timers, which
+// means its bundles execute one at a time, so each sees the state written by
+// the last. processingTimeFamilies are its timer families in the processing
+// time domain.
+func (em *ElementManager) StageStateful(ID string, processingTimeFamilies []string) {
+	ss := em.stages[ID]
+	ss.stateful = true
+	ss.processingTimeFamilies = set[string]{}
+	for _, f := range processingTimeFamilies {
+		ss.processingTimeFamilies.insert(f)
+	}
+}
+
 // Impulse marks and initializes the given stage as an impulse which
 // is a root transform that starts processing.
 func (em *ElementManager) Impulse(stageID string) {
@@ -214,8 +303,14 @@ func (em *ElementManager) Bundles(ctx context.Context, nextBundID func() string)
 		em.pendingElements.Wait()
 		slog.Info("no more pending elements: terminating pipeline")
 		cancelFn()
-		// Ensure the watermark evaluation goroutine exits.
+	}()
+	// Wake the watermark evaluation goroutine once the context is done, whether
+	// from pipeline completion or the job being cancelled, so it may exit.
+	go func() {
+		<-ctx.Done()
+		em.refreshCond.L.Lock()
 		em.refreshCond.Broadcast()
+		em.refreshCond.L.Unlock()
 	}()
 	// Watermark evaluation goroutine.
 	go func() {
@@ -276,6 +371,24 @@ func (em *ElementManager) InputForBundle(rb RunBundle, info PColInfo) [][]byte {
 	return es.ToData(info)
 }
 
+// TimersForBundle returns the encoded timers firing in the given bundle,
+// keyed by timer family.
+func (em *ElementManager) TimersForBundle(rb RunBundle, info PColInfo) map[string][][]byte {
+	ss := em.stages[rb.StageID]
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	var ret map[string][][]byte
+	for _, t := range ss.inprogressTimers[rb.BundleID] {
+		if ret == nil {
+			ret = map[string][][]byte{}
+		}
+		var buf bytes.Buffer
+		t.encode(info.WEnc, &buf)
+		ret[t.family] = append(ret[t.family], buf.Bytes())
+	}
+	return ret
+}
+
 // reElementResiduals extracts the windowed value header from residual bytes, and explodes them
 // back out to their windows.
 func reElementResiduals(residuals [][]byte, inputInfo PColInfo, rb RunBundle) []element {
@@ -364,13 +477,28 @@ func (em *ElementManager) PersistBundle(rb RunBundle, col2Coders map[string]PCol
 		em.pendingElements.Add(len(unprocessedElements))
 		stage.AddPending(unprocessedElements)
 	}
+	var newTimers []timer
+	for family, data := range d.Timers {
+		for _, datum := range data {
+			ts, err := decodeTimers(family, datum, inputInfo)
+			if err != nil {
+				slog.Error("PersistBundle: error decoding timers", err, "bundle", rb, slog.String("family", family))
+				panic("error decoding timers")
+			}
+			newTimers = append(newTimers, ts...)
+		}
+	}
 	// Clear out the inprogress elements associated with the completed bundle.
-	// Must be done after adding the new pending elements to avoid an incorrect
-	// watermark advancement.
+	// Must be done after adding the new pending elements and timers to avoid an
+	// incorrect watermark advancement.
 	stage.mu.Lock()
+	for _, t := range newTimers {
+		stage.setTimer(t, em)
+	}
 	completed := stage.inprogress[rb.BundleID]
-	em.pendingElements.Add(-len(completed.es))
+	em.pendingElements.Add(-len(completed.es) - len(stage.inprogressTimers[rb.BundleID]))
 	delete(stage.inprogress, rb.BundleID)
+	delete(stage.inprogressTimers, rb.BundleID)
 	// If there are estimated output watermarks, set the estimated
 	// output watermark for the stage.
 	if len(estimatedOWM) > 0 {
@@ -382,7 +510,6 @@ func (em *ElementManager) PersistBundle(rb RunBundle, col2Coders map[string]PCol
 	}
 	stage.mu.Unlock()
 
-	// TODO support state/timer watermark holds.
 	em.addRefreshAndClearBundle(stage.ID, rb.BundleID)
 }
 
@@ -430,9 +557,7 @@ func (em *ElementManager) refreshWatermarks() set[string] {
 		ss := em.stages[stageID]
 		refreshed.insert(stageID)
 
-		dummyStateHold := mtime.MaxTimestamp
-
-		refreshes := ss.updateWatermarks(ss.minPendingTimestamp(), dummyStateHold, em)
+		refreshes := ss.updateWatermarks(ss.minPendingTimestamp(), ss.minTimerHold(), em)
 		nextUpdates.merge(refreshes)
 		// cap refreshes incrementally.
 		if i < 10 {
@@ -455,6 +580,11 @@ func (s set[K]) insert(k K) {
 	s[k] = struct{}{}
 }
 
+func (s set[K]) has(k K) bool {
+	_, ok := s[k]
+	return ok
+}
+
 func (s set[K]) merge(o set[K]) {
 	for k := range o {
 		s.insert(k)
@@ -484,6 +614,12 @@ type stageState struct {
 
 	pending    elementHeap         // pending input elements for this stage that are to be processesd
 	inprogress map[string]elements // inprogress elements by active bundles, keyed by bundle
+
+	// User state and timer handling.
+	stateful               bool               // whether bundles must execute one at a time, for consistent state.
+	processingTimeFamilies set[string]        // timer families in the processing time domain.
+	timers                 map[timerKey]timer // pending timers for this stage.
+	inprogressTimers       map[string][]timer // firing timers by active bundles, keyed by bundle.
 }
 
 // makeStageState produces an initialized stageState.
@@ -556,9 +692,81 @@ func (ss *stageState) OutputWatermark() mtime.Time {
 	return ss.output
 }
 
-// startBundle initializes a bundle with elements if possible.
-// A bundle only starts if there are elements at all, and if it's
-// an aggregation stage, if the windowing stratgy allows it.
+// setTimer adds a newly set timer to the pending timers, replacing any timer
+// with the same key, or removes the timer if it's cleared.
+// Must be called while holding ss.mu.
+func (ss *stageState) setTimer(t timer, em *ElementManager) {
+	_, exists := ss.timers[t.timerKey]
+	if t.clear {
+		if exists {
+			delete(ss.timers, t.timerKey)
+			em.pendingElements.Done()
+		}
+		return
+	}
+	if !exists {
+		em.pendingElements.Add(1)
+	}
+	if ss.timers == nil {
+		ss.timers = map[timerKey]timer{}
+	}
+	ss.timers[t.timerKey] = t
+	if ss.processingTimeFamilies.has(t.family) {
+		// Wake up the stage once the timer may fire.
+		time.AfterFunc(time.Until(t.firing.ToTime()), func() {
+			em.addRefreshes(singleSet(ss.ID))
+		})
+	}
+}
+
+// timerReady returns whether the timer may fire. Event time timers fire once the
+// input watermark passes them, and processing time timers once the wall clock
+// does, or when there's no further input.
+// Must be called while holding ss.mu.
+func (ss *stageState) timerReady(t timer, now mtime.Time) bool {
+	if ss.processingTimeFamilies.has(t.family) {
+		return t.firing <= now || ss.input == mtime.MaxTimestamp
+	}
+	return t.firing < ss.input
+}
+
+// hasReadyTimers returns whether any pending timers may fire.
+// Must be called while holding ss.mu.
+func (ss *stageState) hasReadyTimers() bool {
+	now := mtime.Now()
+	for _, t := range ss.timers {
+		if ss.timerReady(t, now) {
+			return true
+		}
+	}
+	return false
+}
+
+// minTimerHold returns the minimum output watermark hold of all timers,
+// including those firing in progress bundles.
+//
+// Elements output when a timer fires may have its hold as their timestamp,
+// so the hold keeps the output watermark strictly before it. Otherwise a
+// downstream aggregation could consider the window complete too early.
+func (ss *stageState) minTimerHold() mtime.Time {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	hold := mtime.MaxTimestamp
+	for _, t := range ss.timers {
+		hold = mtime.Min(hold, t.hold-1)
+	}
+	for _, ts := range ss.inprogressTimers {
+		for _, t := range ts {
+			hold = mtime.Min(hold, t.hold-1)
+		}
+	}
+	return hold
+}
+
+// startBundle initializes a bundle with elements and timers if possible.
+// A bundle only starts if there are elements or timers ready at all, and if it's
+// an aggregation stage, if the windowing stratgy allows it. A stateful stage
+// only has a single bundle in progress at a time.
 func (ss *stageState) startBundle(watermark mtime.Time, genBundID func() string) (string, bool) {
 	defer func() {
 		if e := recover(); e != nil {
@@ -568,6 +776,20 @@ func (ss *stageState) startBundle(watermark mtime.Time, genBundID func() string)
 	ss.mu.Lock()
 	defer ss.mu.Unlock()
 
+	if ss.stateful && len(ss.inprogress) > 0 {
+		return "", false
+	}
+
+	var toFire []timer
+	now := mtime.Now()
+	for k, t := range ss.timers {
+		if ss.timerReady(t, now) {
+			toFire = append(toFire, t)
+			delete(ss.timers, k)
+		}
+	}
+	sort.Slice(toFire, func(i, j int) bool { return toFire[i].firing < toFire[j].firing })
+
 	var toProcess, notYet []element
 	for _, e := range ss.pending {
 		if !ss.aggregate || ss.aggregate && ss.strat.EarliestCompletion(e.window) <= watermark {
@@ -579,19 +801,28 @@ func (ss *stageState) startBundle(watermark mtime.Time, genBundID func() string)
 	ss.pending = notYet
 	heap.Init(&ss.pending)
 
-	if len(toProcess) == 0 {
+	if len(toProcess) == 0 && len(toFire) == 0 {
 		return "", false
 	}
 	// Is THIS is where basic splits should happen/per element processing?
 	es := elements{
 		es:           toProcess,
-		minTimestamp: toProcess[0].timestamp,
+		minTimestamp: mtime.MaxTimestamp,
+	}
+	if len(toProcess) > 0 {
+		es.minTimestamp = toProcess[0].timestamp
 	}
 	if ss.inprogress == nil {
 		ss.inprogress = make(map[string]elements)
 	}
 	bundID := genBundID()
 	ss.inprogress[bundID] = es
+	if len(toFire) > 0 {
+		if ss.inprogressTimers == nil {
+			ss.inprogressTimers = make(map[string][]timer)
+		}
+		ss.inprogressTimers[bundID] = toFire
+	}
 	return bundID, true
 }
 
@@ -693,10 +924,10 @@ func (ss *stageState) bundleReady(em *ElementManager) (mtime.Time, bool) {
 	ss.mu.Lock()
 	defer ss.mu.Unlock()
 	// If the upstream watermark and the input watermark are the same,
-	// then we can't yet process this stage.
+	// then we can't yet process this stage, unless timers are ready to fire.
 	inputW := ss.input
 	_, upstreamW := ss.UpstreamWatermark()
-	if inputW == upstreamW {
+	if inputW == upstreamW && !ss.hasReadyTimers() {
 		slog.Debug("bundleReady: insufficient upstream watermark",
 			slog.String("stage", ss.ID),
 			slog.Group("watermark",
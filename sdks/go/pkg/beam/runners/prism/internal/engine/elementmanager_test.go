@@ -16,6 +16,7 @@
 package engine
 
 import (
+	"bytes"
 	"container/heap"
 	"context"
 	"fmt"
@@ -513,4 +514,60 @@ func TestElementManager(t *testing.T) {
 			t.Errorf("got %v bundles, want %v", got, want)
 		}
 	})
+
+	t.Run("timers", func(t *testing.T) {
+		em := NewElementManager(Config{})
+		em.AddStage("impulse", nil, nil, []string{"input"})
+		em.AddStage("dofn", []string{"input"}, nil, nil)
+		em.StageStateful("dofn", nil)
+		em.Impulse("impulse")
+
+		timerInfo := info
+		timerInfo.KeyDec = func(r io.Reader) []byte {
+			b, err := coder.DecodeBytes(r)
+			if err != nil {
+				t.Fatalf("error decoding key: %v", err)
+			}
+			var buf bytes.Buffer
+			coder.EncodeBytes(b, &buf)
+			return buf.Bytes()
+		}
+		var key bytes.Buffer
+		coder.EncodeBytes([]byte("key"), &key)
+		var timers bytes.Buffer
+		timer{
+			timerKey: timerKey{family: "family", window: window.GlobalWindow{}, key: key.String()},
+			firing:   mtime.EndOfGlobalWindowTime,
+			hold:     mtime.EndOfGlobalWindowTime,
+			pane:     typex.NoFiringPane(),
+		}.encode(timerInfo.WEnc, &timers)
+
+		var i int
+		ch := em.Bundles(context.Background(), func() string {
+			defer func() { i++ }()
+			return fmt.Sprintf("%v", i)
+		})
+		rb, ok := <-ch
+		if !ok {
+			t.Fatal("Bundles channel unexpectedly closed")
+		}
+		var td TentativeData
+		td.WriteTimers("family", timers.Bytes())
+		em.PersistBundle(rb, nil, td, timerInfo, nil, nil)
+
+		rb, ok = <-ch
+		if !ok {
+			t.Fatal("Bundles channel closed before the timer fired")
+		}
+		if got := em.TimersForBundle(rb, timerInfo)["family"]; len(got) != 1 || !bytes.Equal(got[0], timers.Bytes()) {
+			t.Errorf("TimersForBundle() = %v, want the set timer %v", got, timers.Bytes())
+		}
+		em.PersistBundle(rb, nil, TentativeData{}, timerInfo, nil, nil)
+		if _, ok := <-ch; ok {
+			t.Error("Bundles channel expected to be closed")
+		}
+		if got, want := i, 2; got != want {
+			t.Errorf("got %v bundles, want %v", got, want)
+		}
+	})
 }
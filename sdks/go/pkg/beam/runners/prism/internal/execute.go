@@ -61,12 +61,22 @@ func RunPipeline(j *jobservices.Job) {
 	j.Running()
 
 	executePipeline(j.RootCtx, wk, j)
-	j.SendMsg("pipeline completed " + j.String())
+	// The root context is only done at this point if the job was cancelled.
+	canceled := j.RootCtx.Err() != nil
+	if canceled {
+		j.SendMsg("pipeline cancelled " + j.String())
+	} else {
+		j.SendMsg("pipeline completed " + j.String())
+	}
 
 	// Stop the worker.
 	wk.Stop()
 
 	j.SendMsg("terminating " + j.String())
+	if canceled {
+		j.Canceled()
+		return
+	}
 	j.Done()
 }
 
@@ -261,6 +271,9 @@ func executePipeline(ctx context.Context, wk *worker.W, j *jobservices.Job) {
 			outputs := maps.Keys(stage.OutputsToCoders)
 			sort.Strings(outputs)
 			em.AddStage(stage.ID, []string{stage.mainInputPCol}, stage.sides, outputs)
+			if stage.stateful {
+				em.StageStateful(stage.ID, stage.processingTimeFamilies)
+			}
 		default:
 			err := fmt.Errorf("unknown environment[%v]", t.GetEnvironmentId())
 			slog.Error("Execute", err)
@@ -360,6 +360,36 @@ func TestRunner_Pipelines(t *testing.T) {
 		}, {
 			name:     "WindowSums_Lifted",
 			pipeline: primitives.WindowSums_Lifted,
+		}, {
+			name:     "BagStateParDo",
+			pipeline: primitives.BagStateParDo,
+		}, {
+			name:     "BagStateParDoClear",
+			pipeline: primitives.BagStateParDoClear,
+		}, {
+			name:     "MapStateParDo",
+			pipeline: primitives.MapStateParDo,
+		}, {
+			name:     "MapStateParDoClear",
+			pipeline: primitives.MapStateParDoClear,
+		}, {
+			name:     "SetStateParDo",
+			pipeline: primitives.SetStateParDo,
+		}, {
+			name:     "SetStateParDoClear",
+			pipeline: primitives.SetStateParDoClear,
+		}, {
+			name:     "CombiningStateParDo",
+			pipeline: primitives.CombiningStateParDo,
+		}, {
+			name:     "ValueStateParDo",
+			pipeline: primitives.ValueStateParDo,
+		}, {
+			name:     "ValueStateParDoClear",
+			pipeline: primitives.ValueStateParDoClear,
+		}, {
+			name:     "ValueStateParDoWindowed",
+			pipeline: primitives.ValueStateParDoWindowed,
 		}, {
 			name: "ProcessContinuations_globalCombine",
 			pipeline: func(s beam.Scope) {
@@ -82,11 +82,11 @@ func (h *pardo) PrepareTransform(tid string, t *pipepb.PTransform, comps *pipepb
 		!pdo.RequestsFinalization &&
 		!pdo.RequiresStableInput &&
 		!pdo.RequiresTimeSortedInput &&
-		len(pdo.StateSpecs) == 0 &&
-		len(pdo.TimerFamilySpecs) == 0 &&
 		pdo.RestrictionCoderId == "" {
 		// Which inputs are Side inputs don't change the graph further,
 		// so they're not included here. Any nearly any ParDo can have them.
+		// Nor do user state and timers, which are handled by the stage
+		// executing the ParDo, since Prism doesn't fuse stages yet.
 
 		// At their simplest, we don't need to do anything special at pre-processing time, and simply pass through as normal.
 		return &pipepb.Components{
@@ -40,7 +40,8 @@ import (
 )
 
 var supportedRequirements = map[string]struct{}{
-	urns.RequirementSplittableDoFn: {},
+	urns.RequirementSplittableDoFn:     {},
+	urns.RequirementStatefulProcessing: {},
 }
 
 // TODO, move back to main package, and key off of executor handlers?
@@ -112,20 +113,52 @@ func (j *Job) SendMsg(msg string) {
 
 // Start indicates that the job is preparing to execute.
 func (j *Job) Start() {
-	j.stateChan <- jobpb.JobState_STARTING
+	j.sendState(jobpb.JobState_STARTING)
 }
 
 // Running indicates that the job is executing.
 func (j *Job) Running() {
-	j.stateChan <- jobpb.JobState_RUNNING
+	j.sendState(jobpb.JobState_RUNNING)
 }
 
 // Done indicates that the job completed successfully.
 func (j *Job) Done() {
-	j.stateChan <- jobpb.JobState_DONE
+	j.sendState(jobpb.JobState_DONE)
 }
 
 // Failed indicates that the job completed unsuccessfully.
 func (j *Job) Failed() {
-	j.stateChan <- jobpb.JobState_FAILED
+	j.sendState(jobpb.JobState_FAILED)
+}
+
+// Canceled indicates that the job was cancelled before completing.
+func (j *Job) Canceled() {
+	j.sendState(jobpb.JobState_CANCELLED)
+}
+
+// cancel requests that the job stop by cancelling its root context,
+// returning the resulting state. Jobs already in a terminal state are
+// left unchanged.
+func (j *Job) cancel() jobpb.JobState_Enum {
+	switch state := j.State(); state {
+	case jobpb.JobState_CANCELLED, jobpb.JobState_DONE, jobpb.JobState_DRAINED, jobpb.JobState_FAILED, jobpb.JobState_UPDATED:
+		return state
+	}
+	// The executor reports CANCELLED once execution has stopped, so only
+	// the stored state is updated here, to avoid blocking on the state channel.
+	j.state.Store(jobpb.JobState_CANCELLING)
+	j.CancelFn()
+	return jobpb.JobState_CANCELLING
+}
+
+// sendState records the new state of the job, and queues it for any
+// message stream.
+func (j *Job) sendState(state jobpb.JobState_Enum) {
+	j.state.Store(state)
+	j.stateChan <- state
+}
+
+// State returns the last known state of the job.
+func (j *Job) State() jobpb.JobState_Enum {
+	return j.state.Load().(jobpb.JobState_Enum)
 }
@@ -18,6 +18,7 @@ package jobservices
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync/atomic"
 
 	jobpb "github.com/apache/beam/sdks/v2/go/pkg/beam/model/jobmanagement_v1"
@@ -165,6 +166,16 @@ func (s *Server) Run(ctx context.Context, req *jobpb.RunJobRequest) (*jobpb.RunJ
 	}, nil
 }
 
+// Cancel requests that a running job stop. The job reports CANCELLED
+// through its message stream once execution has stopped.
+func (s *Server) Cancel(ctx context.Context, req *jobpb.CancelJobRequest) (*jobpb.CancelJobResponse, error) {
+	j := s.getJob(req.GetJobId())
+	if j == nil {
+		return nil, fmt.Errorf("Cancel: unknown jobID: %v", req.GetJobId())
+	}
+	return &jobpb.CancelJobResponse{State: j.cancel()}, nil
+}
+
 // GetMessageStream subscribes to a stream of state changes and messages from the job
 func (s *Server) GetMessageStream(req *jobpb.JobMessagesRequest, stream jobpb.JobService_GetMessageStreamServer) error {
 	s.mu.Lock()
@@ -194,8 +205,8 @@ func (s *Server) GetMessageStream(req *jobpb.JobMessagesRequest, stream jobpb.Jo
 			// Channel is closed, so the job must be done.
 			if !ok {
 				state = jobpb.JobState_DONE
+				job.state.Store(state)
 			}
-			job.state.Store(state)
 			stream.Send(&jobpb.JobMessagesResponse{
 				Response: &jobpb.JobMessagesResponse_StateResponse{
 					StateResponse: &jobpb.JobStateEvent{
@@ -219,10 +230,49 @@ func (s *Server) GetJobMetrics(ctx context.Context, req *jobpb.GetJobMetricsRequ
 	if j == nil {
 		return nil, fmt.Errorf("GetJobMetrics: unknown jobID: %v", req.GetJobId())
 	}
+	attemptedInfos, err := j.metrics.Results(tentative)
+	if err != nil {
+		return nil, fmt.Errorf("GetJobMetrics: %w", err)
+	}
+	committedInfos, err := j.metrics.Results(committed)
+	if err != nil {
+		return nil, fmt.Errorf("GetJobMetrics: %w", err)
+	}
 	return &jobpb.GetJobMetricsResponse{
 		Metrics: &jobpb.MetricResults{
-			Attempted: j.metrics.Results(tentative),
-			Committed: j.metrics.Results(committed),
+			Attempted: attemptedInfos,
+			Committed: committedInfos,
 		},
 	}, nil
 }
+
+// GetJobs returns the jobs known to the server, in submission order.
+func (s *Server) GetJobs(ctx context.Context, req *jobpb.GetJobsRequest) (*jobpb.GetJobsResponse, error) {
+	s.mu.Lock()
+	var jobs []*Job
+	for _, j := range s.jobs {
+		jobs = append(jobs, j)
+	}
+	s.mu.Unlock()
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].key < jobs[j].key })
+
+	resp := &jobpb.GetJobsResponse{}
+	for _, j := range jobs {
+		resp.JobInfo = append(resp.JobInfo, &jobpb.JobInfo{
+			JobId:           j.key,
+			JobName:         j.jobName,
+			PipelineOptions: j.options,
+			State:           j.State(),
+		})
+	}
+	return resp, nil
+}
+
+// GetState returns the last known state of a given job.
+func (s *Server) GetState(ctx context.Context, req *jobpb.GetJobStateRequest) (*jobpb.JobStateEvent, error) {
+	j := s.getJob(req.GetJobId())
+	if j == nil {
+		return nil, fmt.Errorf("GetState: unknown jobID: %v", req.GetJobId())
+	}
+	return &jobpb.JobStateEvent{State: j.State()}, nil
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/graph/coder"
 	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/metrics"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/runtime/metricsx"
 	fnpb "github.com/apache/beam/sdks/v2/go/pkg/beam/model/fnexecution_v1"
 	pipepb "github.com/apache/beam/sdks/v2/go/pkg/beam/model/pipeline_v1"
 	"golang.org/x/exp/constraints"
@@ -105,18 +106,20 @@ func buildUrnToOpsMap(mUrn2Spec map[string]*pipepb.MonitoringInfoSpec) map[strin
 	resourceLabel := getProp(pipepb.MonitoringInfo_RESOURCE)
 	methodLabel := getProp(pipepb.MonitoringInfo_METHOD)
 
+	userKeyFn := func(urn string, labels map[string]string) metricKey {
+		return userMetricKey{
+			urn:        urn,
+			ptransform: labels[ptransformLabel],
+			namespace:  labels[namespaceLabel],
+			name:       labels[nameLabel],
+		}
+	}
+
 	// Here's where we build the raw map from kinds of labels to the actual functions.
 	labelsToKey(ls(pipepb.MonitoringInfo_TRANSFORM,
 		pipepb.MonitoringInfo_NAMESPACE,
 		pipepb.MonitoringInfo_NAME),
-		func(urn string, labels map[string]string) metricKey {
-			return userMetricKey{
-				urn:        urn,
-				ptransform: labels[ptransformLabel],
-				namespace:  labels[namespaceLabel],
-				name:       labels[nameLabel],
-			}
-		})
+		userKeyFn)
 	labelsToKey(ls(pipepb.MonitoringInfo_TRANSFORM),
 		func(urn string, labels map[string]string) metricKey {
 			return ptransformKey{
@@ -202,6 +205,12 @@ func buildUrnToOpsMap(mUrn2Spec map[string]*pipepb.MonitoringInfoSpec) map[strin
 			newAccum: fac,
 		}
 	}
+	// User histograms aren't in the MonitoringInfoSpecs yet, so they're
+	// added manually.
+	ret[metricsx.UrnToString(metricsx.UrnUserHistogramInt64)] = urnOps{
+		keyFn:    userKeyFn,
+		newAccum: func() metricAccumulator { return &histogramInt64{} },
+	}
 	return ret
 }
 
@@ -218,7 +227,7 @@ func (m *sumInt64) accumulate(pyld []byte) error {
 	return nil
 }
 
-func (m *sumInt64) toProto(key metricKey) *pipepb.MonitoringInfo {
+func (m *sumInt64) toProto(key metricKey) (*pipepb.MonitoringInfo, error) {
 	var buf bytes.Buffer
 	coder.EncodeVarInt(m.sum, &buf)
 	return &pipepb.MonitoringInfo{
@@ -226,7 +235,7 @@ func (m *sumInt64) toProto(key metricKey) *pipepb.MonitoringInfo {
 		Type:    getMetTyp(pipepb.MonitoringInfoTypeUrns_SUM_INT64_TYPE),
 		Payload: buf.Bytes(),
 		Labels:  key.Labels(),
-	}
+	}, nil
 }
 
 type sumFloat64 struct {
@@ -242,7 +251,7 @@ func (m *sumFloat64) accumulate(pyld []byte) error {
 	return nil
 }
 
-func (m *sumFloat64) toProto(key metricKey) *pipepb.MonitoringInfo {
+func (m *sumFloat64) toProto(key metricKey) (*pipepb.MonitoringInfo, error) {
 	var buf bytes.Buffer
 	coder.EncodeDouble(m.sum, &buf)
 	return &pipepb.MonitoringInfo{
@@ -250,7 +259,7 @@ func (m *sumFloat64) toProto(key metricKey) *pipepb.MonitoringInfo {
 		Type:    getMetTyp(pipepb.MonitoringInfoTypeUrns_SUM_DOUBLE_TYPE),
 		Payload: buf.Bytes(),
 		Labels:  key.Labels(),
-	}
+	}, nil
 }
 
 type progress struct {
@@ -276,7 +285,7 @@ func (m *progress) accumulate(pyld []byte) error {
 	return nil
 }
 
-func (m *progress) toProto(key metricKey) *pipepb.MonitoringInfo {
+func (m *progress) toProto(key metricKey) (*pipepb.MonitoringInfo, error) {
 	var buf bytes.Buffer
 	coder.EncodeInt32(int32(len(m.snap)), &buf)
 	for _, v := range m.snap {
@@ -287,7 +296,7 @@ func (m *progress) toProto(key metricKey) *pipepb.MonitoringInfo {
 		Type:    getMetTyp(pipepb.MonitoringInfoTypeUrns_PROGRESS_TYPE),
 		Payload: buf.Bytes(),
 		Labels:  key.Labels(),
-	}
+	}, nil
 }
 
 func ordMin[T constraints.Ordered](a T, b T) T {
@@ -333,7 +342,7 @@ func (m *distributionInt64) accumulate(pyld []byte) error {
 	return nil
 }
 
-func (m *distributionInt64) toProto(key metricKey) *pipepb.MonitoringInfo {
+func (m *distributionInt64) toProto(key metricKey) (*pipepb.MonitoringInfo, error) {
 	var buf bytes.Buffer
 	coder.EncodeVarInt(m.dist.Count, &buf)
 	coder.EncodeVarInt(m.dist.Sum, &buf)
@@ -344,7 +353,39 @@ func (m *distributionInt64) toProto(key metricKey) *pipepb.MonitoringInfo {
 		Type:    getMetTyp(pipepb.MonitoringInfoTypeUrns_DISTRIBUTION_INT64_TYPE),
 		Payload: buf.Bytes(),
 		Labels:  key.Labels(),
+	}, nil
+}
+
+// histogramInt64 accumulates histograms with a fixed bucket layout.
+// The payload is encoded by metricsx.Int64Histogram.
+type histogramInt64 struct {
+	hist metrics.HistogramValue
+}
+
+func (m *histogramInt64) accumulate(pyld []byte) error {
+	v, err := metricsx.DecodeInt64Histogram(bytes.NewBuffer(pyld))
+	if err != nil {
+		return err
+	}
+	hist, err := m.hist.Merge(v)
+	if err != nil {
+		return err
 	}
+	m.hist = hist
+	return nil
+}
+
+func (m *histogramInt64) toProto(key metricKey) (*pipepb.MonitoringInfo, error) {
+	pyld, err := metricsx.Int64Histogram(m.hist)
+	if err != nil {
+		return nil, err
+	}
+	return &pipepb.MonitoringInfo{
+		Urn:     key.Urn(),
+		Type:    metricsx.UrnToType(metricsx.UrnUserHistogramInt64),
+		Payload: pyld,
+		Labels:  key.Labels(),
+	}, nil
 }
 
 type durability int
@@ -358,7 +399,7 @@ type metricAccumulator interface {
 	accumulate([]byte) error
 	// TODO, maybe just the payload, and another method for its type urn,
 	// Since they're all the same except for the payloads and type urn.
-	toProto(key metricKey) *pipepb.MonitoringInfo
+	toProto(key metricKey) (*pipepb.MonitoringInfo, error)
 }
 
 type accumFactory func() metricAccumulator
@@ -530,12 +571,16 @@ func (m *metricsStore) ContributeFinalMetrics(payloads *fnpb.ProcessBundleRespon
 	return unknownIDs
 }
 
-func (m *metricsStore) Results(d durability) []*pipepb.MonitoringInfo {
+func (m *metricsStore) Results(d durability) ([]*pipepb.MonitoringInfo, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	infos := make([]*pipepb.MonitoringInfo, 0, len(m.accums))
 	for key, accum := range m.accums[d] {
-		infos = append(infos, accum.toProto(key))
+		info, err := accum.toProto(key)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding metric %v: %w", key.Urn(), err)
+		}
+		infos = append(infos, info)
 	}
-	return infos
+	return infos, nil
 }
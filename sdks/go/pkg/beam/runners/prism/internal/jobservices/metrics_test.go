@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/graph/coder"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/runtime/metricsx"
 	fnpb "github.com/apache/beam/sdks/v2/go/pkg/beam/model/fnexecution_v1"
 	pipepb "github.com/apache/beam/sdks/v2/go/pkg/beam/model/pipeline_v1"
 	"github.com/google/go-cmp/cmp"
@@ -53,6 +54,19 @@ func makeInfoWBytes(enum pipepb.MonitoringInfoSpecs_Enum, payload []byte) *pipep
 	return info
 }
 
+func userHistogramInfo(payload []byte) *pipepb.MonitoringInfo {
+	return &pipepb.MonitoringInfo{
+		Urn:  metricsx.UrnToString(metricsx.UrnUserHistogramInt64),
+		Type: metricsx.UrnToType(metricsx.UrnUserHistogramInt64),
+		Labels: map[string]string{
+			"PTRANSFORM": "PTRANSFORM",
+			"NAMESPACE":  "NAMESPACE",
+			"NAME":       "NAME",
+		},
+		Payload: payload,
+	}
+}
+
 // This test validates that multiple contributions are correctly summed up and accumulated.
 func Test_metricsStore_ContributeMetrics(t *testing.T) {
 
@@ -128,6 +142,18 @@ func Test_metricsStore_ContributeMetrics(t *testing.T) {
 			want: []*pipepb.MonitoringInfo{
 				makeInfoWBytes(pipepb.MonitoringInfoSpecs_USER_DISTRIBUTION_INT64, []byte{4, 19, 2, 7}),
 			},
+		}, {
+			name: "int64Histogram",
+			input: []map[string][]byte{
+				{"a": []byte{3, 0, 10, 20, 1, 2, 0, 0, 5}},
+				{"a": []byte{3, 0, 10, 20, 0, 3, 4, 1, 60}},
+			},
+			shortIDs: map[string]*pipepb.MonitoringInfo{
+				"a": userHistogramInfo(nil),
+			},
+			want: []*pipepb.MonitoringInfo{
+				userHistogramInfo([]byte{3, 0, 10, 20, 1, 5, 4, 1, 65}),
+			},
 		},
 	}
 
@@ -145,7 +171,10 @@ func Test_metricsStore_ContributeMetrics(t *testing.T) {
 				})
 			}
 
-			got := ms.Results(committed)
+			got, err := ms.Results(committed)
+			if err != nil {
+				t.Fatalf("metricsStore.Results(committed) error = %v", err)
+			}
 
 			if diff := cmp.Diff(test.want, got, protocmp.Transform()); diff != "" {
 				t.Fatalf("metricsStore.ContributeMetrics(%v) diff (-want,+got):\n%v", test.input, diff)
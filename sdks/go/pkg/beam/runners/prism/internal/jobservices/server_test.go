@@ -77,3 +77,86 @@ func TestServer_JobLifecycle(t *testing.T) {
 	t.Log("success!")
 	// Nothing to cleanup because we didn't start the server.
 }
+
+// Validates that prepared jobs are listed with their state.
+func TestServer_GetJobsAndState(t *testing.T) {
+	undertest := NewServer(0, func(j *Job) {})
+	ctx := context.Background()
+
+	resp, err := undertest.Prepare(ctx, &jobpb.PrepareJobRequest{
+		Pipeline: &pipepb.Pipeline{},
+		JobName:  "testJob",
+	})
+	if err != nil {
+		t.Fatalf("server.Prepare() = %v, want nil", err)
+	}
+	jobID := resp.GetPreparationId()
+
+	state, err := undertest.GetState(ctx, &jobpb.GetJobStateRequest{JobId: jobID})
+	if err != nil {
+		t.Fatalf("server.GetState() = %v, want nil", err)
+	}
+	if got, want := state.GetState(), jobpb.JobState_STOPPED; got != want {
+		t.Errorf("server.GetState() = %v, want %v", got, want)
+	}
+	if _, err := undertest.GetState(ctx, &jobpb.GetJobStateRequest{JobId: "unknown"}); err == nil {
+		t.Errorf("server.GetState(unknown) = nil, want error")
+	}
+
+	jobs, err := undertest.GetJobs(ctx, &jobpb.GetJobsRequest{})
+	if err != nil {
+		t.Fatalf("server.GetJobs() = %v, want nil", err)
+	}
+	if got := jobs.GetJobInfo(); len(got) != 1 || got[0].GetJobId() != jobID || got[0].GetJobName() != "testJob" {
+		t.Errorf("server.GetJobs() = %v, want a single job %v[testJob]", prototext.Format(jobs), jobID)
+	}
+}
+
+// Validates that cancelling a running job cancels its root context.
+func TestServer_Cancel(t *testing.T) {
+	stopped := make(chan struct{})
+	undertest := NewServer(0, func(j *Job) {
+		<-j.RootCtx.Done()
+		close(stopped)
+	})
+	ctx := context.Background()
+
+	resp, err := undertest.Prepare(ctx, &jobpb.PrepareJobRequest{
+		Pipeline: &pipepb.Pipeline{},
+		JobName:  "testJob",
+	})
+	if err != nil {
+		t.Fatalf("server.Prepare() = %v, want nil", err)
+	}
+	runResp, err := undertest.Run(ctx, &jobpb.RunJobRequest{
+		PreparationId: resp.GetPreparationId(),
+	})
+	if err != nil {
+		t.Fatalf("server.Run() = %v, want nil", err)
+	}
+	jobID := runResp.GetJobId()
+
+	cancelResp, err := undertest.Cancel(ctx, &jobpb.CancelJobRequest{JobId: jobID})
+	if err != nil {
+		t.Fatalf("server.Cancel() = %v, want nil", err)
+	}
+	if got, want := cancelResp.GetState(), jobpb.JobState_CANCELLING; got != want {
+		t.Errorf("server.Cancel() = %v, want %v", got, want)
+	}
+	// If the root context isn't cancelled, this doesn't unblock and timesout.
+	<-stopped
+
+	if _, err := undertest.Cancel(ctx, &jobpb.CancelJobRequest{JobId: "unknown"}); err == nil {
+		t.Errorf("server.Cancel(unknown) = nil, want error")
+	}
+
+	// Cancelling a job in a terminal state leaves it unchanged.
+	undertest.getJob(jobID).state.Store(jobpb.JobState_DONE)
+	cancelResp, err = undertest.Cancel(ctx, &jobpb.CancelJobRequest{JobId: jobID})
+	if err != nil {
+		t.Fatalf("server.Cancel() on done job = %v, want nil", err)
+	}
+	if got, want := cancelResp.GetState(), jobpb.JobState_DONE; got != want {
+		t.Errorf("server.Cancel() on done job = %v, want %v", got, want)
+	}
+}
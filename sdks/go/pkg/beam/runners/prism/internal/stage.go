@@ -55,6 +55,12 @@ type stage struct {
 	sides            []string
 	prepareSides     func(b *worker.B, tid string, watermark mtime.Time)
 
+	// User state and timers.
+	stateful               bool
+	timerTransformID       string   // The transform with user timers, if any.
+	processingTimeFamilies []string // Timer families in the processing time domain.
+	userState              *engine.StateData
+
 	SinkToPCollection map[string]string
 	OutputsToCoders   map[string]engine.PColInfo
 }
@@ -91,6 +97,10 @@ func (s *stage) Execute(j *jobservices.Job, wk *worker.W, comps *pipepb.Componen
 
 			SinkToPCollection: s.SinkToPCollection,
 			OutputCount:       s.outputCount,
+
+			TimerTransformID: s.timerTransformID,
+			TimerData:        em.TimersForBundle(rb, s.inputInfo),
+			UserState:        s.userState,
 		}
 		b.Init()
 
@@ -208,6 +218,18 @@ func getSideInputs(t *pipepb.PTransform) (map[string]*pipepb.SideInput, error) {
 	return pardo.GetSideInputs(), nil
 }
 
+// getUserStateAndTimers returns the user state and timer family specs of the transform.
+func getUserStateAndTimers(t *pipepb.PTransform) (map[string]*pipepb.StateSpec, map[string]*pipepb.TimerFamilySpec, error) {
+	if t.GetSpec().GetUrn() != urns.TransformParDo {
+		return nil, nil, nil
+	}
+	pardo := &pipepb.ParDoPayload{}
+	if err := (proto.UnmarshalOptions{}).Unmarshal(t.GetSpec().GetPayload(), pardo); err != nil {
+		return nil, nil, fmt.Errorf("unable to decode ParDoPayload")
+	}
+	return pardo.GetStateSpecs(), pardo.GetTimerFamilySpecs(), nil
+}
+
 func portFor(wInCid string, wk *worker.W) []byte {
 	sourcePort := &fnpb.RemoteGrpcPort{
 		CoderId: wInCid,
@@ -235,6 +257,13 @@ func buildStage(s *stage, tid string, t *pipepb.PTransform, comps *pipepb.Compon
 		slog.Error("buildStage: getSide Inputs", err, slog.String("transformID", tid))
 		panic(err)
 	}
+	stateSpecs, timerSpecs, err := getUserStateAndTimers(t)
+	if err != nil {
+		slog.Error("buildStage: getUserStateAndTimers", err, slog.String("transformID", tid))
+		panic(err)
+	}
+	stateful := len(stateSpecs) > 0 || len(timerSpecs) > 0
+	pcols := comps.GetPcollections()
 	var inputInfo engine.PColInfo
 	var sides []string
 	for local, global := range t.GetInputs() {
@@ -257,6 +286,16 @@ func buildStage(s *stage, tid string, t *pipepb.PTransform, comps *pipepb.Compon
 				WEnc:     wEnc,
 				EDec:     ed,
 			}
+			if stateful {
+				// The SDK encodes user state and timer keys with the main input's
+				// coder, so it must use the length prefixed coder Prism can decode.
+				kvcID := lpUnknownCoders(col.GetCoderId(), coders, comps.GetCoders())
+				lpCol := proto.Clone(col).(*pipepb.PCollection)
+				lpCol.CoderId = kvcID
+				pcols = maps.Clone(pcols)
+				pcols[global] = lpCol
+				inputInfo.KeyDec = collectionPullDecoder(coders[kvcID].GetComponentCoderIds()[0], coders, comps)
+			}
 		}
 		// We need to process all inputs to ensure we have all input coders, so we must continue.
 	}
@@ -288,13 +327,36 @@ func buildStage(s *stage, tid string, t *pipepb.PTransform, comps *pipepb.Compon
 		transforms[sinkID] = sinkTransform(sinkID, portFor(wOutCid, wk), global)
 	}
 
+	// The SDK needs the coders of user state to decode the transform.
+	for _, spec := range stateSpecs {
+		for _, cID := range []string{
+			spec.GetReadModifyWriteSpec().GetCoderId(),
+			spec.GetBagSpec().GetElementCoderId(),
+			spec.GetCombiningSpec().GetAccumulatorCoderId(),
+			spec.GetMapSpec().GetKeyCoderId(),
+			spec.GetMapSpec().GetValueCoderId(),
+			spec.GetSetSpec().GetElementCoderId(),
+		} {
+			if cID != "" {
+				coders[cID] = comps.GetCoders()[cID]
+			}
+		}
+	}
+	var timerFamilies []string
+	for family, spec := range timerSpecs {
+		timerFamilies = append(timerFamilies, family)
+		if spec.GetTimeDomain() == pipepb.TimeDomain_PROCESSING_TIME {
+			s.processingTimeFamilies = append(s.processingTimeFamilies, family)
+		}
+	}
+
 	reconcileCoders(coders, comps.GetCoders())
 
 	desc := &fnpb.ProcessBundleDescriptor{
 		Id:                  s.ID,
 		Transforms:          transforms,
 		WindowingStrategies: comps.GetWindowingStrategies(),
-		Pcollections:        comps.GetPcollections(),
+		Pcollections:        pcols,
 		Coders:              coders,
 		StateApiServiceDescriptor: &pipepb.ApiServiceDescriptor{
 			Url: wk.Endpoint(),
@@ -302,7 +364,18 @@ func buildStage(s *stage, tid string, t *pipepb.PTransform, comps *pipepb.Compon
 	}
 
 	s.desc = desc
-	s.outputCount = len(t.Outputs)
+	// The SDK ends the timers of each family, in addition to each output.
+	s.outputCount = len(t.Outputs) + len(timerFamilies)
+	if len(timerFamilies) > 0 {
+		desc.TimerApiServiceDescriptor = &pipepb.ApiServiceDescriptor{
+			Url: wk.Endpoint(),
+		}
+		s.timerTransformID = tid
+	}
+	if stateful {
+		s.stateful = true
+		s.userState = &engine.StateData{}
+	}
 	s.prepareSides = prepareSides
 	s.sides = sides
 	s.SinkToPCollection = sink2Col
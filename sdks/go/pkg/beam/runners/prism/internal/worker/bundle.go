@@ -16,11 +16,14 @@
 package worker
 
 import (
+	"bytes"
+	"sort"
 	"sync/atomic"
 
 	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/typex"
 	fnpb "github.com/apache/beam/sdks/v2/go/pkg/beam/model/fnexecution_v1"
 	"github.com/apache/beam/sdks/v2/go/pkg/beam/runners/prism/internal/engine"
+	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slog"
 )
 
@@ -40,6 +43,13 @@ type B struct {
 	// MultiMapSideInputData is a map from transformID, to inputID, to window, to data key, to data values.
 	MultiMapSideInputData map[string]map[string]map[typex.Window]map[string][][]byte
 
+	// TimerTransformID is the transform with user timers, if any.
+	TimerTransformID string
+	// TimerData is the encoded timers to fire in this bundle, keyed by timer family.
+	TimerData map[string][][]byte
+	// UserState is the user state of the stage, persisted across bundles.
+	UserState *engine.StateData
+
 	// OutputCount is the number of data or timer outputs this bundle has.
 	// We need to see this many closed data channels before the bundle is complete.
 	OutputCount int
@@ -127,6 +137,49 @@ func (b *B) ProcessOn(wk *W) <-chan struct{} {
 			},
 		}
 	}
+	if b.TimerTransformID == "" {
+		return b.DataWait
+	}
+	// Bundles with only timers to fire still need to end their input data.
+	if len(b.InputData) == 0 {
+		wk.DataReqs <- &fnpb.Elements{
+			Data: []*fnpb.Elements_Data{
+				{
+					InstructionId: b.InstID,
+					TransformId:   b.InputTransformID,
+					IsLast:        true,
+				},
+			},
+		}
+	}
+	families := maps.Keys(b.TimerData)
+	sort.Strings(families)
+	for _, family := range families {
+		var buf bytes.Buffer
+		for _, t := range b.TimerData[family] {
+			buf.Write(t)
+		}
+		wk.DataReqs <- &fnpb.Elements{
+			Timers: []*fnpb.Elements_Timers{
+				{
+					InstructionId: b.InstID,
+					TransformId:   b.TimerTransformID,
+					TimerFamilyId: family,
+					Timers:        buf.Bytes(),
+				},
+			},
+		}
+	}
+	// The SDK expects a final timer signal for each transform with timers.
+	wk.DataReqs <- &fnpb.Elements{
+		Timers: []*fnpb.Elements_Timers{
+			{
+				InstructionId: b.InstID,
+				TransformId:   b.TimerTransformID,
+				IsLast:        true,
+			},
+		},
+	}
 	return b.DataWait
 }
 
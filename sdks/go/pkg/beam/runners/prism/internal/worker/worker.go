@@ -316,6 +316,20 @@ func (wk *W) Data(data fnpb.BeamFnData_DataServer) error {
 					b.DataDone()
 				}
 			}
+			for _, t := range resp.GetTimers() {
+				cr, ok := wk.activeInstructions[t.GetInstructionId()]
+				if !ok {
+					slog.Info("data.Recv timers for unknown bundle", "response", resp)
+					continue
+				}
+				b := cr.(*B)
+				if len(t.GetTimers()) > 0 {
+					b.OutputData.WriteTimers(t.GetTimerFamilyId(), t.GetTimers())
+				}
+				if t.GetIsLast() {
+					b.DataDone()
+				}
+			}
 			wk.mu.Unlock()
 		}
 	}()
@@ -408,6 +422,14 @@ func (wk *W) State(state fnpb.BeamFnState_StateServer) error {
 
 					data = winMap[w][string(dKey)]
 
+				case *fnpb.StateKey_BagUserState_, *fnpb.StateKey_MultimapUserState_:
+					cell, mapKey := userStateCell(key)
+					data = b.UserState.Get(cell, mapKey)
+
+				case *fnpb.StateKey_MultimapKeysUserState_:
+					cell, _ := userStateCell(key)
+					data = b.UserState.Keys(cell)
+
 				default:
 					panic(fmt.Sprintf("unsupported StateKey Access type: %T: %v", key.GetType(), prototext.Format(key)))
 				}
@@ -426,6 +448,45 @@ func (wk *W) State(state fnpb.BeamFnState_StateServer) error {
 						},
 					},
 				}
+			case *fnpb.StateRequest_Append:
+				wk.mu.Lock()
+				b := wk.activeInstructions[req.GetInstructionId()].(*B)
+				wk.mu.Unlock()
+				key := req.GetStateKey()
+				switch key.GetType().(type) {
+				case *fnpb.StateKey_BagUserState_, *fnpb.StateKey_MultimapUserState_:
+					cell, mapKey := userStateCell(key)
+					b.UserState.Append(cell, mapKey, req.GetAppend().GetData())
+				default:
+					panic(fmt.Sprintf("unsupported StateKey Append type: %T: %v", key.GetType(), prototext.Format(key)))
+				}
+				responses <- &fnpb.StateResponse{
+					Id: req.GetId(),
+					Response: &fnpb.StateResponse_Append{
+						Append: &fnpb.StateAppendResponse{},
+					},
+				}
+			case *fnpb.StateRequest_Clear:
+				wk.mu.Lock()
+				b := wk.activeInstructions[req.GetInstructionId()].(*B)
+				wk.mu.Unlock()
+				key := req.GetStateKey()
+				switch key.GetType().(type) {
+				case *fnpb.StateKey_BagUserState_, *fnpb.StateKey_MultimapUserState_:
+					cell, mapKey := userStateCell(key)
+					b.UserState.Clear(cell, mapKey)
+				case *fnpb.StateKey_MultimapKeysUserState_:
+					cell, _ := userStateCell(key)
+					b.UserState.ClearAll(cell)
+				default:
+					panic(fmt.Sprintf("unsupported StateKey Clear type: %T: %v", key.GetType(), prototext.Format(key)))
+				}
+				responses <- &fnpb.StateResponse{
+					Id: req.GetId(),
+					Response: &fnpb.StateResponse_Clear{
+						Clear: &fnpb.StateClearResponse{},
+					},
+				}
 			default:
 				panic(fmt.Sprintf("unsupported StateRequest kind %T: %v", req.GetRequest(), prototext.Format(req)))
 			}
@@ -439,6 +500,38 @@ func (wk *W) State(state fnpb.BeamFnState_StateServer) error {
 	return nil
 }
 
+// userStateCell returns the cell of user state and the encoded map key, if any,
+// the state key refers to. Bag state and all the keys of a multimap share a cell.
+func userStateCell(key *fnpb.StateKey) (engine.StateCell, []byte) {
+	switch k := key.GetType().(type) {
+	case *fnpb.StateKey_BagUserState_:
+		bag := k.BagUserState
+		return engine.StateCell{
+			TransformID: bag.GetTransformId(),
+			StateID:     bag.GetUserStateId(),
+			Window:      string(bag.GetWindow()),
+			Key:         string(bag.GetKey()),
+		}, nil
+	case *fnpb.StateKey_MultimapUserState_:
+		mm := k.MultimapUserState
+		return engine.StateCell{
+			TransformID: mm.GetTransformId(),
+			StateID:     mm.GetUserStateId(),
+			Window:      string(mm.GetWindow()),
+			Key:         string(mm.GetKey()),
+		}, mm.GetMapKey()
+	case *fnpb.StateKey_MultimapKeysUserState_:
+		mk := k.MultimapKeysUserState
+		return engine.StateCell{
+			TransformID: mk.GetTransformId(),
+			StateID:     mk.GetUserStateId(),
+			Window:      string(mk.GetWindow()),
+			Key:         string(mk.GetKey()),
+		}, nil
+	}
+	panic(fmt.Sprintf("not a user state key: %v", prototext.Format(key)))
+}
+
 var chanResponderPool = sync.Pool{
 	New: func() any {
 		return &chanResponder{make(chan *fnpb.InstructionResponse, 1)}
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/metrics"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/metrics/sink"
 	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/runtime/graphx"
 	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/runtime/metricsx"
 	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/util/protox"
@@ -40,6 +41,14 @@ func Execute(ctx context.Context, p *pipepb.Pipeline, endpoint string, opt *JobO
 	// (1) Prepare job to obtain artifact staging instructions.
 	presult := &universalPipelineResult{}
 
+	// Create the metrics sinks before submitting, so a misconfigured sink
+	// fails the launch rather than leaving the job running unobserved.
+	sinks, err := newMetricsSinks(ctx, opt, async)
+	if err != nil {
+		return presult, err
+	}
+	defer closeMetricsSinks(sinks)
+
 	bin := opt.Worker
 	if bin == "" {
 		if self, ok := IsWorkerCompatibleBinary(); ok {
@@ -101,7 +110,9 @@ func Execute(ctx context.Context, p *pipepb.Pipeline, endpoint string, opt *JobO
 	if async {
 		return presult, nil
 	}
+	stopExport := exportMetrics(ctx, client, jobID, p, opt, sinks)
 	err = WaitForCompletion(ctx, client, jobID)
+	stopExport()
 
 	res, presultErr := newUniversalPipelineResult(ctx, jobID, client, p)
 	if presultErr != nil {
@@ -138,6 +149,55 @@ func UpdateGoEnvironmentWorker(worker string, p *pipepb.Pipeline) error {
 	return nil
 }
 
+// newMetricsSinks creates the metrics sinks configured in the options.
+// Metrics are only exported while waiting for the job to complete, so
+// the sinks are ignored for async jobs.
+func newMetricsSinks(ctx context.Context, opt *JobOptions, async bool) ([]sink.Sink, error) {
+	if len(opt.MetricsSinks) == 0 {
+		return nil, nil
+	}
+	if async {
+		log.Warnf(ctx, "Ignoring metrics sinks %v for async job: metrics are only exported while waiting for the job to complete.", opt.MetricsSinks)
+		return nil, nil
+	}
+	return sink.NewAll(ctx, opt.MetricsSinks)
+}
+
+func closeMetricsSinks(sinks []sink.Sink) {
+	for _, s := range sinks {
+		s.Close()
+	}
+}
+
+// exportMetrics starts exporting the job's metrics to the given sinks. The
+// returned function stops the export after a final export.
+func exportMetrics(ctx context.Context, client jobpb.JobServiceClient, jobID string, p *pipepb.Pipeline, opt *JobOptions, sinks []sink.Sink) func() {
+	if len(sinks) == 0 {
+		return func() {}
+	}
+	interval := opt.MetricsExportInterval
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+	fetch := func(ctx context.Context) (*metrics.Results, error) {
+		resp, err := client.GetJobMetrics(ctx, &jobpb.GetJobMetricsRequest{JobId: jobID})
+		if err != nil {
+			return nil, err
+		}
+		return metricsx.FromMonitoringInfos(p, resp.GetMetrics().GetAttempted(), resp.GetMetrics().GetCommitted()), nil
+	}
+	ectx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		sink.Export(ectx, jobID, interval, fetch, sinks)
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
 type universalPipelineResult struct {
 	jobID   string
 	metrics *metrics.Results
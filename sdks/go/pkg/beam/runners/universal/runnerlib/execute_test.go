@@ -0,0 +1,48 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runnerlib
+
+import (
+	"context"
+	"testing"
+
+	pipepb "github.com/apache/beam/sdks/v2/go/pkg/beam/model/pipeline_v1"
+)
+
+func TestExecute_invalidMetricsSink(t *testing.T) {
+	opt := &JobOptions{MetricsSinks: []string{"unknown=target"}}
+	// The sink is validated before anything is staged or submitted, so the
+	// unreachable endpoint is never dialed.
+	if _, err := Execute(context.Background(), &pipepb.Pipeline{}, "localhost:0", opt, false); err == nil {
+		t.Fatal("Execute() with an invalid metrics sink succeeded, want error")
+	}
+}
+
+func TestNewMetricsSinks(t *testing.T) {
+	ctx := context.Background()
+	opt := &JobOptions{MetricsSinks: []string{"unknown=target"}}
+	if _, err := newMetricsSinks(ctx, opt, false); err == nil {
+		t.Error("newMetricsSinks() with an invalid sink succeeded, want error")
+	}
+	sinks, err := newMetricsSinks(ctx, opt, true)
+	if err != nil || len(sinks) != 0 {
+		t.Errorf("newMetricsSinks() for async job = %v, %v, want no sinks", sinks, err)
+	}
+	sinks, err = newMetricsSinks(ctx, &JobOptions{}, false)
+	if err != nil || len(sinks) != 0 {
+		t.Errorf("newMetricsSinks() without sinks = %v, %v, want no sinks", sinks, err)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/apache/beam/sdks/v2/go/container/tools"
 	"github.com/apache/beam/sdks/v2/go/pkg/beam"
@@ -48,6 +49,13 @@ type JobOptions struct {
 	RetainDocker bool
 
 	Parallelism int
+
+	// MetricsSinks are the specs of metrics sinks to export to while the
+	// job is running. See the metrics sink package for the format. Sinks
+	// are only used for jobs that aren't executed asynchronously.
+	MetricsSinks []string
+	// MetricsExportInterval is how often metrics are exported to the sinks.
+	MetricsExportInterval time.Duration
 }
 
 // Prepare prepares a job to the given job service. It returns the preparation id
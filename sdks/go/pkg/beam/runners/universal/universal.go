@@ -101,6 +101,9 @@ func Execute(ctx context.Context, p *beam.Pipeline) (beam.PipelineResult, error)
 		Worker:       *jobopts.WorkerBinary,
 		RetainDocker: *jobopts.RetainDockerContainers,
 		Parallelism:  *jobopts.Parallelism,
+
+		MetricsSinks:          jobopts.MetricsSinks,
+		MetricsExportInterval: *jobopts.MetricsExportInterval,
 	}
 	presult, err := runnerlib.Execute(ctx, pipeline, endpoint, opt, *jobopts.Async)
 	return presult, err
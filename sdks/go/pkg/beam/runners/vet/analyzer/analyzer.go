@@ -0,0 +1,265 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package analyzer is a static analysis pass that reports Beam pipeline code
+// that is likely to fail on distributed runners. It complements the checks
+// the vet runner performs on constructed pipelines with those that can be
+// made from source alone:
+//
+//   - DoFns and CombineFns passed to transforms in a package without being
+//     registered in it. Workers fall back to resolving them by symbol lookup,
+//     which fails for stripped binaries and cross-compiled workers.
+//   - Composite literals of DoFns and CombineFns that set unexported fields,
+//     which are dropped when the fn is serialized.
+//   - CombineFns without a MergeAccumulators method, which is required.
+//
+// The pass can be run with go vet using the beamvet command:
+//
+//	go install github.com/apache/beam/sdks/v2/go/cmd/beamvet
+//	go vet -vettool=$(which beamvet) ./...
+package analyzer
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// Analyzer reports unregistered fns, unexported fn fields set at
+// construction, and CombineFns without MergeAccumulators.
+var Analyzer = &analysis.Analyzer{
+	Name:     "beamvet",
+	Doc:      "report Beam DoFns and CombineFns that are likely to fail on distributed runners",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+const (
+	beamPkg     = "github.com/apache/beam/sdks/v2/go/pkg/beam"
+	registerPkg = "github.com/apache/beam/sdks/v2/go/pkg/beam/register"
+)
+
+// transforms maps the beam functions taking a user fn to the index of the fn argument.
+var transforms = map[string]int{
+	"ParDo": 1, "ParDo0": 1, "ParDo2": 1, "ParDo3": 1, "ParDo4": 1, "ParDo5": 1,
+	"ParDo6": 1, "ParDo7": 1, "ParDoN": 1, "TryParDo": 1,
+	"Combine": 1, "CombinePerKey": 1, "TryCombine": 1, "TryCombinePerKey": 1,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	ins := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	// Objects mentioned in registration calls, and uses of fns in transforms.
+	registered := map[types.Object]bool{}
+	type use struct {
+		expr ast.Expr
+		obj  types.Object
+	}
+	var uses []use
+
+	ins.Preorder([]ast.Node{(*ast.CallExpr)(nil), (*ast.CompositeLit)(nil), (*ast.TypeSpec)(nil)}, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			pkg, name := calledFunc(pass, n)
+			switch {
+			case pkg == registerPkg || (strings.HasPrefix(pkg, beamPkg) && strings.HasPrefix(name, "Register")):
+				// Includes the runtime registrations of generated shims.
+				ast.Inspect(n, func(n ast.Node) bool {
+					if e, ok := n.(ast.Expr); ok {
+						if obj := fnObject(pass, e); obj != nil {
+							registered[obj] = true
+						}
+					}
+					return true
+				})
+			case pkg == beamPkg:
+				if i, ok := transforms[name]; ok && i < len(n.Args) {
+					if obj := fnObject(pass, n.Args[i]); obj != nil && obj.Pkg() == pass.Pkg {
+						uses = append(uses, use{n.Args[i], obj})
+					}
+				}
+			}
+		case *ast.CompositeLit:
+			checkLiteral(pass, n)
+		case *ast.TypeSpec:
+			checkCombineFn(pass, n)
+		}
+	})
+
+	for _, u := range uses {
+		if registered[u.obj] {
+			continue
+		}
+		switch u.obj.(type) {
+		case *types.Func:
+			pass.Reportf(u.expr.Pos(), "function %v is used in a transform but isn't registered; register it with register.FunctionXxY or beam.RegisterFunction in an init function", u.obj.Name())
+		case *types.TypeName:
+			kind := fnKind(u.obj.Type())
+			if kind == "" {
+				kind = "structural fn"
+			}
+			pass.Reportf(u.expr.Pos(), "%v %v is used in a transform but isn't registered; register it with register.DoFnXxY, register.CombinerX or beam.RegisterType in an init function", kind, u.obj.Name())
+		}
+	}
+	return nil, nil
+}
+
+// calledFunc returns the package path and name of the function called,
+// if it's a package level function.
+func calledFunc(pass *analysis.Pass, call *ast.CallExpr) (string, string) {
+	fun := call.Fun
+	// Strip explicit type arguments.
+	switch f := fun.(type) {
+	case *ast.IndexExpr:
+		fun = f.X
+	case *ast.IndexListExpr:
+		fun = f.X
+	}
+	var id *ast.Ident
+	switch f := fun.(type) {
+	case *ast.Ident:
+		id = f
+	case *ast.SelectorExpr:
+		id = f.Sel
+	default:
+		return "", ""
+	}
+	fn, ok := pass.TypesInfo.Uses[id].(*types.Func)
+	if !ok || fn.Pkg() == nil {
+		return "", ""
+	}
+	return fn.Pkg().Path(), fn.Name()
+}
+
+// fnObject returns the package level function, or the named struct type of
+// a structural fn, that the expression refers to.
+func fnObject(pass *analysis.Pass, e ast.Expr) types.Object {
+	var id *ast.Ident
+	switch e := e.(type) {
+	case *ast.Ident:
+		id = e
+	case *ast.SelectorExpr:
+		id = e.Sel
+	}
+	if id != nil {
+		switch obj := pass.TypesInfo.Uses[id].(type) {
+		case *types.Func:
+			if obj.Type().(*types.Signature).Recv() != nil {
+				return nil
+			}
+			return obj
+		case *types.TypeName:
+			// Type arguments of generic registration functions.
+			return structType(obj.Type())
+		}
+	}
+	if t := pass.TypesInfo.TypeOf(e); t != nil {
+		return structType(t)
+	}
+	return nil
+}
+
+// structType returns the type name of a named struct, or a pointer to one.
+func structType(t types.Type) types.Object {
+	if p, ok := t.(*types.Pointer); ok {
+		t = p.Elem()
+	}
+	named, ok := t.(*types.Named)
+	if !ok {
+		return nil
+	}
+	if _, ok := named.Underlying().(*types.Struct); !ok {
+		return nil
+	}
+	return named.Obj()
+}
+
+// fnKind returns whether the type looks like a DoFn or a CombineFn.
+func fnKind(t types.Type) string {
+	switch {
+	case hasMethod(t, "ProcessElement"):
+		return "DoFn"
+	case isCombineFn(t):
+		return "CombineFn"
+	}
+	return ""
+}
+
+func isCombineFn(t types.Type) bool {
+	return hasMethod(t, "AddInput") || hasMethod(t, "MergeAccumulators")
+}
+
+// hasMethod returns whether the type or a pointer to it has the named method.
+func hasMethod(t types.Type, name string) bool {
+	if _, ok := t.(*types.Pointer); !ok {
+		t = types.NewPointer(t)
+	}
+	obj, _, _ := types.LookupFieldOrMethod(t, true, nil, name)
+	_, ok := obj.(*types.Func)
+	return ok
+}
+
+// checkLiteral reports unexported fields set in a composite literal of a
+// DoFn or CombineFn. Fns are serialized as JSON, which drops them.
+func checkLiteral(pass *analysis.Pass, lit *ast.CompositeLit) {
+	t := pass.TypesInfo.TypeOf(lit)
+	if t == nil || fnKind(t) == "" {
+		return
+	}
+	st, ok := t.Underlying().(*types.Struct)
+	if !ok {
+		return
+	}
+	for i, elt := range lit.Elts {
+		var f *types.Var
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			id, ok := kv.Key.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			for j := 0; j < st.NumFields(); j++ {
+				if st.Field(j).Name() == id.Name {
+					f = st.Field(j)
+				}
+			}
+		} else if i < st.NumFields() {
+			f = st.Field(i)
+		}
+		if f == nil || f.Exported() {
+			continue
+		}
+		pass.Reportf(elt.Pos(), "unexported field %v of %v %v is set here but isn't serialized with the fn; export it, or initialize it in Setup", f.Name(), fnKind(t), types.TypeString(t, types.RelativeTo(pass.Pkg)))
+	}
+}
+
+// checkCombineFn reports struct types that look like CombineFns, by having
+// an AddInput method, but have no MergeAccumulators method.
+func checkCombineFn(pass *analysis.Pass, spec *ast.TypeSpec) {
+	obj, ok := pass.TypesInfo.Defs[spec.Name].(*types.TypeName)
+	if !ok {
+		return
+	}
+	t := obj.Type()
+	if _, ok := t.Underlying().(*types.Struct); !ok {
+		return
+	}
+	if hasMethod(t, "AddInput") && !hasMethod(t, "MergeAccumulators") {
+		pass.Reportf(spec.Name.Pos(), "CombineFn %v has no MergeAccumulators method, which is required; add one that merges accumulators into a single accumulator", obj.Name())
+	}
+}
@@ -0,0 +1,135 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// testImporter type checks the stub packages in testdata from source, and
+// imports everything else from export data.
+type testImporter struct {
+	t    *testing.T
+	fset *token.FileSet
+	pkgs map[string]*types.Package
+}
+
+func (imp *testImporter) Import(path string) (*types.Package, error) {
+	if p, ok := imp.pkgs[path]; ok {
+		return p, nil
+	}
+	if !strings.HasPrefix(path, beamPkg) {
+		return importer.Default().Import(path)
+	}
+	p, _, _ := imp.check(path)
+	return p, nil
+}
+
+func (imp *testImporter) check(path string) (*types.Package, []*ast.File, *types.Info) {
+	imp.t.Helper()
+	files, err := filepath.Glob(filepath.Join("testdata", "src", path, "*.go"))
+	if err != nil || len(files) == 0 {
+		imp.t.Fatalf("no test files for package %v: %v", path, err)
+	}
+	var parsed []*ast.File
+	for _, f := range files {
+		af, err := parser.ParseFile(imp.fset, f, nil, parser.ParseComments)
+		if err != nil {
+			imp.t.Fatalf("parsing %v: %v", f, err)
+		}
+		parsed = append(parsed, af)
+	}
+	info := &types.Info{
+		Types: map[ast.Expr]types.TypeAndValue{},
+		Defs:  map[*ast.Ident]types.Object{},
+		Uses:  map[*ast.Ident]types.Object{},
+	}
+	conf := types.Config{Importer: imp}
+	p, err := conf.Check(path, imp.fset, parsed, info)
+	if err != nil {
+		imp.t.Fatalf("type checking %v: %v", path, err)
+	}
+	imp.pkgs[path] = p
+	return p, parsed, info
+}
+
+var wantRE = regexp.MustCompile("// want `([^`]*)`")
+
+func TestAnalyzer(t *testing.T) {
+	fset := token.NewFileSet()
+	imp := &testImporter{t: t, fset: fset, pkgs: map[string]*types.Package{}}
+	pkg, files, info := imp.check("a")
+
+	// Expected diagnostics are "// want `regexp`" comments on their line.
+	want := map[int]*regexp.Regexp{}
+	for _, f := range files {
+		for _, cg := range f.Comments {
+			for _, c := range cg.List {
+				if m := wantRE.FindStringSubmatch(c.Text); m != nil {
+					want[fset.Position(c.Pos()).Line] = regexp.MustCompile(m[1])
+				}
+			}
+		}
+	}
+
+	got := map[int]string{}
+	pass := &analysis.Pass{
+		Analyzer:  Analyzer,
+		Fset:      fset,
+		Files:     files,
+		Pkg:       pkg,
+		TypesInfo: info,
+		ResultOf:  map[*analysis.Analyzer]any{inspect.Analyzer: inspector.New(files)},
+		Report: func(d analysis.Diagnostic) {
+			line := fset.Position(d.Pos).Line
+			if prev, ok := got[line]; ok {
+				t.Errorf("line %v: multiple diagnostics: %q and %q", line, prev, d.Message)
+			}
+			got[line] = d.Message
+		},
+	}
+	if _, err := Analyzer.Run(pass); err != nil {
+		t.Fatalf("Analyzer.Run() failed: %v", err)
+	}
+
+	for line, re := range want {
+		msg, ok := got[line]
+		if !ok {
+			t.Errorf("line %v: no diagnostic, want one matching %q", line, re)
+			continue
+		}
+		if !re.MatchString(msg) {
+			t.Errorf("line %v: diagnostic %q, want one matching %q", line, msg, re)
+		}
+	}
+	for line, msg := range got {
+		if _, ok := want[line]; !ok {
+			t.Errorf("line %v: unexpected diagnostic %q", line, msg)
+		}
+	}
+}
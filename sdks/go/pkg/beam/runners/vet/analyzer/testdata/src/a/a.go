@@ -0,0 +1,46 @@
+package a
+
+import (
+	"reflect"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/register"
+)
+
+func init() {
+	register.Function1x1(registeredFn)
+	register.DoFn1x1[*registeredDoFn, int, int](&registeredDoFn{})
+	beam.RegisterType(reflect.TypeOf((*sumFn)(nil)).Elem())
+}
+
+func registeredFn(v int) int   { return v }
+func unregisteredFn(v int) int { return v }
+
+type registeredDoFn struct {
+	Prefix string
+	client any
+}
+
+func (fn *registeredDoFn) ProcessElement(v int) int { return v }
+
+type unregisteredDoFn struct{}
+
+func (fn *unregisteredDoFn) ProcessElement(v int) int { return v }
+
+type sumFn struct{}
+
+func (fn *sumFn) AddInput(a, v int) int          { return a + v }
+func (fn *sumFn) MergeAccumulators(a, b int) int { return a + b }
+
+type noMergeFn struct{} // want `CombineFn noMergeFn has no MergeAccumulators method`
+
+func (fn *noMergeFn) AddInput(a, v int) int { return a + v }
+
+func Build(s beam.Scope, col beam.PCollection) {
+	beam.ParDo(s, registeredFn, col)
+	beam.ParDo(s, unregisteredFn, col) // want `function unregisteredFn is used in a transform but isn't registered`
+	beam.ParDo(s, &registeredDoFn{Prefix: "a"}, col)
+	beam.ParDo(s, &registeredDoFn{client: nil}, col) // want `unexported field client of DoFn registeredDoFn is set here`
+	beam.ParDo(s, &unregisteredDoFn{}, col)          // want `DoFn unregisteredDoFn is used in a transform but isn't registered`
+	beam.CombinePerKey(s, &sumFn{}, col)
+}
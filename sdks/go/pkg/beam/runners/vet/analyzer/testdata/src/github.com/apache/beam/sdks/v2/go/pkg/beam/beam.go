@@ -0,0 +1,14 @@
+// Package beam is a stub of the Beam package for analyzer tests.
+package beam
+
+type Scope struct{}
+
+type PCollection struct{}
+
+func ParDo(s Scope, dofn any, col PCollection, opts ...any) PCollection { return col }
+
+func CombinePerKey(s Scope, combinefn any, col PCollection, opts ...any) PCollection { return col }
+
+func RegisterFunction(fn any) {}
+
+func RegisterType(t any) {}
@@ -0,0 +1,6 @@
+// Package register is a stub of the Beam register package for analyzer tests.
+package register
+
+func Function1x1[I0, R0 any](fn func(I0) R0) {}
+
+func DoFn1x1[T any, I0, R0 any](fn T) {}
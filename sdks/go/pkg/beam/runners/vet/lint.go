@@ -0,0 +1,251 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vet
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/graph"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/graph/coder"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/graph/window"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/runtime"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/typex"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/internal/errors"
+)
+
+// Checks performed by Lint.
+const (
+	// CheckUnregisteredType reports named types in PCollections that aren't
+	// registered, and so lose their identity when the pipeline is serialized.
+	CheckUnregisteredType = "unregistered-type"
+	// CheckNondeterministicKey reports GroupByKey keys whose encoding isn't
+	// deterministic, so equal keys may not be grouped together.
+	CheckNondeterministicKey = "nondeterministic-key"
+	// CheckSideInputWindowing reports side inputs whose windows only cover
+	// part of the windows of the main input.
+	CheckSideInputWindowing = "side-input-windowing"
+	// CheckUnexportedField reports DoFns and CombineFns with unexported fields
+	// set at construction time, which are lost when the fn is serialized.
+	CheckUnexportedField = "unexported-field"
+)
+
+// Diagnostic is a problem found in a pipeline by Lint.
+type Diagnostic struct {
+	// Check is the name of the check that produced the diagnostic.
+	Check string
+	// Transform is the scoped name of the offending transform.
+	Transform string
+	// Message describes the problem and how to fix it.
+	Message string
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%v: %v [%v]", d.Transform, d.Message, d.Check)
+}
+
+// Lint checks the pipeline for constructs that are likely to fail or
+// misbehave on a distributed runner.
+func Lint(_ context.Context, p *beam.Pipeline) ([]Diagnostic, error) {
+	edges, _, err := p.Build()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid pipeline")
+	}
+	return LintEdges(edges), nil
+}
+
+// LintEdges checks the edges of a built pipeline. Diagnostics are sorted
+// by transform and check.
+func LintEdges(edges []*graph.MultiEdge) []Diagnostic {
+	l := &linter{seenTypes: map[reflect.Type]bool{}}
+	for _, e := range edges {
+		l.lintEdge(e)
+	}
+	sort.SliceStable(l.ds, func(i, j int) bool {
+		if l.ds[i].Transform != l.ds[j].Transform {
+			return l.ds[i].Transform < l.ds[j].Transform
+		}
+		return l.ds[i].Check < l.ds[j].Check
+	})
+	return l.ds
+}
+
+type linter struct {
+	ds        []Diagnostic
+	seenTypes map[reflect.Type]bool
+}
+
+func (l *linter) report(e *graph.MultiEdge, check, format string, args ...any) {
+	l.ds = append(l.ds, Diagnostic{Check: check, Transform: e.Name(), Message: fmt.Sprintf(format, args...)})
+}
+
+func (l *linter) lintEdge(e *graph.MultiEdge) {
+	for _, out := range e.Output {
+		l.checkRegistered(e, out.To.Type())
+	}
+	switch e.Op {
+	case graph.ParDo:
+		l.checkUnexported(e, "DoFn", e.DoFn.Recv)
+		l.checkSideInputs(e)
+	case graph.Combine:
+		l.checkUnexported(e, "CombineFn", e.CombineFn.Recv)
+	case graph.CoGBK:
+		for _, in := range e.Input {
+			l.checkKey(e, in.From)
+		}
+	}
+}
+
+// checkRegistered reports each unregistered named type in the full type once.
+func (l *linter) checkRegistered(e *graph.MultiEdge, ft typex.FullType) {
+	if ft == nil {
+		return
+	}
+	if len(ft.Components()) > 0 {
+		for _, c := range ft.Components() {
+			l.checkRegistered(e, c)
+		}
+		return
+	}
+	for _, t := range namedTypes(ft.Type()) {
+		if l.seenTypes[t] {
+			continue
+		}
+		l.seenTypes[t] = true
+		k, _ := runtime.TypeKey(t)
+		if _, ok := runtime.LookupType(k); ok {
+			continue
+		}
+		l.report(e, CheckUnregisteredType, "output type %v isn't registered; call beam.RegisterType(reflect.TypeOf((*%v)(nil)).Elem()) in an init function", t, t)
+	}
+}
+
+// namedTypes returns the user named types reachable from t through pointers,
+// slices, arrays and maps.
+func namedTypes(t reflect.Type) []reflect.Type {
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array:
+		return namedTypes(t.Elem())
+	case reflect.Map:
+		return append(namedTypes(t.Key()), namedTypes(t.Elem())...)
+	case reflect.Interface, reflect.Func, reflect.Chan:
+		return nil
+	}
+	if _, ok := runtime.TypeKey(t); !ok || t.PkgPath() == "time" || typex.IsUniversal(t) {
+		return nil
+	}
+	return []reflect.Type{t}
+}
+
+// checkKey reports a grouping on a KV input whose key coder isn't deterministic.
+func (l *linter) checkKey(e *graph.MultiEdge, n *graph.Node) {
+	c := n.Coder
+	if c == nil || c.Kind != coder.KV || len(c.Components) != 2 {
+		return
+	}
+	if reason := nondeterministic(c.Components[0]); reason != "" {
+		l.report(e, CheckNondeterministicKey, "key type %v has a non-deterministic encoding because %v; convert the key to a deterministic type such as a string before grouping", c.Components[0].T, reason)
+	}
+}
+
+// nondeterministic returns why the coder's encoding isn't deterministic,
+// or the empty string if it is.
+func nondeterministic(c *coder.Coder) string {
+	switch c.Kind {
+	case coder.Double:
+		return "it contains floating point values"
+	case coder.Row, coder.Custom:
+		return nondeterministicType(c.T.Type(), map[reflect.Type]bool{})
+	}
+	for _, sub := range c.Components {
+		if reason := nondeterministic(sub); reason != "" {
+			return reason
+		}
+	}
+	return ""
+}
+
+func nondeterministicType(t reflect.Type, seen map[reflect.Type]bool) string {
+	if seen[t] {
+		return ""
+	}
+	seen[t] = true
+	switch t.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return "it contains floating point values"
+	case reflect.Map:
+		return "it contains maps, which are encoded in random order"
+	case reflect.Ptr, reflect.Slice, reflect.Array:
+		return nondeterministicType(t.Elem(), seen)
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if reason := nondeterministicType(t.Field(i).Type, seen); reason != "" {
+				return reason
+			}
+		}
+	}
+	return ""
+}
+
+// checkSideInputs reports side inputs with finer windows than the main input.
+// Main windows are mapped to the side window containing their end, so the
+// data in the other side windows overlapping the main window is never read.
+// Incompatible merging and global windowing is rejected at construction.
+func (l *linter) checkSideInputs(e *graph.MultiEdge) {
+	if len(e.Input) < 2 {
+		return
+	}
+	main := e.Input[0].From.WindowingStrategy().Fn
+	for _, in := range e.Input[1:] {
+		side := in.From.WindowingStrategy().Fn
+		if main.Kind == window.GlobalWindows || side.Kind == window.GlobalWindows {
+			continue
+		}
+		if side.Size < main.Size {
+			l.report(e, CheckSideInputWindowing, "side input uses %v windows, which are smaller than the %v windows of the main input, so each main window only sees the side input window containing its end; rewindow the side input to match the main input", side, main)
+		}
+	}
+}
+
+// checkUnexported reports unexported fields of a structural fn that are set
+// when the pipeline is constructed. Fns are serialized as JSON, so these
+// values are silently dropped before the fn reaches the workers.
+func (l *linter) checkUnexported(e *graph.MultiEdge, kind string, recv any) {
+	if recv == nil {
+		return
+	}
+	v := reflect.ValueOf(recv)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return
+	}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.IsExported() || v.Field(i).IsZero() {
+			continue
+		}
+		l.report(e, CheckUnexportedField, "%v %v sets unexported field %v, which isn't serialized; export the field, or initialize it in Setup", kind, t, f.Name)
+	}
+}
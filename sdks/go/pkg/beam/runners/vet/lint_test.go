@@ -0,0 +1,159 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vet
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/graph/window"
+	"github.com/google/go-cmp/cmp"
+)
+
+func init() {
+	beam.RegisterType(reflect.TypeOf((*lintRegistered)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*lintMapKey)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*unexportedFn)(nil)).Elem())
+	beam.RegisterFunction(toRegistered)
+	beam.RegisterFunction(toUnregistered)
+	beam.RegisterFunction(toFloatKey)
+	beam.RegisterFunction(toMapKey)
+	beam.RegisterFunction(withSide)
+}
+
+type lintRegistered struct {
+	A string
+}
+
+type lintUnregistered struct {
+	A string
+}
+
+type lintMapKey struct {
+	M map[string]int
+}
+
+func toRegistered(v int) lintRegistered     { return lintRegistered{} }
+func toUnregistered(v int) lintUnregistered { return lintUnregistered{} }
+func toFloatKey(v int) (float64, int)       { return float64(v), v }
+func toMapKey(v int) (lintMapKey, int)      { return lintMapKey{}, v }
+func withSide(v int, side []int) int        { return v }
+
+type unexportedFn struct {
+	Exported string
+	prefix   string
+}
+
+func (fn *unexportedFn) ProcessElement(v int) int {
+	return v
+}
+
+func TestLint(t *testing.T) {
+	tests := []struct {
+		name  string
+		build func(s beam.Scope)
+		want  []string
+	}{
+		{
+			name: "clean",
+			build: func(s beam.Scope) {
+				vs := beam.Create(s, 1, 2, 3)
+				beam.ParDo(s, toRegistered, vs)
+				beam.ParDo(s, withSide, vs, beam.SideInput{Input: vs})
+				beam.ParDo(s, &unexportedFn{Exported: "a"}, vs)
+				main := beam.WindowInto(s, window.NewFixedWindows(time.Minute), vs)
+				side := beam.WindowInto(s, window.NewFixedWindows(time.Hour), vs)
+				beam.ParDo(s, withSide, main, beam.SideInput{Input: side})
+			},
+		},
+		{
+			name: "unregisteredType",
+			build: func(s beam.Scope) {
+				beam.ParDo(s, toUnregistered, beam.Create(s, 1))
+			},
+			want: []string{CheckUnregisteredType},
+		},
+		{
+			name: "floatKey",
+			build: func(s beam.Scope) {
+				beam.GroupByKey(s, beam.ParDo(s, toFloatKey, beam.Create(s, 1)))
+			},
+			want: []string{CheckNondeterministicKey},
+		},
+		{
+			name: "mapKey",
+			build: func(s beam.Scope) {
+				beam.GroupByKey(s, beam.ParDo(s, toMapKey, beam.Create(s, 1)))
+			},
+			want: []string{CheckNondeterministicKey},
+		},
+		{
+			name: "finerSideInputWindows",
+			build: func(s beam.Scope) {
+				vs := beam.Create(s, 1)
+				main := beam.WindowInto(s, window.NewFixedWindows(time.Hour), vs)
+				side := beam.WindowInto(s, window.NewFixedWindows(time.Minute), vs)
+				beam.ParDo(s, withSide, main, beam.SideInput{Input: side})
+			},
+			want: []string{CheckSideInputWindowing},
+		},
+		{
+			name: "unexportedField",
+			build: func(s beam.Scope) {
+				beam.ParDo(s, &unexportedFn{prefix: "lost"}, beam.Create(s, 1))
+			},
+			want: []string{CheckUnexportedField},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, s := beam.NewPipelineWithRoot()
+			test.build(s)
+			ds, err := Lint(context.Background(), p)
+			if err != nil {
+				t.Fatalf("Lint() failed: %v", err)
+			}
+			var got []string
+			for _, d := range ds {
+				got = append(got, d.Check)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Lint() checks diff (-want,+got):\n%v\ndiagnostics: %v", diff, ds)
+			}
+		})
+	}
+}
+
+func TestExecute_LintFailure(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	beam.ParDo(s, &unexportedFn{prefix: "lost"}, beam.Create(s, 1))
+
+	// By default lint problems are only logged.
+	if _, err := Execute(context.Background(), p); err != nil && strings.Contains(err.Error(), "prefix") {
+		t.Errorf("Execute() = %v, want lint problems to be logged rather than fail the pipeline", err)
+	}
+
+	*failOnLint = true
+	defer func() { *failOnLint = false }()
+	_, err := Execute(context.Background(), p)
+	if err == nil || !strings.Contains(err.Error(), "prefix") {
+		t.Errorf("Execute() with --vet_fail_on_lint = %v, want error mentioning the unexported field", err)
+	}
+}
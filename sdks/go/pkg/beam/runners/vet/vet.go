@@ -21,12 +21,21 @@
 // can use this as a sanity check on whether a given pipeline avoids known
 // performance bottlenecks.
 //
+// The runner also lints the pipeline for constructs that are likely to fail
+// or misbehave on distributed runners, such as unregistered types, keys with
+// non-deterministic encodings and side inputs with incompatible windowing.
+// Problems are logged as warnings, or fail the pipeline if --vet_fail_on_lint
+// is set.
+// See Lint for the full set of checks, and the analyzer package for checks
+// that can be performed statically with go vet.
+//
 // TODO(https://github.com/apache/beam/issues/19402): Add usage documentation.
 package vet
 
 import (
 	"bytes"
 	"context"
+	"flag"
 	"fmt"
 	"reflect"
 	"strings"
@@ -43,8 +52,11 @@ import (
 	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/typex"
 	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/util/reflectx"
 	"github.com/apache/beam/sdks/v2/go/pkg/beam/internal/errors"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/log"
 )
 
+var failOnLint = flag.Bool("vet_fail_on_lint", false, "If set, the vet runner fails pipelines with lint problems, rather than logging them as warnings.")
+
 func init() {
 	beam.RegisterRunner("vet", Execute)
 }
@@ -57,8 +69,25 @@ func (p disabledResolver) Sym2Addr(name string) (uintptr, error) {
 	return 0, errors.Errorf("%v not found. Use runtime.RegisterFunction in unit tests", name)
 }
 
-// Execute evaluates the pipeline on whether it can run without reflection.
+// Execute lints the pipeline, and evaluates whether it can run without reflection.
+// Lint problems are logged as warnings, unless --vet_fail_on_lint is set.
 func Execute(ctx context.Context, p *beam.Pipeline) (beam.PipelineResult, error) {
+	ds, err := Lint(ctx, p)
+	if err != nil {
+		return nil, errors.WithContext(err, "validating pipeline with vet runner")
+	}
+	if len(ds) > 0 && *failOnLint {
+		var b strings.Builder
+		for _, d := range ds {
+			fmt.Fprintf(&b, "\t%v\n", d)
+		}
+		err := errors.Errorf("pipeline has %d problems:\n%s", len(ds), b.String())
+		err = errors.WithContext(err, "validating pipeline with vet runner")
+		return nil, errors.SetTopLevelMsg(err, fmt.Sprintf("pipeline has %d problems, the first is %v", len(ds), ds[0]))
+	}
+	for _, d := range ds {
+		log.Warnf(ctx, "vet: %v", d)
+	}
 	e, err := Evaluate(ctx, p)
 	if err != nil {
 		return nil, errors.WithContext(err, "validating pipeline with vet runner")
@@ -65,7 +65,7 @@ func CreateList2(a, b any) (*beam.Pipeline, beam.Scope, beam.PCollection, beam.P
 // to function.
 var (
 	Runner        = runners.Runner
-	defaultRunner = "direct"
+	defaultRunner = beam.DefaultRunner
 	mainCalled    = false
 )
 
@@ -132,7 +132,7 @@ func BuildAndRun(t *testing.T, build func(s beam.Scope)) beam.PipelineResult {
 //		ptest.Main(m)
 //	}
 func Main(m *testing.M) {
-	MainWithDefault(m, "direct")
+	MainWithDefault(m, beam.DefaultRunner)
 }
 
 // MainWithDefault is an implementation of testing's TestMain to permit testing
@@ -156,7 +156,7 @@ func MainWithDefault(m *testing.M, runner string) {
 //		os.Exit(ptest.Main(m))
 //	}
 func MainRet(m *testing.M) int {
-	return MainRetWithDefault(m, "direct")
+	return MainRetWithDefault(m, beam.DefaultRunner)
 }
 
 // MainRetWithDefault is equivelant to MainWithDefault but returns an exit code
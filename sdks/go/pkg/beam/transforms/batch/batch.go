@@ -0,0 +1,281 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package batch contains transforms for grouping the values of keyed
+// PCollections into batches, such as to batch requests to an external
+// service.
+//
+// Batching uses per key state and timers, so it works in both batch and
+// streaming pipelines, unlike buffering elements in a DoFn until
+// FinishBundle. It requires a runner that supports state and timers.
+package batch
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"time"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/state"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/timers"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/typex"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/register"
+)
+
+func init() {
+	register.DoFn8x1[context.Context, beam.Window, beam.EventTime, state.Provider, timers.Provider, beam.T, beam.V, func(beam.T, []beam.V), error](&groupIntoBatchesFn{})
+	register.Emitter2[beam.T, []beam.V]()
+	register.DoFn3x0[beam.T, beam.V, func(beam.X, beam.V)](&shardFn{})
+	register.Emitter2[beam.X, beam.V]()
+	register.DoFn2x2[beam.X, []beam.V, beam.T, []beam.V](&unshardFn{})
+}
+
+// Params configures the batches output by GroupIntoBatches.
+type Params struct {
+	// BatchSize is the maximum number of values in a batch. If zero, batches
+	// are only limited by BatchSizeBytes.
+	BatchSize int64
+	// BatchSizeBytes is the maximum total encoded size of the values in a
+	// batch. A single value larger than the limit is output in a batch on its
+	// own. If zero, batches are only limited by BatchSize.
+	BatchSizeBytes int64
+	// MaxBufferingDuration is the maximum processing time a value is
+	// buffered before its batch is output, even if the batch isn't full. If
+	// zero, incomplete batches are only output at the end of their window.
+	MaxBufferingDuration time.Duration
+}
+
+func (p Params) validate() error {
+	if p.BatchSize < 0 || p.BatchSizeBytes < 0 || p.MaxBufferingDuration < 0 {
+		return fmt.Errorf("batch parameters must not be negative: %+v", p)
+	}
+	if p.BatchSize == 0 && p.BatchSizeBytes == 0 {
+		return fmt.Errorf("one of BatchSize or BatchSizeBytes must be set: %+v", p)
+	}
+	return nil
+}
+
+// GroupIntoBatches groups the values of a PCollection<KV<K,V>> into batches
+// of up to size values per key and window, returning a PCollection<KV<K,[]V>>.
+// A batch is output when it's full, when maxBufferingDuration of processing
+// time has passed since its first value was buffered, if non-zero, or at the
+// end of its window. For example:
+//
+//	batches := batch.GroupIntoBatches(s, requests, 100, 10*time.Second)
+//
+// Here, "batches" holds batches of at most 100 requests for each key, each
+// output at most 10 seconds after its first request arrived.
+func GroupIntoBatches(s beam.Scope, col beam.PCollection, size int64, maxBufferingDuration time.Duration) beam.PCollection {
+	return GroupIntoBatchesWithParams(s, col, Params{BatchSize: size, MaxBufferingDuration: maxBufferingDuration})
+}
+
+// GroupIntoBatchesWithParams is GroupIntoBatches with batches configured by
+// the parameters, which may limit the size of batches in bytes.
+func GroupIntoBatchesWithParams(s beam.Scope, col beam.PCollection, p Params) beam.PCollection {
+	s = s.Scope("batch.GroupIntoBatches")
+	_, v := mustKV(s, col)
+	if err := p.validate(); err != nil {
+		panic(fmt.Sprintf("%v: %v", s, err))
+	}
+	return beam.ParDo(s, newGroupIntoBatchesFn(p, v), col)
+}
+
+// GroupIntoBatchesWithShardedKey is GroupIntoBatchesWithParams for
+// PCollections with hot keys. The values of each key are split into shards,
+// which are batched independently and in parallel, so a key may have
+// several incomplete batches at once. Each worker adds its values to its own
+// shard, so the number of shards of a key scales with the number of workers.
+func GroupIntoBatchesWithShardedKey(s beam.Scope, col beam.PCollection, p Params) beam.PCollection {
+	s = s.Scope("batch.GroupIntoBatchesWithShardedKey")
+	k, v := mustKV(s, col)
+	if err := p.validate(); err != nil {
+		panic(fmt.Sprintf("%v: %v", s, err))
+	}
+	sk := shardedKeyType(k)
+	sharded := beam.ParDo(s, &shardFn{Key: beam.EncodedType{T: sk}}, col, beam.TypeDefinition{Var: beam.XType, T: sk})
+	batches := beam.ParDo(s, newGroupIntoBatchesFn(p, v), sharded)
+	return beam.ParDo(s, &unshardFn{}, batches, beam.TypeDefinition{Var: beam.TType, T: k})
+}
+
+// mustKV returns the key and value types of the input, and panics if it isn't
+// a KV.
+func mustKV(s beam.Scope, col beam.PCollection) (reflect.Type, reflect.Type) {
+	if !typex.IsKV(col.Type()) {
+		panic(fmt.Sprintf("%v: input must be a KV, got %v", s, col.Type()))
+	}
+	return col.Type().Components()[0].Type(), col.Type().Components()[1].Type()
+}
+
+func newGroupIntoBatchesFn(p Params, v reflect.Type) *groupIntoBatchesFn {
+	return &groupIntoBatchesFn{
+		BatchSize:            p.BatchSize,
+		BatchSizeBytes:       p.BatchSizeBytes,
+		MaxBufferingDuration: p.MaxBufferingDuration,
+		Value:                beam.EncodedType{T: v},
+
+		Buffer:      state.MakeBagState[[]byte]("buffer"),
+		Count:       state.MakeValueState[int64]("count"),
+		Bytes:       state.MakeValueState[int64]("bytes"),
+		EndOfWindow: timers.InEventTime("endOfWindow"),
+		Buffering:   timers.InProcessingTime("buffering"),
+	}
+}
+
+// groupIntoBatchesFn buffers the values of each key and window in state, and
+// outputs them as a batch when the batch is full or a timer fires. Values
+// are buffered encoded, as state coders are fixed when the fn is constructed.
+type groupIntoBatchesFn struct {
+	// BatchSize is the maximum number of values in a batch, if non-zero.
+	BatchSize int64 `json:"batchSize"`
+	// BatchSizeBytes is the maximum encoded size of a batch, if non-zero.
+	BatchSizeBytes int64 `json:"batchSizeBytes"`
+	// MaxBufferingDuration is the maximum time to buffer a batch, if non-zero.
+	MaxBufferingDuration time.Duration `json:"maxBufferingDuration"`
+	// Value is the type of the values.
+	Value beam.EncodedType `json:"value"`
+
+	Buffer      state.Bag[[]byte]
+	Count       state.Value[int64]
+	Bytes       state.Value[int64]
+	EndOfWindow timers.EventTime
+	Buffering   timers.ProcessingTime
+
+	enc beam.ElementEncoder
+	dec beam.ElementDecoder
+}
+
+func (fn *groupIntoBatchesFn) Setup() {
+	fn.enc = beam.NewElementEncoder(fn.Value.T)
+	fn.dec = beam.NewElementDecoder(fn.Value.T)
+}
+
+func (fn *groupIntoBatchesFn) ProcessElement(ctx context.Context, w beam.Window, ts beam.EventTime, sp state.Provider, tp timers.Provider, key beam.T, value beam.V, emit func(beam.T, []beam.V)) error {
+	var buf bytes.Buffer
+	if err := fn.enc.Encode(value, &buf); err != nil {
+		return err
+	}
+	size := int64(buf.Len())
+
+	count, _, err := fn.Count.Read(sp)
+	if err != nil {
+		return err
+	}
+	total, _, err := fn.Bytes.Read(sp)
+	if err != nil {
+		return err
+	}
+	if count > 0 && fn.BatchSizeBytes > 0 && total+size > fn.BatchSizeBytes {
+		// The value doesn't fit, so output the buffered values without it.
+		if err := fn.flush(sp, tp, key, emit); err != nil {
+			return err
+		}
+		count, total = 0, 0
+	}
+	if count == 0 {
+		// The first value of a batch sets the timers that bound its lifetime.
+		fn.EndOfWindow.Set(tp, w.MaxTimestamp().ToTime())
+		if fn.MaxBufferingDuration > 0 {
+			fn.Buffering.Set(tp, time.Now().Add(fn.MaxBufferingDuration), timers.WithOutputTimestamp(ts.ToTime()))
+		}
+	}
+
+	if err := fn.Buffer.Add(sp, buf.Bytes()); err != nil {
+		return err
+	}
+	count, total = count+1, total+size
+	if (fn.BatchSize > 0 && count >= fn.BatchSize) || (fn.BatchSizeBytes > 0 && total >= fn.BatchSizeBytes) {
+		return fn.flush(sp, tp, key, emit)
+	}
+	if err := fn.Count.Write(sp, count); err != nil {
+		return err
+	}
+	return fn.Bytes.Write(sp, total)
+}
+
+func (fn *groupIntoBatchesFn) OnTimer(ctx context.Context, sp state.Provider, tp timers.Provider, key beam.T, timerKey string, emit func(beam.T, []beam.V)) error {
+	return fn.flush(sp, tp, key, emit)
+}
+
+// flush outputs the buffered values, if any, and clears the batch.
+func (fn *groupIntoBatchesFn) flush(sp state.Provider, tp timers.Provider, key beam.T, emit func(beam.T, []beam.V)) error {
+	encoded, ok, err := fn.Buffer.Read(sp)
+	if err != nil {
+		return err
+	}
+	if !ok || len(encoded) == 0 {
+		return nil
+	}
+	batch := make([]beam.V, 0, len(encoded))
+	for _, b := range encoded {
+		v, err := fn.dec.Decode(bytes.NewReader(b))
+		if err != nil {
+			return err
+		}
+		batch = append(batch, v)
+	}
+	emit(key, batch)
+
+	if err := fn.Buffer.Clear(sp); err != nil {
+		return err
+	}
+	if err := fn.Count.Clear(sp); err != nil {
+		return err
+	}
+	if err := fn.Bytes.Clear(sp); err != nil {
+		return err
+	}
+	if fn.MaxBufferingDuration > 0 {
+		fn.Buffering.Clear(tp)
+	}
+	return nil
+}
+
+// shardedKeyType returns the type of the sharded keys of keys of type k.
+func shardedKeyType(k reflect.Type) reflect.Type {
+	return reflect.StructOf([]reflect.StructField{
+		{Name: "Key", Type: k, Tag: `beam:"key"`},
+		{Name: "Shard", Type: reflect.TypeOf(int64(0)), Tag: `beam:"shard"`},
+	})
+}
+
+// shardFn replaces the key of each element with a sharded key, using a
+// shard that's unique to the fn instance.
+type shardFn struct {
+	// Key is the sharded key type.
+	Key beam.EncodedType `json:"key"`
+
+	shard int64
+}
+
+func (fn *shardFn) Setup() {
+	fn.shard = rand.New(rand.NewSource(time.Now().UnixNano())).Int63()
+}
+
+func (fn *shardFn) ProcessElement(key beam.T, value beam.V, emit func(beam.X, beam.V)) {
+	sk := reflect.New(fn.Key.T).Elem()
+	sk.Field(0).Set(reflect.ValueOf(key))
+	sk.Field(1).SetInt(fn.shard)
+	emit(sk.Interface(), value)
+}
+
+// unshardFn restores the original key of a batch.
+type unshardFn struct{}
+
+func (fn *unshardFn) ProcessElement(sk beam.X, batch []beam.V) (beam.T, []beam.V) {
+	return reflect.ValueOf(sk).Field(0).Interface(), batch
+}
@@ -0,0 +1,288 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package batch
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/graph/mtime"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/graph/window"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/state"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/timers"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/register"
+	_ "github.com/apache/beam/sdks/v2/go/pkg/beam/runners/prism"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/testing/passert"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/testing/ptest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func init() {
+	register.Function1x2(keyByParity)
+	register.Function3x0(batchSize)
+	register.Function3x0(unbatch)
+	register.Emitter1[string]()
+	register.Emitter1[int]()
+}
+
+func TestMain(m *testing.M) {
+	os.Exit(ptest.MainRetWithDefault(m, "prism"))
+}
+
+// fakeState is an in memory state.Provider for a single key and window,
+// supporting value and bag state.
+type fakeState struct {
+	state.Provider
+
+	values map[string]any
+	bags   map[string][]any
+}
+
+func newFakeState() *fakeState {
+	return &fakeState{values: map[string]any{}, bags: map[string][]any{}}
+}
+
+func (s *fakeState) ReadValueState(id string) (any, []state.Transaction, error) {
+	return s.values[id], nil, nil
+}
+
+func (s *fakeState) WriteValueState(t state.Transaction) error {
+	s.values[t.Key] = t.Val
+	return nil
+}
+
+func (s *fakeState) ClearValueState(t state.Transaction) error {
+	delete(s.values, t.Key)
+	return nil
+}
+
+func (s *fakeState) ReadBagState(id string) ([]any, []state.Transaction, error) {
+	return s.bags[id], nil, nil
+}
+
+func (s *fakeState) WriteBagState(t state.Transaction) error {
+	s.bags[t.Key] = append(s.bags[t.Key], t.Val)
+	return nil
+}
+
+func (s *fakeState) ClearBagState(t state.Transaction) error {
+	delete(s.bags, t.Key)
+	return nil
+}
+
+// fakeTimers records the timers that are set.
+type fakeTimers struct {
+	set map[string]timers.TimerMap
+}
+
+func (t *fakeTimers) Set(tm timers.TimerMap) {
+	if tm.Clear {
+		delete(t.set, tm.Family)
+		return
+	}
+	t.set[tm.Family] = tm
+}
+
+func TestGroupIntoBatchesFn(t *testing.T) {
+	tests := []struct {
+		name   string
+		params Params
+		values []string
+		// want are the batches output while processing the values.
+		want [][]string
+		// wantOnTimer is the batch output if a timer then fires.
+		wantOnTimer []string
+		// wantTimers are the timer families set after processing the values.
+		wantTimers []string
+	}{
+		{
+			name:        "size",
+			params:      Params{BatchSize: 2},
+			values:      []string{"a", "b", "c", "d", "e"},
+			want:        [][]string{{"a", "b"}, {"c", "d"}},
+			wantOnTimer: []string{"e"},
+			wantTimers:  []string{"endOfWindow"},
+		},
+		{
+			name:        "bufferingDuration",
+			params:      Params{BatchSize: 10, MaxBufferingDuration: time.Minute},
+			values:      []string{"a", "b"},
+			wantOnTimer: []string{"a", "b"},
+			wantTimers:  []string{"buffering", "endOfWindow"},
+		},
+		{
+			// Strings encode with a one byte length prefix.
+			name:        "bytes",
+			params:      Params{BatchSizeBytes: 6},
+			values:      []string{"aa", "bb", "ccc", "dddddddd", "e"},
+			want:        [][]string{{"aa", "bb"}, {"ccc"}, {"dddddddd"}},
+			wantOnTimer: []string{"e"},
+			wantTimers:  []string{"endOfWindow"},
+		},
+		{
+			name:       "sizeAndBytes",
+			params:     Params{BatchSize: 2, BatchSizeBytes: 100},
+			values:     []string{"a", "b"},
+			want:       [][]string{{"a", "b"}},
+			wantTimers: []string{"endOfWindow"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fn := newGroupIntoBatchesFn(test.params, reflect.TypeOf(""))
+			fn.Setup()
+			sp := newFakeState()
+			tp := &fakeTimers{set: map[string]timers.TimerMap{}}
+			ctx := context.Background()
+			w := window.IntervalWindow{Start: 0, End: mtime.FromMilliseconds(1000)}
+
+			var got [][]string
+			emit := func(key beam.T, batch []beam.V) {
+				if key != "k" {
+					t.Errorf("emitted key %v, want k", key)
+				}
+				var b []string
+				for _, v := range batch {
+					b = append(b, v.(string))
+				}
+				got = append(got, b)
+			}
+			for _, v := range test.values {
+				if err := fn.ProcessElement(ctx, w, mtime.FromMilliseconds(10), sp, tp, "k", v, emit); err != nil {
+					t.Fatalf("ProcessElement(%v) failed: %v", v, err)
+				}
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("batches diff (-want,+got):\n%v", diff)
+			}
+			var gotTimers []string
+			for family := range tp.set {
+				gotTimers = append(gotTimers, family)
+			}
+			if diff := cmp.Diff(test.wantTimers, gotTimers, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Errorf("timers diff (-want,+got):\n%v", diff)
+			}
+			if end, ok := tp.set["endOfWindow"]; ok && end.FireTimestamp != w.MaxTimestamp() {
+				t.Errorf("endOfWindow timer fires at %v, want %v", end.FireTimestamp, w.MaxTimestamp())
+			}
+
+			got = nil
+			if err := fn.OnTimer(ctx, sp, tp, "k", "endOfWindow", emit); err != nil {
+				t.Fatalf("OnTimer() failed: %v", err)
+			}
+			var want [][]string
+			if test.wantOnTimer != nil {
+				want = [][]string{test.wantOnTimer}
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("OnTimer() batches diff (-want,+got):\n%v", diff)
+			}
+			if _, ok := tp.set["buffering"]; ok {
+				t.Errorf("buffering timer is still set after the batch was output")
+			}
+		})
+	}
+}
+
+func TestGroupIntoBatches_Construction(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	kvs := beam.ParDo(s, func(v int) (string, int) { return "k", v }, beam.Create(s, 1, 2, 3))
+	batches := GroupIntoBatches(s, kvs, 2, time.Minute)
+	sharded := GroupIntoBatchesWithShardedKey(s, kvs, Params{BatchSizeBytes: 1 << 20})
+
+	want := "KV<string,[]int>"
+	for _, col := range []beam.PCollection{batches, sharded} {
+		if got := fmt.Sprint(col.Type()); got != want {
+			t.Errorf("output type = %v, want %v", got, want)
+		}
+	}
+	if _, _, err := p.Build(); err != nil {
+		t.Errorf("Build() failed: %v", err)
+	}
+}
+
+func TestGroupIntoBatches_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		params Params
+	}{
+		{"noLimit", Params{MaxBufferingDuration: time.Second}},
+		{"negative", Params{BatchSize: -1}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("GroupIntoBatchesWithParams(%+v) didn't panic", test.params)
+				}
+			}()
+			_, s := beam.NewPipelineWithRoot()
+			kvs := beam.ParDo(s, func(v int) (string, int) { return "k", v }, beam.Create(s, 1))
+			GroupIntoBatchesWithParams(s, kvs, test.params)
+		})
+	}
+}
+
+func keyByParity(v int) (string, int) {
+	if v%2 == 0 {
+		return "even", v
+	}
+	return "odd", v
+}
+
+func batchSize(k string, batch []int, emit func(string)) {
+	emit(fmt.Sprintf("%v:%v", k, len(batch)))
+}
+
+func unbatch(_ string, batch []int, emit func(int)) {
+	for _, v := range batch {
+		emit(v)
+	}
+}
+
+func TestGroupIntoBatches(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	kvs := beam.ParDo(s, keyByParity, beam.Create(s, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10))
+	batches := GroupIntoBatches(s, kvs, 3, 0)
+	// The end of window timer flushes each key's partial batch.
+	passert.Equals(s, beam.ParDo(s, batchSize, batches), "even:3", "even:2", "odd:3", "odd:2")
+	passert.Equals(s, beam.ParDo(s, unbatch, batches), 1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	ptest.RunAndValidate(t, p)
+}
+
+func TestGroupIntoBatches_MaxBufferingDuration(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	kvs := beam.ParDo(s, keyByParity, beam.Create(s, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10))
+	// Batches are never full, so the processing time timer or the end of
+	// window timer flush them.
+	batches := GroupIntoBatches(s, kvs, 100, time.Millisecond)
+	passert.Equals(s, beam.ParDo(s, unbatch, batches), 1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	ptest.RunAndValidate(t, p)
+}
+
+func TestGroupIntoBatchesWithShardedKey(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	kvs := beam.ParDo(s, keyByParity, beam.Create(s, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10))
+	batches := GroupIntoBatchesWithShardedKey(s, kvs, Params{BatchSize: 5})
+	passert.Equals(s, beam.ParDo(s, batchSize, batches), "even:5", "odd:5")
+	ptest.RunAndValidate(t, p)
+}
@@ -0,0 +1,70 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package join
+
+import (
+	"github.com/apache/beam/sdks/v2/go/pkg/beam"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/register"
+)
+
+func init() {
+	register.DoFn4x0[beam.T, beam.X, func(beam.T) func(*beam.Y) bool, func(beam.T, beam.Z)](&hashJoinFn{})
+}
+
+// HashInner returns the pairs of left and right values with equal keys, like
+// Inner, but reads the right input as a multimap side input instead of
+// grouping both inputs. The right input should be small, as it's read in
+// full by every worker, but the left input isn't shuffled. Side inputs are
+// matched by window, so the right input must be windowed compatibly with the
+// left input.
+func HashInner(s beam.Scope, left, right beam.PCollection) beam.PCollection {
+	return hashJoin(s.Scope("join.HashInner"), false, left, right)
+}
+
+// HashLeftOuter is the left outer join variant of HashInner. Left values
+// without a matching right value are paired with a nil right.
+func HashLeftOuter(s beam.Scope, left, right beam.PCollection) beam.PCollection {
+	return hashJoin(s.Scope("join.HashLeftOuter"), true, left, right)
+}
+
+func hashJoin(s beam.Scope, leftOuter bool, left, right beam.PCollection) beam.PCollection {
+	_, lt, rt := mustKVs(s, left, right)
+	pair := PairType(lt, rt, false, leftOuter)
+	fn := &hashJoinFn{LeftOuter: leftOuter, Pair: beam.EncodedType{T: pair}}
+	return beam.ParDo(s, fn, left, beam.SideInput{Input: right}, beam.TypeDefinition{Var: beam.ZType, T: pair})
+}
+
+// hashJoinFn joins each left value with the right values of its key, looked
+// up in the side input.
+type hashJoinFn struct {
+	// LeftOuter indicates whether to emit unmatched left values.
+	LeftOuter bool `json:"leftOuter"`
+	// Pair is the output pair type.
+	Pair beam.EncodedType `json:"pair"`
+}
+
+func (f *hashJoinFn) ProcessElement(key beam.T, l beam.X, right func(beam.T) func(*beam.Y) bool, emit func(beam.T, beam.Z)) {
+	iter := right(key)
+	matched := false
+	var r beam.Y
+	for iter(&r) {
+		matched = true
+		emit(key, pair(f.Pair.T, l, r))
+	}
+	if !matched && f.LeftOuter {
+		emit(key, pair(f.Pair.T, l, nil))
+	}
+}
@@ -0,0 +1,170 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package join contains transforms joining two keyed PCollections on equal
+// keys.
+//
+// The joins take a PCollection<KV<K,L>> and a PCollection<KV<K,R>> and return
+// a PCollection<KV<K,P>>, where P is a struct holding a joined pair of values:
+//
+//	struct {
+//	    Left  L `beam:"left"`
+//	    Right R `beam:"right"`
+//	}
+//
+// In outer joins, the side that may be missing is a pointer instead, which is
+// nil when there's no matching value. For example, LeftOuter returns pairs of
+// type struct{Left L; Right *R}. Sides whose values are already pointers
+// aren't wrapped again.
+//
+// Inner, LeftOuter, RightOuter and FullOuter group both inputs with
+// CoGroupByKey. HashInner and HashLeftOuter instead read the right input as a
+// side input, which avoids shuffling the left input when the right input is
+// small enough to fit in memory on each worker.
+package join
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/typex"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/register"
+)
+
+func init() {
+	register.DoFn4x0[beam.T, func(*beam.X) bool, func(*beam.Y) bool, func(beam.T, beam.Z)](&joinFn{})
+	register.Iter1[beam.X]()
+	register.Iter1[beam.Y]()
+	register.Emitter2[beam.T, beam.Z]()
+}
+
+// Inner returns the pairs of left and right values with equal keys.
+func Inner(s beam.Scope, left, right beam.PCollection) beam.PCollection {
+	return join(s.Scope("join.Inner"), false, false, left, right)
+}
+
+// LeftOuter returns the pairs of left and right values with equal keys, and
+// the left values without a matching right value, paired with a nil right.
+func LeftOuter(s beam.Scope, left, right beam.PCollection) beam.PCollection {
+	return join(s.Scope("join.LeftOuter"), true, false, left, right)
+}
+
+// RightOuter returns the pairs of left and right values with equal keys, and
+// the right values without a matching left value, paired with a nil left.
+func RightOuter(s beam.Scope, left, right beam.PCollection) beam.PCollection {
+	return join(s.Scope("join.RightOuter"), false, true, left, right)
+}
+
+// FullOuter returns the pairs of left and right values with equal keys, and
+// the left and right values without a match on the other side, paired with
+// nil.
+func FullOuter(s beam.Scope, left, right beam.PCollection) beam.PCollection {
+	return join(s.Scope("join.FullOuter"), true, true, left, right)
+}
+
+func join(s beam.Scope, leftOuter, rightOuter bool, left, right beam.PCollection) beam.PCollection {
+	_, lt, rt := mustKVs(s, left, right)
+	pair := PairType(lt, rt, rightOuter, leftOuter)
+	grouped := beam.CoGroupByKey(s, left, right)
+	fn := &joinFn{LeftOuter: leftOuter, RightOuter: rightOuter, Pair: beam.EncodedType{T: pair}}
+	return beam.ParDo(s, fn, grouped, beam.TypeDefinition{Var: beam.ZType, T: pair})
+}
+
+// mustKVs returns the key type and the left and right value types of the
+// inputs, and panics if they aren't KVs with the same key type.
+func mustKVs(s beam.Scope, left, right beam.PCollection) (reflect.Type, reflect.Type, reflect.Type) {
+	if !typex.IsKV(left.Type()) || !typex.IsKV(right.Type()) {
+		panic(fmt.Sprintf("%v: inputs must be KVs, got %v and %v", s, left.Type(), right.Type()))
+	}
+	lk, rk := left.Type().Components()[0].Type(), right.Type().Components()[0].Type()
+	if lk != rk {
+		panic(fmt.Sprintf("%v: inputs must have the same key type, got %v and %v", s, lk, rk))
+	}
+	return lk, left.Type().Components()[1].Type(), right.Type().Components()[1].Type()
+}
+
+// PairType returns the type of the joined pairs of values of types l and r.
+// Optional sides are made pointers, unless they already are.
+func PairType(l, r reflect.Type, leftOptional, rightOptional bool) reflect.Type {
+	if leftOptional && l.Kind() != reflect.Ptr {
+		l = reflect.PtrTo(l)
+	}
+	if rightOptional && r.Kind() != reflect.Ptr {
+		r = reflect.PtrTo(r)
+	}
+	return reflect.StructOf([]reflect.StructField{
+		{Name: "Left", Type: l, Tag: `beam:"left"`},
+		{Name: "Right", Type: r, Tag: `beam:"right"`},
+	})
+}
+
+// joinFn emits the joined pairs of the grouped left and right values of a key.
+type joinFn struct {
+	// LeftOuter indicates whether to emit unmatched left values.
+	LeftOuter bool `json:"leftOuter"`
+	// RightOuter indicates whether to emit unmatched right values.
+	RightOuter bool `json:"rightOuter"`
+	// Pair is the output pair type.
+	Pair beam.EncodedType `json:"pair"`
+}
+
+func (f *joinFn) ProcessElement(key beam.T, left func(*beam.X) bool, right func(*beam.Y) bool, emit func(beam.T, beam.Z)) {
+	var rights []beam.Y
+	var r beam.Y
+	for right(&r) {
+		rights = append(rights, r)
+	}
+	var l beam.X
+	matched := false
+	for left(&l) {
+		matched = true
+		if len(rights) == 0 && f.LeftOuter {
+			emit(key, pair(f.Pair.T, l, nil))
+		}
+		for _, r := range rights {
+			emit(key, pair(f.Pair.T, l, r))
+		}
+	}
+	if !matched && f.RightOuter {
+		for _, r := range rights {
+			emit(key, pair(f.Pair.T, nil, r))
+		}
+	}
+}
+
+// pair returns a value of the pair type t holding the values, where a nil
+// value is left as the zero value.
+func pair(t reflect.Type, l, r any) any {
+	out := reflect.New(t).Elem()
+	setSide(out.Field(0), l)
+	setSide(out.Field(1), r)
+	return out.Interface()
+}
+
+// setSide sets the field to the value, boxing it if the field is a pointer
+// for the optional side of an outer join.
+func setSide(fv reflect.Value, v any) {
+	if v == nil {
+		return
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Type() != fv.Type() {
+		p := reflect.New(rv.Type())
+		p.Elem().Set(rv)
+		rv = p
+	}
+	fv.Set(rv)
+}
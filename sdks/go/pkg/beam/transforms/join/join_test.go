@@ -0,0 +1,178 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package join
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/testing/passert"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/testing/ptest"
+)
+
+func init() {
+	beam.RegisterFunction(formatInner)
+	beam.RegisterFunction(formatLeftOuter)
+	beam.RegisterFunction(formatRightOuter)
+	beam.RegisterFunction(formatFullOuter)
+}
+
+type (
+	innerPair = struct {
+		Left  string `beam:"left"`
+		Right int    `beam:"right"`
+	}
+	leftOuterPair = struct {
+		Left  string `beam:"left"`
+		Right *int   `beam:"right"`
+	}
+	rightOuterPair = struct {
+		Left  *string `beam:"left"`
+		Right int     `beam:"right"`
+	}
+	fullOuterPair = struct {
+		Left  *string `beam:"left"`
+		Right *int    `beam:"right"`
+	}
+)
+
+func formatInner(k string, p innerPair) string {
+	return fmt.Sprintf("%v:%v,%v", k, p.Left, p.Right)
+}
+
+func formatLeftOuter(k string, p leftOuterPair) string {
+	return fmt.Sprintf("%v:%v,%v", k, p.Left, orNil(p.Right))
+}
+
+func formatRightOuter(k string, p rightOuterPair) string {
+	return fmt.Sprintf("%v:%v,%v", k, orNil(p.Left), p.Right)
+}
+
+func formatFullOuter(k string, p fullOuterPair) string {
+	return fmt.Sprintf("%v:%v,%v", k, orNil(p.Left), orNil(p.Right))
+}
+
+func orNil[T any](v *T) string {
+	if v == nil {
+		return "nil"
+	}
+	return fmt.Sprint(*v)
+}
+
+// inputs returns the left input {a: x, y; b: z; c: w} and the right input
+// {a: 1, 2; b: 3; d: 4}.
+func inputs(s beam.Scope) (beam.PCollection, beam.PCollection) {
+	left := beam.ParDo(s, func(kv string) (string, string) {
+		return kv[:1], kv[1:]
+	}, beam.Create(s, "ax", "ay", "bz", "cw"))
+	right := beam.ParDo(s, func(kv string) (string, int) {
+		return kv[:1], int(kv[1] - '0')
+	}, beam.Create(s, "a1", "a2", "b3", "d4"))
+	return left, right
+}
+
+func TestJoins(t *testing.T) {
+	tests := []struct {
+		name   string
+		join   func(s beam.Scope, left, right beam.PCollection) beam.PCollection
+		format any
+		want   []any
+	}{
+		{
+			name:   "Inner",
+			join:   Inner,
+			format: formatInner,
+			want:   []any{"a:x,1", "a:x,2", "a:y,1", "a:y,2", "b:z,3"},
+		},
+		{
+			name:   "LeftOuter",
+			join:   LeftOuter,
+			format: formatLeftOuter,
+			want:   []any{"a:x,1", "a:x,2", "a:y,1", "a:y,2", "b:z,3", "c:w,nil"},
+		},
+		{
+			name:   "RightOuter",
+			join:   RightOuter,
+			format: formatRightOuter,
+			want:   []any{"a:x,1", "a:x,2", "a:y,1", "a:y,2", "b:z,3", "d:nil,4"},
+		},
+		{
+			name:   "FullOuter",
+			join:   FullOuter,
+			format: formatFullOuter,
+			want:   []any{"a:x,1", "a:x,2", "a:y,1", "a:y,2", "b:z,3", "c:w,nil", "d:nil,4"},
+		},
+		{
+			name:   "HashInner",
+			join:   HashInner,
+			format: formatInner,
+			want:   []any{"a:x,1", "a:x,2", "a:y,1", "a:y,2", "b:z,3"},
+		},
+		{
+			name:   "HashLeftOuter",
+			join:   HashLeftOuter,
+			format: formatLeftOuter,
+			want:   []any{"a:x,1", "a:x,2", "a:y,1", "a:y,2", "b:z,3", "c:w,nil"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, s := beam.NewPipelineWithRoot()
+			left, right := inputs(s)
+			got := beam.ParDo(s, test.format, test.join(s, left, right))
+			passert.Equals(s, got, test.want...)
+			if err := ptest.Run(p); err != nil {
+				t.Errorf("%v() failed: %v", test.name, err)
+			}
+		})
+	}
+}
+
+func TestJoin_InvalidInputs(t *testing.T) {
+	tests := []struct {
+		name  string
+		build func(s beam.Scope) (beam.PCollection, beam.PCollection)
+	}{
+		{
+			name: "notKV",
+			build: func(s beam.Scope) (beam.PCollection, beam.PCollection) {
+				left, _ := inputs(s)
+				return left, beam.Create(s, 1)
+			},
+		},
+		{
+			name: "keyMismatch",
+			build: func(s beam.Scope) (beam.PCollection, beam.PCollection) {
+				left, _ := inputs(s)
+				right := beam.ParDo(s, func(v int) (int, int) { return v, v }, beam.Create(s, 1))
+				return left, right
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Inner() didn't panic")
+				}
+			}()
+			_, s := beam.NewPipelineWithRoot()
+			left, right := test.build(s)
+			Inner(s, left, right)
+		})
+	}
+}
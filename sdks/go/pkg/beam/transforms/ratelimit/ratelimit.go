@@ -0,0 +1,351 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ratelimit contains client-side throttling for DoFns that call
+// external services, such as HTTP APIs that reject requests when overloaded.
+//
+// A Limiter spaces out requests to its current rate, and adapts the rate to
+// the fraction of requests the service rejects: the rate is cut
+// multiplicatively when too many requests are rejected, and increased
+// additively otherwise (AIMD). Workers don't coordinate directly, but since
+// each reacts to the rejections it sees, together they converge on the rate
+// the service accepts. Limiters are usually shared by the DoFn instances of a
+// worker, using Shared:
+//
+//	type callFn struct {
+//		URL string `json:"url"`
+//
+//		limiter *ratelimit.Limiter
+//	}
+//
+//	func (f *callFn) Setup() {
+//		f.limiter = ratelimit.Shared(f.URL, ratelimit.Options{MaxRate: 100})
+//	}
+//
+//	func (f *callFn) ProcessElement(ctx context.Context, req string) (string, error) {
+//		if err := f.limiter.Wait(ctx); err != nil {
+//			return "", err
+//		}
+//		resp, err := call(ctx, f.URL, req)
+//		f.limiter.Report(resp.StatusCode == http.StatusTooManyRequests)
+//		...
+//	}
+//
+// The time spent waiting is reported to the standard throttling counter
+// of package metrics, which runners may use for autoscaling decisions.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/metrics"
+)
+
+// throttled counts the milliseconds spent waiting for limiters.
+var throttled = beam.NewCounter(metrics.ThrottlingNamespace, metrics.ThrottlingMsecs)
+
+// Options configures a Limiter. The zero value is a valid configuration.
+type Options struct {
+	// InitialRate is the rate, in requests per second, that the limiter
+	// starts at. It defaults to 10 requests per second, bounded by MinRate
+	// and MaxRate.
+	InitialRate float64
+	// MinRate is the lowest rate the limiter decreases to. It defaults to
+	// 0.1 requests per second.
+	MinRate float64
+	// MaxRate is the highest rate the limiter increases to. It defaults to
+	// no limit.
+	MaxRate float64
+	// Burst is the number of requests allowed at once after a quiet period.
+	// It defaults to 1.
+	Burst int
+	// Increase is added to the rate after each interval without too many
+	// rejections. It defaults to 1 request per second.
+	Increase float64
+	// Decrease is the factor the rate is multiplied by after each interval
+	// with too many rejections. It must be within (0, 1), and defaults to
+	// 0.5.
+	Decrease float64
+	// MaxRejectionRate is the fraction of requests that may be rejected in
+	// an interval without decreasing the rate. It defaults to 0.
+	MaxRejectionRate float64
+	// Interval is the period over which rejections are measured, and the
+	// rate adjusted. It defaults to a second.
+	Interval time.Duration
+}
+
+// withDefaults returns the options with defaults filled in. It panics if
+// the options are invalid.
+func (o Options) withDefaults() Options {
+	switch {
+	case o.InitialRate < 0, o.MinRate < 0, o.MaxRate < 0, o.Burst < 0, o.Increase < 0, o.Interval < 0:
+		panic(fmt.Sprintf("invalid ratelimit options %+v: values must not be negative", o))
+	case o.Decrease < 0 || o.Decrease >= 1:
+		panic(fmt.Sprintf("invalid ratelimit options %+v: Decrease must be within (0, 1)", o))
+	case o.MaxRejectionRate < 0 || o.MaxRejectionRate > 1:
+		panic(fmt.Sprintf("invalid ratelimit options %+v: MaxRejectionRate must be within [0, 1]", o))
+	case o.MaxRate > 0 && o.MinRate > o.MaxRate:
+		panic(fmt.Sprintf("invalid ratelimit options %+v: MinRate must not exceed MaxRate", o))
+	}
+	if o.MinRate == 0 {
+		o.MinRate = 0.1
+	}
+	if o.MaxRate == 0 {
+		o.MaxRate = math.Inf(1)
+	}
+	if o.InitialRate == 0 {
+		o.InitialRate = 10
+	}
+	o.InitialRate = math.Max(o.MinRate, math.Min(o.MaxRate, o.InitialRate))
+	if o.Burst == 0 {
+		o.Burst = 1
+	}
+	if o.Increase == 0 {
+		o.Increase = 1
+	}
+	if o.Decrease == 0 {
+		o.Decrease = 0.5
+	}
+	if o.Interval == 0 {
+		o.Interval = time.Second
+	}
+	return o
+}
+
+// Limiter is an adaptive rate limiter. It's safe for concurrent use.
+type Limiter struct {
+	opts Options
+
+	mu       sync.Mutex
+	rate     float64 // Requests per second.
+	tokens   float64 // Negative when requests are waiting.
+	last     time.Time
+	start    time.Time // Start of the current interval.
+	requests int64
+	rejected int64
+
+	now   func() time.Time
+	sleep func(context.Context, time.Duration) error
+}
+
+// New returns a Limiter with the given options. It panics if the options
+// are invalid.
+func New(opts Options) *Limiter {
+	return newLimiter(opts.withDefaults(), time.Now, sleep)
+}
+
+func newLimiter(opts Options, now func() time.Time, sleep func(context.Context, time.Duration) error) *Limiter {
+	t := now()
+	return &Limiter{
+		opts:   opts,
+		rate:   opts.InitialRate,
+		tokens: float64(opts.Burst),
+		last:   t,
+		start:  t,
+		now:    now,
+		sleep:  sleep,
+	}
+}
+
+// Rate returns the current rate of the limiter, in requests per second.
+func (l *Limiter) Rate() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.adjust(l.now())
+	return l.rate
+}
+
+// Wait blocks until a request may be made at the current rate, or the
+// context is done. The time spent waiting is added to the throttling
+// counter of the transform in the context. If the context is done first,
+// the request's token is returned to the limiter.
+func (l *Limiter) Wait(ctx context.Context) error {
+	d := l.reserve()
+	if d <= 0 {
+		return nil
+	}
+	throttled.Inc(ctx, d.Milliseconds())
+	if err := l.sleep(ctx, d); err != nil {
+		l.cancel()
+		return err
+	}
+	return nil
+}
+
+// reserve takes a token, and returns how long the caller must wait for it.
+func (l *Limiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.adjust(now)
+	l.refill(now)
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns the token of a request that won't be made, so the requests
+// waiting after it aren't delayed by it.
+func (l *Limiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.refill(now)
+	l.tokens = math.Min(float64(l.opts.Burst), l.tokens+1)
+}
+
+// refill adds the tokens accrued at the current rate since the last refill.
+func (l *Limiter) refill(now time.Time) {
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens = math.Min(float64(l.opts.Burst), l.tokens+elapsed.Seconds()*l.rate)
+		l.last = now
+	}
+}
+
+// Report records the outcome of a request, with rejected true if the
+// service rejected it as overloaded, such as with an HTTP 429 or 503
+// response. Other failures shouldn't be reported as rejections. After each
+// interval with requests, the rate is decreased if too many of them were
+// rejected, and increased otherwise.
+func (l *Limiter) Report(rejected bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.adjust(l.now())
+	l.requests++
+	if rejected {
+		l.rejected++
+	}
+}
+
+// adjust updates the rate from the outcomes of the interval, once it's over.
+func (l *Limiter) adjust(now time.Time) {
+	if now.Sub(l.start) < l.opts.Interval {
+		return
+	}
+	if l.requests > 0 {
+		// Tokens accrued so far were at the old rate.
+		l.refill(now)
+		if float64(l.rejected)/float64(l.requests) > l.opts.MaxRejectionRate {
+			l.rate = math.Max(l.opts.MinRate, l.rate*l.opts.Decrease)
+		} else {
+			l.rate = math.Min(l.opts.MaxRate, l.rate+l.opts.Increase)
+		}
+	}
+	l.start = now
+	l.requests = 0
+	l.rejected = 0
+}
+
+// KeyedLimiter adapts the rate separately for each key, such as per host or
+// per API endpoint, so that an overloaded key doesn't throttle the others.
+// It's safe for concurrent use.
+type KeyedLimiter struct {
+	opts Options
+
+	mu       sync.Mutex
+	limiters map[string]*Limiter
+
+	now   func() time.Time
+	sleep func(context.Context, time.Duration) error
+}
+
+// NewKeyed returns a KeyedLimiter, whose keys each start with a Limiter with
+// the given options. It panics if the options are invalid.
+func NewKeyed(opts Options) *KeyedLimiter {
+	return &KeyedLimiter{opts: opts.withDefaults(), limiters: make(map[string]*Limiter), now: time.Now, sleep: sleep}
+}
+
+// Get returns the Limiter of the key.
+func (k *KeyedLimiter) Get(key string) *Limiter {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	l, ok := k.limiters[key]
+	if !ok {
+		l = newLimiter(k.opts, k.now, k.sleep)
+		k.limiters[key] = l
+	}
+	return l
+}
+
+// Wait blocks until a request for the key may be made, or the context is
+// done.
+func (k *KeyedLimiter) Wait(ctx context.Context, key string) error {
+	return k.Get(key).Wait(ctx)
+}
+
+// Report records the outcome of a request for the key.
+func (k *KeyedLimiter) Report(key string, rejected bool) {
+	k.Get(key).Report(rejected)
+}
+
+var (
+	sharedMu    sync.Mutex
+	shared      = make(map[string]*Limiter)
+	sharedKeyed = make(map[string]*KeyedLimiter)
+)
+
+// Shared returns the Limiter with the given name for the worker process,
+// creating it with the options if it doesn't exist yet. It's intended to be
+// called in DoFn Setup methods, so that all instances of the DoFn on a
+// worker share the limiter. The options of later calls for the same name
+// are ignored.
+func Shared(name string, opts Options) *Limiter {
+	sharedMu.Lock()
+	defer sharedMu.Unlock()
+
+	l, ok := shared[name]
+	if !ok {
+		l = New(opts)
+		shared[name] = l
+	}
+	return l
+}
+
+// SharedKeyed returns the KeyedLimiter with the given name for the worker
+// process, creating it with the options if it doesn't exist yet.
+func SharedKeyed(name string, opts Options) *KeyedLimiter {
+	sharedMu.Lock()
+	defer sharedMu.Unlock()
+
+	k, ok := sharedKeyed[name]
+	if !ok {
+		k = NewKeyed(opts)
+		sharedKeyed[name] = k
+	}
+	return k
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,256 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/metrics"
+	"github.com/google/go-cmp/cmp"
+)
+
+// fakeClock is a clock that only advances when slept on.
+type fakeClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Sleep(_ context.Context, d time.Duration) error {
+	c.sleeps = append(c.sleeps, d)
+	c.now = c.now.Add(d)
+	return nil
+}
+
+func newFakeLimiter(opts Options) (*Limiter, *fakeClock) {
+	c := &fakeClock{now: time.Unix(1000, 0)}
+	return newLimiter(opts.withDefaults(), c.Now, c.Sleep), c
+}
+
+func TestLimiter_Wait(t *testing.T) {
+	l, c := newFakeLimiter(Options{InitialRate: 2})
+	for i := 0; i < 4; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatalf("Wait() failed: %v", err)
+		}
+	}
+	want := []time.Duration{500 * time.Millisecond, 500 * time.Millisecond, 500 * time.Millisecond}
+	if diff := cmp.Diff(want, c.sleeps); diff != "" {
+		t.Errorf("Wait() sleeps (-want,+got):\n%v", diff)
+	}
+
+	// Tokens accrue up to the burst while idle.
+	c.now = c.now.Add(time.Minute)
+	c.sleeps = nil
+	l.Wait(context.Background())
+	if len(c.sleeps) != 0 {
+		t.Errorf("Wait() after idle slept %v, want no sleep", c.sleeps)
+	}
+}
+
+func TestLimiter_WaitBurst(t *testing.T) {
+	l, c := newFakeLimiter(Options{InitialRate: 1, Burst: 3})
+	for i := 0; i < 4; i++ {
+		l.Wait(context.Background())
+	}
+	if diff := cmp.Diff([]time.Duration{time.Second}, c.sleeps); diff != "" {
+		t.Errorf("Wait() sleeps (-want,+got):\n%v", diff)
+	}
+}
+
+func TestLimiter_WaitCanceled(t *testing.T) {
+	l := New(Options{InitialRate: 0.1, MinRate: 0.1})
+	l.Wait(context.Background())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := l.Wait(ctx); err != context.Canceled {
+		t.Errorf("Wait() with canceled context = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestLimiter_WaitCanceledReturnsToken(t *testing.T) {
+	c := &fakeClock{now: time.Unix(1000, 0)}
+	// Canceled waits are interrupted halfway.
+	sleep := func(ctx context.Context, d time.Duration) error {
+		if ctx.Err() != nil {
+			c.sleeps = append(c.sleeps, d)
+			c.now = c.now.Add(d / 2)
+			return ctx.Err()
+		}
+		return c.Sleep(ctx, d)
+	}
+	l := newLimiter(Options{InitialRate: 2}.withDefaults(), c.Now, sleep)
+	l.Wait(context.Background())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := l.Wait(ctx); err != context.Canceled {
+		t.Fatalf("Wait() with canceled context = %v, want %v", err, context.Canceled)
+	}
+	// The canceled request's token is returned, so the next request only
+	// waits for the rest of the first interval.
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait() failed: %v", err)
+	}
+	want := []time.Duration{500 * time.Millisecond, 250 * time.Millisecond}
+	if diff := cmp.Diff(want, c.sleeps); diff != "" {
+		t.Errorf("Wait() sleeps (-want,+got):\n%v", diff)
+	}
+}
+
+func TestLimiter_WaitMetric(t *testing.T) {
+	ctx := metrics.SetBundleID(context.Background(), "bundle")
+	ctx = metrics.SetPTransformID(ctx, "transform")
+
+	l, _ := newFakeLimiter(Options{InitialRate: 4})
+	l.Wait(ctx)
+	l.Wait(ctx)
+	l.Wait(ctx)
+
+	counters := metrics.ResultsExtractor(ctx).AllMetrics().Counters()
+	if len(counters) != 1 {
+		t.Fatalf("counters = %v, want 1 throttling counter", counters)
+	}
+	got := counters[0]
+	if got.Key.Namespace != metrics.ThrottlingNamespace || got.Key.Name != metrics.ThrottlingMsecs || got.Key.Step != "transform" {
+		t.Errorf("counter key = %+v, want throttling counter of transform", got.Key)
+	}
+	if got.Committed != 500 {
+		t.Errorf("throttled msecs = %v, want 500", got.Committed)
+	}
+}
+
+func TestLimiter_Report(t *testing.T) {
+	opts := Options{InitialRate: 8, MinRate: 1, MaxRate: 10, Increase: 1.5, MaxRejectionRate: 0.25}
+	l, c := newFakeLimiter(opts)
+
+	// reports reports the outcomes of an interval, and returns the rate
+	// once it's over.
+	reports := func(outcomes ...bool) float64 {
+		for _, rejected := range outcomes {
+			l.Report(rejected)
+		}
+		c.now = c.now.Add(time.Second)
+		return l.Rate()
+	}
+	tests := []struct {
+		name     string
+		outcomes []bool
+		want     float64
+	}{
+		{name: "accepted", outcomes: []bool{false, false}, want: 9.5},
+		{name: "capped", outcomes: []bool{false}, want: 10},
+		{name: "fewRejected", outcomes: []bool{true, false, false, false}, want: 10},
+		{name: "rejected", outcomes: []bool{true, true, false}, want: 5},
+		{name: "moreRejected", outcomes: []bool{true}, want: 2.5},
+		{name: "floored", outcomes: []bool{true, true}, want: 1.25},
+		{name: "floor", outcomes: []bool{true}, want: 1},
+		{name: "recovering", outcomes: []bool{false}, want: 2.5},
+	}
+	for _, test := range tests {
+		if got := reports(test.outcomes...); got != test.want {
+			t.Errorf("%v: Rate() = %v, want %v", test.name, got, test.want)
+		}
+	}
+
+	// The rate only changes once the interval is over.
+	l.Report(true)
+	l.Report(true)
+	if got, want := l.Rate(), 2.5; got != want {
+		t.Errorf("Rate() within interval = %v, want %v", got, want)
+	}
+	c.now = c.now.Add(time.Second)
+	if got, want := l.Rate(), 1.25; got != want {
+		t.Errorf("Rate() after interval = %v, want %v", got, want)
+	}
+	// Intervals without requests don't change the rate.
+	c.now = c.now.Add(time.Second)
+	if got, want := l.Rate(), 1.25; got != want {
+		t.Errorf("Rate() after idle interval = %v, want %v", got, want)
+	}
+}
+
+func TestKeyedLimiter(t *testing.T) {
+	c := &fakeClock{now: time.Unix(1000, 0)}
+	k := NewKeyed(Options{InitialRate: 4})
+	k.now, k.sleep = c.Now, c.Sleep
+
+	k.Report("a", true)
+	k.Report("b", false)
+	c.now = c.now.Add(time.Second)
+
+	if got, want := k.Get("a").Rate(), 2.0; got != want {
+		t.Errorf("Rate(a) = %v, want %v", got, want)
+	}
+	if got, want := k.Get("b").Rate(), 5.0; got != want {
+		t.Errorf("Rate(b) = %v, want %v", got, want)
+	}
+	if k.Get("a") != k.Get("a") {
+		t.Errorf("Get(a) returned different limiters")
+	}
+}
+
+func TestShared(t *testing.T) {
+	a := Shared("TestShared", Options{InitialRate: 3})
+	if b := Shared("TestShared", Options{InitialRate: 5}); a != b {
+		t.Errorf("Shared() returned different limiters for the same name")
+	}
+	if got, want := a.Rate(), 3.0; got != want {
+		t.Errorf("Rate() = %v, want %v", got, want)
+	}
+	if Shared("TestShared2", Options{}) == a {
+		t.Errorf("Shared() returned the same limiter for different names")
+	}
+	if SharedKeyed("TestShared", Options{}) != SharedKeyed("TestShared", Options{}) {
+		t.Errorf("SharedKeyed() returned different limiters for the same name")
+	}
+}
+
+func TestOptions(t *testing.T) {
+	got := Options{MaxRate: 5}.withDefaults()
+	want := Options{InitialRate: 5, MinRate: 0.1, MaxRate: 5, Burst: 1, Increase: 1, Decrease: 0.5, Interval: time.Second}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("withDefaults() (-want,+got):\n%v", diff)
+	}
+	if got := (Options{}).withDefaults().MaxRate; !math.IsInf(got, 1) {
+		t.Errorf("withDefaults().MaxRate = %v, want unlimited", got)
+	}
+
+	invalid := []Options{
+		{InitialRate: -1},
+		{Decrease: 1},
+		{Decrease: -0.5},
+		{MaxRejectionRate: 2},
+		{MinRate: 10, MaxRate: 5},
+	}
+	for _, opts := range invalid {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("New(%+v) didn't panic", opts)
+				}
+			}()
+			New(opts)
+		}()
+	}
+}
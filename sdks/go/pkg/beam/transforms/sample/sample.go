@@ -0,0 +1,247 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sample contains transforms for sampling the elements of a
+// PCollection.
+//
+// FixedSize and FixedSizePerKey select elements uniformly at random, and
+// Any selects arbitrary elements cheaply. They're implemented as CombineFns,
+// so runners may partially sample elements before shuffling them.
+// Fraction selects each element independently with a given probability.
+package sample
+
+import (
+	"bytes"
+	"container/heap"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"time"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/typex"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/register"
+)
+
+func init() {
+	beam.RegisterType(reflect.TypeOf((*reservoir)(nil)).Elem())
+	register.Combiner3[reservoir, beam.T, []beam.T](&reservoirFn{})
+	register.DoFn2x0[[]beam.T, func(beam.T)](&flattenFn{})
+	register.Emitter1[beam.T]()
+	register.DoFn2x0[beam.T, func(beam.T)](&fractionFn{})
+}
+
+// FixedSize returns a uniformly random sample of up to n elements of a
+// PCollection<T>, as a single element PCollection<[]T>. All elements are
+// returned if there are n or fewer. For example:
+//
+//	col := beam.Create(s, 1, 2, 3, 4, 5)
+//	three := sample.FixedSize(s, col, 3) // PCollection<[]int> with 3 of the elements.
+func FixedSize(s beam.Scope, col beam.PCollection, n int) beam.PCollection {
+	s = s.Scope(fmt.Sprintf("sample.FixedSize(%v)", n))
+
+	t := beam.ValidateNonCompositeType(col)
+	validate(s, n)
+	return beam.Combine(s, newReservoirFn(n, t.Type(), false), col)
+}
+
+// FixedSizePerKey returns a uniformly random sample of up to n values of
+// each key of a PCollection<KV<K,V>>, as a PCollection<KV<K,[]V>>.
+func FixedSizePerKey(s beam.Scope, col beam.PCollection, n int) beam.PCollection {
+	s = s.Scope(fmt.Sprintf("sample.FixedSizePerKey(%v)", n))
+
+	if !typex.IsKV(col.Type()) {
+		panic(fmt.Sprintf("%v: input must be a KV, got %v", s, col.Type()))
+	}
+	validate(s, n)
+	return beam.CombinePerKey(s, newReservoirFn(n, col.Type().Components()[1].Type(), false), col)
+}
+
+// Any returns up to n arbitrary elements of a PCollection<T>, as a
+// PCollection<T>. Unlike FixedSize, the elements aren't chosen at random,
+// which makes Any cheaper when any subset of the elements will do.
+func Any(s beam.Scope, col beam.PCollection, n int) beam.PCollection {
+	s = s.Scope(fmt.Sprintf("sample.Any(%v)", n))
+
+	t := beam.ValidateNonCompositeType(col)
+	validate(s, n)
+	return beam.ParDo(s, &flattenFn{}, beam.Combine(s, newReservoirFn(n, t.Type(), true), col))
+}
+
+// Fraction returns each element of a PCollection<T> independently with
+// probability p, as a PCollection<T>. The number of returned elements isn't
+// fixed, but is p times the number of elements on average.
+func Fraction(s beam.Scope, col beam.PCollection, p float64) beam.PCollection {
+	s = s.Scope(fmt.Sprintf("sample.Fraction(%v)", p))
+
+	if p < 0 || p > 1 {
+		panic(fmt.Sprintf("%v: probability must be in [0, 1], got %v", s, p))
+	}
+	return beam.ParDo(s, &fractionFn{P: p}, col)
+}
+
+func validate(s beam.Scope, n int) {
+	if n < 0 {
+		panic(fmt.Sprintf("%v: sample size must not be negative, got %v", s, n))
+	}
+}
+
+// reservoir is a min-heap of up to N encoded elements, ordered by the random
+// weights they were assigned when added. Keeping the elements with the
+// largest weights is a uniform sample, which is preserved when reservoirs are
+// merged. Elements are kept encoded, so the reservoir has a schema coder
+// independent of the element type.
+type reservoir struct {
+	Weights  []float64
+	Elements [][]byte
+}
+
+func (r *reservoir) Len() int           { return len(r.Weights) }
+func (r *reservoir) Less(i, j int) bool { return r.Weights[i] < r.Weights[j] }
+func (r *reservoir) Swap(i, j int) {
+	r.Weights[i], r.Weights[j] = r.Weights[j], r.Weights[i]
+	r.Elements[i], r.Elements[j] = r.Elements[j], r.Elements[i]
+}
+
+type weighted struct {
+	w   float64
+	elm []byte
+}
+
+func (r *reservoir) Push(x any) {
+	e := x.(weighted)
+	r.Weights = append(r.Weights, e.w)
+	r.Elements = append(r.Elements, e.elm)
+}
+
+func (r *reservoir) Pop() any {
+	n := len(r.Weights) - 1
+	e := weighted{r.Weights[n], r.Elements[n]}
+	r.Weights, r.Elements = r.Weights[:n], r.Elements[:n]
+	return e
+}
+
+// accepts returns whether an element with the weight would be kept in a
+// reservoir of size n.
+func (r *reservoir) accepts(w float64, n int) bool {
+	return r.Len() < n || (n > 0 && w > r.Weights[0])
+}
+
+// add adds the encoded element with the weight, evicting the element with
+// the smallest weight if the reservoir is full.
+func (r *reservoir) add(w float64, elm []byte, n int) {
+	if !r.accepts(w, n) {
+		return
+	}
+	if r.Len() < n {
+		heap.Push(r, weighted{w, elm})
+		return
+	}
+	r.Weights[0], r.Elements[0] = w, elm
+	heap.Fix(r, 0)
+}
+
+// reservoirFn samples up to N elements with a reservoir.
+type reservoirFn struct {
+	// N is the number of elements to keep.
+	N int `json:"n"`
+	// Type is the element type.
+	Type beam.EncodedType `json:"type"`
+	// Arbitrary indicates that any elements may be kept, so elements are
+	// kept in the order they're added instead of at random.
+	Arbitrary bool `json:"arbitrary"`
+
+	enc beam.ElementEncoder
+	dec beam.ElementDecoder
+	rnd *rand.Rand
+}
+
+func newReservoirFn(n int, t reflect.Type, arbitrary bool) *reservoirFn {
+	return &reservoirFn{N: n, Type: beam.EncodedType{T: t}, Arbitrary: arbitrary}
+}
+
+func (f *reservoirFn) Setup() {
+	f.enc = beam.NewElementEncoder(f.Type.T)
+	f.dec = beam.NewElementDecoder(f.Type.T)
+	f.rnd = rand.New(rand.NewSource(time.Now().UnixNano()))
+}
+
+func (f *reservoirFn) CreateAccumulator() reservoir {
+	return reservoir{}
+}
+
+func (f *reservoirFn) AddInput(r reservoir, elm beam.T) (reservoir, error) {
+	// Arbitrary samples use a constant weight, so a full reservoir rejects
+	// all further elements.
+	w := 0.0
+	if !f.Arbitrary {
+		w = f.rnd.Float64()
+	}
+	if !r.accepts(w, f.N) {
+		return r, nil
+	}
+	var buf bytes.Buffer
+	if err := f.enc.Encode(elm, &buf); err != nil {
+		return r, err
+	}
+	r.add(w, buf.Bytes(), f.N)
+	return r, nil
+}
+
+func (f *reservoirFn) MergeAccumulators(a, b reservoir) reservoir {
+	for i, w := range b.Weights {
+		a.add(w, b.Elements[i], f.N)
+	}
+	return a
+}
+
+func (f *reservoirFn) ExtractOutput(r reservoir) ([]beam.T, error) {
+	out := make([]beam.T, 0, r.Len())
+	for _, b := range r.Elements {
+		elm, err := f.dec.Decode(bytes.NewReader(b))
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, elm)
+	}
+	return out, nil
+}
+
+// flattenFn emits the elements of a slice.
+type flattenFn struct{}
+
+func (f *flattenFn) ProcessElement(elms []beam.T, emit func(beam.T)) {
+	for _, elm := range elms {
+		emit(elm)
+	}
+}
+
+// fractionFn keeps each element with probability P.
+type fractionFn struct {
+	// P is the probability of keeping an element.
+	P float64 `json:"p"`
+
+	rnd *rand.Rand
+}
+
+func (f *fractionFn) Setup() {
+	f.rnd = rand.New(rand.NewSource(time.Now().UnixNano()))
+}
+
+func (f *fractionFn) ProcessElement(elm beam.T, emit func(beam.T)) {
+	if f.rnd.Float64() < f.P {
+		emit(elm)
+	}
+}
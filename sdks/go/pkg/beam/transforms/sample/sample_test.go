@@ -0,0 +1,173 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sample
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam"
+	_ "github.com/apache/beam/sdks/v2/go/pkg/beam/runners/prism"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/testing/passert"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/testing/ptest"
+	"github.com/google/go-cmp/cmp"
+)
+
+// Prism lifts combines, so the reservoirs are partially combined, encoded and
+// merged across bundles.
+func TestMain(m *testing.M) {
+	os.Exit(ptest.MainRetWithDefault(m, "prism"))
+}
+
+func init() {
+	beam.RegisterFunction(checkSample)
+	beam.RegisterFunction(checkSamplePerKey)
+	beam.RegisterFunction(toKV)
+}
+
+func seq(n int) []int {
+	var vs []int
+	for i := 0; i < n; i++ {
+		vs = append(vs, i)
+	}
+	return vs
+}
+
+// checkSample returns the size of the sample, or an error if it has repeated
+// or unknown elements.
+func checkSample(sample []int) (int, error) {
+	seen := map[int]bool{}
+	for _, v := range sample {
+		if seen[v] || v < 0 || v >= 100 {
+			return 0, fmt.Errorf("invalid sample %v", sample)
+		}
+		seen[v] = true
+	}
+	return len(sample), nil
+}
+
+func checkSamplePerKey(k string, sample []int) (string, error) {
+	n, err := checkSample(sample)
+	return fmt.Sprintf("%v:%v", k, n), err
+}
+
+func toKV(v int) (string, int) {
+	return fmt.Sprint(v % 2), v
+}
+
+func TestFixedSize(t *testing.T) {
+	tests := []struct {
+		n, size, want int
+	}{
+		{n: 10, size: 100, want: 10},
+		{n: 100, size: 10, want: 10},
+		{n: 0, size: 10, want: 0},
+	}
+	p, s := beam.NewPipelineWithRoot()
+	for _, test := range tests {
+		col := beam.CreateList(s, seq(test.size))
+		sizes := beam.ParDo(s, checkSample, FixedSize(s, col, test.n))
+		passert.Equals(s, sizes, test.want)
+	}
+	if err := ptest.Run(p); err != nil {
+		t.Errorf("FixedSize() failed: %v", err)
+	}
+}
+
+func TestFixedSizePerKey(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	col := beam.ParDo(s, toKV, beam.CreateList(s, seq(100)))
+	sizes := beam.ParDo(s, checkSamplePerKey, FixedSizePerKey(s, col, 5))
+	passert.Equals(s, sizes, "0:5", "1:5")
+	if err := ptest.Run(p); err != nil {
+		t.Errorf("FixedSizePerKey() failed: %v", err)
+	}
+}
+
+func TestAny(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	col := beam.CreateList(s, seq(100))
+	passert.Count(s, Any(s, col, 7), "any", 7)
+	passert.Count(s, Any(s, beam.Create(s, 1, 2), 7), "all", 2)
+	if err := ptest.Run(p); err != nil {
+		t.Errorf("Any() failed: %v", err)
+	}
+}
+
+func TestFraction(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	col := beam.CreateList(s, seq(100))
+	passert.Equals(s, Fraction(s, col, 1), beam.CreateList(s, seq(100)))
+	passert.Empty(s, Fraction(s, col, 0))
+	if err := ptest.Run(p); err != nil {
+		t.Errorf("Fraction() failed: %v", err)
+	}
+}
+
+// TestReservoirFn_Uniform checks that merged reservoirs sample uniformly, by
+// sampling one of four elements split across two reservoirs many times.
+func TestReservoirFn_Uniform(t *testing.T) {
+	fn := newReservoirFn(1, reflect.TypeOf(0), false)
+	fn.Setup()
+	counts := map[any]int{}
+	const trials = 4000
+	for i := 0; i < trials; i++ {
+		a, b := fn.CreateAccumulator(), fn.CreateAccumulator()
+		for _, v := range []int{0, 1, 2} {
+			a, _ = fn.AddInput(a, v)
+		}
+		b, _ = fn.AddInput(b, 3)
+		out, err := fn.ExtractOutput(fn.MergeAccumulators(a, b))
+		if err != nil {
+			t.Fatalf("ExtractOutput() failed: %v", err)
+		}
+		counts[out[0]]++
+	}
+	for v := 0; v < 4; v++ {
+		// Each element is expected trials/4 = 1000 times; the bounds are over
+		// 7 standard deviations wide.
+		if c := counts[v]; c < 800 || c > 1200 {
+			t.Errorf("element %v sampled %v times in %v trials, want about %v: %v", v, c, trials, trials/4, counts)
+		}
+	}
+}
+
+func TestReservoirFn_Arbitrary(t *testing.T) {
+	fn := newReservoirFn(3, reflect.TypeOf(""), true)
+	fn.Setup()
+	a, b := fn.CreateAccumulator(), fn.CreateAccumulator()
+	for _, v := range []string{"a", "b"} {
+		a, _ = fn.AddInput(a, v)
+	}
+	for _, v := range []string{"c", "d", "e"} {
+		b, _ = fn.AddInput(b, v)
+	}
+	out, err := fn.ExtractOutput(fn.MergeAccumulators(a, b))
+	if err != nil {
+		t.Fatalf("ExtractOutput() failed: %v", err)
+	}
+	var got []string
+	for _, v := range out {
+		got = append(got, v.(string))
+	}
+	sort.Strings(got)
+	if want := []string{"a", "b", "c"}; !cmp.Equal(got, want) {
+		t.Errorf("Any sample = %v, want %v", got, want)
+	}
+}
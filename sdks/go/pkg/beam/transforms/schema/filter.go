@@ -0,0 +1,86 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/funcx"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/util/reflectx"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/register"
+)
+
+func init() {
+	register.DoFn2x0[beam.T, func(beam.T)](&filterFn{})
+	register.Emitter1[beam.T]()
+}
+
+var (
+	sig = funcx.MakePredicate(beam.TType) // T -> bool
+)
+
+// Filter keeps the elements of a PCollection<A> of structs for which the
+// predicate returns true for the named field. The predicate must be of the
+// form: F -> bool, where F is the type of the field. It returns a
+// PCollection of the same type as the input. For example:
+//
+//	adults := schema.Filter(s, users, "Age", func(age int) bool {
+//	    return age >= 18
+//	})
+//
+// If a nil pointer is found along a nested path, the predicate is called
+// with the zero value of the field.
+//
+// Filter panics if the field doesn't exist, or the predicate doesn't match its
+// type.
+func Filter(s beam.Scope, col beam.PCollection, field string, pred any) beam.PCollection {
+	s = s.Scope("schema.Filter")
+
+	t, err := elementType(col)
+	if err != nil {
+		panic(fmt.Sprintf("schema.Filter: %v", err))
+	}
+	f, err := resolve(t, field)
+	if err != nil {
+		panic(fmt.Sprintf("schema.Filter: %v", err))
+	}
+	funcx.MustSatisfy(pred, funcx.Replace(sig, beam.TType, f.Type))
+	return beam.ParDo(s, &filterFn{Path: f.Index, Predicate: beam.EncodedFunc{Fn: reflectx.MakeFunc(pred)}}, col)
+}
+
+type filterFn struct {
+	// Path is the index path of the field.
+	Path []int `json:"path"`
+	// Predicate is the encoded predicate.
+	Predicate beam.EncodedFunc `json:"predicate"`
+
+	fn reflectx.Func1x1
+	t  reflect.Type
+}
+
+func (f *filterFn) Setup() {
+	f.fn = reflectx.ToFunc1x1(f.Predicate.Fn)
+	f.t = f.Predicate.Fn.Type().In(0)
+}
+
+func (f *filterFn) ProcessElement(elm beam.T, emit func(beam.T)) {
+	v := getOrZero(reflect.ValueOf(elm), f.Path, f.t)
+	if f.fn.Call1x1(v.Interface()).(bool) {
+		emit(elm)
+	}
+}
@@ -0,0 +1,388 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"fmt"
+	"go/token"
+	"reflect"
+	"strings"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/register"
+)
+
+func init() {
+	beam.RegisterType(reflect.TypeOf((*aggAccum)(nil)).Elem())
+	register.DoFn1x2[beam.X, beam.Y, beam.X](&keyFn{})
+	register.Combiner2[aggAccum, beam.X](&aggregateFn{})
+	register.DoFn2x1[beam.X, aggAccum, beam.Y](&aggregateOutputFn{})
+}
+
+// aggOp is an aggregation operation.
+type aggOp string
+
+const (
+	opCount aggOp = "count"
+	opSum   aggOp = "sum"
+	opMin   aggOp = "min"
+	opMax   aggOp = "max"
+	opMean  aggOp = "mean"
+)
+
+// Aggregate is an aggregation of a field over the elements of a group,
+// output as a named field. Aggregates are created with Count, Sum, Min, Max
+// and Mean.
+type Aggregate struct {
+	op    aggOp
+	field string
+	as    string
+}
+
+// Count counts the elements in each group, output as an int64 field.
+func Count(as string) Aggregate {
+	return Aggregate{op: opCount, as: as}
+}
+
+// Sum sums a numeric field in each group. Integer fields are summed as an
+// int64 field, and floating point fields as a float64 field.
+func Sum(field, as string) Aggregate {
+	return Aggregate{op: opSum, field: field, as: as}
+}
+
+// Min finds the minimum of a numeric or string field in each group, output
+// as a field of the same type.
+func Min(field, as string) Aggregate {
+	return Aggregate{op: opMin, field: field, as: as}
+}
+
+// Max finds the maximum of a numeric or string field in each group, output
+// as a field of the same type.
+func Max(field, as string) Aggregate {
+	return Aggregate{op: opMax, field: field, as: as}
+}
+
+// Mean averages a numeric field in each group, output as a float64 field.
+func Mean(field, as string) Aggregate {
+	return Aggregate{op: opMean, field: field, as: as}
+}
+
+// valueKind classifies the types that can be aggregated.
+type valueKind int
+
+const (
+	kindNone valueKind = iota
+	kindInt
+	kindFloat
+	kindString
+)
+
+func kindOf(t reflect.Type) valueKind {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return kindInt
+	case reflect.Float32, reflect.Float64:
+		return kindFloat
+	case reflect.String:
+		return kindString
+	}
+	return kindNone
+}
+
+// aggSpec is the serializable form of a resolved Aggregate.
+type aggSpec struct {
+	Op   aggOp     `json:"op"`
+	Path []int     `json:"path,omitempty"`
+	Kind valueKind `json:"kind"`
+}
+
+// spec resolves the aggregate against the element type t, returning its
+// runtime spec and output field.
+func (a Aggregate) spec(t reflect.Type) (aggSpec, reflect.StructField, error) {
+	name := a.as
+	if name == "" || !token.IsIdentifier(name) {
+		return aggSpec{}, reflect.StructField{}, fmt.Errorf("invalid output field name %q for %v aggregate", name, a.op)
+	}
+	goName := strings.ToUpper(name[:1]) + name[1:]
+	if a.op == opCount {
+		return aggSpec{Op: opCount}, structField(name, goName, reflect.TypeOf(int64(0))), nil
+	}
+	f, err := resolve(t, a.field)
+	if err != nil {
+		return aggSpec{}, reflect.StructField{}, err
+	}
+	kind := kindOf(f.Type)
+	var out reflect.Type
+	switch {
+	case a.op == opMin || a.op == opMax:
+		if kind == kindNone {
+			return aggSpec{}, reflect.StructField{}, fmt.Errorf("can't compute %v of field %q of type %v", a.op, a.field, f.Type)
+		}
+		out = f.Type
+	case kind == kindInt && a.op == opSum:
+		out = reflect.TypeOf(int64(0))
+	case (kind == kindInt || kind == kindFloat):
+		out = reflect.TypeOf(float64(0))
+	default:
+		return aggSpec{}, reflect.StructField{}, fmt.Errorf("can't compute %v of field %q of type %v", a.op, a.field, f.Type)
+	}
+	return aggSpec{Op: a.op, Path: f.Index, Kind: kind}, structField(name, goName, out), nil
+}
+
+// Group groups the elements of a PCollection<A> of structs by the named key
+// fields and computes the aggregates for each group. It returns a
+// PCollection of a new struct type containing the key fields followed by a
+// field for each aggregate. For example:
+//
+//	type Purchase struct {
+//	    User, Country string
+//	    Amount        float64
+//	}
+//
+//	totals := schema.Group(s, purchases, []string{"Country"},
+//	    schema.Count("purchases"),
+//	    schema.Sum("Amount", "total"),
+//	    schema.Max("Amount", "largest"))
+//
+// Here, "totals" is a PCollection<struct{Country string; Purchases int64;
+// Total float64; Largest float64}>, where the aggregate fields have the
+// schema names "purchases", "total" and "largest". Aggregates are computed
+// with a CombineFn, so they're partially computed before the shuffle by
+// runners that support combiner lifting.
+//
+// Group panics if there are no key fields, if a field doesn't exist or can't
+// be aggregated, or if two output fields have the same name.
+func Group(s beam.Scope, col beam.PCollection, keys []string, aggs ...Aggregate) beam.PCollection {
+	s = s.Scope("schema.Group")
+
+	t, err := elementType(col)
+	if err != nil {
+		panic(fmt.Sprintf("schema.Group: %v", err))
+	}
+	if len(keys) == 0 {
+		panic("schema.Group: no key fields")
+	}
+	kfs, err := resolveAll(t, keys)
+	if err != nil {
+		panic(fmt.Sprintf("schema.Group: %v", err))
+	}
+	var outFields []reflect.StructField
+	for _, f := range kfs {
+		outFields = append(outFields, structField(f.Name, f.GoName, f.Type))
+	}
+	var specs []aggSpec
+	for _, a := range aggs {
+		spec, sf, err := a.spec(t)
+		if err != nil {
+			panic(fmt.Sprintf("schema.Group: %v", err))
+		}
+		specs = append(specs, spec)
+		outFields = append(outFields, sf)
+	}
+	out, err := makeStruct(outFields)
+	if err != nil {
+		panic(fmt.Sprintf("schema.Group: %v", err))
+	}
+
+	key := keyType(kfs)
+	keyed := beam.ParDo(s, &keyFn{Paths: indices(kfs), Key: beam.EncodedType{T: key}}, col, beam.TypeDefinition{Var: beam.YType, T: key})
+	accums := beam.CombinePerKey(s, &aggregateFn{Aggs: specs}, keyed)
+	return beam.ParDo(s, &aggregateOutputFn{Aggs: specs, Out: beam.EncodedType{T: out}}, accums, beam.TypeDefinition{Var: beam.YType, T: out})
+}
+
+// keyType returns a struct type with a field for each of the fields, used
+// as the grouping key.
+func keyType(fs []field) reflect.Type {
+	var sfs []reflect.StructField
+	for i, f := range fs {
+		sfs = append(sfs, reflect.StructField{Name: fmt.Sprintf("F%d", i), Type: f.Type})
+	}
+	return reflect.StructOf(sfs)
+}
+
+// keyFn keys each element by the values of its fields at the paths.
+type keyFn struct {
+	// Paths are the index paths of the key fields.
+	Paths [][]int `json:"paths"`
+	// Key is the key struct type.
+	Key beam.EncodedType `json:"key"`
+}
+
+func (f *keyFn) ProcessElement(elm beam.X) (beam.Y, beam.X) {
+	return project(reflect.ValueOf(elm), f.Paths, f.Key.T).Interface(), elm
+}
+
+// aggAccum is the accumulator of aggregateFn, with a slot in each slice for
+// each aggregate.
+type aggAccum struct {
+	Counts  []int64
+	Ints    []int64
+	Floats  []float64
+	Strings []string
+	Set     []bool
+}
+
+// aggregateFn computes the aggregates of the elements in a group. It has no
+// ExtractOutput, so its output is the accumulator.
+type aggregateFn struct {
+	// Aggs are the aggregates to compute.
+	Aggs []aggSpec `json:"aggs"`
+}
+
+func (f *aggregateFn) CreateAccumulator() aggAccum {
+	n := len(f.Aggs)
+	return aggAccum{
+		Counts:  make([]int64, n),
+		Ints:    make([]int64, n),
+		Floats:  make([]float64, n),
+		Strings: make([]string, n),
+		Set:     make([]bool, n),
+	}
+}
+
+func (f *aggregateFn) AddInput(a aggAccum, elm beam.X) aggAccum {
+	v := reflect.ValueOf(elm)
+	for i, agg := range f.Aggs {
+		if agg.Op == opCount {
+			a.Counts[i]++
+			continue
+		}
+		fv, ok := get(v, agg.Path)
+		if !ok {
+			// Fields behind nil pointers don't contribute to aggregates.
+			continue
+		}
+		add(&a, i, agg, fv)
+	}
+	return a
+}
+
+// add adds the value of a field to slot i of the accumulator.
+func add(a *aggAccum, i int, agg aggSpec, v reflect.Value) {
+	var n int64
+	var x float64
+	var str string
+	switch agg.Kind {
+	case kindInt:
+		if v.CanInt() {
+			n = v.Int()
+		} else {
+			n = int64(v.Uint())
+		}
+		x = float64(n)
+	case kindFloat:
+		x = v.Float()
+	case kindString:
+		str = v.String()
+	}
+	switch agg.Op {
+	case opSum:
+		a.Ints[i] += n
+		a.Floats[i] += x
+	case opMean:
+		a.Floats[i] += x
+		a.Counts[i]++
+	case opMin, opMax:
+		var less bool
+		switch agg.Kind {
+		case kindInt:
+			less = n < a.Ints[i]
+		case kindFloat:
+			less = x < a.Floats[i]
+		case kindString:
+			less = str < a.Strings[i]
+		}
+		// For max, equal values may replace the current one harmlessly.
+		if !a.Set[i] || less == (agg.Op == opMin) {
+			a.Ints[i], a.Floats[i], a.Strings[i] = n, x, str
+			a.Set[i] = true
+		}
+	}
+}
+
+func (f *aggregateFn) MergeAccumulators(a, b aggAccum) aggAccum {
+	for i, agg := range f.Aggs {
+		switch agg.Op {
+		case opCount, opSum, opMean:
+			a.Counts[i] += b.Counts[i]
+			a.Ints[i] += b.Ints[i]
+			a.Floats[i] += b.Floats[i]
+		case opMin, opMax:
+			if !b.Set[i] {
+				continue
+			}
+			switch agg.Kind {
+			case kindInt:
+				add(&a, i, agg, reflect.ValueOf(b.Ints[i]))
+			case kindFloat:
+				add(&a, i, agg, reflect.ValueOf(b.Floats[i]))
+			case kindString:
+				add(&a, i, agg, reflect.ValueOf(b.Strings[i]))
+			}
+		}
+	}
+	return a
+}
+
+// aggregateOutputFn builds the output struct from a key and its accumulator.
+type aggregateOutputFn struct {
+	// Aggs are the computed aggregates.
+	Aggs []aggSpec `json:"aggs"`
+	// Out is the output struct type.
+	Out beam.EncodedType `json:"out"`
+}
+
+func (f *aggregateOutputFn) ProcessElement(key beam.X, a aggAccum) beam.Y {
+	out := reflect.New(f.Out.T).Elem()
+	k := reflect.ValueOf(key)
+	nk := k.NumField()
+	for i := 0; i < nk; i++ {
+		out.Field(i).Set(k.Field(i))
+	}
+	for i, agg := range f.Aggs {
+		fv := out.Field(nk + i)
+		switch agg.Op {
+		case opCount:
+			fv.SetInt(a.Counts[i])
+		case opSum:
+			if agg.Kind == kindInt {
+				fv.SetInt(a.Ints[i])
+			} else {
+				fv.SetFloat(a.Floats[i])
+			}
+		case opMean:
+			if a.Counts[i] > 0 {
+				fv.SetFloat(a.Floats[i] / float64(a.Counts[i]))
+			}
+		case opMin, opMax:
+			if !a.Set[i] {
+				continue
+			}
+			var v reflect.Value
+			switch agg.Kind {
+			case kindInt:
+				v = reflect.ValueOf(a.Ints[i])
+			case kindFloat:
+				v = reflect.ValueOf(a.Floats[i])
+			case kindString:
+				v = reflect.ValueOf(a.Strings[i])
+			}
+			fv.Set(v.Convert(fv.Type()))
+		}
+	}
+	return out.Interface()
+}
@@ -0,0 +1,124 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/transforms/join"
+)
+
+// JoinKind is the kind of join performed by Join.
+type JoinKind int
+
+const (
+	// InnerJoin outputs the pairs of left and right elements with equal keys.
+	InnerJoin JoinKind = iota
+	// LeftOuterJoin also outputs left elements without a matching right
+	// element, with a nil right.
+	LeftOuterJoin
+	// RightOuterJoin also outputs right elements without a matching left
+	// element, with a nil left.
+	RightOuterJoin
+	// FullOuterJoin outputs the elements of both outer joins.
+	FullOuterJoin
+)
+
+func (k JoinKind) String() string {
+	switch k {
+	case InnerJoin:
+		return "InnerJoin"
+	case LeftOuterJoin:
+		return "LeftOuterJoin"
+	case RightOuterJoin:
+		return "RightOuterJoin"
+	case FullOuterJoin:
+		return "FullOuterJoin"
+	}
+	return fmt.Sprintf("JoinKind(%d)", int(k))
+}
+
+// Join joins a PCollection<L> and a PCollection<R> of structs on equal values
+// of the named fields. Each field is named either as "field", if it has the
+// same name on both sides, or as "leftField=rightField". Joined fields must
+// have the same type on both sides.
+//
+// It returns a PCollection of a struct type with a field named "left" holding
+// the left element and a field named "right" holding the right element. The
+// side of an outer join that may be missing is a pointer, which is nil when
+// there was no matching element. For example:
+//
+//	joined := schema.Join(s, schema.LeftOuterJoin, users, orders, "Id=UserId")
+//
+// Here, "joined" is a PCollection<struct{Left User; Right *Order}>.
+//
+// Join panics if there are no join fields, a field doesn't exist or the types
+// of joined fields differ.
+func Join(s beam.Scope, kind JoinKind, left, right beam.PCollection, on ...string) beam.PCollection {
+	s = s.Scope("schema.Join")
+
+	if kind < InnerJoin || kind > FullOuterJoin {
+		panic(fmt.Sprintf("schema.Join: invalid join kind %v", kind))
+	}
+	if len(on) == 0 {
+		panic("schema.Join: no join fields")
+	}
+	lt, err := elementType(left)
+	if err != nil {
+		panic(fmt.Sprintf("schema.Join: left: %v", err))
+	}
+	rt, err := elementType(right)
+	if err != nil {
+		panic(fmt.Sprintf("schema.Join: right: %v", err))
+	}
+	var lfs, rfs []field
+	for _, o := range on {
+		ln, rn := o, o
+		if i := strings.Index(o, "="); i >= 0 {
+			ln, rn = o[:i], o[i+1:]
+		}
+		lf, err := resolve(lt, ln)
+		if err != nil {
+			panic(fmt.Sprintf("schema.Join: left: %v", err))
+		}
+		rf, err := resolve(rt, rn)
+		if err != nil {
+			panic(fmt.Sprintf("schema.Join: right: %v", err))
+		}
+		if lf.Type != rf.Type {
+			panic(fmt.Sprintf("schema.Join: can't join left field %q of type %v with right field %q of type %v", ln, lf.Type, rn, rf.Type))
+		}
+		lfs, rfs = append(lfs, lf), append(rfs, rf)
+	}
+
+	key := keyType(lfs)
+	lk := beam.ParDo(s, &keyFn{Paths: indices(lfs), Key: beam.EncodedType{T: key}}, left, beam.TypeDefinition{Var: beam.YType, T: key})
+	rk := beam.ParDo(s, &keyFn{Paths: indices(rfs), Key: beam.EncodedType{T: key}}, right, beam.TypeDefinition{Var: beam.YType, T: key})
+	var joined beam.PCollection
+	switch kind {
+	case InnerJoin:
+		joined = join.Inner(s, lk, rk)
+	case LeftOuterJoin:
+		joined = join.LeftOuter(s, lk, rk)
+	case RightOuterJoin:
+		joined = join.RightOuter(s, lk, rk)
+	case FullOuterJoin:
+		joined = join.FullOuter(s, lk, rk)
+	}
+	return beam.DropKey(s, joined)
+}
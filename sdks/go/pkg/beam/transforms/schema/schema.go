@@ -0,0 +1,178 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package schema contains relational transforms over PCollections of
+// schema types, such as registered structs: field selection, filtering on
+// fields, grouping with aggregations and joins on fields.
+//
+// Fields are named by their schema names: the name in the field's "beam"
+// struct tag if present, or the Go field name otherwise. Nested fields are
+// named with dot separated paths, such as "user.address.city". Pointers
+// along a path are followed, and a nil pointer is treated as the zero value
+// of the field it would lead to.
+//
+// Transforms produce PCollections of new struct types, which are built
+// when the pipeline is constructed and encoded with schema row coders, so
+// no additional type registration is needed for their outputs.
+//
+// Inputs must be PCollections of structs, or pointers to structs. The Go SDK
+// has no dynamic row type, so PCollections of rows decoded from another SDK
+// must first be converted to a struct with the row's schema.
+package schema
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam"
+)
+
+// field is a resolved field path in a struct type.
+type field struct {
+	// Name is the schema name of the last field in the path.
+	Name string
+	// GoName is the Go name of the last field in the path.
+	GoName string
+	// Index is the sequence of field indices from the root type.
+	Index []int
+	// Type is the type of the field.
+	Type reflect.Type
+}
+
+// schemaName returns the schema name of a struct field.
+func schemaName(sf reflect.StructField) string {
+	if tag := sf.Tag.Get("beam"); tag != "" {
+		if name := strings.Split(tag, ",")[0]; name != "" {
+			return name
+		}
+	}
+	return sf.Name
+}
+
+// elementType returns the struct element type of the PCollection, or an
+// error if the elements aren't structs or pointers to structs.
+func elementType(col beam.PCollection) (reflect.Type, error) {
+	t := col.Type().Type()
+	st := t
+	if st.Kind() == reflect.Ptr {
+		st = st.Elem()
+	}
+	if st.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("elements of type %v aren't structs; schema transforms only operate on PCollections of structs", t)
+	}
+	return t, nil
+}
+
+// resolve finds the field with the dot separated path in t.
+func resolve(t reflect.Type, path string) (field, error) {
+	f := field{}
+	cur := t
+	for _, part := range strings.Split(path, ".") {
+		for cur.Kind() == reflect.Ptr {
+			cur = cur.Elem()
+		}
+		if cur.Kind() != reflect.Struct {
+			return field{}, fmt.Errorf("field %q of %v: %v isn't a struct", path, t, cur)
+		}
+		found := false
+		for i := 0; i < cur.NumField(); i++ {
+			sf := cur.Field(i)
+			if !sf.IsExported() || schemaName(sf) != part {
+				continue
+			}
+			f.Name, f.GoName = part, sf.Name
+			f.Index = append(f.Index, i)
+			cur = sf.Type
+			found = true
+			break
+		}
+		if !found {
+			return field{}, fmt.Errorf("field %q of %v: no exported field named %q in %v", path, t, part, cur)
+		}
+	}
+	f.Type = cur
+	return f, nil
+}
+
+// resolveAll resolves each of the paths in t.
+func resolveAll(t reflect.Type, paths []string) ([]field, error) {
+	var fs []field
+	for _, p := range paths {
+		f, err := resolve(t, p)
+		if err != nil {
+			return nil, err
+		}
+		fs = append(fs, f)
+	}
+	return fs, nil
+}
+
+// get returns the value at the index path in v. It returns false if a nil
+// pointer is found along the path.
+func get(v reflect.Value, index []int) (reflect.Value, bool) {
+	for _, i := range index {
+		for v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	return v, true
+}
+
+// getOrZero returns the value at the index path in v, or the zero value of
+// t if a nil pointer is found along the path.
+func getOrZero(v reflect.Value, index []int, t reflect.Type) reflect.Value {
+	if fv, ok := get(v, index); ok {
+		return fv
+	}
+	return reflect.Zero(t)
+}
+
+// structField returns an output struct field with the schema name, using
+// the Go name if it's exported and tagging the field if the names differ.
+func structField(name, goName string, t reflect.Type) reflect.StructField {
+	sf := reflect.StructField{Name: goName, Type: t}
+	if name != goName {
+		sf.Tag = reflect.StructTag(fmt.Sprintf(`beam:"%s"`, name))
+	}
+	return sf
+}
+
+// makeStruct returns a struct type with the given fields, or an error if
+// any names are duplicated.
+func makeStruct(fields []reflect.StructField) (reflect.Type, error) {
+	names := map[string]bool{}
+	for _, f := range fields {
+		name := schemaName(f)
+		if names[name] || names[f.Name] {
+			return nil, fmt.Errorf("duplicate output field %q", name)
+		}
+		names[name], names[f.Name] = true, true
+	}
+	return reflect.StructOf(fields), nil
+}
+
+// indices returns the index paths of the fields.
+func indices(fs []field) [][]int {
+	var idx [][]int
+	for _, f := range fs {
+		idx = append(idx, f.Index)
+	}
+	return idx
+}
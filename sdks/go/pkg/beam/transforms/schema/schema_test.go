@@ -0,0 +1,288 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema_test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/testing/passert"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/testing/ptest"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/transforms/schema"
+)
+
+func init() {
+	beam.RegisterType(reflect.TypeOf((*address)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*user)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*order)(nil)).Elem())
+	beam.RegisterFunction(isAdult)
+}
+
+type address struct {
+	City    string
+	Country string `beam:"country"`
+}
+
+type user struct {
+	ID      int64 `beam:"id"`
+	Name    string
+	Age     int
+	Address *address
+}
+
+type order struct {
+	UserID int64
+	Amount float64
+	Item   string
+}
+
+func isAdult(age int) bool {
+	return age >= 18
+}
+
+var (
+	alice = user{ID: 1, Name: "alice", Age: 30, Address: &address{City: "Paris", Country: "FR"}}
+	bob   = user{ID: 2, Name: "bob", Age: 12, Address: &address{City: "Lyon", Country: "FR"}}
+	carol = user{ID: 3, Name: "carol", Age: 45}
+
+	book  = order{UserID: 1, Amount: 10, Item: "book"}
+	pen   = order{UserID: 1, Amount: 2.5, Item: "pen"}
+	lamp  = order{UserID: 2, Amount: 30, Item: "lamp"}
+	chair = order{UserID: 4, Amount: 50, Item: "chair"}
+)
+
+func TestSelect(t *testing.T) {
+	type nameCity = struct {
+		Name string
+		City string
+	}
+	type idCountry = struct {
+		ID      int64  `beam:"id"`
+		Country string `beam:"country"`
+	}
+
+	p, s := beam.NewPipelineWithRoot()
+	users := beam.Create(s, alice, bob, carol)
+	passert.Equals(s, schema.Select(s, users, "Name", "Address.City"),
+		nameCity{"alice", "Paris"}, nameCity{"bob", "Lyon"}, nameCity{"carol", ""})
+	passert.Equals(s, schema.Select(s, users, "id", "Address.country"),
+		idCountry{1, "FR"}, idCountry{2, "FR"}, idCountry{3, ""})
+
+	if err := ptest.Run(p); err != nil {
+		t.Errorf("Select() failed: %v", err)
+	}
+}
+
+func TestSelect_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields []string
+	}{
+		{"none", nil},
+		{"missing", []string{"Missing"}},
+		{"notStruct", []string{"Name.First"}},
+		{"goNameOfTaggedField", []string{"ID"}},
+		{"duplicate", []string{"Name", "Name"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Select(%v) didn't panic", test.fields)
+				}
+			}()
+			_, s := beam.NewPipelineWithRoot()
+			schema.Select(s, beam.Create(s, alice), test.fields...)
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	users := beam.Create(s, alice, bob, carol)
+	passert.Equals(s, schema.Filter(s, users, "Age", isAdult), alice, carol)
+	passert.Equals(s, schema.Filter(s, users, "Address.City", func(city string) bool {
+		return city != "Paris"
+	}), bob, carol)
+	// carol has no address, so the predicate sees the zero value of the city.
+	passert.Equals(s, schema.Filter(s, users, "Address.City", func(city string) bool {
+		return city == ""
+	}), carol)
+
+	if err := ptest.Run(p); err != nil {
+		t.Errorf("Filter() failed: %v", err)
+	}
+}
+
+func TestFilter_BadPredicate(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Filter() with predicate of the wrong type didn't panic")
+		}
+	}()
+	_, s := beam.NewPipelineWithRoot()
+	schema.Filter(s, beam.Create(s, alice), "Name", isAdult)
+}
+
+func TestGroup(t *testing.T) {
+	type totals = struct {
+		UserID  int64
+		N       int64   `beam:"n"`
+		Total   float64 `beam:"total"`
+		Mean    float64 `beam:"mean"`
+		Largest float64 `beam:"largest"`
+		First   string  `beam:"first"`
+	}
+	type byCountry = struct {
+		Country string `beam:"country"`
+		Users   int64  `beam:"users"`
+		Ages    int64  `beam:"ages"`
+		Oldest  int    `beam:"oldest"`
+	}
+
+	p, s := beam.NewPipelineWithRoot()
+	orders := beam.Create(s, book, pen, lamp, chair)
+	passert.Equals(s, schema.Group(s, orders, []string{"UserID"},
+		schema.Count("n"),
+		schema.Sum("Amount", "total"),
+		schema.Mean("Amount", "mean"),
+		schema.Max("Amount", "largest"),
+		schema.Min("Item", "first")),
+		totals{1, 2, 12.5, 6.25, 10, "book"},
+		totals{2, 1, 30, 30, 30, "lamp"},
+		totals{4, 1, 50, 50, 50, "chair"})
+
+	users := beam.Create(s, alice, bob, carol)
+	passert.Equals(s, schema.Group(s, users, []string{"Address.country"},
+		schema.Count("users"),
+		schema.Sum("Age", "ages"),
+		schema.Max("Age", "oldest")),
+		byCountry{"FR", 2, 42, 30},
+		byCountry{"", 1, 45, 45})
+
+	if err := ptest.Run(p); err != nil {
+		t.Errorf("Group() failed: %v", err)
+	}
+}
+
+func TestGroup_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		keys []string
+		aggs []schema.Aggregate
+	}{
+		{"noKeys", nil, []schema.Aggregate{schema.Count("n")}},
+		{"sumOfString", []string{"UserID"}, []schema.Aggregate{schema.Sum("Item", "s")}},
+		{"badName", []string{"UserID"}, []schema.Aggregate{schema.Count("a b")}},
+		{"duplicate", []string{"UserID"}, []schema.Aggregate{schema.Count("userID")}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Group(%v) didn't panic", test.keys)
+				}
+			}()
+			_, s := beam.NewPipelineWithRoot()
+			schema.Group(s, beam.Create(s, book), test.keys, test.aggs...)
+		})
+	}
+}
+
+func TestJoin(t *testing.T) {
+	type inner = struct {
+		Left  user  `beam:"left"`
+		Right order `beam:"right"`
+	}
+	type leftOuter = struct {
+		Left  user   `beam:"left"`
+		Right *order `beam:"right"`
+	}
+	type rightOuter = struct {
+		Left  *user `beam:"left"`
+		Right order `beam:"right"`
+	}
+	type fullOuter = struct {
+		Left  *user  `beam:"left"`
+		Right *order `beam:"right"`
+	}
+
+	p, s := beam.NewPipelineWithRoot()
+	users := beam.Create(s, alice, bob, carol)
+	orders := beam.Create(s, book, pen, lamp, chair)
+
+	passert.Equals(s, schema.Join(s, schema.InnerJoin, users, orders, "id=UserID"),
+		inner{alice, book}, inner{alice, pen}, inner{bob, lamp})
+	passert.Equals(s, schema.Join(s, schema.LeftOuterJoin, users, orders, "id=UserID"),
+		leftOuter{alice, &book}, leftOuter{alice, &pen}, leftOuter{bob, &lamp}, leftOuter{carol, nil})
+	passert.Equals(s, schema.Join(s, schema.RightOuterJoin, users, orders, "id=UserID"),
+		rightOuter{&alice, book}, rightOuter{&alice, pen}, rightOuter{&bob, lamp}, rightOuter{nil, chair})
+	passert.Equals(s, schema.Join(s, schema.FullOuterJoin, users, orders, "id=UserID"),
+		fullOuter{&alice, &book}, fullOuter{&alice, &pen}, fullOuter{&bob, &lamp}, fullOuter{&carol, nil}, fullOuter{nil, &chair})
+
+	if err := ptest.Run(p); err != nil {
+		t.Errorf("Join() failed: %v", err)
+	}
+}
+
+func TestJoin_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		on   []string
+	}{
+		{"none", nil},
+		{"missingLeft", []string{"Missing=UserID"}},
+		{"missingRight", []string{"id=Missing"}},
+		{"typeMismatch", []string{"Name=UserID"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Join(%v) didn't panic", test.on)
+				}
+			}()
+			_, s := beam.NewPipelineWithRoot()
+			schema.Join(s, schema.InnerJoin, beam.Create(s, alice), beam.Create(s, book), test.on...)
+		})
+	}
+}
+
+func TestTransforms_NonStruct(t *testing.T) {
+	tests := []struct {
+		name  string
+		build func(s beam.Scope, col beam.PCollection)
+	}{
+		{"select", func(s beam.Scope, col beam.PCollection) { schema.Select(s, col, "Name") }},
+		{"filter", func(s beam.Scope, col beam.PCollection) { schema.Filter(s, col, "Age", isAdult) }},
+		{"group", func(s beam.Scope, col beam.PCollection) { schema.Group(s, col, []string{"Name"}) }},
+		{"join", func(s beam.Scope, col beam.PCollection) { schema.Join(s, schema.InnerJoin, col, col, "Name") }},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil || !strings.Contains(fmt.Sprint(r), "aren't structs") {
+					t.Errorf("%v of a PCollection<string> = %v, want panic about non-struct elements", test.name, r)
+				}
+			}()
+			_, s := beam.NewPipelineWithRoot()
+			test.build(s, beam.Create(s, "a", "b"))
+		})
+	}
+}
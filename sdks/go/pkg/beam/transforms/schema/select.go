@@ -0,0 +1,96 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/register"
+)
+
+func init() {
+	register.DoFn2x0[beam.X, func(beam.Y)](&selectFn{})
+	register.Emitter1[beam.Y]()
+}
+
+// Select projects the elements of a PCollection<A> of structs onto the named
+// fields, returning a PCollection of a new struct type containing only those
+// fields, in the given order. Nested fields are named with dot separated
+// paths, and are output as a top level field named after the last element of
+// the path. For example:
+//
+//	type Address struct { City, Country string }
+//	type User struct {
+//	    Name    string
+//	    Age     int
+//	    Address *Address
+//	}
+//
+//	cities := schema.Select(s, users, "Name", "Address.City")
+//
+// Here, "cities" is a PCollection<struct{Name string; City string}>. Users
+// without an address have an empty City.
+//
+// Select panics if a field doesn't exist, or if two selected fields have the
+// same name.
+func Select(s beam.Scope, col beam.PCollection, fields ...string) beam.PCollection {
+	s = s.Scope("schema.Select")
+
+	t, err := elementType(col)
+	if err != nil {
+		panic(fmt.Sprintf("schema.Select: %v", err))
+	}
+	fs, err := resolveAll(t, fields)
+	if err != nil {
+		panic(fmt.Sprintf("schema.Select: %v", err))
+	}
+	if len(fs) == 0 {
+		panic("schema.Select: no fields selected")
+	}
+	var sfs []reflect.StructField
+	for _, f := range fs {
+		sfs = append(sfs, structField(f.Name, f.GoName, f.Type))
+	}
+	out, err := makeStruct(sfs)
+	if err != nil {
+		panic(fmt.Sprintf("schema.Select: %v", err))
+	}
+	return beam.ParDo(s, &selectFn{Paths: indices(fs), Out: beam.EncodedType{T: out}}, col, beam.TypeDefinition{Var: beam.YType, T: out})
+}
+
+// selectFn copies the fields at the paths into the output struct.
+type selectFn struct {
+	// Paths are the index paths of the selected fields.
+	Paths [][]int `json:"paths"`
+	// Out is the output struct type.
+	Out beam.EncodedType `json:"out"`
+}
+
+func (f *selectFn) ProcessElement(elm beam.X, emit func(beam.Y)) {
+	emit(project(reflect.ValueOf(elm), f.Paths, f.Out.T).Interface())
+}
+
+// project returns a value of the struct type t whose fields are the values at
+// the paths in v, in order.
+func project(v reflect.Value, paths [][]int, t reflect.Type) reflect.Value {
+	out := reflect.New(t).Elem()
+	for i, p := range paths {
+		out.Field(i).Set(getOrZero(v, p, t.Field(i).Type))
+	}
+	return out
+}
@@ -0,0 +1,281 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/typex"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/internal/errors"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/register"
+)
+
+func init() {
+	beam.RegisterType(hllAccumType)
+	beam.RegisterCoder(hllAccumType, encodeHLLAccum, decodeHLLAccum)
+	register.Combiner3[hllAccum, beam.T, int64](&countDistinctFn{})
+	register.Combiner3[hllAccum, beam.T, []byte](&hllSketchFn{})
+	register.Combiner3[hllAccum, []byte, []byte](&mergeHLLSketchesFn{})
+	register.Function1x2(estimateHLLSketch)
+	register.Function2x3(estimateHLLSketchPerKey)
+}
+
+// ApproximateCountDistinct estimates the number of distinct elements in a
+// PCollection<T> with a HyperLogLog++ sketch of the precision, between
+// MinHLLPrecision and MaxHLLPrecision. It returns a single element
+// PCollection<int64>. Higher precisions are more accurate but use more
+// memory: the relative error is about 1.04/sqrt(2^precision), and the sketch
+// uses up to 2^precision bytes. Elements are distinguished by their encoding,
+// which must be deterministic. For example:
+//
+//	users := beam.Create(s, "alice", "bob", "alice")
+//	n := stats.ApproximateCountDistinct(s, users, stats.DefaultHLLPrecision) // About 2.
+func ApproximateCountDistinct(s beam.Scope, col beam.PCollection, precision int) beam.PCollection {
+	s = s.Scope("stats.ApproximateCountDistinct")
+
+	t := beam.ValidateNonCompositeType(col)
+	validatePrecision(s, precision)
+	return beam.Combine(s, &countDistinctFn{Precision: precision, Type: beam.EncodedType{T: t.Type()}}, col)
+}
+
+// ApproximateCountDistinctPerKey is ApproximateCountDistinct for the values of
+// each key of a PCollection<KV<K,V>>. It returns a PCollection<KV<K,int64>>.
+func ApproximateCountDistinctPerKey(s beam.Scope, col beam.PCollection, precision int) beam.PCollection {
+	s = s.Scope("stats.ApproximateCountDistinctPerKey")
+
+	_, t := beam.ValidateKVType(col)
+	validatePrecision(s, precision)
+	return beam.CombinePerKey(s, &countDistinctFn{Precision: precision, Type: beam.EncodedType{T: t.Type()}}, col)
+}
+
+// HLLSketches is ApproximateCountDistinct returning the serialized sketch
+// instead of its estimate, as a single element PCollection<[]byte>. Sketches
+// can be persisted, merged with MergeHLLSketches, and estimated with
+// EstimateHLLSketches or HLLSketch.Estimate.
+func HLLSketches(s beam.Scope, col beam.PCollection, precision int) beam.PCollection {
+	s = s.Scope("stats.HLLSketches")
+
+	t := beam.ValidateNonCompositeType(col)
+	validatePrecision(s, precision)
+	return beam.Combine(s, &hllSketchFn{Precision: precision, Type: beam.EncodedType{T: t.Type()}}, col)
+}
+
+// HLLSketchesPerKey is ApproximateCountDistinctPerKey returning serialized
+// sketches instead of their estimates, as a PCollection<KV<K,[]byte>>.
+func HLLSketchesPerKey(s beam.Scope, col beam.PCollection, precision int) beam.PCollection {
+	s = s.Scope("stats.HLLSketchesPerKey")
+
+	_, t := beam.ValidateKVType(col)
+	validatePrecision(s, precision)
+	return beam.CombinePerKey(s, &hllSketchFn{Precision: precision, Type: beam.EncodedType{T: t.Type()}}, col)
+}
+
+// MergeHLLSketches merges the serialized sketches of a PCollection<[]byte>
+// into a single sketch, returned as a single element PCollection<[]byte>. For
+// example, daily sketches can be merged into a weekly sketch. Sketches of
+// different precisions are merged at the lowest precision. If there are no
+// sketches, the result is an empty sketch of DefaultHLLPrecision.
+func MergeHLLSketches(s beam.Scope, col beam.PCollection) beam.PCollection {
+	s = s.Scope("stats.MergeHLLSketches")
+
+	return beam.Combine(s, &mergeHLLSketchesFn{}, col)
+}
+
+// MergeHLLSketchesPerKey merges the serialized sketches of each key of a
+// PCollection<KV<K,[]byte>>, returning a PCollection<KV<K,[]byte>>.
+func MergeHLLSketchesPerKey(s beam.Scope, col beam.PCollection) beam.PCollection {
+	s = s.Scope("stats.MergeHLLSketchesPerKey")
+
+	return beam.CombinePerKey(s, &mergeHLLSketchesFn{}, col)
+}
+
+// EstimateHLLSketches decodes the serialized sketches of a PCollection<[]byte>
+// or PCollection<KV<K,[]byte>> and returns their estimates, as a
+// PCollection<int64> or PCollection<KV<K,int64>> respectively.
+func EstimateHLLSketches(s beam.Scope, col beam.PCollection) beam.PCollection {
+	s = s.Scope("stats.EstimateHLLSketches")
+
+	if typex.IsKV(col.Type()) {
+		return beam.ParDo(s, estimateHLLSketchPerKey, col)
+	}
+	return beam.ParDo(s, estimateHLLSketch, col)
+}
+
+func estimateHLLSketch(b []byte) (int64, error) {
+	var sketch HLLSketch
+	if err := sketch.UnmarshalBinary(b); err != nil {
+		return 0, err
+	}
+	return sketch.Estimate(), nil
+}
+
+func estimateHLLSketchPerKey(k beam.T, b []byte) (beam.T, int64, error) {
+	n, err := estimateHLLSketch(b)
+	return k, n, err
+}
+
+// hllAccum is the accumulator of the HyperLogLog++ CombineFns. Its sketch is
+// nil until the precision is known when merging serialized sketches.
+type hllAccum struct {
+	sketch *HLLSketch
+}
+
+var hllAccumType = reflect.TypeOf((*hllAccum)(nil)).Elem()
+
+func encodeHLLAccum(a hllAccum) ([]byte, error) {
+	if a.sketch == nil {
+		return nil, nil
+	}
+	return a.sketch.MarshalBinary()
+}
+
+func decodeHLLAccum(b []byte) (hllAccum, error) {
+	if len(b) == 0 {
+		return hllAccum{}, nil
+	}
+	var sketch HLLSketch
+	if err := sketch.UnmarshalBinary(b); err != nil {
+		return hllAccum{}, err
+	}
+	return hllAccum{sketch: &sketch}, nil
+}
+
+// merge merges b into a, either of which may have a nil sketch.
+func (a hllAccum) merge(b hllAccum) hllAccum {
+	switch {
+	case b.sketch == nil:
+		return a
+	case a.sketch == nil:
+		return b
+	}
+	a.sketch.Merge(b.sketch)
+	return a
+}
+
+// sketchOrEmpty returns the sketch, or an empty sketch of the default
+// precision if there's none.
+func (a hllAccum) sketchOrEmpty() *HLLSketch {
+	if a.sketch != nil {
+		return a.sketch
+	}
+	sketch, _ := NewHLLSketch(DefaultHLLPrecision)
+	return sketch
+}
+
+// validatePrecision panics if the precision isn't supported.
+func validatePrecision(s beam.Scope, precision int) {
+	if _, err := NewHLLSketch(precision); err != nil {
+		panic(fmt.Sprintf("%v: %v", s, err))
+	}
+}
+
+// addToSketch adds the encoded element to the sketch of the accumulator.
+func addToSketch(enc beam.ElementEncoder, a hllAccum, elm beam.T) (hllAccum, error) {
+	var buf bytes.Buffer
+	if err := enc.Encode(elm, &buf); err != nil {
+		return a, errors.WithContextf(err, "encoding %v for HyperLogLog++ sketch", elm)
+	}
+	a.sketch.AddBytes(buf.Bytes())
+	return a, nil
+}
+
+// countDistinctFn estimates the number of distinct elements.
+type countDistinctFn struct {
+	// Precision is the precision of the sketch.
+	Precision int `json:"precision"`
+	// Type is the element type.
+	Type beam.EncodedType `json:"type"`
+
+	enc beam.ElementEncoder
+}
+
+func (f *countDistinctFn) Setup() {
+	f.enc = beam.NewElementEncoder(f.Type.T)
+}
+
+func (f *countDistinctFn) CreateAccumulator() (hllAccum, error) {
+	sketch, err := NewHLLSketch(f.Precision)
+	return hllAccum{sketch: sketch}, err
+}
+
+func (f *countDistinctFn) AddInput(a hllAccum, elm beam.T) (hllAccum, error) {
+	return addToSketch(f.enc, a, elm)
+}
+
+func (f *countDistinctFn) MergeAccumulators(a, b hllAccum) hllAccum {
+	return a.merge(b)
+}
+
+func (f *countDistinctFn) ExtractOutput(a hllAccum) int64 {
+	return a.sketchOrEmpty().Estimate()
+}
+
+// hllSketchFn builds a serialized sketch of the elements.
+type hllSketchFn struct {
+	// Precision is the precision of the sketch.
+	Precision int `json:"precision"`
+	// Type is the element type.
+	Type beam.EncodedType `json:"type"`
+
+	enc beam.ElementEncoder
+}
+
+func (f *hllSketchFn) Setup() {
+	f.enc = beam.NewElementEncoder(f.Type.T)
+}
+
+func (f *hllSketchFn) CreateAccumulator() (hllAccum, error) {
+	sketch, err := NewHLLSketch(f.Precision)
+	return hllAccum{sketch: sketch}, err
+}
+
+func (f *hllSketchFn) AddInput(a hllAccum, elm beam.T) (hllAccum, error) {
+	return addToSketch(f.enc, a, elm)
+}
+
+func (f *hllSketchFn) MergeAccumulators(a, b hllAccum) hllAccum {
+	return a.merge(b)
+}
+
+func (f *hllSketchFn) ExtractOutput(a hllAccum) ([]byte, error) {
+	return a.sketchOrEmpty().MarshalBinary()
+}
+
+// mergeHLLSketchesFn merges serialized sketches.
+type mergeHLLSketchesFn struct{}
+
+func (f *mergeHLLSketchesFn) CreateAccumulator() hllAccum {
+	return hllAccum{}
+}
+
+func (f *mergeHLLSketchesFn) AddInput(a hllAccum, b []byte) (hllAccum, error) {
+	var sketch HLLSketch
+	if err := sketch.UnmarshalBinary(b); err != nil {
+		return a, err
+	}
+	return a.merge(hllAccum{sketch: &sketch}), nil
+}
+
+func (f *mergeHLLSketchesFn) MergeAccumulators(a, b hllAccum) hllAccum {
+	return a.merge(b)
+}
+
+func (f *mergeHLLSketchesFn) ExtractOutput(a hllAccum) ([]byte, error) {
+	return a.sketchOrEmpty().MarshalBinary()
+}
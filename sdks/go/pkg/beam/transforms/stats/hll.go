@@ -0,0 +1,335 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/bits"
+	"sort"
+)
+
+const (
+	// MinHLLPrecision is the smallest supported HyperLogLog++ precision.
+	MinHLLPrecision = 4
+	// MaxHLLPrecision is the largest supported HyperLogLog++ precision.
+	MaxHLLPrecision = 18
+	// DefaultHLLPrecision is the HyperLogLog++ precision used by default,
+	// with a relative error of about 0.4%.
+	DefaultHLLPrecision = 15
+
+	// sparsePrecision is the precision of the sparse representation.
+	sparsePrecision = 25
+	// hllVersion is the version of the serialized sketch format.
+	hllVersion = 1
+
+	formatSparse = 0
+	formatDense  = 1
+)
+
+// HLLSketch is a HyperLogLog++ sketch, which estimates the number of distinct
+// values added to it in a fixed amount of memory. A sketch with precision p
+// uses 2^p bytes and has a relative standard error of about 1.04/sqrt(2^p).
+// Sketches start in a sparse representation, which is smaller and more
+// accurate for low cardinalities, and switch to the dense representation as
+// they grow.
+//
+// Sketches can be merged, including sketches of different precisions, in
+// which case the result has the lower precision. Sketches serialize to a
+// stable binary format with MarshalBinary, so they can be persisted and
+// merged later.
+type HLLSketch struct {
+	precision int
+	// sparse maps indices at sparsePrecision to their register values, and is
+	// nil in the dense representation.
+	sparse map[uint32]uint8
+	// registers are the registers of the dense representation, and are nil in
+	// the sparse representation.
+	registers []uint8
+}
+
+// NewHLLSketch returns an empty sketch with the precision, which must be
+// between MinHLLPrecision and MaxHLLPrecision.
+func NewHLLSketch(precision int) (*HLLSketch, error) {
+	if precision < MinHLLPrecision || precision > MaxHLLPrecision {
+		return nil, fmt.Errorf("invalid HyperLogLog++ precision %v, must be in [%v, %v]", precision, MinHLLPrecision, MaxHLLPrecision)
+	}
+	return &HLLSketch{precision: precision, sparse: map[uint32]uint8{}}, nil
+}
+
+// Precision returns the precision of the sketch.
+func (s *HLLSketch) Precision() int {
+	return s.precision
+}
+
+// AddBytes adds a value to the sketch, identified by its bytes.
+func (s *HLLSketch) AddBytes(b []byte) {
+	h := fnv.New64a()
+	h.Write(b)
+	s.AddHash(mix64(h.Sum64()))
+}
+
+// AddHash adds a value to the sketch, identified by a uniformly distributed
+// 64 bit hash.
+func (s *HLLSketch) AddHash(h uint64) {
+	if s.sparse != nil {
+		idx, rho := split(h, sparsePrecision)
+		if rho > s.sparse[uint32(idx)] {
+			s.sparse[uint32(idx)] = rho
+		}
+		s.maybeDensify()
+		return
+	}
+	idx, rho := split(h, s.precision)
+	if rho > s.registers[idx] {
+		s.registers[idx] = rho
+	}
+}
+
+// split returns the register index of the hash at precision p, and the
+// position of the leftmost 1 bit of the remaining bits.
+func split(h uint64, p int) (uint64, uint8) {
+	idx := h >> (64 - p)
+	w := h<<p | 1<<(p-1)
+	return idx, uint8(bits.LeadingZeros64(w) + 1)
+}
+
+// mix64 is the finalizer of MurmurHash3, which spreads the bits of FNV
+// hashes more evenly over the whole word.
+func mix64(h uint64) uint64 {
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+	return h
+}
+
+// maybeDensify switches to the dense representation once the sparse one
+// stops being smaller.
+func (s *HLLSketch) maybeDensify() {
+	if len(s.sparse) > (1<<s.precision)/4 {
+		s.densify()
+	}
+}
+
+func (s *HLLSketch) densify() {
+	if s.sparse == nil {
+		return
+	}
+	s.registers = make([]uint8, 1<<s.precision)
+	for idx, rho := range s.sparse {
+		i, r := fold(uint64(idx), rho, sparsePrecision-s.precision)
+		if r > s.registers[i] {
+			s.registers[i] = r
+		}
+	}
+	s.sparse = nil
+}
+
+// fold returns the index and register value of a register at a precision d
+// bits lower.
+func fold(idx uint64, rho uint8, d int) (uint64, uint8) {
+	if d == 0 {
+		return idx, rho
+	}
+	if low := idx & (1<<d - 1); low != 0 {
+		return idx >> d, uint8(d - bits.Len64(low) + 1)
+	}
+	return idx >> d, uint8(d) + rho
+}
+
+// downgrade lowers the precision of the sketch.
+func (s *HLLSketch) downgrade(precision int) {
+	if precision >= s.precision {
+		return
+	}
+	if s.sparse == nil {
+		registers := make([]uint8, 1<<precision)
+		for idx, rho := range s.registers {
+			if rho == 0 {
+				continue
+			}
+			i, r := fold(uint64(idx), rho, s.precision-precision)
+			if r > registers[i] {
+				registers[i] = r
+			}
+		}
+		s.registers = registers
+	}
+	s.precision = precision
+	if s.sparse != nil {
+		s.maybeDensify()
+	}
+}
+
+// Merge adds the values of the other sketch to this one. If the precisions
+// differ, this sketch takes the lower precision.
+func (s *HLLSketch) Merge(o *HLLSketch) {
+	if o.precision < s.precision {
+		s.downgrade(o.precision)
+	}
+	if o.sparse != nil {
+		for idx, rho := range o.sparse {
+			if s.sparse != nil {
+				if rho > s.sparse[idx] {
+					s.sparse[idx] = rho
+				}
+				continue
+			}
+			i, r := fold(uint64(idx), rho, sparsePrecision-s.precision)
+			if r > s.registers[i] {
+				s.registers[i] = r
+			}
+		}
+		if s.sparse != nil {
+			s.maybeDensify()
+		}
+		return
+	}
+	s.densify()
+	d := o.precision - s.precision
+	for idx, rho := range o.registers {
+		if rho == 0 {
+			continue
+		}
+		i, r := fold(uint64(idx), rho, d)
+		if r > s.registers[i] {
+			s.registers[i] = r
+		}
+	}
+}
+
+// Estimate returns the estimated number of distinct values added to the
+// sketch.
+func (s *HLLSketch) Estimate() int64 {
+	if s.sparse != nil {
+		m := float64(uint64(1) << sparsePrecision)
+		return int64(math.Round(linearCounting(m, m-float64(len(s.sparse)))))
+	}
+	m := float64(len(s.registers))
+	sum, zeros := 0.0, 0
+	for _, rho := range s.registers {
+		sum += math.Ldexp(1, -int(rho))
+		if rho == 0 {
+			zeros++
+		}
+	}
+	// Without empirical bias correction, the raw estimate is biased for small
+	// cardinalities, where linear counting is more accurate.
+	if zeros > 0 {
+		if lc := linearCounting(m, float64(zeros)); lc <= 2.5*m {
+			return int64(math.Round(lc))
+		}
+	}
+	return int64(math.Round(alpha(m) * m * m / sum))
+}
+
+func linearCounting(m, zeros float64) float64 {
+	return m * math.Log(m/zeros)
+}
+
+func alpha(m float64) float64 {
+	switch m {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	}
+	return 0.7213 / (1 + 1.079/m)
+}
+
+// MarshalBinary encodes the sketch in a stable binary format.
+func (s *HLLSketch) MarshalBinary() ([]byte, error) {
+	if s.sparse == nil {
+		return append([]byte{hllVersion, byte(s.precision), formatDense}, s.registers...), nil
+	}
+	// Sparse entries are sorted and delta encoded.
+	entries := make([]uint32, 0, len(s.sparse))
+	for idx, rho := range s.sparse {
+		entries = append(entries, idx<<6|uint32(rho))
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i] < entries[j] })
+	buf := []byte{hllVersion, byte(s.precision), formatSparse}
+	buf = binary.AppendUvarint(buf, uint64(len(entries)))
+	prev := uint32(0)
+	for _, e := range entries {
+		buf = binary.AppendUvarint(buf, uint64(e-prev))
+		prev = e
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary decodes a sketch encoded by MarshalBinary.
+func (s *HLLSketch) UnmarshalBinary(b []byte) error {
+	if len(b) < 3 {
+		return fmt.Errorf("invalid HyperLogLog++ sketch: too short")
+	}
+	if b[0] != hllVersion {
+		return fmt.Errorf("invalid HyperLogLog++ sketch: unsupported version %v", b[0])
+	}
+	p := int(b[1])
+	if p < MinHLLPrecision || p > MaxHLLPrecision {
+		return fmt.Errorf("invalid HyperLogLog++ sketch: invalid precision %v", p)
+	}
+	data := b[3:]
+	switch b[2] {
+	case formatDense:
+		if len(data) != 1<<p {
+			return fmt.Errorf("invalid HyperLogLog++ sketch: %v registers, want %v", len(data), 1<<p)
+		}
+		*s = HLLSketch{precision: p, registers: append([]uint8(nil), data...)}
+	case formatSparse:
+		n, k := binary.Uvarint(data)
+		if k <= 0 {
+			return fmt.Errorf("invalid HyperLogLog++ sketch: bad sparse length")
+		}
+		data = data[k:]
+		// Each entry takes at least one byte, so a larger count is truncated.
+		if n > uint64(len(data)) {
+			return fmt.Errorf("invalid HyperLogLog++ sketch: %v sparse entries in %v bytes", n, len(data))
+		}
+		sparse := make(map[uint32]uint8, n)
+		prev := uint64(0)
+		for i := uint64(0); i < n; i++ {
+			delta, k := binary.Uvarint(data)
+			if k <= 0 {
+				return fmt.Errorf("invalid HyperLogLog++ sketch: bad sparse entry %v", i)
+			}
+			data = data[k:]
+			prev += delta
+			idx, rho := prev>>6, prev&0x3f
+			if idx >= 1<<sparsePrecision {
+				return fmt.Errorf("invalid HyperLogLog++ sketch: sparse index %v out of range", idx)
+			}
+			if rho == 0 || rho > 64-sparsePrecision+1 {
+				return fmt.Errorf("invalid HyperLogLog++ sketch: invalid register value %v of sparse index %v", rho, idx)
+			}
+			sparse[uint32(idx)] = uint8(rho)
+		}
+		if len(data) != 0 {
+			return fmt.Errorf("invalid HyperLogLog++ sketch: %v trailing bytes", len(data))
+		}
+		*s = HLLSketch{precision: p, sparse: sparse}
+	default:
+		return fmt.Errorf("invalid HyperLogLog++ sketch: unknown format %v", b[2])
+	}
+	return nil
+}
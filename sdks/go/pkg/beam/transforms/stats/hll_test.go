@@ -0,0 +1,234 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/testing/passert"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/testing/ptest"
+	"github.com/google/go-cmp/cmp"
+)
+
+// sketchOf returns a sketch of the values [from, to).
+func sketchOf(t *testing.T, precision, from, to int) *HLLSketch {
+	t.Helper()
+	s, err := NewHLLSketch(precision)
+	if err != nil {
+		t.Fatalf("NewHLLSketch(%v) failed: %v", precision, err)
+	}
+	for i := from; i < to; i++ {
+		s.AddBytes([]byte(fmt.Sprint(i)))
+	}
+	return s
+}
+
+// checkEstimate fails if the estimate is off by more than 4 standard errors.
+func checkEstimate(t *testing.T, s *HLLSketch, want int) {
+	t.Helper()
+	got := s.Estimate()
+	tolerance := 4 * 1.04 / math.Sqrt(float64(int(1)<<s.Precision())) * float64(want)
+	if math.Abs(float64(got)-float64(want)) > math.Max(tolerance, 1) {
+		t.Errorf("Estimate() = %v, want %v ± %.0f", got, want, tolerance)
+	}
+}
+
+func TestHLLSketch_Estimate(t *testing.T) {
+	for _, precision := range []int{MinHLLPrecision, 10, DefaultHLLPrecision} {
+		for _, n := range []int{0, 1, 10, 100, 1000, 10000, 100000} {
+			t.Run(fmt.Sprintf("p%v_n%v", precision, n), func(t *testing.T) {
+				s := sketchOf(t, precision, 0, n)
+				checkEstimate(t, s, n)
+				// Duplicates don't change the sketch.
+				for i := 0; i < n; i++ {
+					s.AddBytes([]byte(fmt.Sprint(i)))
+				}
+				checkEstimate(t, s, n)
+			})
+		}
+	}
+}
+
+func TestHLLSketch_Sparse(t *testing.T) {
+	s := sketchOf(t, DefaultHLLPrecision, 0, 100)
+	if s.sparse == nil {
+		t.Fatalf("sketch of 100 values isn't sparse")
+	}
+	// Sparse sketches have the accuracy of the sparse precision.
+	if got := s.Estimate(); got != 100 {
+		t.Errorf("Estimate() = %v, want 100", got)
+	}
+	s = sketchOf(t, DefaultHLLPrecision, 0, 20000)
+	if s.sparse != nil {
+		t.Errorf("sketch of 20000 values is still sparse")
+	}
+}
+
+func TestHLLSketch_Marshal(t *testing.T) {
+	for _, n := range []int{0, 100, 100000} {
+		s := sketchOf(t, 12, 0, n)
+		b, err := s.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary() failed: %v", err)
+		}
+		var got HLLSketch
+		if err := got.UnmarshalBinary(b); err != nil {
+			t.Fatalf("UnmarshalBinary() failed: %v", err)
+		}
+		if diff := cmp.Diff(s, &got, cmp.AllowUnexported(HLLSketch{})); diff != "" {
+			t.Errorf("sketch of %v values changed by marshalling (-want,+got):\n%v", n, diff)
+		}
+	}
+}
+
+// sparseSketch returns a sparse sketch of precision 4 encoding the entries,
+// each an index at sparsePrecision shifted left by 6 bits and its register.
+func sparseSketch(entries ...uint64) []byte {
+	b := binary.AppendUvarint([]byte{hllVersion, 4, formatSparse}, uint64(len(entries)))
+	prev := uint64(0)
+	for _, e := range entries {
+		b = binary.AppendUvarint(b, e-prev)
+		prev = e
+	}
+	return b
+}
+
+func TestHLLSketch_UnmarshalInvalid(t *testing.T) {
+	tests := [][]byte{
+		nil,
+		{2, 12, formatDense},
+		{hllVersion, 30, formatDense},
+		{hllVersion, 4, formatDense, 0},
+		{hllVersion, 4, formatSparse, 5},
+		{hllVersion, 4, 7},
+		sparseSketch(1 << (sparsePrecision + 6)),              // index out of range
+		sparseSketch(1 << 6),                                  // zero register
+		sparseSketch(1<<6 | (64 - sparsePrecision + 2)),       // register too large
+		append(sparseSketch(1<<6|1), 0),                       // trailing bytes
+		sparseSketch(1<<6|1, 2<<6|1)[:5],                      // truncated
+		{hllVersion, 4, formatSparse, 0xff, 0xff, 0xff, 0x0f}, // more entries than bytes
+	}
+	for _, b := range tests {
+		var s HLLSketch
+		if err := s.UnmarshalBinary(b); err == nil {
+			t.Errorf("UnmarshalBinary(%v) succeeded, want error", b)
+		}
+	}
+}
+
+func TestHLLSketch_Merge(t *testing.T) {
+	tests := []struct {
+		name            string
+		pa, pb          int
+		na, nb, overlap int
+		wantPrecision   int
+	}{
+		{name: "sparse", pa: 14, pb: 14, na: 100, nb: 200, overlap: 50, wantPrecision: 14},
+		{name: "dense", pa: 14, pb: 14, na: 50000, nb: 30000, overlap: 10000, wantPrecision: 14},
+		{name: "sparseIntoDense", pa: 14, pb: 14, na: 50000, nb: 100, overlap: 0, wantPrecision: 14},
+		{name: "denseIntoSparse", pa: 14, pb: 14, na: 100, nb: 50000, overlap: 0, wantPrecision: 14},
+		{name: "lowerPrecision", pa: 14, pb: 10, na: 50000, nb: 30000, overlap: 10000, wantPrecision: 10},
+		{name: "higherPrecision", pa: 10, pb: 14, na: 50000, nb: 30000, overlap: 10000, wantPrecision: 10},
+		{name: "sparseLowerPrecision", pa: 14, pb: 10, na: 100, nb: 100, overlap: 50, wantPrecision: 10},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a := sketchOf(t, test.pa, 0, test.na)
+			b := sketchOf(t, test.pb, test.na-test.overlap, test.na-test.overlap+test.nb)
+			a.Merge(b)
+			if got := a.Precision(); got != test.wantPrecision {
+				t.Errorf("Precision() = %v, want %v", got, test.wantPrecision)
+			}
+			checkEstimate(t, a, test.na+test.nb-test.overlap)
+
+			// Merging gives the same registers as adding the values directly.
+			direct := sketchOf(t, test.wantPrecision, 0, test.na+test.nb-test.overlap)
+			a.densify()
+			direct.densify()
+			if !cmp.Equal(a.registers, direct.registers) {
+				t.Errorf("merged registers differ from registers of a sketch of all values")
+			}
+		})
+	}
+}
+
+func TestApproximateCountDistinct(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	var words []string
+	for i := 0; i < 1000; i++ {
+		words = append(words, fmt.Sprint(i%300))
+	}
+	col := beam.CreateList(s, words)
+	passert.Equals(s, ApproximateCountDistinct(s, col, DefaultHLLPrecision), int64(300))
+
+	keyed := beam.ParDo(s, func(w string) (int, string) { return len(w), w }, col)
+	perKey := ApproximateCountDistinctPerKey(s, keyed, DefaultHLLPrecision)
+	passert.Equals(s, beam.ParDo(s, formatKV, perKey), "1:10", "2:90", "3:200")
+
+	if err := ptest.Run(p); err != nil {
+		t.Errorf("ApproximateCountDistinct() failed: %v", err)
+	}
+}
+
+func formatKV(k int, n int64) string {
+	return fmt.Sprintf("%v:%v", k, n)
+}
+
+func TestHLLSketches(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	monday := HLLSketches(s, beam.Create(s, "a", "b", "c"), DefaultHLLPrecision)
+	tuesday := HLLSketches(s, beam.Create(s, "c", "d"), 12)
+	empty := HLLSketches(s, beam.CreateList(s, []string{}), DefaultHLLPrecision)
+	merged := MergeHLLSketches(s, beam.Flatten(s, monday, tuesday, empty))
+	passert.Equals(s, EstimateHLLSketches(s, merged), int64(4))
+	passert.Equals(s, EstimateHLLSketches(s, monday), int64(3))
+
+	kvs := beam.ParDo(s, func(w string) (string, string) { return "k", w }, beam.Create(s, "a", "b", "a"))
+	perKey := MergeHLLSketchesPerKey(s, HLLSketchesPerKey(s, kvs, DefaultHLLPrecision))
+	passert.Equals(s, beam.ParDo(s, func(k string, n int64) string {
+		return fmt.Sprintf("%v:%v", k, n)
+	}, EstimateHLLSketches(s, perKey)), "k:2")
+
+	if err := ptest.Run(p); err != nil {
+		t.Errorf("HLLSketches() failed: %v", err)
+	}
+}
+
+func TestMergeHLLSketches_Malformed(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	valid := HLLSketches(s, beam.Create(s, "a", "b"), 4)
+	// A sparse index which folds to register 1024 of a sketch with 16 registers.
+	malformed := beam.Create(s, sparseSketch(1<<31<<6|1))
+	MergeHLLSketches(s, beam.Flatten(s, valid, malformed))
+
+	if err := ptest.Run(p); err == nil {
+		t.Errorf("MergeHLLSketches() of a malformed sketch succeeded, want error")
+	}
+}
+
+func TestApproximateCountDistinct_InvalidPrecision(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("ApproximateCountDistinct() with precision 30 didn't panic")
+		}
+	}()
+	_, s := beam.NewPipelineWithRoot()
+	ApproximateCountDistinct(s, beam.Create(s, 1), 30)
+}
@@ -0,0 +1,158 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"bytes"
+	"reflect"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/internal/errors"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/register"
+)
+
+func init() {
+	beam.RegisterType(reflect.TypeOf((*timestamped)(nil)).Elem())
+	register.DoFn2x2[beam.EventTime, beam.T, timestamped, error](&timestampFn{})
+	register.DoFn3x3[beam.EventTime, beam.T, beam.V, beam.T, timestamped, error](&timestampPerKeyFn{})
+	register.Combiner3[timestamped, timestamped, beam.T](&latestFn{})
+}
+
+// Latest returns the element of a PCollection<T> with the greatest event
+// timestamp, as a single element PCollection<T>. Like other combiners, it
+// applies per window, so for a windowed PCollection it returns the latest
+// element of each window. Ties are broken by the encoded elements, so the
+// result doesn't depend on the order in which elements are processed. For
+// example:
+//
+//	readings := beam.WindowInto(s, window.NewFixedWindows(time.Minute), col)
+//	last := stats.Latest(s, readings) // The last reading of each minute.
+func Latest(s beam.Scope, col beam.PCollection) beam.PCollection {
+	s = s.Scope("stats.Latest")
+
+	t := beam.ValidateNonCompositeType(col)
+	fn := &timestampFn{Type: beam.EncodedType{T: t.Type()}}
+	return beam.Combine(s, &latestFn{Type: fn.Type}, beam.ParDo(s, fn, col), beam.TypeDefinition{Var: beam.TType, T: t.Type()})
+}
+
+// LatestPerKey returns the value with the greatest event timestamp for each
+// key of a PCollection<KV<K,V>>, as a PCollection<KV<K,V>>.
+func LatestPerKey(s beam.Scope, col beam.PCollection) beam.PCollection {
+	s = s.Scope("stats.LatestPerKey")
+
+	_, t := beam.ValidateKVType(col)
+	fn := &timestampPerKeyFn{Type: beam.EncodedType{T: t.Type()}}
+	return beam.CombinePerKey(s, &latestFn{Type: fn.Type}, beam.ParDo(s, fn, col), beam.TypeDefinition{Var: beam.TType, T: t.Type()})
+}
+
+// timestamped is an encoded element with its event timestamp. It's both the
+// input and the accumulator of latestFn, with Set false for the empty
+// accumulator.
+type timestamped struct {
+	Set       bool
+	Timestamp int64
+	Value     []byte
+}
+
+// after returns whether a is later than b.
+func (a timestamped) after(b timestamped) bool {
+	switch {
+	case !b.Set:
+		return a.Set
+	case !a.Set:
+		return false
+	case a.Timestamp != b.Timestamp:
+		return a.Timestamp > b.Timestamp
+	}
+	return bytes.Compare(a.Value, b.Value) > 0
+}
+
+func encodeTimestamped(enc beam.ElementEncoder, ts beam.EventTime, elm any) (timestamped, error) {
+	var buf bytes.Buffer
+	if err := enc.Encode(elm, &buf); err != nil {
+		return timestamped{}, errors.WithContextf(err, "encoding %v", elm)
+	}
+	return timestamped{Set: true, Timestamp: int64(ts), Value: buf.Bytes()}, nil
+}
+
+// timestampFn pairs elements with their event timestamps.
+type timestampFn struct {
+	// Type is the element type.
+	Type beam.EncodedType `json:"type"`
+
+	enc beam.ElementEncoder
+}
+
+func (f *timestampFn) Setup() {
+	f.enc = beam.NewElementEncoder(f.Type.T)
+}
+
+func (f *timestampFn) ProcessElement(ts beam.EventTime, elm beam.T) (timestamped, error) {
+	return encodeTimestamped(f.enc, ts, elm)
+}
+
+// timestampPerKeyFn pairs values with their event timestamps.
+type timestampPerKeyFn struct {
+	// Type is the value type.
+	Type beam.EncodedType `json:"type"`
+
+	enc beam.ElementEncoder
+}
+
+func (f *timestampPerKeyFn) Setup() {
+	f.enc = beam.NewElementEncoder(f.Type.T)
+}
+
+func (f *timestampPerKeyFn) ProcessElement(ts beam.EventTime, k beam.T, v beam.V) (beam.T, timestamped, error) {
+	e, err := encodeTimestamped(f.enc, ts, v)
+	return k, e, err
+}
+
+// latestFn keeps the latest timestamped element.
+type latestFn struct {
+	// Type is the element type.
+	Type beam.EncodedType `json:"type"`
+
+	dec beam.ElementDecoder
+}
+
+func (f *latestFn) Setup() {
+	f.dec = beam.NewElementDecoder(f.Type.T)
+}
+
+func (f *latestFn) CreateAccumulator() timestamped {
+	return timestamped{}
+}
+
+func (f *latestFn) AddInput(a, e timestamped) timestamped {
+	if e.after(a) {
+		return e
+	}
+	return a
+}
+
+func (f *latestFn) MergeAccumulators(a, b timestamped) timestamped {
+	return f.AddInput(a, b)
+}
+
+// ExtractOutput decodes the latest element, or returns the zero value of the
+// element type if there were no elements.
+func (f *latestFn) ExtractOutput(a timestamped) (beam.T, error) {
+	if !a.Set {
+		return reflect.Zero(f.Type.T).Interface(), nil
+	}
+	return f.dec.Decode(bytes.NewReader(a.Value))
+}
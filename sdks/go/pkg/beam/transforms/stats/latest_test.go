@@ -0,0 +1,115 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/graph/mtime"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/graph/window"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/util/reflectx"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/testing/passert"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/testing/ptest"
+)
+
+func init() {
+	beam.RegisterFunction(withTimestamp)
+	beam.RegisterFunction(keyByParity)
+	beam.RegisterFunction(formatLatest)
+}
+
+// withTimestamp timestamps readings "<seconds>:<value>" at their seconds.
+func withTimestamp(reading string, emit func(beam.EventTime, string)) error {
+	var secs int64
+	var v string
+	if _, err := fmt.Sscanf(reading, "%d:%s", &secs, &v); err != nil {
+		return err
+	}
+	emit(mtime.FromMilliseconds(secs*1000), v)
+	return nil
+}
+
+func keyByParity(ts beam.EventTime, v string) (int, string) {
+	return int(ts.Milliseconds()/1000) % 2, v
+}
+
+func formatLatest(k int, v string) string {
+	return fmt.Sprintf("%v:%v", k, v)
+}
+
+func TestLatest(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	readings := beam.ParDo(s, withTimestamp, beam.Create(s, "3:c", "1:a", "7:g", "2:b", "5:e"))
+	passert.Equals(s, Latest(s, readings), "g")
+
+	keyed := beam.ParDo(s, keyByParity, readings)
+	passert.Equals(s, beam.ParDo(s, formatLatest, LatestPerKey(s, keyed)), "0:b", "1:g")
+
+	windowed := beam.WindowInto(s, window.NewFixedWindows(4*time.Second), readings)
+	latest := beam.WindowInto(s, window.NewGlobalWindows(), Latest(s, windowed))
+	passert.Equals(s, latest, "c", "g")
+
+	if err := ptest.Run(p); err != nil {
+		t.Errorf("Latest() failed: %v", err)
+	}
+}
+
+func TestLatestFn(t *testing.T) {
+	fn := &latestFn{Type: beam.EncodedType{T: reflectx.String}}
+	fn.Setup()
+	enc := beam.NewElementEncoder(reflectx.String)
+	elm := func(ts int64, v string) timestamped {
+		e, err := encodeTimestamped(enc, mtime.Time(ts), v)
+		if err != nil {
+			t.Fatalf("encodeTimestamped(%v) failed: %v", v, err)
+		}
+		return e
+	}
+
+	tests := []struct {
+		name string
+		a, b []timestamped
+		want string
+	}{
+		{name: "empty", want: ""},
+		{name: "later", a: []timestamped{elm(1, "x"), elm(3, "y")}, b: []timestamped{elm(2, "z")}, want: "y"},
+		{name: "earlier", a: []timestamped{elm(1, "x")}, b: []timestamped{elm(2, "z")}, want: "z"},
+		{name: "tie", a: []timestamped{elm(1, "x")}, b: []timestamped{elm(1, "z"), elm(1, "y")}, want: "z"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for _, order := range [][][]timestamped{{test.a, test.b}, {test.b, test.a}} {
+				a, b := fn.CreateAccumulator(), fn.CreateAccumulator()
+				for _, e := range order[0] {
+					a = fn.AddInput(a, e)
+				}
+				for _, e := range order[1] {
+					b = fn.AddInput(b, e)
+				}
+				got, err := fn.ExtractOutput(fn.MergeAccumulators(a, b))
+				if err != nil {
+					t.Fatalf("ExtractOutput() failed: %v", err)
+				}
+				if got != test.want {
+					t.Errorf("latest = %v, want %v", got, test.want)
+				}
+			}
+		})
+	}
+}
@@ -101,6 +101,58 @@ func SmallestPerKey(s beam.Scope, col beam.PCollection, n int, less any) beam.PC
 	return beam.CombinePerKey(s, newCombineFn(less, n, t.Type(), true), col)
 }
 
+// LargestWithinBytes returns the largest elements of a PCollection<T> whose
+// encoded sizes add up to at most maxBytes, instead of a fixed number of them.
+// The order is defined by the comparator, less : T x T -> bool. It returns a
+// single-element PCollection<[]T> with the largest elements in order, up to
+// the first element that doesn't fit in the budget. Bounding the bytes
+// instead of the count keeps accumulators small when elements can be large.
+func LargestWithinBytes(s beam.Scope, col beam.PCollection, maxBytes int64, less any) beam.PCollection {
+	s = s.Scope(fmt.Sprintf("top.LargestWithinBytes(%v)", maxBytes))
+
+	t := beam.ValidateNonCompositeType(col)
+	validateBytes(t, maxBytes, less)
+
+	return beam.Combine(s, newBytesCombineFn(less, maxBytes, t.Type(), false), col)
+}
+
+// LargestPerKeyWithinBytes returns the largest values for each key of a
+// PCollection<KV<K,T>> whose encoded sizes add up to at most maxBytes. It
+// returns a PCollection<KV<K,[]T>>.
+func LargestPerKeyWithinBytes(s beam.Scope, col beam.PCollection, maxBytes int64, less any) beam.PCollection {
+	s = s.Scope(fmt.Sprintf("top.LargestPerKeyWithinBytes(%v)", maxBytes))
+
+	_, t := beam.ValidateKVType(col)
+	validateBytes(t, maxBytes, less)
+
+	return beam.CombinePerKey(s, newBytesCombineFn(less, maxBytes, t.Type(), false), col)
+}
+
+// SmallestWithinBytes returns the smallest elements of a PCollection<T> whose
+// encoded sizes add up to at most maxBytes. It returns a single-element
+// PCollection<[]T> with the smallest elements in order, up to the first
+// element that doesn't fit in the budget.
+func SmallestWithinBytes(s beam.Scope, col beam.PCollection, maxBytes int64, less any) beam.PCollection {
+	s = s.Scope(fmt.Sprintf("top.SmallestWithinBytes(%v)", maxBytes))
+
+	t := beam.ValidateNonCompositeType(col)
+	validateBytes(t, maxBytes, less)
+
+	return beam.Combine(s, newBytesCombineFn(less, maxBytes, t.Type(), true), col)
+}
+
+// SmallestPerKeyWithinBytes returns the smallest values for each key of a
+// PCollection<KV<K,T>> whose encoded sizes add up to at most maxBytes. It
+// returns a PCollection<KV<K,[]T>>.
+func SmallestPerKeyWithinBytes(s beam.Scope, col beam.PCollection, maxBytes int64, less any) beam.PCollection {
+	s = s.Scope(fmt.Sprintf("top.SmallestPerKeyWithinBytes(%v)", maxBytes))
+
+	_, t := beam.ValidateKVType(col)
+	validateBytes(t, maxBytes, less)
+
+	return beam.CombinePerKey(s, newBytesCombineFn(less, maxBytes, t.Type(), true), col)
+}
+
 func validate(t typex.FullType, n int, less any) {
 	if n < 1 {
 		panic("n must be > 0")
@@ -108,6 +160,19 @@ func validate(t typex.FullType, n int, less any) {
 	funcx.MustSatisfy(less, funcx.Replace(sig, beam.TType, t.Type()))
 }
 
+func validateBytes(t typex.FullType, maxBytes int64, less any) {
+	if maxBytes < 1 {
+		panic("maxBytes must be > 0")
+	}
+	funcx.MustSatisfy(less, funcx.Replace(sig, beam.TType, t.Type()))
+}
+
+func newBytesCombineFn(less any, maxBytes int64, t reflect.Type, reversed bool) *combineFn {
+	fn := newCombineFn(less, 0, t, reversed)
+	fn.MaxBytes = maxBytes
+	return fn
+}
+
 func newCombineFn(less any, n int, t reflect.Type, reversed bool) *combineFn {
 	fn := &combineFn{Less: beam.EncodedFunc{Fn: reflectx.MakeFunc(less)}, N: n, Type: beam.EncodedType{T: t}, Reversed: reversed}
 	// Running SetupFn at pipeline construction helps validate the
@@ -125,6 +190,10 @@ type accum struct {
 	data [][]byte
 	// list stores the elements of type A in order. It has at most size N.
 	list []any
+	// sizes stores the encoded size of each element in list, and bytes their
+	// total. They're only maintained if MaxBytes is set.
+	sizes []int64
+	bytes int64
 }
 
 func (a *accum) unmarshal() error {
@@ -137,6 +206,8 @@ func (a *accum) unmarshal() error {
 			return errors.WithContextf(err, "top.accum: unmarshalling")
 		}
 		a.list = append(a.list, element)
+		a.sizes = append(a.sizes, int64(len(val)))
+		a.bytes += int64(len(val))
 	}
 	a.data = nil
 	return nil
@@ -203,7 +274,9 @@ func accumDec() func([]byte) (accum, error) {
 
 // combineFn is the internal CombineFn. It maintains accumulators containing
 // sorted lists of element of the underlying type, A, up to size N, under the
-// Less ordering on A. The natural order maintains the largest elements.
+// Less ordering on A. The natural order maintains the largest elements. If
+// MaxBytes is set, the lists are also cut at the first element that exceeds
+// MaxBytes in total encoded size, and N may be zero for no count limit.
 type combineFn struct {
 	// Less is the < order on the underlying type, A.
 	Less beam.EncodedFunc `json:"less"`
@@ -211,6 +284,8 @@ type combineFn struct {
 	Reversed bool `json:"reversed"`
 	// N is the number of elements to keep.
 	N int `json:"n"`
+	// MaxBytes is the total encoded size of the elements to keep.
+	MaxBytes int64 `json:"maxBytes,omitempty"`
 	// Type is the element type A
 	Type beam.EncodedType `json:"type"`
 
@@ -229,8 +304,24 @@ func (f *combineFn) CreateAccumulator() accum {
 }
 
 func (f *combineFn) AddInput(a accum, val beam.T) accum {
-	ret := append(a.list, val)
-	return f.trim(ret)
+	// The list is kept sorted, so the new element goes after any equal ones.
+	i := sort.Search(len(a.list), func(i int) bool {
+		return f.before(val, a.list[i])
+	})
+	if f.N > 0 && i >= f.N {
+		return a
+	}
+	a.list = append(a.list, nil)
+	copy(a.list[i+1:], a.list[i:])
+	a.list[i] = val
+	if f.MaxBytes > 0 {
+		size := f.size(val)
+		a.sizes = append(a.sizes, 0)
+		copy(a.sizes[i+1:], a.sizes[i:])
+		a.sizes[i] = size
+		a.bytes += size
+	}
+	return f.trim(a)
 }
 
 func (f *combineFn) MergeAccumulators(a, b accum) accum {
@@ -242,7 +333,29 @@ func (f *combineFn) MergeAccumulators(a, b accum) accum {
 	if err := b.unmarshal(); err != nil {
 		panic(err)
 	}
-	ret := append(a.list, b.list...)
+	ret := accum{enc: f.enc, dec: f.dec}
+	take := func(from *accum, i int) {
+		ret.list = append(ret.list, from.list[i])
+		if f.MaxBytes > 0 {
+			ret.sizes = append(ret.sizes, from.sizes[i])
+			ret.bytes += from.sizes[i]
+		}
+	}
+	// Both lists are sorted, so merge them, preferring a's elements over equal
+	// ones from b.
+	i, j := 0, 0
+	for (i < len(a.list) || j < len(b.list)) && (f.N <= 0 || len(ret.list) < f.N) {
+		if j == len(b.list) || (i < len(a.list) && !f.before(b.list[j], a.list[i])) {
+			take(&a, i)
+			i++
+		} else {
+			take(&b, j)
+			j++
+		}
+		if f.MaxBytes > 0 && ret.bytes > f.MaxBytes {
+			break
+		}
+	}
 	return f.trim(ret)
 }
 
@@ -258,22 +371,44 @@ func (f *combineFn) ExtractOutput(a accum) []beam.T {
 	return ret
 }
 
-func (f *combineFn) trim(ret []any) accum {
+// before returns whether x comes before y in the kept order.
+func (f *combineFn) before(x, y any) bool {
 	if f.less == nil {
 		f.less = reflectx.ToFunc2x1(f.Less.Fn)
 	}
-
 	if f.Reversed {
-		sort.SliceStable(ret, func(i, j int) bool {
-			return f.less.Call2x1(ret[i], ret[j]).(bool) // uses <
-		})
-	} else {
-		sort.SliceStable(ret, func(i, j int) bool {
-			return f.less.Call2x1(ret[j], ret[i]).(bool) // uses >
-		})
+		return f.less.Call2x1(x, y).(bool) // uses <
+	}
+	return f.less.Call2x1(y, x).(bool) // uses >
+}
+
+// size returns the encoded size of the element.
+func (f *combineFn) size(elm any) int64 {
+	var buf bytes.Buffer
+	if err := f.enc.Encode(elm, &buf); err != nil {
+		panic(errors.WithContextf(err, "top.combineFn: measuring %v", elm))
 	}
-	if len(ret) > f.N {
-		ret = ret[:f.N]
+	return int64(buf.Len())
+}
+
+// trim cuts the sorted elements of the accumulator to at most N, and at the
+// first one that doesn't fit in MaxBytes.
+func (f *combineFn) trim(a accum) accum {
+	if f.N > 0 && len(a.list) > f.N {
+		if f.MaxBytes > 0 {
+			for _, size := range a.sizes[f.N:] {
+				a.bytes -= size
+			}
+			a.sizes = a.sizes[:f.N]
+		}
+		a.list = a.list[:f.N]
+	}
+	if f.MaxBytes > 0 {
+		for a.bytes > f.MaxBytes {
+			last := len(a.list) - 1
+			a.bytes -= a.sizes[last]
+			a.list, a.sizes = a.list[:last], a.sizes[:last]
+		}
 	}
-	return accum{enc: f.enc, dec: f.dec, list: ret}
+	return accum{enc: f.enc, dec: f.dec, list: a.list, sizes: a.sizes, bytes: a.bytes}
 }
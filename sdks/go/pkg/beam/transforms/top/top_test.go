@@ -249,3 +249,85 @@ func TestSmallestPerKey(t *testing.T) {
 		t.Errorf("pipeline failed but should have succeeded, got %v", err)
 	}
 }
+
+// TestCombineFnBytes verifies that the accumulator keeps the longest strings
+// that fit in the byte budget. Strings encode to their length plus one byte.
+func TestCombineFnBytes(t *testing.T) {
+	less := func(a, b string) bool {
+		return len(a) < len(b)
+	}
+	fn := newBytesCombineFn(less, 10, reflectx.String, false)
+
+	tests := []struct {
+		Elms     []string
+		Expected []string
+	}{
+		{[]string{}, nil},
+		{[]string{"a", "bb", "ccc"}, []string{"ccc", "bb", "a"}},
+		{[]string{"a", "bb", "ccc", "dddd"}, []string{"dddd", "ccc"}},
+		{[]string{"a", "bb", "too long to fit"}, nil},
+	}
+
+	for _, test := range tests {
+		a := load(fn, test.Elms...)
+
+		actual := output(fn, a)
+		if !reflect.DeepEqual(actual, test.Expected) {
+			t.Errorf("CombineFn(10 bytes; %v) = %v, want %v", test.Elms, actual, test.Expected)
+		}
+	}
+}
+
+// TestCombineFnBytesMerge verifies that merged accumulators, including ones
+// that have been encoded, keep a running total of the encoded sizes.
+func TestCombineFnBytesMerge(t *testing.T) {
+	less := func(a, b string) bool {
+		return len(a) < len(b)
+	}
+	fn := newBytesCombineFn(less, 10, reflectx.String, false)
+	tests := []struct {
+		Elms     [][]string
+		Expected []string
+		Bytes    int64
+	}{
+		{[][]string{nil}, nil, 0},
+		{[][]string{{"a", "bb"}, {"ccc"}}, []string{"ccc", "bb", "a"}, 9},
+		{[][]string{{"a", "bb"}, {"ccc"}, {"dddd"}}, []string{"dddd", "ccc"}, 9},
+		{[][]string{{"too long to fit"}, {"a"}}, []string{"a"}, 2},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%02d", i), func(t *testing.T) {
+			var list []accum
+			for _, a := range test.Elms {
+				list = append(list, load(fn, a...))
+			}
+			a := merge(t, fn, list...)
+			if a.bytes != test.Bytes {
+				t.Errorf("CombineFn(10 bytes; %v) accumulated %v bytes, want %v", test.Elms, a.bytes, test.Bytes)
+			}
+			actual := outputUnmarshal(t, fn, a)
+			if !reflect.DeepEqual(actual, test.Expected) {
+				t.Errorf("CombineFn(10 bytes; %v) = %v, want %v", test.Elms, actual, test.Expected)
+			}
+		})
+	}
+}
+
+// TestLargestWithinBytes checks that the byte budget variants keep the
+// largest and smallest elements that fit.
+func TestLargestWithinBytes(t *testing.T) {
+	less := func(a, b string) bool {
+		return a < b
+	}
+	p, s := beam.NewPipelineWithRoot()
+	col := beam.Create(s, "a", "b", "c", "d", "e")
+	passert.Equals(s, LargestWithinBytes(s, col, 5, less), []string{"e", "d"})
+	passert.Equals(s, SmallestWithinBytes(s, col, 6, less), []string{"a", "b", "c"})
+	keyed := addKey(s, col, 0)
+	passert.Equals(s, beam.DropKey(s, LargestPerKeyWithinBytes(s, keyed, 2, less)), []string{"e"})
+	passert.Equals(s, beam.DropKey(s, SmallestPerKeyWithinBytes(s, keyed, 2, less)), []string{"a"})
+	if err := ptest.Run(p); err != nil {
+		t.Errorf("pipeline failed but should have succeeded, got %v", err)
+	}
+}
@@ -0,0 +1,422 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package watch contains a transform for repeatedly polling a source for new
+// outputs, such as the new files in a directory, the new rows of a database
+// table or the new items of a REST API.
+package watch
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"time"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/graph/mtime"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/sdf"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/util/reflectx"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/register"
+)
+
+func init() {
+	register.DoFn5x2[context.Context, *sdf.ManualWatermarkEstimator, *sdf.LockRTracker, beam.T,
+		func(beam.EventTime, beam.T, beam.X),
+		sdf.ProcessContinuation, error](&watchFn{})
+	register.Emitter3[beam.EventTime, beam.T, beam.X]()
+	beam.RegisterType(reflect.TypeOf(GrowthState{}))
+	beam.RegisterType(reflect.TypeOf(Termination{}))
+}
+
+// Watch repeatedly polls for new outputs of each element of a PCollection<I>,
+// every interval, until the termination condition is reached. The poll
+// function has the signature
+//
+//	func(context.Context, I) ([]O, error)
+//
+// and returns all the current outputs for the input. Outputs are deduplicated
+// by their encoding, so each distinct output is emitted only once per input,
+// however many polls return it. The OutputKey option deduplicates outputs by
+// a key instead, so an output whose content changes under the same key, such
+// as a file whose size grows, isn't emitted again. Watch returns an unbounded
+// PCollection<KV<I,O>> with the new outputs of each poll, timestamped at the
+// time of the poll.
+//
+// For example, to emit the new rows of a table until none appear for an hour:
+//
+//	rows := watch.Watch(s, listRows, time.Minute,
+//		watch.AfterPollsWithoutNewOutput(60), tables)
+//
+// The emitted outputs of each input are recorded in a GrowthState
+// restriction, so the state grows with the number of distinct outputs. A poll
+// error fails the bundle, so the poll is retried by the runner.
+func Watch(s beam.Scope, pollFn any, interval time.Duration, termination Termination, col beam.PCollection, opts ...WatchOptionFn) beam.PCollection {
+	s = s.Scope("watch.Watch")
+
+	in := beam.ValidateNonCompositeType(col)
+	out := validatePollFn(s, pollFn, in.Type())
+	if interval <= 0 {
+		panic(fmt.Sprintf("%v: poll interval must be positive, got %v", s, interval))
+	}
+	option := &watchOption{}
+	for _, opt := range opts {
+		opt(option)
+	}
+	fn := &watchFn{
+		Poll:        beam.EncodedFunc{Fn: reflectx.MakeFunc(pollFn)},
+		Output:      beam.EncodedType{T: out},
+		Interval:    interval,
+		Termination: termination,
+	}
+	if option.OutputKey != nil {
+		validateOutputKeyFn(s, option.OutputKey, out)
+		fn.Key = &beam.EncodedFunc{Fn: reflectx.MakeFunc(option.OutputKey)}
+	}
+	return beam.ParDo(s, fn, col, beam.TypeDefinition{Var: beam.XType, T: out})
+}
+
+type watchOption struct {
+	OutputKey any
+}
+
+// WatchOptionFn is a function that can be passed to Watch to configure options
+// for polling.
+type WatchOptionFn func(*watchOption)
+
+// OutputKey specifies that outputs are deduplicated by the key returned by
+// keyFn, instead of by their encoding. The key function has the signature
+//
+//	func(O) K
+//
+// where K is any encodable type. For example, to emit each file once by its
+// name, however its size changes:
+//
+//	files := watch.Watch(s, listFiles, time.Minute, watch.Never(), dirs,
+//		watch.OutputKey(func(f File) string { return f.Name }))
+func OutputKey(keyFn any) WatchOptionFn {
+	return func(o *watchOption) {
+		o.OutputKey = keyFn
+	}
+}
+
+var (
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// validatePollFn panics if the poll function doesn't have the signature
+// func(context.Context, I) ([]O, error), and returns O.
+func validatePollFn(s beam.Scope, pollFn any, in reflect.Type) reflect.Type {
+	t := reflect.TypeOf(pollFn)
+	if t == nil || t.Kind() != reflect.Func ||
+		t.NumIn() != 2 || t.In(0) != contextType || t.In(1) != in ||
+		t.NumOut() != 2 || t.Out(0).Kind() != reflect.Slice || t.Out(1) != errorType {
+		panic(fmt.Sprintf("%v: poll function must be a func(context.Context, %v) ([]O, error), got %v", s, in, t))
+	}
+	return t.Out(0).Elem()
+}
+
+// validateOutputKeyFn panics if the output key function doesn't have the
+// signature func(O) K.
+func validateOutputKeyFn(s beam.Scope, keyFn any, out reflect.Type) {
+	t := reflect.TypeOf(keyFn)
+	if t == nil || t.Kind() != reflect.Func || t.NumIn() != 1 || t.In(0) != out || t.NumOut() != 1 {
+		panic(fmt.Sprintf("%v: output key function must be a func(%v) K, got %v", s, out, t))
+	}
+}
+
+// Termination is a condition for ending the polling of an input. The zero
+// Termination never ends polling.
+type Termination struct {
+	// MaxPolls is the number of polls after which polling ends, if positive.
+	MaxPolls int64
+	// MaxPollsWithoutNewOutput is the number of consecutive polls without new
+	// outputs after which polling ends, if positive.
+	MaxPollsWithoutNewOutput int64
+	// MaxDuration is the time after the first poll after which polling ends,
+	// if positive.
+	MaxDuration time.Duration
+}
+
+// Never returns a Termination that never ends polling.
+func Never() Termination {
+	return Termination{}
+}
+
+// AfterPolls returns a Termination that ends polling after n polls.
+func AfterPolls(n int64) Termination {
+	return Termination{MaxPolls: n}
+}
+
+// AfterPollsWithoutNewOutput returns a Termination that ends polling once n
+// consecutive polls have returned no new outputs.
+func AfterPollsWithoutNewOutput(n int64) Termination {
+	return Termination{MaxPollsWithoutNewOutput: n}
+}
+
+// AfterTotalOf returns a Termination that ends polling once d has passed
+// since the first poll.
+func AfterTotalOf(d time.Duration) Termination {
+	return Termination{MaxDuration: d}
+}
+
+// EitherOf returns a Termination that ends polling as soon as any of the
+// conditions is reached.
+func EitherOf(conditions ...Termination) Termination {
+	var ret Termination
+	for _, c := range conditions {
+		ret.MaxPolls = minPositive(ret.MaxPolls, c.MaxPolls)
+		ret.MaxPollsWithoutNewOutput = minPositive(ret.MaxPollsWithoutNewOutput, c.MaxPollsWithoutNewOutput)
+		ret.MaxDuration = time.Duration(minPositive(int64(ret.MaxDuration), int64(c.MaxDuration)))
+	}
+	return ret
+}
+
+func minPositive(a, b int64) int64 {
+	if a <= 0 || (b > 0 && b < a) {
+		return b
+	}
+	return a
+}
+
+// reached returns whether polling ends in the state, at the time.
+func (t Termination) reached(st GrowthState, now time.Time) bool {
+	switch {
+	case t.MaxPolls > 0 && st.Polls >= t.MaxPolls:
+		return true
+	case t.MaxPollsWithoutNewOutput > 0 && st.PollsWithoutNewOutput >= t.MaxPollsWithoutNewOutput:
+		return true
+	case t.MaxDuration > 0 && now.Sub(time.UnixMilli(st.FirstPollMillis)) >= t.MaxDuration:
+		return true
+	}
+	return false
+}
+
+// GrowthState is the restriction of Watch. It records the outputs emitted for
+// an input so far, and the polling progress used by the termination
+// condition.
+type GrowthState struct {
+	// Emitted holds the hashes of the encoded outputs, or of their encoded
+	// keys, emitted so far.
+	Emitted []int64
+	// Polls is the number of polls so far.
+	Polls int64
+	// PollsWithoutNewOutput is the number of consecutive polls so far that
+	// returned no new outputs.
+	PollsWithoutNewOutput int64
+	// FirstPollMillis is the time of the first poll, in milliseconds since
+	// the Unix epoch.
+	FirstPollMillis int64
+	// Done indicates that polling has ended.
+	Done bool
+}
+
+// next returns the state after a poll at the time, with the hashes of its new
+// outputs.
+func (st GrowthState) next(fresh []int64, now time.Time) GrowthState {
+	ret := st
+	ret.Emitted = append(append([]int64(nil), st.Emitted...), fresh...)
+	if ret.Polls == 0 {
+		ret.FirstPollMillis = now.UnixMilli()
+	}
+	ret.Polls++
+	if len(fresh) == 0 {
+		ret.PollsWithoutNewOutput++
+	} else {
+		ret.PollsWithoutNewOutput = 0
+	}
+	return ret
+}
+
+// growthTracker tracks a GrowthState. Each poll claims the state following
+// it, and the tracker only splits to checkpoint between polls: the primary
+// is the state so far, marked done, and the residual continues polling from
+// it.
+type growthTracker struct {
+	rest    GrowthState
+	stopped bool
+}
+
+func newGrowthTracker(rest GrowthState) *growthTracker {
+	return &growthTracker{rest: rest}
+}
+
+// TryClaim claims the GrowthState following a poll, and fails if the
+// tracker was checkpointed or polling has ended.
+func (t *growthTracker) TryClaim(pos any) bool {
+	if t.stopped || t.rest.Done {
+		return false
+	}
+	t.rest = pos.(GrowthState)
+	return true
+}
+
+func (t *growthTracker) GetError() error {
+	return nil
+}
+
+// TrySplit checkpoints the tracker at fraction 0, and doesn't split
+// otherwise.
+func (t *growthTracker) TrySplit(fraction float64) (primary, residual any, err error) {
+	if fraction != 0 || t.stopped || t.rest.Done {
+		return t.rest, nil, nil
+	}
+	residual = t.rest
+	t.rest.Done = true
+	t.stopped = true
+	return t.rest, residual, nil
+}
+
+func (t *growthTracker) GetProgress() (done, remaining float64) {
+	if t.rest.Done {
+		return 1, 0
+	}
+	return 0, 1
+}
+
+func (t *growthTracker) IsDone() bool {
+	return t.rest.Done
+}
+
+func (t *growthTracker) GetRestriction() any {
+	return t.rest
+}
+
+func (t *growthTracker) IsBounded() bool {
+	return t.rest.Done
+}
+
+// watchFn is the splittable DoFn polling each input.
+type watchFn struct {
+	// Poll is the poll function.
+	Poll beam.EncodedFunc `json:"poll"`
+	// Output is the output type.
+	Output beam.EncodedType `json:"output"`
+	// Interval is the time between polls.
+	Interval time.Duration `json:"interval"`
+	// Termination is the condition for ending polling.
+	Termination Termination `json:"termination"`
+	// Key is the output key function, if outputs are deduplicated by key.
+	Key *beam.EncodedFunc `json:"key,omitempty"`
+
+	poll reflectx.Func2x2
+	key  reflectx.Func1x1
+	enc  beam.ElementEncoder
+}
+
+func (fn *watchFn) Setup() {
+	fn.poll = reflectx.ToFunc2x2(fn.Poll.Fn)
+	if fn.Key == nil {
+		fn.enc = beam.NewElementEncoder(fn.Output.T)
+		return
+	}
+	fn.key = reflectx.ToFunc1x1(fn.Key.Fn)
+	fn.enc = beam.NewElementEncoder(fn.Key.Fn.Type().Out(0))
+}
+
+func (fn *watchFn) CreateInitialRestriction(_ beam.T) GrowthState {
+	return GrowthState{}
+}
+
+func (fn *watchFn) SplitRestriction(_ beam.T, rest GrowthState) []GrowthState {
+	return []GrowthState{rest}
+}
+
+func (fn *watchFn) RestrictionSize(_ beam.T, rest GrowthState) float64 {
+	if rest.Done {
+		return 0
+	}
+	return 1
+}
+
+func (fn *watchFn) CreateTracker(rest GrowthState) *sdf.LockRTracker {
+	return sdf.NewLockRTracker(newGrowthTracker(rest))
+}
+
+// TruncateRestriction ends polling when the pipeline is drained.
+func (fn *watchFn) TruncateRestriction(_ *sdf.LockRTracker, _ beam.T) GrowthState {
+	return GrowthState{Done: true}
+}
+
+func (fn *watchFn) CreateWatermarkEstimator() *sdf.ManualWatermarkEstimator {
+	return &sdf.ManualWatermarkEstimator{}
+}
+
+func (fn *watchFn) ProcessElement(ctx context.Context, we *sdf.ManualWatermarkEstimator, rt *sdf.LockRTracker, in beam.T, emit func(beam.EventTime, beam.T, beam.X)) (sdf.ProcessContinuation, error) {
+	rest := rt.GetRestriction().(GrowthState)
+	if rest.Done {
+		return sdf.StopProcessing(), nil
+	}
+	now := time.Now()
+	polled, err := fn.poll.Call2x2(ctx, in)
+	if err != nil {
+		return sdf.StopProcessing(), err.(error)
+	}
+
+	seen := make(map[int64]bool, len(rest.Emitted))
+	for _, h := range rest.Emitted {
+		seen[h] = true
+	}
+	var fresh []int64
+	var outs []any
+	outputs := reflect.ValueOf(polled)
+	for i := 0; i < outputs.Len(); i++ {
+		out := outputs.Index(i).Interface()
+		h, err := fn.hash(out)
+		if err != nil {
+			return sdf.StopProcessing(), err
+		}
+		if seen[h] {
+			continue
+		}
+		seen[h] = true
+		fresh = append(fresh, h)
+		outs = append(outs, out)
+	}
+
+	next := rest.next(fresh, now)
+	next.Done = fn.Termination.reached(next, now)
+	if !rt.TryClaim(next) {
+		return sdf.StopProcessing(), rt.GetError()
+	}
+	ts := mtime.FromTime(now)
+	for _, out := range outs {
+		emit(ts, in, out)
+	}
+	if next.Done {
+		we.UpdateWatermark(mtime.MaxTimestamp.ToTime())
+		return sdf.StopProcessing(), nil
+	}
+	we.UpdateWatermark(now)
+	return sdf.ResumeProcessingIn(fn.Interval), nil
+}
+
+// hash returns the hash of the encoded output, or of its encoded key if
+// outputs are deduplicated by key.
+func (fn *watchFn) hash(out any) (int64, error) {
+	v := out
+	if fn.key != nil {
+		v = fn.key.Call1x1(out)
+	}
+	var buf bytes.Buffer
+	if err := fn.enc.Encode(v, &buf); err != nil {
+		return 0, fmt.Errorf("encoding poll output %v: %w", out, err)
+	}
+	h := fnv.New64a()
+	h.Write(buf.Bytes())
+	return int64(h.Sum64()), nil
+}
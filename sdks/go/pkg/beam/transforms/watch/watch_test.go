@@ -0,0 +1,247 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watch
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam"
+	_ "github.com/apache/beam/sdks/v2/go/pkg/beam/runners/prism"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/testing/passert"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/testing/ptest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(ptest.MainRetWithDefault(m, "prism"))
+}
+
+func init() {
+	beam.RegisterFunction(listFiles)
+	beam.RegisterFunction(listGrowing)
+	beam.RegisterFunction(formatKV)
+	beam.RegisterFunction(listResized)
+	beam.RegisterFunction(fileName)
+	beam.RegisterFunction(formatFile)
+	beam.RegisterType(reflect.TypeOf((*file)(nil)).Elem())
+}
+
+// listFiles returns the same files on every poll.
+func listFiles(_ context.Context, dir string) ([]string, error) {
+	return []string{dir + "/a", dir + "/b", dir + "/a"}, nil
+}
+
+// polls counts the calls to listGrowing.
+var polls int64
+
+// listGrowing returns one more row on each poll.
+func listGrowing(_ context.Context, table string) ([]int, error) {
+	n := atomic.AddInt64(&polls, 1)
+	var rows []int
+	for i := 0; i < int(n); i++ {
+		rows = append(rows, i)
+	}
+	return rows, nil
+}
+
+// file is a polled file, whose size changes between polls.
+type file struct {
+	Name string
+	Size int
+}
+
+// resizes counts the calls to listResized.
+var resizes int64
+
+// listResized returns the same file with a larger size on each poll.
+func listResized(_ context.Context, dir string) ([]file, error) {
+	n := atomic.AddInt64(&resizes, 1)
+	return []file{{Name: dir + "/a", Size: int(n)}}, nil
+}
+
+func fileName(f file) string {
+	return f.Name
+}
+
+func formatFile(dir string, f file) string {
+	return fmt.Sprintf("%v:%v:%v", dir, f.Name, f.Size)
+}
+
+func formatKV(k string, v beam.V) string {
+	return fmt.Sprintf("%v:%v", k, v)
+}
+
+func TestWatch(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	files := Watch(s, listFiles, 10*time.Millisecond, AfterPollsWithoutNewOutput(2), beam.Create(s, "x", "y"))
+	passert.Equals(s, beam.ParDo(s, formatKV, files), "x:x/a", "x:x/b", "y:y/a", "y:y/b")
+	ptest.RunAndValidate(t, p)
+}
+
+func TestWatch_Growing(t *testing.T) {
+	atomic.StoreInt64(&polls, 0)
+	p, s := beam.NewPipelineWithRoot()
+	rows := Watch(s, listGrowing, 10*time.Millisecond, AfterPolls(4), beam.Create(s, "t"))
+	passert.Equals(s, beam.ParDo(s, formatKV, rows), "t:0", "t:1", "t:2", "t:3")
+	ptest.RunAndValidate(t, p)
+}
+
+func TestWatch_ChangedOutput(t *testing.T) {
+	atomic.StoreInt64(&resizes, 0)
+	p, s := beam.NewPipelineWithRoot()
+	files := Watch(s, listResized, 10*time.Millisecond, AfterPolls(3), beam.Create(s, "x"))
+	// Outputs are deduplicated by their encoding, so each size is new.
+	passert.Equals(s, beam.ParDo(s, formatFile, files), "x:x/a:1", "x:x/a:2", "x:x/a:3")
+	ptest.RunAndValidate(t, p)
+}
+
+func TestWatch_OutputKey(t *testing.T) {
+	atomic.StoreInt64(&resizes, 0)
+	p, s := beam.NewPipelineWithRoot()
+	files := Watch(s, listResized, 10*time.Millisecond, AfterPolls(3), beam.Create(s, "x"), OutputKey(fileName))
+	// Outputs are deduplicated by name, so only the first size is emitted.
+	passert.Equals(s, beam.ParDo(s, formatFile, files), "x:x/a:1")
+	ptest.RunAndValidate(t, p)
+}
+
+func TestWatch_InvalidOutputKey(t *testing.T) {
+	tests := []any{
+		"not a function",
+		func(string) string { return "" },
+		func(file) {},
+		func(file) (string, error) { return "", nil },
+	}
+	for _, keyFn := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Watch(OutputKey(%T)) didn't panic", keyFn)
+				}
+			}()
+			_, s := beam.NewPipelineWithRoot()
+			Watch(s, listResized, time.Second, Never(), beam.Create(s, "x"), OutputKey(keyFn))
+		}()
+	}
+}
+
+func TestWatch_InvalidPollFn(t *testing.T) {
+	tests := []any{
+		"not a function",
+		func(string) ([]string, error) { return nil, nil },
+		func(context.Context, int) ([]string, error) { return nil, nil },
+		func(context.Context, string) (string, error) { return "", nil },
+		func(context.Context, string) []string { return nil },
+	}
+	for _, pollFn := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Watch(%T) didn't panic", pollFn)
+				}
+			}()
+			_, s := beam.NewPipelineWithRoot()
+			Watch(s, pollFn, time.Second, Never(), beam.Create(s, "x"))
+		}()
+	}
+}
+
+func TestTermination(t *testing.T) {
+	start := time.Unix(1000, 0)
+	st := GrowthState{FirstPollMillis: start.UnixMilli(), Polls: 5, PollsWithoutNewOutput: 2}
+	tests := []struct {
+		name string
+		t    Termination
+		now  time.Time
+		want bool
+	}{
+		{name: "never", t: Never(), now: start.Add(time.Hour), want: false},
+		{name: "polls", t: AfterPolls(5), now: start, want: true},
+		{name: "morePolls", t: AfterPolls(6), now: start, want: false},
+		{name: "withoutNewOutput", t: AfterPollsWithoutNewOutput(2), now: start, want: true},
+		{name: "withNewOutput", t: AfterPollsWithoutNewOutput(3), now: start, want: false},
+		{name: "duration", t: AfterTotalOf(time.Minute), now: start.Add(time.Minute), want: true},
+		{name: "shortDuration", t: AfterTotalOf(time.Minute), now: start.Add(time.Second), want: false},
+		{name: "either", t: EitherOf(AfterPolls(10), AfterTotalOf(time.Second)), now: start.Add(time.Second), want: true},
+		{name: "neither", t: EitherOf(AfterPolls(10), AfterTotalOf(time.Minute)), now: start.Add(time.Second), want: false},
+	}
+	for _, test := range tests {
+		if got := test.t.reached(st, test.now); got != test.want {
+			t.Errorf("%v: reached() = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestEitherOf(t *testing.T) {
+	got := EitherOf(AfterPolls(10), AfterPolls(3), AfterPollsWithoutNewOutput(2), Never())
+	want := Termination{MaxPolls: 3, MaxPollsWithoutNewOutput: 2}
+	if got != want {
+		t.Errorf("EitherOf() = %+v, want %+v", got, want)
+	}
+}
+
+func TestGrowthTracker(t *testing.T) {
+	now := time.Unix(1000, 0)
+	tracker := newGrowthTracker(GrowthState{})
+	first := GrowthState{}.next([]int64{1, 2}, now)
+	if !tracker.TryClaim(first) {
+		t.Fatalf("TryClaim(%+v) failed", first)
+	}
+	if tracker.IsDone() {
+		t.Fatalf("IsDone() after first poll = true, want false")
+	}
+
+	// Checkpointing completes the primary, and the residual resumes polling.
+	primary, residual, err := tracker.TrySplit(0)
+	if err != nil {
+		t.Fatalf("TrySplit(0) failed: %v", err)
+	}
+	wantPrimary := first
+	wantPrimary.Done = true
+	if diff := cmp.Diff(wantPrimary, primary); diff != "" {
+		t.Errorf("TrySplit(0) primary (-want,+got):\n%v", diff)
+	}
+	if diff := cmp.Diff(first, residual); diff != "" {
+		t.Errorf("TrySplit(0) residual (-want,+got):\n%v", diff)
+	}
+	if !tracker.IsDone() {
+		t.Errorf("IsDone() after checkpoint = false, want true")
+	}
+	if tracker.TryClaim(first.next(nil, now)) {
+		t.Errorf("TryClaim() after checkpoint succeeded, want failure")
+	}
+
+	// Polls after the residual continue from its state.
+	resumed := newGrowthTracker(residual.(GrowthState))
+	second := residual.(GrowthState).next(nil, now.Add(time.Second))
+	if !resumed.TryClaim(second) {
+		t.Fatalf("TryClaim(%+v) failed", second)
+	}
+	want := GrowthState{Emitted: []int64{1, 2}, Polls: 2, PollsWithoutNewOutput: 1, FirstPollMillis: now.UnixMilli()}
+	if diff := cmp.Diff(want, resumed.GetRestriction()); diff != "" {
+		t.Errorf("GetRestriction() (-want,+got):\n%v", diff)
+	}
+
+	// Non-checkpoint splits are rejected.
+	if _, residual, _ := resumed.TrySplit(0.5); residual != nil {
+		t.Errorf("TrySplit(0.5) residual = %v, want nil", residual)
+	}
+}
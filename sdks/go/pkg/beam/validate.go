@@ -45,24 +45,24 @@ func ValidateNonCompositeType(col PCollection) typex.FullType {
 
 // validate validates and processes the input collection and options. Private convenience
 // function.
-func validate(s Scope, col PCollection, opts []Option) ([]SideInput, map[string]reflect.Type, error) {
+func validate(s Scope, col PCollection, opts []Option) ([]SideInput, map[string]reflect.Type, *ErrorHandler, error) {
 	if !s.IsValid() {
-		return nil, nil, errors.New("invalid scope")
+		return nil, nil, nil, errors.New("invalid scope")
 	}
 	if !col.IsValid() {
-		return nil, nil, errors.New("invalid main pcollection")
+		return nil, nil, nil, errors.New("invalid main pcollection")
 	}
-	side, defs := parseOpts(opts)
+	side, defs, handler := parseOpts(opts)
 	for i, in := range side {
 		if !in.Input.IsValid() {
-			return nil, nil, errors.Errorf("invalid side pcollection: index %v", i)
+			return nil, nil, nil, errors.Errorf("invalid side pcollection: index %v", i)
 		}
 	}
 	typedefs, err := makeTypedefs(defs)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
-	return side, typedefs, nil
+	return side, typedefs, handler, nil
 }
 
 func makeTypedefs(list []TypeDefinition) (map[string]reflect.Type, error) {
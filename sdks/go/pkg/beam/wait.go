@@ -0,0 +1,76 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package beam
+
+import (
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/typex"
+)
+
+func init() {
+	RegisterFunction(signalFn)
+	RegisterFunction(signalKVFn)
+	RegisterFunction(waitFn)
+	RegisterFunction(waitKVFn)
+}
+
+// WaitOn returns the elements of the main PCollection, held back per window
+// until the corresponding windows of all the signal PCollections are
+// complete. It's used to sequence side effects, such as publishing a marker
+// only once a write has finished:
+//
+//	written := databaseio.Write(s, "mysql", dsn, "orders", nil, orders)
+//	beam.ParDo0(s, publishDoneFn, beam.WaitOn(s, markers, written))
+//
+// Each signal is read as a side input of the main PCollection, so runners
+// only process a main input window once the signal's matching window is
+// ready, as determined by the signal's windowing. As with other side inputs,
+// signals must be globally windowed if the main PCollection is. Signals are
+// reduced to empty PCollections first, so their elements are never
+// materialized. The main PCollection's elements, timestamps and windows are
+// unchanged.
+func WaitOn(s Scope, main PCollection, signals ...PCollection) PCollection {
+	s = s.Scope("beam.WaitOn")
+
+	var wait any = waitFn
+	if typex.IsKV(main.Type()) {
+		wait = waitKVFn
+	}
+	ret := main
+	for _, col := range signals {
+		var signal any = signalFn
+		if typex.IsKV(col.Type()) {
+			signal = signalKVFn
+		}
+		done := ParDo(s, signal, col)
+		ret = ParDo(s, wait, ret, SideInput{Input: done})
+	}
+	return ret
+}
+
+// signalFn drops all elements, leaving an empty PCollection with the
+// windowing of the signal.
+func signalFn(_ T, _ func([]byte)) {}
+
+func signalKVFn(_ X, _ Y, _ func([]byte)) {}
+
+// waitFn passes elements through once the signal side input is ready.
+func waitFn(elm T, _ func(*[]byte) bool) T {
+	return elm
+}
+
+func waitKVFn(k X, v Y, _ func(*[]byte) bool) (X, Y) {
+	return k, v
+}
@@ -0,0 +1,93 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package beam_test
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/apache/beam/sdks/v2/go/pkg/beam"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/core/graph/window"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/runners/prism"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/testing/passert"
+	"github.com/apache/beam/sdks/v2/go/pkg/beam/testing/ptest"
+)
+
+func init() {
+	beam.RegisterFunction(recordWrite)
+	beam.RegisterFunction(countWrites)
+	beam.RegisterFunction(keyByLength)
+	beam.RegisterFunction(formatLength)
+}
+
+// writes counts the elements processed by recordWrite.
+var writes int64
+
+func recordWrite(v int) int {
+	atomic.AddInt64(&writes, 1)
+	return v
+}
+
+// countWrites replaces the element with the number of writes seen so far.
+func countWrites(_ string) int64 {
+	return atomic.LoadInt64(&writes)
+}
+
+func keyByLength(v string) (int, string) {
+	return len(v), v
+}
+
+func formatLength(k int, v string) string {
+	return fmt.Sprintf("%v:%v", k, v)
+}
+
+func TestWaitOn(t *testing.T) {
+	atomic.StoreInt64(&writes, 0)
+	p, s := beam.NewPipelineWithRoot()
+	written := beam.ParDo(s, recordWrite, beam.Create(s, 1, 2, 3))
+	keyed := beam.ParDo(s, keyByLength, beam.Create(s, "a", "bb"))
+	main := beam.Create(s, "done", "also done")
+
+	// All writes have happened once main elements are released.
+	passert.Equals(s, beam.ParDo(s, countWrites, beam.WaitOn(s, main, written)), int64(3), int64(3))
+	// Elements pass through unchanged, including KVs and with several signals.
+	passert.Equals(s, beam.WaitOn(s, main, written, written), "done", "also done")
+	passert.Equals(s, beam.ParDo(s, formatLength, beam.WaitOn(s, keyed, written)), "1:a", "2:bb")
+	passert.Equals(s, beam.WaitOn(s, main), "done", "also done")
+
+	if err := ptest.Run(p); err != nil {
+		t.Errorf("WaitOn() failed: %v", err)
+	}
+}
+
+// TestWaitOn_Windowed runs on Prism, since the direct runner doesn't support
+// side inputs on windowed main inputs.
+func TestWaitOn_Windowed(t *testing.T) {
+	beam.Init()
+	p, s := beam.NewPipelineWithRoot()
+	keyed := beam.ParDo(s, keyByLength, beam.Create(s, "a", "bb"))
+	signal := beam.WindowInto(s, window.NewFixedWindows(time.Minute), keyed)
+	main := beam.WindowInto(s, window.NewFixedWindows(time.Minute), beam.Create(s, "done"))
+	released := beam.WaitOn(s, main, signal)
+	passert.Equals(s, beam.WindowInto(s, window.NewGlobalWindows(), released), "done")
+
+	if _, err := prism.Execute(context.Background(), p); err != nil {
+		t.Errorf("WaitOn() failed: %v", err)
+	}
+}
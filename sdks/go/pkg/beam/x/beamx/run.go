@@ -37,24 +37,15 @@ import (
 	_ "github.com/apache/beam/sdks/v2/go/pkg/beam/runners/universal"
 )
 
-var (
-	runner        = runners.Runner
-	defaultRunner = "direct"
-)
-
-func getRunner() string {
-	r := *runner
-	if r == "" {
-		r = defaultRunner
-	}
-	return r
-}
+var runner = runners.Runner
 
 // Run invokes beam.Run with the runner supplied by the flag "runner". It
-// defaults to the direct runner, but all beam-distributed runners and textio
-// filesystems are implicitly registered.
+// defaults to beam.DefaultRunner, and all beam-distributed runners except
+// Prism and textio filesystems are implicitly registered. Prism runs in the
+// launching process, so it's registered by importing
+// github.com/apache/beam/sdks/v2/go/pkg/beam/runners/prism.
 func Run(ctx context.Context, p *beam.Pipeline) error {
-	_, err := beam.Run(ctx, getRunner(), p)
+	_, err := beam.Run(ctx, *runner, p)
 	return err
 }
 
@@ -62,5 +53,5 @@ func Run(ctx context.Context, p *beam.Pipeline) error {
 // flag "runner". Returns a beam.PipelineResult objects, which can be
 // accessed to query the pipeline's metrics.
 func RunWithMetrics(ctx context.Context, p *beam.Pipeline) (beam.PipelineResult, error) {
-	return beam.Run(ctx, getRunner(), p)
+	return beam.Run(ctx, *runner, p)
 }
@@ -39,6 +39,8 @@ func init() {
 	register.DoFn3x1[state.Provider, string, int, string](&mapStateClearFn{})
 	register.DoFn3x1[state.Provider, string, int, string](&setStateFn{})
 	register.DoFn3x1[state.Provider, string, int, string](&setStateClearFn{})
+	register.Function2x0(pairWithOne)
+	register.Function2x1(sumInts)
 	register.Emitter2[string, int]()
 	register.Combiner1[int](&combine1{})
 	register.Combiner2[string, int](&combine2{})
@@ -46,6 +48,16 @@ func init() {
 	register.Combiner1[int](&combine4{})
 }
 
+// pairWithOne keys each word with a count of one.
+func pairWithOne(w string, emit func(string, int)) {
+	emit(w, 1)
+}
+
+// sumInts is a function used to combine state.
+func sumInts(a, b int) int {
+	return a + b
+}
+
 type valueStateFn struct {
 	State1 state.Value[int]
 	State2 state.Value[string]
@@ -81,9 +93,7 @@ func (f *valueStateFn) ProcessElement(s state.Provider, w string, c int) string
 // ValueStateParDo tests a DoFn that uses value state.
 func ValueStateParDo(s beam.Scope) {
 	in := beam.Create(s, "apple", "pear", "peach", "apple", "apple", "pear")
-	keyed := beam.ParDo(s, func(w string, emit func(string, int)) {
-		emit(w, 1)
-	}, in)
+	keyed := beam.ParDo(s, pairWithOne, in)
 	counts := beam.ParDo(s, &valueStateFn{}, keyed)
 	passert.Equals(s, counts, "apple: 1, I", "pear: 1, I", "peach: 1, I", "apple: 2, II", "apple: 3, III", "pear: 2, II")
 }
@@ -124,9 +134,7 @@ func (f *valueStateClearFn) ProcessElement(s state.Provider, w string, c int) st
 // ValueStateParDoClear tests that a DoFn that uses value state can be cleared.
 func ValueStateParDoClear(s beam.Scope) {
 	in := beam.Create(s, "apple", "pear", "peach", "apple", "apple", "pear", "pear", "apple")
-	keyed := beam.ParDo(s, func(w string, emit func(string, int)) {
-		emit(w, 1)
-	}, in)
+	keyed := beam.ParDo(s, pairWithOne, in)
 	counts := beam.ParDo(s, &valueStateClearFn{State1: state.MakeValueState[int]("key1")}, keyed)
 	passert.Equals(s, counts, "apple: 0,false", "pear: 0,false", "peach: 0,false", "apple: 1,true", "apple: 0,false", "pear: 1,true", "pear: 0,false", "apple: 1,true")
 }
@@ -170,9 +178,7 @@ func (f *bagStateFn) ProcessElement(s state.Provider, w string, c int) string {
 // BagStateParDo tests a DoFn that uses bag state.
 func BagStateParDo(s beam.Scope) {
 	in := beam.Create(s, "apple", "pear", "peach", "apple", "apple", "pear")
-	keyed := beam.ParDo(s, func(w string, emit func(string, int)) {
-		emit(w, 1)
-	}, in)
+	keyed := beam.ParDo(s, pairWithOne, in)
 	counts := beam.ParDo(s, &bagStateFn{}, keyed)
 	passert.Equals(s, counts, "apple: 0, ", "pear: 0, ", "peach: 0, ", "apple: 1, I", "apple: 2, I,I", "pear: 1, I")
 }
@@ -207,9 +213,7 @@ func (f *bagStateClearFn) ProcessElement(s state.Provider, w string, c int) stri
 // BagStateParDoClear tests a DoFn that uses bag state.
 func BagStateParDoClear(s beam.Scope) {
 	in := beam.Create(s, "apple", "pear", "apple", "apple", "pear", "apple", "apple", "pear", "pear", "pear", "apple", "pear")
-	keyed := beam.ParDo(s, func(w string, emit func(string, int)) {
-		emit(w, 1)
-	}, in)
+	keyed := beam.ParDo(s, pairWithOne, in)
 	counts := beam.ParDo(s, &bagStateClearFn{State1: state.MakeBagState[int]("key1")}, keyed)
 	passert.Equals(s, counts, "apple: 0", "pear: 0", "apple: 1", "apple: 2", "pear: 1", "apple: 3", "apple: 0", "pear: 2", "pear: 3", "pear: 0", "apple: 1", "pear: 1")
 }
@@ -315,13 +319,9 @@ func (f *combiningStateFn) ProcessElement(s state.Provider, w string, c int) str
 // CombiningStateParDo tests a DoFn that uses value state.
 func CombiningStateParDo(s beam.Scope) {
 	in := beam.Create(s, "apple", "pear", "peach", "apple", "apple", "pear")
-	keyed := beam.ParDo(s, func(w string, emit func(string, int)) {
-		emit(w, 1)
-	}, in)
+	keyed := beam.ParDo(s, pairWithOne, in)
 	counts := beam.ParDo(s, &combiningStateFn{
-		State0: state.MakeCombiningState[int, int, int]("key0", func(a, b int) int {
-			return a + b
-		}),
+		State0: state.MakeCombiningState[int, int, int]("key0", sumInts),
 		State1: state.Combining[int, int, int](state.MakeCombiningState[int, int, int]("key1", &combine1{})),
 		State2: state.Combining[string, string, int](state.MakeCombiningState[string, string, int]("key2", &combine2{})),
 		State3: state.Combining[string, string, int](state.MakeCombiningState[string, string, int]("key3", &combine3{})),
@@ -369,9 +369,7 @@ func (f *mapStateFn) ProcessElement(s state.Provider, w string, c int) string {
 // MapStateParDo tests a DoFn that uses value state.
 func MapStateParDo(s beam.Scope) {
 	in := beam.Create(s, "apple", "pear", "peach", "apple", "apple", "pear")
-	keyed := beam.ParDo(s, func(w string, emit func(string, int)) {
-		emit(w, 1)
-	}, in)
+	keyed := beam.ParDo(s, pairWithOne, in)
 	counts := beam.ParDo(s, &mapStateFn{State1: state.MakeMapState[string, int]("key1")}, keyed)
 	passert.Equals(s, counts, "apple: 1, keys: [apple apple1]", "pear: 1, keys: [pear pear1]", "peach: 1, keys: [peach peach1]", "apple: 2, keys: [apple apple1 apple2]", "apple: 3, keys: [apple apple1 apple2 apple3]", "pear: 2, keys: [pear pear1 pear2]")
 }
@@ -425,9 +423,7 @@ func (f *mapStateClearFn) ProcessElement(s state.Provider, w string, c int) stri
 // MapStateParDoClear tests clearing and removing from a DoFn that uses map state.
 func MapStateParDoClear(s beam.Scope) {
 	in := beam.Create(s, "apple", "pear", "peach", "apple", "apple", "pear")
-	keyed := beam.ParDo(s, func(w string, emit func(string, int)) {
-		emit(w, 1)
-	}, in)
+	keyed := beam.ParDo(s, pairWithOne, in)
 	counts := beam.ParDo(s, &mapStateClearFn{State1: state.MakeMapState[string, int]("key1")}, keyed)
 	passert.Equals(s, counts, "apple: [apple]", "pear: [pear]", "peach: [peach]", "apple: [apple1 apple2 apple3]", "apple: []", "pear: [pear1 pear2 pear3]")
 }
@@ -465,9 +461,7 @@ func (f *setStateFn) ProcessElement(s state.Provider, w string, c int) string {
 // SetStateParDo tests a DoFn that uses set state.
 func SetStateParDo(s beam.Scope) {
 	in := beam.Create(s, "apple", "pear", "peach", "apple", "apple", "pear")
-	keyed := beam.ParDo(s, func(w string, emit func(string, int)) {
-		emit(w, 1)
-	}, in)
+	keyed := beam.ParDo(s, pairWithOne, in)
 	counts := beam.ParDo(s, &setStateFn{State1: state.MakeSetState[string]("key1")}, keyed)
 	passert.Equals(s, counts, "apple: false, keys: [apple]", "pear: false, keys: [pear]", "peach: false, keys: [peach]", "apple: true, keys: [apple apple1]", "apple: true, keys: [apple apple1]", "pear: true, keys: [pear pear1]")
 }
@@ -521,9 +515,7 @@ func (f *setStateClearFn) ProcessElement(s state.Provider, w string, c int) stri
 // SetStateParDoClear tests clearing and removing from a DoFn that uses set state.
 func SetStateParDoClear(s beam.Scope) {
 	in := beam.Create(s, "apple", "pear", "peach", "apple", "apple", "pear")
-	keyed := beam.ParDo(s, func(w string, emit func(string, int)) {
-		emit(w, 1)
-	}, in)
+	keyed := beam.ParDo(s, pairWithOne, in)
 	counts := beam.ParDo(s, &setStateClearFn{State1: state.MakeSetState[string]("key1")}, keyed)
 	passert.Equals(s, counts, "apple: [apple]", "pear: [pear]", "peach: [peach]", "apple: [apple1 apple2 apple3]", "apple: []", "pear: [pear1 pear2 pear3]")
 }
@@ -87,6 +87,7 @@ include(":playground:backend:containers:go")
 include(":playground:backend:containers:python")
 include(":playground:backend:containers:router")
 include(":playground:backend:containers:scio")
+include(":playground:backend:containers:typescript")
 include(":playground:terraform")
 include(":playground:kafka-emulator")
 